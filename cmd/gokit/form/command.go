@@ -0,0 +1,178 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kdsmith18542/gokit/form/formgen"
+)
+
+// exitFunc is used for testability; defaults to os.Exit but can be overridden in tests
+var exitFunc = os.Exit
+
+// validatePath ensures the path is safe and within allowed directories
+func validatePath(path string) error {
+	// Check for path traversal attempts
+	if strings.Contains(path, "..") {
+		return fmt.Errorf("path traversal not allowed: %s", path)
+	}
+
+	// Ensure path is valid
+	_, err := filepath.Abs(path)
+	if err != nil {
+		return fmt.Errorf("invalid path: %s", path)
+	}
+
+	return nil
+}
+
+// Run executes the form command-line tool.
+// It parses subcommands and arguments to perform form-related operations
+// like generating reflection-free decoders.
+func Run(args []string) {
+	if len(args) < 1 {
+		printFormUsage()
+		exitFunc(1)
+		return
+	}
+
+	subcommand := args[0]
+	subArgs := args[1:]
+
+	switch subcommand {
+	case "gen":
+		generate(subArgs)
+	case "help":
+		printFormUsage()
+	default:
+		fmt.Printf("Unknown form subcommand: %s\n", subcommand)
+		printFormUsage()
+		exitFunc(1)
+		return
+	}
+}
+
+func printFormUsage() {
+	fmt.Println("form - Generate form decoders and validators")
+	fmt.Println()
+	fmt.Println("Usage:")
+	fmt.Println("  gokit form <subcommand> [options]")
+	fmt.Println()
+	fmt.Println("Subcommands:")
+	fmt.Println("  gen     Generate reflection-free decoders for tagged structs")
+	fmt.Println("  help    Show this help message")
+	fmt.Println()
+	fmt.Println("Examples:")
+	fmt.Println("  gokit form gen .")
+	fmt.Println("  gokit form gen ./...")
+	fmt.Println("  gokit form gen ./internal/forms ./api")
+}
+
+// generate writes form_gen.go for each package directory named in args.
+// A directory ending in "/..." includes every package below it.
+func generate(args []string) {
+	fs := flag.NewFlagSet("gen", flag.ExitOnError)
+	quiet := fs.Bool("quiet", false, "Only print errors")
+
+	if err := fs.Parse(args); err != nil {
+		fmt.Printf("Error parsing flags: %v\n", err)
+		fs.Usage()
+		exitFunc(1)
+		return
+	}
+
+	patterns := fs.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"."}
+	}
+
+	var dirs []string
+	for _, pattern := range patterns {
+		root, recursive := strings.CutSuffix(pattern, "/...")
+		if pattern == "..." {
+			root, recursive = ".", true
+		}
+		if err := validatePath(root); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			exitFunc(1)
+			return
+		}
+		if !recursive {
+			dirs = append(dirs, root)
+			continue
+		}
+		found, err := packageDirs(root)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			exitFunc(1)
+			return
+		}
+		dirs = append(dirs, found...)
+	}
+
+	failed := false
+	for _, dir := range dirs {
+		names, err := formgen.GenerateDir(dir)
+		if err != nil {
+			fmt.Printf("Error: %s: %v\n", dir, err)
+			failed = true
+			continue
+		}
+		if len(names) > 0 && !*quiet {
+			fmt.Printf("%s: generated %s for %s\n", filepath.Join(dir, formgen.OutputFile), pluralize(len(names)), strings.Join(names, ", "))
+		}
+	}
+	if failed {
+		exitFunc(1)
+	}
+}
+
+// packageDirs returns root and every directory below it that contains Go files,
+// skipping testdata, vendor and hidden directories as the go tool does
+func packageDirs(root string) ([]string, error) {
+	var dirs []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		name := d.Name()
+		if path != root && (name == "testdata" || name == "vendor" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+			return filepath.SkipDir
+		}
+		if hasGoFiles(path) {
+			dirs = append(dirs, path)
+		}
+		return nil
+	})
+	return dirs, err
+}
+
+// hasGoFiles reports whether dir contains non-test Go source files
+func hasGoFiles(dir string) bool {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() && strings.HasSuffix(name, ".go") && !strings.HasSuffix(name, "_test.go") {
+			return true
+		}
+	}
+	return false
+}
+
+// pluralize describes a count of generated types
+func pluralize(n int) string {
+	if n == 1 {
+		return "1 type"
+	}
+	return fmt.Sprintf("%d types", n)
+}
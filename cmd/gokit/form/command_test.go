@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kdsmith18542/gokit/form/formgen"
+)
+
+var lastExitCode int
+
+func recordExit(code int) { lastExitCode = code }
+
+func TestMain(m *testing.M) {
+	exitFunc = recordExit
+	os.Exit(m.Run())
+}
+
+const contactSource = "package forms\n\ntype Contact struct {\n\tEmail string `form:\"email\" validate:\"required,email\"`\n}\n"
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRun_NoArgs(t *testing.T) {
+	lastExitCode = 0
+	Run([]string{})
+	if lastExitCode != 1 {
+		t.Errorf("Expected exit code 1, got %d", lastExitCode)
+	}
+}
+
+func TestRun_UnknownSubcommand(t *testing.T) {
+	lastExitCode = 0
+	Run([]string{"unknown"})
+	if lastExitCode != 1 {
+		t.Errorf("Expected exit code 1, got %d", lastExitCode)
+	}
+}
+
+func TestRun_Help(t *testing.T) {
+	lastExitCode = 0
+	Run([]string{"help"})
+	if lastExitCode != 0 {
+		t.Errorf("Expected exit code 0, got %d", lastExitCode)
+	}
+}
+
+func TestGenerate_Directory(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "types.go"), contactSource)
+
+	lastExitCode = 0
+	Run([]string{"gen", "--quiet", dir})
+	if lastExitCode != 0 {
+		t.Errorf("Expected exit code 0, got %d", lastExitCode)
+	}
+	if _, err := os.Stat(filepath.Join(dir, formgen.OutputFile)); err != nil {
+		t.Errorf("Expected %s to be generated: %v", formgen.OutputFile, err)
+	}
+}
+
+func TestGenerate_Recursive(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "a", "types.go"), contactSource)
+	writeFile(t, filepath.Join(dir, "a", "b", "types.go"), contactSource)
+	writeFile(t, filepath.Join(dir, "testdata", "types.go"), contactSource)
+	writeFile(t, filepath.Join(dir, ".hidden", "types.go"), contactSource)
+
+	lastExitCode = 0
+	Run([]string{"gen", dir + "/..."})
+	if lastExitCode != 0 {
+		t.Errorf("Expected exit code 0, got %d", lastExitCode)
+	}
+
+	for rel, want := range map[string]bool{
+		"a":        true,
+		"a/b":      true,
+		"testdata": false,
+		".hidden":  false,
+	} {
+		_, err := os.Stat(filepath.Join(dir, rel, formgen.OutputFile))
+		if got := err == nil; got != want {
+			t.Errorf("%s: expected generated=%v, got %v", rel, want, got)
+		}
+	}
+}
+
+func TestGenerate_PathTraversal(t *testing.T) {
+	lastExitCode = 0
+	Run([]string{"gen", "../outside"})
+	if lastExitCode != 1 {
+		t.Errorf("Expected exit code 1, got %d", lastExitCode)
+	}
+}
+
+func TestGenerate_InvalidPackage(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "types.go"), "package forms\n\ntype Broken struct {\n\tWhen Missing `form:\"when\"`\n}\n")
+
+	lastExitCode = 0
+	Run([]string{"gen", dir})
+	if lastExitCode != 1 {
+		t.Errorf("Expected exit code 1, got %d", lastExitCode)
+	}
+}
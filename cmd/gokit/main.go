@@ -5,6 +5,7 @@
 //
 // Features:
 //   - i18n file management (find missing keys, validate locales, extract keys)
+//   - Code generation for reflection-free form decoders
 //   - Extensible command structure for future tools
 //   - Cross-platform compatibility
 //
@@ -15,6 +16,7 @@
 // Commands:
 //
 //	i18n    Manage internationalization files
+//	form    Generate form decoders and validators
 //	help    Show help message
 //
 // Examples:
@@ -28,6 +30,9 @@
 //	# Extract keys from source code
 //	gokit i18n extract --dir=./src --output=./locales
 //
+//	# Generate form decoders for every package in a module
+//	gokit form gen ./...
+//
 // Installation:
 //
 //	go install github.com/kdsmith18542/gokit/cmd/gokit@latest
@@ -37,6 +42,7 @@ import (
 	"fmt"
 	"os"
 
+	formcli "github.com/kdsmith18542/gokit/cmd/gokit/form"
 	cli "github.com/kdsmith18542/gokit/cmd/gokit/i18n"
 )
 
@@ -54,6 +60,8 @@ func main() {
 	switch command {
 	case "i18n":
 		cli.Run(args)
+	case "form":
+		formcli.Run(args)
 	case "help":
 		printUsage()
 	default:
@@ -73,10 +81,12 @@ func printUsage() {
 	fmt.Println()
 	fmt.Println("Commands:")
 	fmt.Println("  i18n    Manage internationalization files")
+	fmt.Println("  form    Generate form decoders and validators")
 	fmt.Println("  help    Show this help message")
 	fmt.Println()
 	fmt.Println("Examples:")
 	fmt.Println("  gokit i18n find-missing --source=en --target=es")
 	fmt.Println("  gokit i18n validate --dir=./locales")
 	fmt.Println("  gokit i18n extract --dir=./src --output=./locales")
+	fmt.Println("  gokit form gen ./...")
 }
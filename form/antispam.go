@@ -0,0 +1,320 @@
+package form
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SpamCode identifies why SpamGuard rejected a submission.
+type SpamCode string
+
+// Rejection codes reported by SpamGuard
+const (
+	// SpamHoneypot means the hidden honeypot field was filled in
+	SpamHoneypot SpamCode = "spam_honeypot"
+	// SpamInvalidToken means the render timestamp was missing, malformed or forged
+	SpamInvalidToken SpamCode = "spam_invalid_token"
+	// SpamTooFast means the form was submitted sooner than MinFillTime after rendering
+	SpamTooFast SpamCode = "spam_too_fast"
+	// SpamExpired means the form was submitted later than MaxFillTime after rendering
+	SpamExpired SpamCode = "spam_expired"
+	// SpamThrottled means the client made more than MaxSubmissions within Window
+	SpamThrottled SpamCode = "spam_throttled"
+)
+
+// Defaults for SpamGuardOptions
+const (
+	DefaultHoneypotField  = "website"
+	DefaultTimestampField = "_form_ts"
+	DefaultMinFillTime    = 2 * time.Second
+	DefaultMaxFillTime    = 2 * time.Hour
+	DefaultThrottleWindow = time.Minute
+)
+
+// SpamRejectionHandler responds to a submission rejected by SpamGuard.
+type SpamRejectionHandler func(w http.ResponseWriter, r *http.Request, code SpamCode)
+
+// ThrottleStore counts submissions for SpamGuard. Implementations backed by a shared
+// store such as Redis let several instances enforce one limit.
+type ThrottleStore interface {
+	// Hit records a submission for key and returns the number of submissions for key in
+	// the current window of the given length, including this one.
+	Hit(ctx context.Context, key string, window time.Duration) (int, error)
+}
+
+// SpamGuardOptions configures a SpamGuard.
+type SpamGuardOptions struct {
+	// Secret signs render timestamps. Instances behind a load balancer must share it;
+	// if empty, a random secret is generated, valid for this process only.
+	Secret []byte
+	// HoneypotField names the hidden field that people leave empty; it defaults to
+	// DefaultHoneypotField. Pick a name bots are tempted to fill in.
+	HoneypotField string
+	// TimestampField names the field holding the signed render timestamp; it defaults
+	// to DefaultTimestampField.
+	TimestampField string
+	// MinFillTime and MaxFillTime bound the time between rendering and submitting a
+	// form. They default to DefaultMinFillTime and DefaultMaxFillTime; a negative value
+	// disables the bound.
+	MinFillTime time.Duration
+	MaxFillTime time.Duration
+	// MaxSubmissions limits the submissions of one client within Window. Zero disables
+	// throttling.
+	MaxSubmissions int
+	// Window is the throttling window; it defaults to DefaultThrottleWindow.
+	Window time.Duration
+	// Store counts submissions; it defaults to an in-memory MemoryThrottleStore.
+	Store ThrottleStore
+	// Fingerprint identifies the client submissions are counted against; it defaults to
+	// the remote IP address. RequestFingerprint also takes request headers into account.
+	Fingerprint func(r *http.Request) string
+	// MaxBodyBytes caps the request body read to check the fields; it defaults to
+	// DefaultMaxBodyBytes.
+	MaxBodyBytes int64
+	// OnReject responds to rejected submissions; it defaults to DefaultSpamRejectionHandler.
+	OnReject SpamRejectionHandler
+}
+
+// SpamGuard protects public forms from bots with a hidden honeypot field, an
+// HMAC-signed render timestamp that enforces a minimum and maximum fill time, and
+// per-client submission throttling. A SpamGuard is safe for concurrent use.
+//
+// Example:
+//
+//	guard := form.NewSpamGuard(form.SpamGuardOptions{
+//	    Secret:         []byte(os.Getenv("FORM_SECRET")),
+//	    MaxSubmissions: 5,
+//	})
+//	mux.Handle("/contact", guard.Middleware(form.ValidationMiddleware(ContactForm{}, nil)(contactHandler)))
+//
+//	// In the template of the form
+//	<form method="post" action="/contact">{{ .SpamFields }} ... </form>
+type SpamGuard struct {
+	opts SpamGuardOptions
+	now  func() time.Time
+}
+
+// NewSpamGuard returns a SpamGuard with the given options, filling in defaults for unset ones.
+func NewSpamGuard(opts SpamGuardOptions) *SpamGuard {
+	if len(opts.Secret) == 0 {
+		opts.Secret = make([]byte, 32)
+		if _, err := rand.Read(opts.Secret); err != nil {
+			panic("form: generating spam guard secret: " + err.Error())
+		}
+	}
+	if opts.HoneypotField == "" {
+		opts.HoneypotField = DefaultHoneypotField
+	}
+	if opts.TimestampField == "" {
+		opts.TimestampField = DefaultTimestampField
+	}
+	if opts.MinFillTime == 0 {
+		opts.MinFillTime = DefaultMinFillTime
+	}
+	if opts.MaxFillTime == 0 {
+		opts.MaxFillTime = DefaultMaxFillTime
+	}
+	if opts.Window <= 0 {
+		opts.Window = DefaultThrottleWindow
+	}
+	if opts.Store == nil {
+		opts.Store = NewMemoryThrottleStore()
+	}
+	if opts.Fingerprint == nil {
+		opts.Fingerprint = remoteIP
+	}
+	if opts.MaxBodyBytes <= 0 {
+		opts.MaxBodyBytes = DefaultMaxBodyBytes
+	}
+	if opts.OnReject == nil {
+		opts.OnReject = DefaultSpamRejectionHandler
+	}
+	return &SpamGuard{opts: opts, now: time.Now}
+}
+
+// Token returns a signed render timestamp for the current time. Fields includes it
+// already; use Token for clients that build requests themselves.
+func (g *SpamGuard) Token() string {
+	ts := strconv.FormatInt(g.now().Unix(), 10)
+	return ts + "." + g.sign(ts)
+}
+
+// Fields returns the hidden honeypot field and the signed render timestamp, to be
+// placed inside the form. The honeypot is moved off screen rather than hidden with
+// type="hidden", which bots recognize, and is skipped by keyboard navigation and
+// screen readers.
+func (g *SpamGuard) Fields() template.HTML {
+	return template.HTML(`<div style="position:absolute;left:-10000px" aria-hidden="true">` +
+		`<input type="text" name="` + template.HTMLEscapeString(g.opts.HoneypotField) + `" value="" tabindex="-1" autocomplete="off">` +
+		`</div>` +
+		`<input type="hidden" name="` + template.HTMLEscapeString(g.opts.TimestampField) + `" value="` + g.Token() + `">`) // #nosec G203 -- names are escaped and the token is digits and base64
+}
+
+// Middleware returns middleware that checks submissions before passing them on.
+// Requests with safe methods such as GET pass through unchecked. The guard's fields are
+// removed from the parsed form afterwards, so they never reach strict decoders.
+//
+// The fields are read from the query string and urlencoded or multipart bodies; JSON
+// clients can send them in the query string.
+func (g *SpamGuard) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if err := parseRequestForm(r, g.opts.MaxBodyBytes); err != nil {
+			http.Error(w, parseFailure(err, "Failed to parse form data"), http.StatusBadRequest)
+			return
+		}
+		if code, ok := g.check(r); !ok {
+			g.opts.OnReject(w, r, code)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// check runs the checks of a parsed request in order of cost and reports the first failure
+func (g *SpamGuard) check(r *http.Request) (SpamCode, bool) {
+	honeypot := takeFormValue(r, g.opts.HoneypotField)
+	token := takeFormValue(r, g.opts.TimestampField)
+
+	if honeypot != "" {
+		return SpamHoneypot, false
+	}
+	if code, ok := g.checkToken(token); !ok {
+		return code, false
+	}
+	if g.opts.MaxSubmissions > 0 {
+		// Fail open when the store is unavailable rather than rejecting everyone
+		count, err := g.opts.Store.Hit(r.Context(), g.opts.Fingerprint(r), g.opts.Window)
+		if err == nil && count > g.opts.MaxSubmissions {
+			return SpamThrottled, false
+		}
+	}
+	return "", true
+}
+
+// checkToken verifies a render timestamp and the time since it was issued
+func (g *SpamGuard) checkToken(token string) (SpamCode, bool) {
+	ts, signature, found := strings.Cut(token, ".")
+	if !found || !hmac.Equal([]byte(signature), []byte(g.sign(ts))) {
+		return SpamInvalidToken, false
+	}
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return SpamInvalidToken, false
+	}
+
+	elapsed := g.now().Sub(time.Unix(unix, 0))
+	switch {
+	case g.opts.MinFillTime > 0 && elapsed < g.opts.MinFillTime:
+		return SpamTooFast, false
+	case g.opts.MaxFillTime > 0 && elapsed > g.opts.MaxFillTime:
+		return SpamExpired, false
+	}
+	return "", true
+}
+
+// sign returns the HMAC of a timestamp
+func (g *SpamGuard) sign(ts string) string {
+	mac := hmac.New(sha256.New, g.opts.Secret)
+	mac.Write([]byte(ts))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// DefaultSpamRejectionHandler responds with a JSON body holding the rejection code, with
+// status 429 Too Many Requests for throttled clients and 403 Forbidden otherwise:
+//
+//	{"error": "Submission rejected", "code": "spam_too_fast"}
+func DefaultSpamRejectionHandler(w http.ResponseWriter, r *http.Request, code SpamCode) {
+	status := http.StatusForbidden
+	if code == SpamThrottled {
+		status = http.StatusTooManyRequests
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	response := struct {
+		Error string   `json:"error"`
+		Code  SpamCode `json:"code"`
+	}{
+		Error: "Submission rejected",
+		Code:  code,
+	}
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
+
+// RequestFingerprint identifies a client by its remote IP address together with its
+// User-Agent and Accept-Language headers, so that clients sharing an address, such as
+// those behind a NAT, are throttled separately.
+func RequestFingerprint(r *http.Request) string {
+	sum := sha256.Sum256([]byte(remoteIP(r) + "\x00" + r.UserAgent() + "\x00" + r.Header.Get("Accept-Language")))
+	return hex.EncodeToString(sum[:16])
+}
+
+// MemoryThrottleStore is an in-memory ThrottleStore counting submissions in fixed
+// windows. It is the default store of SpamGuard; use a shared store when running
+// several instances. It tracks at most 10000 clients, dropping the window closing first
+// when a new client arrives.
+type MemoryThrottleStore struct {
+	mu      sync.Mutex
+	windows map[string]throttleWindow
+	now     func() time.Time
+}
+
+// throttleWindow is the submission count of one key in its current window
+type throttleWindow struct {
+	count int
+	reset time.Time
+}
+
+// NewMemoryThrottleStore returns an empty MemoryThrottleStore.
+func NewMemoryThrottleStore() *MemoryThrottleStore {
+	return &MemoryThrottleStore{windows: make(map[string]throttleWindow), now: time.Now}
+}
+
+// Hit implements ThrottleStore.
+func (s *MemoryThrottleStore) Hit(ctx context.Context, key string, window time.Duration) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	w, ok := s.windows[key]
+	if !ok || !now.Before(w.reset) {
+		if !ok && len(s.windows) >= maxRateLimitClients {
+			var oldest string
+			for k, other := range s.windows {
+				if !now.Before(other.reset) {
+					delete(s.windows, k)
+				} else if oldest == "" || other.reset.Before(s.windows[oldest].reset) {
+					oldest = k
+				}
+			}
+			// Then the window closing first, so that many clients can't grow the map
+			// without bound
+			if len(s.windows) >= maxRateLimitClients {
+				delete(s.windows, oldest)
+			}
+		}
+		w = throttleWindow{reset: now.Add(window)}
+	}
+	w.count++
+	s.windows[key] = w
+	return w.count, nil
+}
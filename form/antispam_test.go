@@ -0,0 +1,220 @@
+package form
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+type spamForm struct {
+	Name    string `form:"name" validate:"required"`
+	Message string `form:"message" validate:"required"`
+}
+
+// newTestSpamGuard returns a guard with a controllable clock and a handler behind it
+// that decodes spamForm strictly
+func newTestSpamGuard(opts SpamGuardOptions) (*SpamGuard, *time.Time, http.Handler) {
+	opts.Secret = []byte("test secret")
+	g := NewSpamGuard(opts)
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	g.now = func() time.Time { return now }
+
+	decoder := NewDecoder(DecoderOptions{Strict: true})
+	handler := g.Middleware(decoder.ValidationMiddleware(spamForm{}, nil)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})))
+	return g, &now, handler
+}
+
+// submitSpamForm posts values to handler and returns the recorded response
+func submitSpamForm(handler http.Handler, values url.Values) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/contact", strings.NewReader(values.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+// spamCode returns the rejection code of a response from DefaultSpamRejectionHandler
+func spamCode(rec *httptest.ResponseRecorder) SpamCode {
+	var response struct {
+		Code SpamCode `json:"code"`
+	}
+	json.Unmarshal(rec.Body.Bytes(), &response)
+	return response.Code
+}
+
+func TestSpamGuardChecks(t *testing.T) {
+	g, now, handler := newTestSpamGuard(SpamGuardOptions{})
+	token := g.Token()
+	valid := url.Values{"name": {"Ada"}, "message": {"Hello"}, DefaultTimestampField: {token}, DefaultHoneypotField: {""}}
+
+	*now = now.Add(5 * time.Second)
+	// The guard's fields are removed, so the strict decoder doesn't reject them
+	if rec := submitSpamForm(handler, valid); rec.Code != http.StatusNoContent {
+		t.Fatalf("Expected a valid submission to pass, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	testCases := []struct {
+		name   string
+		change func(url.Values)
+		after  time.Duration
+		code   SpamCode
+	}{
+		{"honeypot", func(v url.Values) { v.Set(DefaultHoneypotField, "http://spam.example") }, 0, SpamHoneypot},
+		{"missing token", func(v url.Values) { v.Del(DefaultTimestampField) }, 0, SpamInvalidToken},
+		{"forged token", func(v url.Values) { v.Set(DefaultTimestampField, "1777636800.forged") }, 0, SpamInvalidToken},
+		{"altered timestamp", func(v url.Values) { v.Set(DefaultTimestampField, "1"+token) }, 0, SpamInvalidToken},
+		{"too fast", func(v url.Values) { v.Set(DefaultTimestampField, g.Token()) }, 0, SpamTooFast},
+		{"expired", func(v url.Values) {}, 3 * time.Hour, SpamExpired},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			values := url.Values{}
+			for key, vs := range valid {
+				values[key] = vs
+			}
+			tc.change(values)
+			*now = now.Add(tc.after)
+			defer func() { *now = now.Add(-tc.after) }()
+
+			rec := submitSpamForm(handler, values)
+			if rec.Code != http.StatusForbidden || spamCode(rec) != tc.code {
+				t.Errorf("Expected 403 with %q, got %d: %s", tc.code, rec.Code, rec.Body.String())
+			}
+		})
+	}
+
+	// Safe methods aren't checked
+	rec := httptest.NewRecorder()
+	g.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/contact", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected GET to pass, got %d", rec.Code)
+	}
+}
+
+func TestSpamGuardMultipart(t *testing.T) {
+	g, now, handler := newTestSpamGuard(SpamGuardOptions{})
+	token := g.Token()
+	*now = now.Add(time.Minute)
+
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+	writer.WriteField("name", "Ada")
+	writer.WriteField("message", "Hello")
+	writer.WriteField(DefaultTimestampField, token)
+	writer.Close()
+	req := httptest.NewRequest(http.MethodPost, "/contact", &buf)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("Expected a multipart submission to pass, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestSpamGuardThrottle(t *testing.T) {
+	store := NewMemoryThrottleStore()
+	g, now, handler := newTestSpamGuard(SpamGuardOptions{MaxSubmissions: 2, Window: time.Minute, Store: store})
+	store.now = g.now
+	values := url.Values{"name": {"Ada"}, "message": {"Hello"}, DefaultTimestampField: {g.Token()}}
+	*now = now.Add(5 * time.Second)
+
+	for i := 0; i < 2; i++ {
+		if rec := submitSpamForm(handler, values); rec.Code != http.StatusNoContent {
+			t.Fatalf("Expected submission %d to pass, got %d", i, rec.Code)
+		}
+	}
+	rec := submitSpamForm(handler, values)
+	if rec.Code != http.StatusTooManyRequests || spamCode(rec) != SpamThrottled {
+		t.Errorf("Expected 429 with %q, got %d: %s", SpamThrottled, rec.Code, rec.Body.String())
+	}
+
+	*now = now.Add(time.Minute)
+	if rec := submitSpamForm(handler, values); rec.Code != http.StatusNoContent {
+		t.Errorf("Expected a new window to pass, got %d", rec.Code)
+	}
+}
+
+func TestMemoryThrottleStoreCap(t *testing.T) {
+	store := NewMemoryThrottleStore()
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+	for i := 0; i < maxRateLimitClients; i++ {
+		store.windows[strconv.Itoa(i)] = throttleWindow{count: 1, reset: now.Add(time.Duration(i+1) * time.Second)}
+	}
+	if count, _ := store.Hit(context.Background(), "new", time.Minute); count != 1 || len(store.windows) > maxRateLimitClients {
+		t.Errorf("Expected at most %d keys, got %d", maxRateLimitClients, len(store.windows))
+	}
+	if _, ok := store.windows["0"]; ok {
+		t.Error("Expected the window closing first to be evicted")
+	}
+}
+
+// failingStore is a ThrottleStore that is always unavailable
+type failingStore struct{}
+
+func (failingStore) Hit(ctx context.Context, key string, window time.Duration) (int, error) {
+	return 0, errors.New("unavailable")
+}
+
+func TestSpamGuardOptions(t *testing.T) {
+	var rejected SpamCode
+	g, now, handler := newTestSpamGuard(SpamGuardOptions{
+		HoneypotField:  "url",
+		TimestampField: "rendered",
+		MinFillTime:    -1,
+		MaxSubmissions: 1,
+		Store:          failingStore{},
+		OnReject: func(w http.ResponseWriter, r *http.Request, code SpamCode) {
+			rejected = code
+			w.WriteHeader(http.StatusOK)
+		},
+	})
+
+	fields := string(g.Fields())
+	for _, want := range []string{`name="url"`, `name="rendered"`, `value="` + g.Token() + `"`, `tabindex="-1"`} {
+		if !strings.Contains(fields, want) {
+			t.Errorf("Expected fields to contain %s, got %s", want, fields)
+		}
+	}
+
+	// No minimum fill time, and the throttle fails open when its store is unavailable
+	values := url.Values{"name": {"Ada"}, "message": {"Hello"}, "rendered": {g.Token()}}
+	for i := 0; i < 2; i++ {
+		if rec := submitSpamForm(handler, values); rec.Code != http.StatusNoContent {
+			t.Fatalf("Expected submission %d to pass, got %d", i, rec.Code)
+		}
+	}
+
+	values.Set("url", "x")
+	*now = now.Add(time.Second)
+	if rec := submitSpamForm(handler, values); rec.Code != http.StatusOK || rejected != SpamHoneypot {
+		t.Errorf("Expected the custom handler to get %q, got %d and %q", SpamHoneypot, rec.Code, rejected)
+	}
+}
+
+func TestRequestFingerprint(t *testing.T) {
+	a := httptest.NewRequest(http.MethodPost, "/", nil)
+	a.Header.Set("User-Agent", "agent-a")
+	b := httptest.NewRequest(http.MethodPost, "/", nil)
+	b.Header.Set("User-Agent", "agent-b")
+
+	if RequestFingerprint(a) == RequestFingerprint(b) {
+		t.Error("Expected different user agents at one address to have different fingerprints")
+	}
+	if RequestFingerprint(a) != RequestFingerprint(a.Clone(context.Background())) {
+		t.Error("Expected fingerprints to be stable")
+	}
+}
@@ -0,0 +1,314 @@
+package form
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultMaxRecordBytes is the longest NDJSON line accepted when BatchOptions leaves
+// MaxRecordBytes unset
+const DefaultMaxRecordBytes = 1 << 20
+
+// ErrTooManyInvalidRows is returned by the batch validators when more rows than
+// BatchOptions.MaxInvalid fail validation.
+var ErrTooManyInvalidRows = errors.New("form: too many invalid rows")
+
+// BatchOptions configures ValidateCSV, ValidateJSONArray and ValidateNDJSON.
+type BatchOptions struct {
+	// OnValid is called with the number and decoded record of each valid row; the record
+	// is a pointer to a new struct of the row type. Returning an error stops validation.
+	OnValid func(row int, record interface{}) error
+	// OnInvalid is called with the number and errors of each invalid row, for example
+	// BatchErrorReport.Add. Returning an error stops validation.
+	OnInvalid func(row int, errs ValidationErrors) error
+	// MaxInvalid stops validation with ErrTooManyInvalidRows once more rows than this are
+	// invalid. Zero means no limit.
+	MaxInvalid int
+	// Comma is the CSV field delimiter; it defaults to ','.
+	Comma rune
+	// MaxRecordBytes caps the length of an NDJSON line; it defaults to DefaultMaxRecordBytes.
+	MaxRecordBytes int
+	// Decoder sets the field limits and strictness applied to each row; it defaults to the
+	// package's default decoder. Its body limit doesn't apply.
+	Decoder *Decoder
+}
+
+// BatchResult counts the rows read by a batch validator.
+type BatchResult struct {
+	Rows    int
+	Valid   int
+	Invalid int
+}
+
+// batch validates the records of one input, one at a time
+type batch struct {
+	ctx      context.Context
+	opts     BatchOptions
+	typ      reflect.Type
+	tags     tagNames
+	formName string
+	result   BatchResult
+}
+
+// newBatch checks the row struct and fills in option defaults
+func newBatch(ctx context.Context, rowStruct interface{}, opts BatchOptions, fromJSON bool) (*batch, error) {
+	typ := reflect.TypeOf(rowStruct)
+	for typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("form: batch rows must be structs, got %T", rowStruct)
+	}
+	if opts.Decoder == nil {
+		opts.Decoder = defaultDecoder
+	}
+	if opts.Comma == 0 {
+		opts.Comma = ','
+	}
+	if opts.MaxRecordBytes <= 0 {
+		opts.MaxRecordBytes = DefaultMaxRecordBytes
+	}
+	tags := opts.Decoder.tags
+	if fromJSON {
+		tags = opts.Decoder.jsonTags
+	}
+	if problems := referenceErrors(planWithTags(typ, tags)); len(problems) > 0 {
+		return nil, fmt.Errorf("form: %s", strings.Join(problems, "; "))
+	}
+	return &batch{ctx: ctx, opts: opts, typ: typ, tags: tags, formName: typ.Name()}, nil
+}
+
+// validate decodes and validates one row and passes it to the callbacks. rowErrors,
+// if set, are reported instead, for rows that couldn't be read into form data.
+func (b *batch) validate(row int, formData map[string][]string, rowErrors ValidationErrors) error {
+	if err := b.ctx.Err(); err != nil {
+		return err
+	}
+	b.result.Rows++
+
+	v := reflect.New(b.typ)
+	errs := rowErrors
+	if errs == nil {
+		start := time.Now()
+		if obs := getObserver(); obs != nil {
+			obs.OnDecodeStart(b.ctx, b.formName)
+		}
+		ctx := trackRules(b.ctx)
+		errs = b.opts.Decoder.decodeAndValidateFields(ctx, v.Interface(), v.Elem(), formData, b.formName, b.tags)
+		handleFormObservability(ctx, b.formName, errs, start)
+	}
+
+	if len(errs) > 0 {
+		b.result.Invalid++
+		if b.opts.OnInvalid != nil {
+			if err := b.opts.OnInvalid(row, errs); err != nil {
+				return err
+			}
+		}
+		if b.opts.MaxInvalid > 0 && b.result.Invalid > b.opts.MaxInvalid {
+			return ErrTooManyInvalidRows
+		}
+		return nil
+	}
+	b.result.Valid++
+	if b.opts.OnValid != nil {
+		return b.opts.OnValid(row, v.Interface())
+	}
+	return nil
+}
+
+// ValidateCSV validates each row of a CSV file against rowStruct, a struct or pointer to
+// one, reading one row at a time so that memory use doesn't grow with the file. The
+// header row names the columns by form key; nested fields are named "address.city" and
+// collections repeat their column or use "tags[0]", "tags[1]". Unknown columns are
+// ignored unless the decoder is strict. Empty cells count as absent, so defaults apply.
+//
+// Rows are numbered as in a spreadsheet, the header being row 1. Rows with the wrong
+// number of cells are reported as invalid under "_form". It returns the row counts,
+// and an error if the file can't be read, a callback fails, the context is canceled or
+// MaxInvalid is exceeded.
+//
+// Example:
+//
+//	report := form.NewBatchErrorReport(errorsFile, &CustomerRow{})
+//	result, err := form.ValidateCSV(ctx, file, &CustomerRow{}, form.BatchOptions{
+//	    OnValid: func(row int, record interface{}) error {
+//	        return store.Insert(ctx, record.(*CustomerRow))
+//	    },
+//	    OnInvalid: report.Add,
+//	})
+//	report.Flush()
+func ValidateCSV(ctx context.Context, r io.Reader, rowStruct interface{}, opts BatchOptions) (BatchResult, error) {
+	b, err := newBatch(ctx, rowStruct, opts, false)
+	if err != nil {
+		return BatchResult{}, err
+	}
+
+	reader := csv.NewReader(r)
+	reader.Comma = b.opts.Comma
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return b.result, nil
+	}
+	if err != nil {
+		return b.result, fmt.Errorf("form: reading CSV header: %w", err)
+	}
+	columns := make([]string, len(header))
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		columns[i] = strings.TrimSpace(name)
+	}
+
+	for row := 2; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return b.result, nil
+		}
+		if err != nil {
+			return b.result, fmt.Errorf("form: reading CSV row %d: %w", row, err)
+		}
+
+		var rowErrors ValidationErrors
+		if len(record) != len(columns) {
+			rowErrors = ValidationErrors{FormErrorKey: {fmt.Sprintf("Expected %d columns, got %d", len(columns), len(record))}}
+		}
+		formData := make(map[string][]string, len(columns))
+		for i, value := range record {
+			if i < len(columns) && value != "" {
+				formData[columns[i]] = append(formData[columns[i]], value)
+			}
+		}
+		if err := b.validate(row, formData, rowErrors); err != nil {
+			return b.result, err
+		}
+	}
+}
+
+// ValidateJSONArray validates each object of a JSON array against rowStruct, like
+// ValidateCSV, reading one element at a time. Keys are read from the decoder's JSONTag
+// tags. Rows are numbered from 1; elements that aren't objects are reported as invalid
+// under "_json".
+func ValidateJSONArray(ctx context.Context, r io.Reader, rowStruct interface{}, opts BatchOptions) (BatchResult, error) {
+	b, err := newBatch(ctx, rowStruct, opts, true)
+	if err != nil {
+		return BatchResult{}, err
+	}
+
+	decoder := json.NewDecoder(r)
+	if token, err := decoder.Token(); err != nil || token != json.Delim('[') {
+		return b.result, errors.New("form: batch input must be a JSON array")
+	}
+	for row := 1; decoder.More(); row++ {
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			return b.result, fmt.Errorf("form: reading JSON row %d: %w", row, err)
+		}
+		if err := b.validateJSON(row, raw); err != nil {
+			return b.result, err
+		}
+	}
+	if _, err := decoder.Token(); err != nil {
+		return b.result, fmt.Errorf("form: reading JSON array: %w", err)
+	}
+	return b.result, nil
+}
+
+// ValidateNDJSON validates newline-delimited JSON objects against rowStruct, like
+// ValidateJSONArray. Rows are numbered by line and blank lines are skipped. Malformed
+// lines are reported as invalid under "_json"; lines longer than MaxRecordBytes stop
+// validation with an error.
+func ValidateNDJSON(ctx context.Context, r io.Reader, rowStruct interface{}, opts BatchOptions) (BatchResult, error) {
+	b, err := newBatch(ctx, rowStruct, opts, true)
+	if err != nil {
+		return BatchResult{}, err
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, min(64<<10, b.opts.MaxRecordBytes)), b.opts.MaxRecordBytes)
+	row := 0
+	for scanner.Scan() {
+		row++
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		if err := b.validateJSON(row, line); err != nil {
+			return b.result, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return b.result, fmt.Errorf("form: reading NDJSON line %d: %w", row+1, err)
+	}
+	return b.result, nil
+}
+
+// validateJSON validates one JSON record, reporting records that aren't objects as invalid
+func (b *batch) validateJSON(row int, raw []byte) error {
+	var object map[string]interface{}
+	if err := json.Unmarshal(raw, &object); err != nil || object == nil {
+		return b.validate(row, nil, ValidationErrors{"_json": {"Each row must be a JSON object"}})
+	}
+	formData := make(map[string][]string, len(object))
+	for key, value := range object {
+		addFormValue(formData, key, value)
+	}
+	return b.validate(row, formData, nil)
+}
+
+// BatchErrorReport writes the errors of invalid rows as CSV with the columns row, field
+// and error, one line per message, so that they can be opened next to the imported file.
+type BatchErrorReport struct {
+	w      *csv.Writer
+	typ    reflect.Type
+	header bool
+}
+
+// NewBatchErrorReport returns a report writing to w. Each row's errors are listed in the
+// field order of rowStruct.
+func NewBatchErrorReport(w io.Writer, rowStruct interface{}) *BatchErrorReport {
+	typ := reflect.TypeOf(rowStruct)
+	for typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return &BatchErrorReport{w: csv.NewWriter(w), typ: typ}
+}
+
+// Add writes the errors of one row. Its signature matches BatchOptions.OnInvalid.
+func (r *BatchErrorReport) Add(row int, errs ValidationErrors) error {
+	if !r.header {
+		r.header = true
+		if err := r.w.Write([]string{"row", "field", "error"}); err != nil {
+			return err
+		}
+	}
+	number := strconv.Itoa(row)
+	for _, field := range errs.orderedByType(r.typ, defaultTags) {
+		for _, message := range field.Messages {
+			if err := r.w.Write([]string{number, field.Field, message}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Flush writes any buffered lines and returns the first write error.
+func (r *BatchErrorReport) Flush() error {
+	r.w.Flush()
+	return r.w.Error()
+}
@@ -0,0 +1,151 @@
+package form
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type customerRow struct {
+	Email   string   `form:"email" json:"email" sanitize:"trim,to_lower" validate:"required,email"`
+	Name    string   `form:"name" json:"name" validate:"required"`
+	Age     int      `form:"age" json:"age" validate:"omitempty,min=18"`
+	Country string   `form:"country" json:"country" default:"GB"`
+	Tags    []string `form:"tags" json:"tags" validate:"dive,alpha"`
+}
+
+// collectBatch returns options that record valid rows and the errors of invalid ones
+func collectBatch(valid *[]customerRow, invalid map[int]ValidationErrors) BatchOptions {
+	return BatchOptions{
+		OnValid: func(row int, record interface{}) error {
+			*valid = append(*valid, *record.(*customerRow))
+			return nil
+		},
+		OnInvalid: func(row int, errs ValidationErrors) error {
+			invalid[row] = errs
+			return nil
+		},
+	}
+}
+
+func TestValidateCSV(t *testing.T) {
+	input := "\ufeffemail, name ,age,country,tags,tags,notes\n" +
+		" Ada@Example.com ,Ada,36,,math,poetry,first\n" +
+		"nope,,12,FR,,,\n" +
+		"grace@example.com,Grace\n" +
+		"alan@example.com,Alan,,US,cs1,,\n"
+
+	var valid []customerRow
+	invalid := make(map[int]ValidationErrors)
+	result, err := ValidateCSV(context.Background(), strings.NewReader(input), &customerRow{}, collectBatch(&valid, invalid))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result != (BatchResult{Rows: 4, Valid: 1, Invalid: 3}) {
+		t.Errorf("Expected 1 valid and 3 invalid rows, got %+v", result)
+	}
+
+	expected := []customerRow{{Email: "ada@example.com", Name: "Ada", Age: 36, Country: "GB", Tags: []string{"math", "poetry"}}}
+	if !reflect.DeepEqual(valid, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, valid)
+	}
+	if errs := invalid[3]; len(errs["email"]) == 0 || len(errs["name"]) == 0 || len(errs["age"]) == 0 {
+		t.Errorf("Expected errors for row 3's columns, got %v", errs)
+	}
+	if errs := invalid[4]; len(errs[FormErrorKey]) == 0 {
+		t.Errorf("Expected a column count error for row 4, got %v", errs)
+	}
+	if errs := invalid[5]; len(errs["tags[0]"]) == 0 {
+		t.Errorf("Expected an element error for row 5, got %v", errs)
+	}
+}
+
+func TestValidateJSONBatches(t *testing.T) {
+	for name, tc := range map[string]struct {
+		validate func(context.Context, *strings.Reader, interface{}, BatchOptions) (BatchResult, error)
+		input    string
+		badRow   int
+	}{
+		"array": {
+			validate: func(ctx context.Context, r *strings.Reader, v interface{}, opts BatchOptions) (BatchResult, error) {
+				return ValidateJSONArray(ctx, r, v, opts)
+			},
+			input:  `[{"email":"ada@example.com","name":"Ada","tags":["math"]}, {"email":"x"}, 42]`,
+			badRow: 3,
+		},
+		"ndjson": {
+			validate: func(ctx context.Context, r *strings.Reader, v interface{}, opts BatchOptions) (BatchResult, error) {
+				return ValidateNDJSON(ctx, r, v, opts)
+			},
+			input:  "{\"email\":\"ada@example.com\",\"name\":\"Ada\",\"tags\":[\"math\"]}\n{\"email\":\"x\"}\n\n{broken\n",
+			badRow: 4,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var valid []customerRow
+			invalid := make(map[int]ValidationErrors)
+			result, err := tc.validate(context.Background(), strings.NewReader(tc.input), &customerRow{}, collectBatch(&valid, invalid))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result != (BatchResult{Rows: 3, Valid: 1, Invalid: 2}) {
+				t.Errorf("Expected 1 valid and 2 invalid rows, got %+v", result)
+			}
+			if len(valid) != 1 || valid[0].Name != "Ada" || valid[0].Tags[0] != "math" {
+				t.Errorf("Expected Ada's record, got %+v", valid)
+			}
+			if len(invalid[2]["email"]) == 0 || len(invalid[tc.badRow]["_json"]) == 0 {
+				t.Errorf("Expected field and JSON errors, got %v", invalid)
+			}
+		})
+	}
+
+	if _, err := ValidateJSONArray(context.Background(), strings.NewReader(`{"email":"x"}`), &customerRow{}, BatchOptions{}); err == nil {
+		t.Error("Expected an error for input that isn't an array")
+	}
+	if _, err := ValidateNDJSON(context.Background(), strings.NewReader(`{"name":"`+strings.Repeat("a", 100)+`"}`), &customerRow{}, BatchOptions{MaxRecordBytes: 50}); err == nil {
+		t.Error("Expected an error for an overlong line")
+	}
+}
+
+func TestBatchStops(t *testing.T) {
+	input := "email,name\nx,\ny,\nz,\n"
+
+	result, err := ValidateCSV(context.Background(), strings.NewReader(input), customerRow{}, BatchOptions{MaxInvalid: 1})
+	if !errors.Is(err, ErrTooManyInvalidRows) || result.Rows != 2 {
+		t.Errorf("Expected to stop after the second invalid row, got %+v %v", result, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := ValidateCSV(ctx, strings.NewReader(input), customerRow{}, BatchOptions{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the canceled context's error, got %v", err)
+	}
+
+	if _, err := ValidateCSV(context.Background(), strings.NewReader(input), "row", BatchOptions{}); err == nil {
+		t.Error("Expected an error for a row type that isn't a struct")
+	}
+}
+
+func TestBatchErrorReport(t *testing.T) {
+	var out bytes.Buffer
+	report := NewBatchErrorReport(&out, &customerRow{})
+	input := "name,email,age\n,nope,12\nAda,ada@example.com,40\n"
+	if _, err := ValidateCSV(context.Background(), strings.NewReader(input), &customerRow{}, BatchOptions{OnInvalid: report.Add}); err != nil {
+		t.Fatal(err)
+	}
+	if err := report.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "row,field,error\n" +
+		"2,email,Invalid email format\n" +
+		"2,name,This field is required\n" +
+		"2,age,Must be at least 18\n"
+	if out.String() != expected {
+		t.Errorf("Expected report:\n%s\ngot:\n%s", expected, out.String())
+	}
+}
@@ -0,0 +1,230 @@
+package form
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// collection holds the sanitized elements bound to a slice or map field.
+// For maps, keys holds the map keys in sorted order, parallel to values.
+type collection struct {
+	keys   []string
+	values []string
+}
+
+// isCollectionType reports whether a field type is bound as a slice or map of scalars
+func isCollectionType(typ reflect.Type) bool {
+	switch typ.Kind() {
+	case reflect.Slice:
+		return typ.Elem().Kind() != reflect.Uint8 && isScalarKind(typ.Elem().Kind())
+	case reflect.Map:
+		return typ.Key().Kind() == reflect.String && isScalarKind(typ.Elem().Kind())
+	}
+	return false
+}
+
+// isScalarKind reports whether setFieldValue can bind a value of the given kind
+func isScalarKind(kind reflect.Kind) bool {
+	return kind == reflect.String || kind == reflect.Bool || isNumericType(kind)
+}
+
+// sliceValues gathers the raw values for a slice field.
+// Values may be submitted as repeated keys (tags=a&tags=b), with a trailing
+// "[]" (tags[]=a&tags[]=b) or with explicit indexes (tags[0]=a&tags[1]=b).
+func sliceValues(formData map[string][]string, key string) []string {
+	if values, exists := formData[key+"[]"]; exists {
+		return values
+	}
+
+	prefix := key + "["
+	indexed := make(map[int]string)
+	for name, values := range formData {
+		if !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, "]") || len(values) == 0 {
+			continue
+		}
+		if index, err := strconv.Atoi(name[len(prefix) : len(name)-1]); err == nil && index >= 0 {
+			indexed[index] = values[0]
+		}
+	}
+	if len(indexed) > 0 {
+		indexes := make([]int, 0, len(indexed))
+		for index := range indexed {
+			indexes = append(indexes, index)
+		}
+		sort.Ints(indexes)
+		values := make([]string, len(indexes))
+		for i, index := range indexes {
+			values[i] = indexed[index]
+		}
+		return values
+	}
+
+	return formData[key]
+}
+
+// mapValues gathers the raw entries for a map field submitted as attrs[color]=red
+// or, for flattened JSON objects, attrs.color=red
+func mapValues(formData map[string][]string, key string) *collection {
+	entries := make(map[string]string)
+	for name, values := range formData {
+		if len(values) == 0 {
+			continue
+		}
+		if strings.HasPrefix(name, key+"[") && strings.HasSuffix(name, "]") {
+			entries[name[len(key)+1:len(name)-1]] = values[0]
+		} else if strings.HasPrefix(name, key+".") {
+			entries[name[len(key)+1:]] = values[0]
+		}
+	}
+
+	c := &collection{keys: make([]string, 0, len(entries))}
+	for k := range entries {
+		c.keys = append(c.keys, k)
+	}
+	sort.Strings(c.keys)
+	for _, k := range c.keys {
+		c.values = append(c.values, entries[k])
+	}
+	return c
+}
+
+// collectFieldValues gathers and sanitizes the input for a slice or map field.
+// The first sanitizer error is returned and stops processing.
+func collectFieldValues(formData map[string][]string, key, sanitizeTag string, isMap bool) (*collection, error) {
+	var c *collection
+	if isMap {
+		c = mapValues(formData, key)
+	} else {
+		c = &collection{values: append([]string{}, sliceValues(formData, key)...)}
+	}
+
+	if sanitizeTag != "" {
+		for i, value := range c.values {
+			sanitized, err := applySanitizers(value, sanitizeTag)
+			if err != nil {
+				return c, err
+			}
+			c.values[i] = sanitized
+		}
+	}
+	return c, nil
+}
+
+// setCollectionValue binds sanitized collection input to a slice or map field
+func setCollectionValue(field reflect.Value, c *collection) {
+	typ := field.Type()
+	if typ.Kind() == reflect.Map {
+		m := reflect.MakeMapWithSize(typ, len(c.keys))
+		for i, key := range c.keys {
+			elem := reflect.New(typ.Elem()).Elem()
+			setFieldValue(elem, c.values[i])
+			m.SetMapIndex(reflect.ValueOf(key).Convert(typ.Key()), elem)
+		}
+		field.Set(m)
+		return
+	}
+
+	s := reflect.MakeSlice(typ, len(c.values), len(c.values))
+	for i, value := range c.values {
+		setFieldValue(s.Index(i), value)
+	}
+	field.Set(s)
+}
+
+// splitDiveRules splits a validate tag into rules for the collection itself,
+// rules for each element (after "dive") and rules for map keys (between "keys" and "endkeys")
+func splitDiveRules(validateTag string) (collectionRules, elementRules, keyRules []string) {
+	dived, inKeys := false, false
+	for _, rule := range strings.Split(validateTag, ",") {
+		rule = strings.TrimSpace(rule)
+		switch {
+		case rule == "":
+		case !dived && rule == "dive":
+			dived = true
+		case !dived:
+			collectionRules = append(collectionRules, rule)
+		case rule == "keys" && !inKeys && len(elementRules) == 0 && len(keyRules) == 0:
+			inKeys = true
+		case rule == "endkeys" && inKeys:
+			inKeys = false
+		case inKeys:
+			keyRules = append(keyRules, rule)
+		default:
+			elementRules = append(elementRules, rule)
+		}
+	}
+	return collectionRules, elementRules, keyRules
+}
+
+// validateCollection validates a slice or map field. Rules before "dive" apply to the
+// collection as a whole and errors are reported under key; element and key errors are
+// reported per element as key[index] for slices or key[mapkey] for maps.
+func validateCollection(key string, c *collection, validateTag string, context ValidationContext, elemKind reflect.Kind) ValidationErrors {
+	errors := make(ValidationErrors)
+	collectionRules, elementRules, keyRules := splitDiveRules(validateTag)
+	if len(c.values) == 0 && hasOmitEmpty(collectionRules) {
+		return errors
+	}
+
+	for _, rule := range collectionRules {
+		name, param, _ := strings.Cut(rule, "=")
+		var errorMsg string
+		switch name {
+		case "omitempty":
+		case "required":
+			if len(c.values) == 0 {
+				errorMsg = ErrFieldRequired
+			}
+		case "min":
+			if minItems, err := strconv.Atoi(param); err == nil && len(c.values) < minItems {
+				errorMsg = fmt.Sprintf("Must contain at least %d items", minItems)
+			}
+		case "max":
+			if maxItems, err := strconv.Atoi(param); err == nil && len(c.values) > maxItems {
+				errorMsg = fmt.Sprintf("Must contain no more than %d items", maxItems)
+			}
+		case "unique":
+			seen := make(map[string]bool, len(c.values))
+			for _, value := range c.values {
+				if seen[value] {
+					errorMsg = ErrMustBeUnique
+					break
+				}
+				seen[value] = true
+			}
+		default:
+			// Other rules see the elements joined, as cross-field validators do
+			if fieldErrors := validateFieldWithContext(strings.Join(c.values, ","), rule, context); len(fieldErrors) > 0 {
+				errors[key] = append(errors[key], fieldErrors...)
+			}
+		}
+		if errorMsg != "" {
+			errors[key] = append(errors[key], errorMsg)
+			context.failures.add(key, name)
+		}
+	}
+
+	elementTag := strings.Join(elementRules, ",")
+	keyTag := strings.Join(keyRules, ",")
+	for i, value := range c.values {
+		elementKey := fmt.Sprintf("%s[%d]", key, i)
+		var elementErrors []string
+		if c.keys != nil {
+			elementKey = fmt.Sprintf("%s[%s]", key, c.keys[i])
+			if keyTag != "" {
+				elementErrors = append(elementErrors, validateFieldWithContext(c.keys[i], keyTag, context)...)
+			}
+		}
+		if elementTag != "" {
+			elementErrors = append(elementErrors, validateFieldWithContext(value, elementTag, context, elemKind)...)
+		}
+		if len(elementErrors) > 0 {
+			errors[elementKey] = elementErrors
+		}
+	}
+
+	return errors
+}
@@ -0,0 +1,135 @@
+package form
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func newValuesRequest(values url.Values) *http.Request {
+	req, _ := http.NewRequest("POST", "/test", strings.NewReader(values.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return req
+}
+
+type InviteForm struct {
+	Invitees []string          `form:"invitees" sanitize:"trim,to_lower" validate:"min=1,max=3,unique,dive,email"`
+	Scores   []int             `form:"scores" validate:"dive,min=1,max=10"`
+	Labels   map[string]string `form:"labels" validate:"dive,keys,alpha,endkeys,required"`
+}
+
+func TestCollectionBinding(t *testing.T) {
+	values := url.Values{}
+	values.Add("invitees", " Ann@Example.com ")
+	values.Add("invitees", "bob@example.com")
+	values.Set("scores[1]", "7")
+	values.Set("scores[0]", "3")
+	values.Set("labels[team]", "core")
+	values.Set("labels[env]", "prod")
+
+	var f InviteForm
+	errs := DecodeAndValidate(newValuesRequest(values), &f)
+	if len(errs) > 0 {
+		t.Fatalf("Expected no validation errors, got: %v", errs)
+	}
+
+	if !reflect.DeepEqual(f.Invitees, []string{"ann@example.com", "bob@example.com"}) {
+		t.Errorf("Expected sanitized invitees, got %v", f.Invitees)
+	}
+	if !reflect.DeepEqual(f.Scores, []int{3, 7}) {
+		t.Errorf("Expected scores [3 7], got %v", f.Scores)
+	}
+	if !reflect.DeepEqual(f.Labels, map[string]string{"team": "core", "env": "prod"}) {
+		t.Errorf("Expected labels to be bound, got %v", f.Labels)
+	}
+}
+
+func TestCollectionDiveErrors(t *testing.T) {
+	values := url.Values{}
+	values.Add("invitees[]", "ann@example.com")
+	values.Add("invitees[]", "not-an-email")
+	values.Add("invitees[]", "ann@example.com")
+	values.Add("invitees[]", "dan@example.com")
+	values.Add("scores", "5")
+	values.Add("scores", "11")
+	values.Set("labels[team1]", "core")
+	values.Set("labels[env]", "")
+
+	var f InviteForm
+	errs := DecodeAndValidate(newValuesRequest(values), &f)
+
+	expected := ValidationErrors{
+		"invitees":      {"Must contain no more than 3 items", ErrMustBeUnique},
+		"invitees[1]":   {ErrInvalidEmail},
+		"scores[1]":     {"Must be no more than 10"},
+		"labels[team1]": {ErrMustBeAlpha},
+		"labels[env]":   {ErrFieldRequired},
+	}
+	if !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected %v, got %v", expected, errs)
+	}
+}
+
+func TestCollectionRequired(t *testing.T) {
+	type TagsForm struct {
+		Tags []string `form:"tags" validate:"required,min=2"`
+	}
+
+	var f TagsForm
+	errs := DecodeAndValidate(newValuesRequest(url.Values{}), &f)
+	expected := []string{ErrFieldRequired, "Must contain at least 2 items"}
+	if !reflect.DeepEqual(errs["tags"], expected) {
+		t.Errorf("Expected %v, got %v", expected, errs["tags"])
+	}
+}
+
+func TestSplitDiveRules(t *testing.T) {
+	collectionRules, elementRules, keyRules := splitDiveRules("min=1,max=10,dive,keys,alpha,endkeys,required,email")
+	if !reflect.DeepEqual(collectionRules, []string{"min=1", "max=10"}) {
+		t.Errorf("Unexpected collection rules: %v", collectionRules)
+	}
+	if !reflect.DeepEqual(elementRules, []string{"required", "email"}) {
+		t.Errorf("Unexpected element rules: %v", elementRules)
+	}
+	if !reflect.DeepEqual(keyRules, []string{"alpha"}) {
+		t.Errorf("Unexpected key rules: %v", keyRules)
+	}
+}
+
+func TestCollectionJSON(t *testing.T) {
+	jsonData := `{"invitees":["ann@example.com","bad"],"scores":[],"labels":{"team":"core"}}`
+
+	var f InviteForm
+	errs := DecodeAndValidateJSON(context.Background(), strings.NewReader(jsonData), &f)
+
+	expected := ValidationErrors{"invitees[1]": {ErrInvalidEmail}}
+	if !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected %v, got %v", expected, errs)
+	}
+	if len(f.Scores) != 0 {
+		t.Errorf("Expected empty scores, got %v", f.Scores)
+	}
+	if f.Labels["team"] != "core" {
+		t.Errorf("Expected labels to be bound from JSON object, got %v", f.Labels)
+	}
+}
+
+func TestCollectionJSONKeepsScalarArrays(t *testing.T) {
+	type TagsForm struct {
+		Tags string `form:"tags"`
+	}
+
+	var f TagsForm
+	errs := DecodeAndValidateMap(context.Background(), map[string]interface{}{
+		"tags": []interface{}{"a", "b"},
+	}, &f)
+	if len(errs) > 0 {
+		t.Fatalf("Expected no validation errors, got: %v", errs)
+	}
+	if f.Tags != "a,b" {
+		t.Errorf("Expected array joined into string field, got '%s'", f.Tags)
+	}
+}
@@ -0,0 +1,236 @@
+package form
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+// envSource is the ConfigError source reported for environment variables
+const envSource = "environment"
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// ConfigError is returned by the config loaders when configuration can't be read or
+// decoded, or when it fails validation.
+type ConfigError struct {
+	// Source is the config file path, or "environment" for environment variables.
+	Source string
+	// Err is the read or decode failure, if any.
+	Err error
+	// Errors holds the validation errors by field, using dotted keys for nested structs.
+	Errors ValidationErrors
+}
+
+// Error describes the failure, listing validation errors in key order.
+func (e *ConfigError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("config %s: %v", e.Source, e.Err)
+	}
+
+	keys := make([]string, 0, len(e.Errors))
+	for key := range e.Errors {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	problems := make([]string, len(keys))
+	for i, key := range keys {
+		problems[i] = key + ": " + strings.Join(e.Errors[key], ", ")
+	}
+	return fmt.Sprintf("config %s: invalid: %s", e.Source, strings.Join(problems, "; "))
+}
+
+// Unwrap returns the read or decode failure, if any.
+func (e *ConfigError) Unwrap() error {
+	return e.Err
+}
+
+// LoadTOML decodes a TOML file into v, applies environment overrides from env tags,
+// then sanitizes and validates the result as ValidateStruct does.
+// It returns a *ConfigError if the file can't be decoded or the config is invalid.
+//
+// Example:
+//
+//	type Config struct {
+//	    Host string `toml:"host" env:"APP_HOST" sanitize:"trim" validate:"required"`
+//	    Port int    `toml:"port" env:"APP_PORT" validate:"min=1,max=65535"`
+//	}
+//
+//	var cfg Config
+//	if err := form.LoadTOML(ctx, "config.toml", &cfg); err != nil {
+//	    log.Fatal(err)
+//	}
+func LoadTOML(ctx context.Context, path string, v interface{}) error {
+	data, err := os.ReadFile(filepath.Clean(path)) // #nosec G304 -- config path is chosen by the application, not by request input
+	if err != nil {
+		return &ConfigError{Source: path, Err: err}
+	}
+	if err := toml.Unmarshal(data, v); err != nil {
+		return &ConfigError{Source: path, Err: err}
+	}
+	return finishConfig(ctx, path, v)
+}
+
+// LoadJSON decodes a JSON file into v, applies environment overrides from env tags,
+// then sanitizes and validates the result as ValidateStruct does.
+// It returns a *ConfigError if the file can't be decoded or the config is invalid.
+func LoadJSON(ctx context.Context, path string, v interface{}) error {
+	data, err := os.ReadFile(filepath.Clean(path)) // #nosec G304 -- config path is chosen by the application, not by request input
+	if err != nil {
+		return &ConfigError{Source: path, Err: err}
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return &ConfigError{Source: path, Err: err}
+	}
+	return finishConfig(ctx, path, v)
+}
+
+// LoadEnv sets the fields of v that have an env tag from environment variables, then
+// sanitizes and validates the result as ValidateStruct does. Fields whose variable
+// isn't set keep their current value, so defaults can be assigned beforehand.
+//
+// Numbers, booleans and time.Duration values are parsed strictly; slices are read
+// from comma-separated lists. It returns a *ConfigError if a variable can't be parsed
+// or the config is invalid.
+//
+// Example:
+//
+//	cfg := Config{Host: "localhost", Port: 8080}
+//	if err := form.LoadEnv(ctx, &cfg); err != nil {
+//	    log.Fatal(err)
+//	}
+func LoadEnv(ctx context.Context, v interface{}) error {
+	return finishConfig(ctx, envSource, v)
+}
+
+// finishConfig applies environment overrides to a decoded config, then sanitizes and validates it
+func finishConfig(ctx context.Context, source string, v interface{}) error {
+	val := reflect.ValueOf(v)
+	if val.Kind() != reflect.Ptr || val.IsNil() || val.Elem().Kind() != reflect.Struct {
+		return &ConfigError{Source: source, Err: fmt.Errorf("target must be a non-nil pointer to struct")}
+	}
+
+	if errs := applyEnv(val.Elem(), planFor(val.Elem().Type()), ""); len(errs) > 0 {
+		return &ConfigError{Source: envSource, Errors: errs}
+	}
+
+	if errs := validateStruct(ctx, v, true); len(errs) > 0 {
+		return &ConfigError{Source: source, Errors: errs}
+	}
+	return nil
+}
+
+// applyEnv sets fields with env tags from environment variables, recursing into nested
+// structs. Errors are reported under the field's dotted form key.
+func applyEnv(val reflect.Value, plan *structPlan, prefix string) ValidationErrors {
+	errors := make(ValidationErrors)
+
+	for _, f := range plan.fields {
+		if !f.exported {
+			continue
+		}
+		field := val.Field(f.index)
+		key := prefix + f.key
+
+		if name := plan.typ.Field(f.index).Tag.Get("env"); name != "" {
+			if raw, ok := os.LookupEnv(name); ok {
+				if msg := setEnvValue(field, raw); msg != "" {
+					errors[key] = []string{fmt.Sprintf("%s (from %s)", msg, name)}
+				}
+			}
+			continue
+		}
+
+		switch {
+		case f.nested == nil:
+		case !f.pointer:
+			errors.Merge(applyEnv(field, plan.nested(f), key+"."))
+		case field.IsNil():
+			// Only allocate a nested struct if the environment sets one of its fields
+			nested := reflect.New(f.nested)
+			if errs := applyEnv(nested.Elem(), plan.nested(f), key+"."); len(errs) > 0 {
+				errors.Merge(errs)
+			} else if !nested.Elem().IsZero() {
+				field.Set(nested)
+			}
+		default:
+			errors.Merge(applyEnv(field.Elem(), plan.nested(f), key+"."))
+		}
+	}
+
+	return errors
+}
+
+// setEnvValue parses an environment variable into a field. Unlike form input, which is
+// bound leniently, config values must parse; the returned message describes the failure.
+func setEnvValue(field reflect.Value, raw string) string {
+	if field.Kind() == reflect.Slice && isCollectionType(field.Type()) {
+		parts := strings.Split(raw, ",")
+		s := reflect.MakeSlice(field.Type(), 0, len(parts))
+		for _, part := range parts {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+			elem := reflect.New(field.Type().Elem()).Elem()
+			if msg := setEnvValue(elem, part); msg != "" {
+				return msg
+			}
+			s = reflect.Append(s, elem)
+		}
+		field.Set(s)
+		return ""
+	}
+
+	raw = strings.TrimSpace(raw)
+	if field.Type() == durationType {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return ErrMustBeDuration
+		}
+		field.SetInt(int64(d))
+		return ""
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(raw)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, field.Type().Bits())
+		if err != nil {
+			return ErrMustBeNumber
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 10, field.Type().Bits())
+		if err != nil {
+			return ErrMustBeNumber
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(raw, field.Type().Bits())
+		if err != nil {
+			return ErrMustBeNumber
+		}
+		field.SetFloat(n)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return ErrMustBeBool
+		}
+		field.SetBool(b)
+	default:
+		return fmt.Sprintf("Unsupported type %s", field.Type())
+	}
+	return ""
+}
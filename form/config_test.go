@@ -0,0 +1,185 @@
+package form
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type DatabaseConfig struct {
+	URL      string `toml:"url" json:"url" form:"url" env:"TEST_APP_DB_URL" sanitize:"trim" validate:"required"`
+	MaxConns int    `toml:"max_conns" json:"max_conns" form:"max_conns" validate:"min=1,max=100"`
+}
+
+type AppConfig struct {
+	Host     string         `toml:"host" json:"host" env:"TEST_APP_HOST" sanitize:"trim,to_lower" validate:"required"`
+	Port     int            `toml:"port" json:"port" env:"TEST_APP_PORT" validate:"min=1,max=65535"`
+	Debug    bool           `toml:"debug" json:"debug" env:"TEST_APP_DEBUG"`
+	Timeout  time.Duration  `toml:"timeout" json:"timeout" env:"TEST_APP_TIMEOUT"`
+	Origins  []string       `toml:"origins" json:"origins" env:"TEST_APP_ORIGINS" validate:"dive,url"`
+	Database DatabaseConfig `toml:"database" json:"database" form:"database"`
+}
+
+func writeConfig(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadTOML(t *testing.T) {
+	path := writeConfig(t, "app.toml", `
+host = "  API.Example.com "
+port = 8080
+timeout = "5s"
+origins = ["https://example.com"]
+
+[database]
+url = " postgres://db.internal/app "
+max_conns = 20
+`)
+
+	var cfg AppConfig
+	if err := LoadTOML(context.Background(), path, &cfg); err != nil {
+		t.Fatalf("LoadTOML failed: %v", err)
+	}
+	if cfg.Host != "api.example.com" || cfg.Database.URL != "postgres://db.internal/app" {
+		t.Errorf("Expected sanitized values, got host %q and database url %q", cfg.Host, cfg.Database.URL)
+	}
+	if cfg.Timeout != 5*time.Second {
+		t.Errorf("Expected timeout 5s, got %v", cfg.Timeout)
+	}
+}
+
+func TestLoadTOMLInvalid(t *testing.T) {
+	path := writeConfig(t, "app.toml", `
+host = ""
+port = 70000
+
+[database]
+max_conns = 0
+`)
+
+	var cfg AppConfig
+	err := LoadTOML(context.Background(), path, &cfg)
+
+	var configErr *ConfigError
+	if !errors.As(err, &configErr) {
+		t.Fatalf("Expected *ConfigError, got %v", err)
+	}
+	expected := ValidationErrors{
+		"host":               {ErrFieldRequired},
+		"port":               {"Must be no more than 65535"},
+		"database.url":       {ErrFieldRequired},
+		"database.max_conns": {"Must be at least 1"},
+	}
+	if configErr.Source != path || !reflect.DeepEqual(configErr.Errors, expected) {
+		t.Errorf("Expected errors %v from %s, got %v from %s", expected, path, configErr.Errors, configErr.Source)
+	}
+	if !strings.Contains(err.Error(), "database.max_conns: Must be at least 1; database.url: "+ErrFieldRequired) {
+		t.Errorf("Expected sorted problems in message, got %q", err.Error())
+	}
+}
+
+func TestLoadTOMLDecodeError(t *testing.T) {
+	path := writeConfig(t, "app.toml", `port = "not a number"`)
+
+	var cfg AppConfig
+	err := LoadTOML(context.Background(), path, &cfg)
+
+	var configErr *ConfigError
+	if !errors.As(err, &configErr) || configErr.Err == nil {
+		t.Fatalf("Expected *ConfigError wrapping a decode error, got %v", err)
+	}
+
+	if err := LoadTOML(context.Background(), filepath.Join(t.TempDir(), "missing.toml"), &cfg); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Expected missing file error, got %v", err)
+	}
+}
+
+func TestLoadJSON(t *testing.T) {
+	t.Setenv("TEST_APP_PORT", "9090")
+	path := writeConfig(t, "app.json", `{"host":"localhost","port":8080,"database":{"url":"postgres://localhost/app","max_conns":5}}`)
+
+	var cfg AppConfig
+	if err := LoadJSON(context.Background(), path, &cfg); err != nil {
+		t.Fatalf("LoadJSON failed: %v", err)
+	}
+	if cfg.Port != 9090 {
+		t.Errorf("Expected environment to override port, got %d", cfg.Port)
+	}
+}
+
+func TestLoadEnv(t *testing.T) {
+	t.Setenv("TEST_APP_HOST", " Example.org")
+	t.Setenv("TEST_APP_DEBUG", "true")
+	t.Setenv("TEST_APP_TIMEOUT", "1m30s")
+	t.Setenv("TEST_APP_ORIGINS", "https://a.example, https://b.example")
+	t.Setenv("TEST_APP_DB_URL", "postgres://db/app")
+
+	cfg := AppConfig{Port: 8080, Database: DatabaseConfig{MaxConns: 10}}
+	if err := LoadEnv(context.Background(), &cfg); err != nil {
+		t.Fatalf("LoadEnv failed: %v", err)
+	}
+
+	expected := AppConfig{
+		Host:     "example.org",
+		Port:     8080,
+		Debug:    true,
+		Timeout:  90 * time.Second,
+		Origins:  []string{"https://a.example", "https://b.example"},
+		Database: DatabaseConfig{URL: "postgres://db/app", MaxConns: 10},
+	}
+	if !reflect.DeepEqual(cfg, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, cfg)
+	}
+}
+
+func TestLoadEnvParseErrors(t *testing.T) {
+	t.Setenv("TEST_APP_HOST", "example.org")
+	t.Setenv("TEST_APP_PORT", "eighty")
+	t.Setenv("TEST_APP_DEBUG", "sometimes")
+	t.Setenv("TEST_APP_TIMEOUT", "5")
+
+	var cfg AppConfig
+	err := LoadEnv(context.Background(), &cfg)
+
+	var configErr *ConfigError
+	if !errors.As(err, &configErr) {
+		t.Fatalf("Expected *ConfigError, got %v", err)
+	}
+	expected := ValidationErrors{
+		"port":    {ErrMustBeNumber + " (from TEST_APP_PORT)"},
+		"debug":   {ErrMustBeBool + " (from TEST_APP_DEBUG)"},
+		"timeout": {ErrMustBeDuration + " (from TEST_APP_TIMEOUT)"},
+	}
+	if configErr.Source != "environment" || !reflect.DeepEqual(configErr.Errors, expected) {
+		t.Errorf("Expected %v from environment, got %v from %s", expected, configErr.Errors, configErr.Source)
+	}
+}
+
+func TestLoadEnvFormKeys(t *testing.T) {
+	type Config struct {
+		Name   string `form:"name,omitempty" env:"TEST_APP_NAME" validate:"max=3"`
+		Secret string `form:"-" env:"TEST_APP_SECRET"`
+	}
+	t.Setenv("TEST_APP_NAME", "toolong")
+	t.Setenv("TEST_APP_SECRET", "s3cret")
+
+	var cfg Config
+	err := LoadEnv(context.Background(), &cfg)
+	var configErr *ConfigError
+	if !errors.As(err, &configErr) || len(configErr.Errors["name"]) != 1 {
+		t.Errorf("Expected an error under the key before the comma, got %v", err)
+	}
+	if cfg.Secret != "" {
+		t.Errorf("Expected fields tagged form:\"-\" to be skipped, got %q", cfg.Secret)
+	}
+}
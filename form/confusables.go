@@ -0,0 +1,139 @@
+package form
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+//go:generate go run ./internal/confusablesgen -input internal/confusablesgen/confusables.txt -output confusables_table.go
+
+// Skeleton returns the UTS #39 skeleton of s: two strings that look alike have the same
+// skeleton, so "pаypal" with a Cyrillic "а" and "paypal" both map to "paypal".
+// Store skeletons alongside usernames to reject lookalikes of existing accounts.
+func Skeleton(s string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(s) {
+		if prototype, ok := confusables[r]; ok {
+			b.WriteString(prototype)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return norm.NFD.String(b.String())
+}
+
+// commonScripts are checked first when looking up a rune's script
+var commonScripts = []string{"Latin", "Common", "Inherited", "Cyrillic", "Greek", "Han", "Hiragana", "Katakana", "Hangul", "Arabic", "Hebrew"}
+
+// allowedScriptSets lists the script combinations that are normal in a single word,
+// following the "highly restrictive" level of UTS #39
+var allowedScriptSets = []map[string]bool{
+	{"Latin": true, "Han": true, "Hiragana": true, "Katakana": true},
+	{"Latin": true, "Han": true, "Bopomofo": true},
+	{"Latin": true, "Han": true, "Hangul": true},
+}
+
+// scriptOf returns the name of the Unicode script r belongs to, or "" if unknown
+func scriptOf(r rune) string {
+	if r < utf8.RuneSelf {
+		if unicode.IsLetter(r) {
+			return "Latin"
+		}
+		return "Common"
+	}
+	for _, name := range commonScripts {
+		if unicode.Is(unicode.Scripts[name], r) {
+			return name
+		}
+	}
+	for name, table := range unicode.Scripts {
+		if unicode.Is(table, r) {
+			return name
+		}
+	}
+	return ""
+}
+
+// scriptsOf returns the scripts used by the letters and digits in s,
+// ignoring characters shared between scripts such as punctuation and combining marks
+func scriptsOf(s string) map[string]bool {
+	scripts := make(map[string]bool)
+	for _, r := range s {
+		switch script := scriptOf(r); script {
+		case "Common", "Inherited", "":
+		default:
+			scripts[script] = true
+		}
+	}
+	return scripts
+}
+
+// isMixedScript reports whether s combines scripts that don't normally appear together
+func isMixedScript(scripts map[string]bool) bool {
+	if len(scripts) <= 1 {
+		return false
+	}
+	for _, allowed := range allowedScriptSets {
+		ok := true
+		for script := range scripts {
+			if !allowed[script] {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return false
+		}
+	}
+	return true
+}
+
+// isWholeScriptConfusable reports whether s is written in a single non-Latin script
+// entirely with characters that imitate ASCII, such as "раура" in Cyrillic
+func isWholeScriptConfusable(s string, scripts map[string]bool) bool {
+	if len(scripts) != 1 || scripts["Latin"] {
+		return false
+	}
+	imitates := false
+	for _, r := range norm.NFD.String(s) {
+		if r < utf8.RuneSelf || unicode.In(r, unicode.Mn) {
+			continue
+		}
+		prototype, ok := confusables[r]
+		if !ok || !isASCII(prototype) {
+			return false
+		}
+		imitates = true
+	}
+	return imitates
+}
+
+// isASCII reports whether s contains only ASCII characters
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// noConfusables implements the no_confusables rule: it rejects values that mix scripts,
+// such as a Latin username with a Cyrillic "а", and values written in another script
+// with characters that all imitate Latin ones.
+func noConfusables(value, param string) string {
+	if value == "" {
+		return ""
+	}
+	scripts := scriptsOf(value)
+	if isMixedScript(scripts) {
+		return ErrMixedScripts
+	}
+	if isWholeScriptConfusable(value, scripts) {
+		return ErrConfusable
+	}
+	return ""
+}
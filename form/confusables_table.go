@@ -0,0 +1,6319 @@
+// Code generated by confusablesgen from confusables.txt. DO NOT EDIT.
+
+package form
+
+// confusables maps characters to the prototype they are visually confusable with,
+// from the Unicode confusables data (UTS #39).
+var confusables = map[rune]string{
+	0x0022:  "''",                                                                             // QUOTATION MARK
+	0x0025:  "\u00ba/\u2080",                                                                  // PERCENT SIGN
+	0x0030:  "O",                                                                              // DIGIT ZERO
+	0x0031:  "l",                                                                              // DIGIT ONE
+	0x0049:  "l",                                                                              // LATIN CAPITAL LETTER I
+	0x0060:  "'",                                                                              // GRAVE ACCENT
+	0x006D:  "rn",                                                                             // LATIN SMALL LETTER M
+	0x007C:  "l",                                                                              // VERTICAL LINE
+	0x00A0:  " ",                                                                              // NO-BREAK SPACE
+	0x00A2:  "c\u0338",                                                                        // CENT SIGN
+	0x00A5:  "Y\u0335",                                                                        // YEN SIGN
+	0x00AF:  "\u02c9",                                                                         // MACRON
+	0x00B4:  "'",                                                                              // ACUTE ACCENT
+	0x00B5:  "\u03bc",                                                                         // MICRO SIGN
+	0x00B8:  ",",                                                                              // CEDILLA
+	0x00C6:  "AE",                                                                             // LATIN CAPITAL LETTER AE
+	0x00C7:  "C\u0326",                                                                        // LATIN CAPITAL LETTER C WITH CEDILLA
+	0x00D0:  "D\u0335",                                                                        // LATIN CAPITAL LETTER ETH
+	0x00D7:  "x",                                                                              // MULTIPLICATION SIGN
+	0x00D8:  "O\u0338",                                                                        // LATIN CAPITAL LETTER O WITH STROKE
+	0x00E6:  "ae",                                                                             // LATIN SMALL LETTER AE
+	0x00E7:  "c\u0326",                                                                        // LATIN SMALL LETTER C WITH CEDILLA
+	0x00F0:  "\u2202\u0335",                                                                   // LATIN SMALL LETTER ETH
+	0x00F6:  "\u0629",                                                                         // LATIN SMALL LETTER O WITH DIAERESIS
+	0x00F8:  "o\u0338",                                                                        // LATIN SMALL LETTER O WITH STROKE
+	0x0110:  "D\u0335",                                                                        // LATIN CAPITAL LETTER D WITH STROKE
+	0x0111:  "d\u0335",                                                                        // LATIN SMALL LETTER D WITH STROKE
+	0x011A:  "\u0114",                                                                         // LATIN CAPITAL LETTER E WITH CARON
+	0x011B:  "\u0115",                                                                         // LATIN SMALL LETTER E WITH CARON
+	0x0126:  "H\u0335",                                                                        // LATIN CAPITAL LETTER H WITH STROKE
+	0x0127:  "h\u0335",                                                                        // LATIN SMALL LETTER H WITH STROKE
+	0x0131:  "i",                                                                              // LATIN SMALL LETTER DOTLESS I
+	0x0132:  "lJ",                                                                             // LATIN CAPITAL LIGATURE IJ
+	0x0133:  "ij",                                                                             // LATIN SMALL LIGATURE IJ
+	0x013F:  "l\u00b7",                                                                        // LATIN CAPITAL LETTER L WITH MIDDLE DOT
+	0x0140:  "l\u00b7",                                                                        // LATIN SMALL LETTER L WITH MIDDLE DOT
+	0x0141:  "L\u0338",                                                                        // LATIN CAPITAL LETTER L WITH STROKE
+	0x0142:  "l\u0338",                                                                        // LATIN SMALL LETTER L WITH STROKE
+	0x0146:  "\u0272",                                                                         // LATIN SMALL LETTER N WITH CEDILLA
+	0x0149:  "'n",                                                                             // LATIN SMALL LETTER N PRECEDED BY APOSTROPHE
+	0x0150:  "\u00d6",                                                                         // LATIN CAPITAL LETTER O WITH DOUBLE ACUTE
+	0x0152:  "OE",                                                                             // LATIN CAPITAL LIGATURE OE
+	0x0153:  "oe",                                                                             // LATIN SMALL LIGATURE OE
+	0x0163:  "\u01ab",                                                                         // LATIN SMALL LETTER T WITH CEDILLA
+	0x0166:  "T\u0335",                                                                        // LATIN CAPITAL LETTER T WITH STROKE
+	0x0167:  "t\u0335",                                                                        // LATIN SMALL LETTER T WITH STROKE
+	0x017F:  "f",                                                                              // LATIN SMALL LETTER LONG S
+	0x0180:  "b\u0335",                                                                        // LATIN SMALL LETTER B WITH STROKE
+	0x0181:  "'B",                                                                             // LATIN CAPITAL LETTER B WITH HOOK
+	0x0182:  "b\u0304",                                                                        // LATIN CAPITAL LETTER B WITH TOPBAR
+	0x0183:  "b\u0304",                                                                        // LATIN SMALL LETTER B WITH TOPBAR
+	0x0184:  "b",                                                                              // LATIN CAPITAL LETTER TONE SIX
+	0x0187:  "C'",                                                                             // LATIN CAPITAL LETTER C WITH HOOK
+	0x0189:  "D\u0335",                                                                        // LATIN CAPITAL LETTER AFRICAN D
+	0x018A:  "'D",                                                                             // LATIN CAPITAL LETTER D WITH HOOK
+	0x018C:  "d\u0304",                                                                        // LATIN SMALL LETTER D WITH TOPBAR
+	0x018D:  "g",                                                                              // LATIN SMALL LETTER TURNED DELTA
+	0x0191:  "F\u0326",                                                                        // LATIN CAPITAL LETTER F WITH HOOK
+	0x0192:  "f\u0326",                                                                        // LATIN SMALL LETTER F WITH HOOK
+	0x0193:  "G'",                                                                             // LATIN CAPITAL LETTER G WITH HOOK
+	0x0196:  "l",                                                                              // LATIN CAPITAL LETTER IOTA
+	0x0197:  "l\u0335",                                                                        // LATIN CAPITAL LETTER I WITH STROKE
+	0x0198:  "K'",                                                                             // LATIN CAPITAL LETTER K WITH HOOK
+	0x0199:  "k\u0314",                                                                        // LATIN SMALL LETTER K WITH HOOK
+	0x019A:  "l\u0335",                                                                        // LATIN SMALL LETTER L WITH BAR
+	0x019D:  "N\u0326",                                                                        // LATIN CAPITAL LETTER N WITH LEFT HOOK
+	0x019E:  "n\u0329",                                                                        // LATIN SMALL LETTER N WITH LONG RIGHT LEG
+	0x019F:  "O\u0335",                                                                        // LATIN CAPITAL LETTER O WITH MIDDLE TILDE
+	0x01A0:  "O'",                                                                             // LATIN CAPITAL LETTER O WITH HORN
+	0x01A1:  "o'",                                                                             // LATIN SMALL LETTER O WITH HORN
+	0x01A4:  "'P",                                                                             // LATIN CAPITAL LETTER P WITH HOOK
+	0x01A5:  "p\u0314",                                                                        // LATIN SMALL LETTER P WITH HOOK
+	0x01A6:  "R",                                                                              // LATIN LETTER YR
+	0x01A7:  "2",                                                                              // LATIN CAPITAL LETTER TONE TWO
+	0x01AC:  "'T",                                                                             // LATIN CAPITAL LETTER T WITH HOOK
+	0x01AD:  "t\u0314",                                                                        // LATIN SMALL LETTER T WITH HOOK
+	0x01AE:  "T\u0328",                                                                        // LATIN CAPITAL LETTER T WITH RETROFLEX HOOK
+	0x01B3:  "'Y",                                                                             // LATIN CAPITAL LETTER Y WITH HOOK
+	0x01B4:  "y\u0314",                                                                        // LATIN SMALL LETTER Y WITH HOOK
+	0x01B5:  "Z\u0335",                                                                        // LATIN CAPITAL LETTER Z WITH STROKE
+	0x01B6:  "z\u0335",                                                                        // LATIN SMALL LETTER Z WITH STROKE
+	0x01B7:  "3",                                                                              // LATIN CAPITAL LETTER EZH
+	0x01BB:  "2\u0335",                                                                        // LATIN LETTER TWO WITH STROKE
+	0x01BC:  "5",                                                                              // LATIN CAPITAL LETTER TONE FIVE
+	0x01BD:  "s",                                                                              // LATIN SMALL LETTER TONE FIVE
+	0x01BF:  "\u00fe",                                                                         // LATIN LETTER WYNN
+	0x01C0:  "l",                                                                              // LATIN LETTER DENTAL CLICK
+	0x01C1:  "ll",                                                                             // LATIN LETTER LATERAL CLICK
+	0x01C3:  "!",                                                                              // LATIN LETTER RETROFLEX CLICK
+	0x01C4:  "D\u017d",                                                                        // LATIN CAPITAL LETTER DZ WITH CARON
+	0x01C5:  "D\u017e",                                                                        // LATIN CAPITAL LETTER D WITH SMALL LETTER Z WITH CARON
+	0x01C6:  "d\u017e",                                                                        // LATIN SMALL LETTER DZ WITH CARON
+	0x01C7:  "LJ",                                                                             // LATIN CAPITAL LETTER LJ
+	0x01C8:  "Lj",                                                                             // LATIN CAPITAL LETTER L WITH SMALL LETTER J
+	0x01C9:  "lj",                                                                             // LATIN SMALL LETTER LJ
+	0x01CA:  "NJ",                                                                             // LATIN CAPITAL LETTER NJ
+	0x01CB:  "Nj",                                                                             // LATIN CAPITAL LETTER N WITH SMALL LETTER J
+	0x01CC:  "nj",                                                                             // LATIN SMALL LETTER NJ
+	0x01CD:  "\u0102",                                                                         // LATIN CAPITAL LETTER A WITH CARON
+	0x01CE:  "\u0103",                                                                         // LATIN SMALL LETTER A WITH CARON
+	0x01CF:  "\u012c",                                                                         // LATIN CAPITAL LETTER I WITH CARON
+	0x01D0:  "\u012d",                                                                         // LATIN SMALL LETTER I WITH CARON
+	0x01D1:  "\u014e",                                                                         // LATIN CAPITAL LETTER O WITH CARON
+	0x01D2:  "\u014f",                                                                         // LATIN SMALL LETTER O WITH CARON
+	0x01D3:  "\u016c",                                                                         // LATIN CAPITAL LETTER U WITH CARON
+	0x01D4:  "\u016d",                                                                         // LATIN SMALL LETTER U WITH CARON
+	0x01E4:  "G\u0335",                                                                        // LATIN CAPITAL LETTER G WITH STROKE
+	0x01E5:  "g\u0335",                                                                        // LATIN SMALL LETTER G WITH STROKE
+	0x01E6:  "\u011e",                                                                         // LATIN CAPITAL LETTER G WITH CARON
+	0x01E7:  "\u011f",                                                                         // LATIN SMALL LETTER G WITH CARON
+	0x01F1:  "DZ",                                                                             // LATIN CAPITAL LETTER DZ
+	0x01F2:  "Dz",                                                                             // LATIN CAPITAL LETTER D WITH SMALL LETTER Z
+	0x01F3:  "dz",                                                                             // LATIN SMALL LETTER DZ
+	0x01F5:  "\u0123",                                                                         // LATIN SMALL LETTER G WITH ACUTE
+	0x01FE:  "O\u0338\u0301",                                                                  // LATIN CAPITAL LETTER O WITH STROKE AND ACUTE
+	0x021A:  "\u0162",                                                                         // LATIN CAPITAL LETTER T WITH COMMA BELOW
+	0x021B:  "\u01ab",                                                                         // LATIN SMALL LETTER T WITH COMMA BELOW
+	0x021C:  "3",                                                                              // LATIN CAPITAL LETTER YOGH
+	0x0222:  "8",                                                                              // LATIN CAPITAL LETTER OU
+	0x0223:  "8",                                                                              // LATIN SMALL LETTER OU
+	0x0224:  "Z\u0326",                                                                        // LATIN CAPITAL LETTER Z WITH HOOK
+	0x0225:  "z\u0326",                                                                        // LATIN SMALL LETTER Z WITH HOOK
+	0x0226:  "\u00c5",                                                                         // LATIN CAPITAL LETTER A WITH DOT ABOVE
+	0x0227:  "\u00e5",                                                                         // LATIN SMALL LETTER A WITH DOT ABOVE
+	0x023C:  "c\u0338",                                                                        // LATIN SMALL LETTER C WITH STROKE
+	0x023E:  "T\u0338",                                                                        // LATIN CAPITAL LETTER T WITH DIAGONAL STROKE
+	0x0241:  "?",                                                                              // LATIN CAPITAL LETTER GLOTTAL STOP
+	0x0244:  "U\u0335",                                                                        // LATIN CAPITAL LETTER U BAR
+	0x0246:  "E\u0338",                                                                        // LATIN CAPITAL LETTER E WITH STROKE
+	0x0247:  "e\u0338",                                                                        // LATIN SMALL LETTER E WITH STROKE
+	0x0248:  "J\u0335",                                                                        // LATIN CAPITAL LETTER J WITH STROKE
+	0x0249:  "j\u0335",                                                                        // LATIN SMALL LETTER J WITH STROKE
+	0x024D:  "r\u0335",                                                                        // LATIN SMALL LETTER R WITH STROKE
+	0x024E:  "Y\u0335",                                                                        // LATIN CAPITAL LETTER Y WITH STROKE
+	0x024F:  "y\u0335",                                                                        // LATIN SMALL LETTER Y WITH STROKE
+	0x0251:  "a",                                                                              // LATIN SMALL LETTER ALPHA
+	0x0253:  "b\u0314",                                                                        // LATIN SMALL LETTER B WITH HOOK
+	0x0256:  "d\u0328",                                                                        // LATIN SMALL LETTER D WITH TAIL
+	0x0257:  "d\u0314",                                                                        // LATIN SMALL LETTER D WITH HOOK
+	0x0259:  "\u01dd",                                                                         // LATIN SMALL LETTER SCHWA
+	0x025A:  "\u01dd\u02de",                                                                   // LATIN SMALL LETTER SCHWA WITH HOOK
+	0x025B:  "\ua793",                                                                         // LATIN SMALL LETTER OPEN E
+	0x0260:  "g\u0314",                                                                        // LATIN SMALL LETTER G WITH HOOK
+	0x0261:  "g",                                                                              // LATIN SMALL LETTER SCRIPT G
+	0x0263:  "y",                                                                              // LATIN SMALL LETTER GAMMA
+	0x0266:  "h\u0314",                                                                        // LATIN SMALL LETTER H WITH HOOK
+	0x0268:  "i\u0335",                                                                        // LATIN SMALL LETTER I WITH STROKE
+	0x0269:  "i",                                                                              // LATIN SMALL LETTER IOTA
+	0x026A:  "i",                                                                              // LATIN LETTER SMALL CAPITAL I
+	0x026B:  "l\u0334",                                                                        // LATIN SMALL LETTER L WITH MIDDLE TILDE
+	0x026D:  "l\u0328",                                                                        // LATIN SMALL LETTER L WITH RETROFLEX HOOK
+	0x026E:  "l\u021d",                                                                        // LATIN SMALL LETTER LEZH
+	0x026F:  "w",                                                                              // LATIN SMALL LETTER TURNED M
+	0x0271:  "rn\u0326",                                                                       // LATIN SMALL LETTER M WITH HOOK
+	0x0273:  "n\u0328",                                                                        // LATIN SMALL LETTER N WITH RETROFLEX HOOK
+	0x0275:  "o\u0335",                                                                        // LATIN SMALL LETTER BARRED O
+	0x0276:  "o\u1d07",                                                                        // LATIN LETTER SMALL CAPITAL OE
+	0x027C:  "r\u0329",                                                                        // LATIN SMALL LETTER R WITH LONG LEG
+	0x027D:  "r\u0328",                                                                        // LATIN SMALL LETTER R WITH TAIL
+	0x0282:  "s\u0328",                                                                        // LATIN SMALL LETTER S WITH HOOK
+	0x028B:  "u",                                                                              // LATIN SMALL LETTER V WITH HOOK
+	0x028F:  "y",                                                                              // LATIN LETTER SMALL CAPITAL Y
+	0x0290:  "z\u0328",                                                                        // LATIN SMALL LETTER Z WITH RETROFLEX HOOK
+	0x0292:  "\u021d",                                                                         // LATIN SMALL LETTER EZH
+	0x0294:  "?",                                                                              // LATIN LETTER GLOTTAL STOP
+	0x02A0:  "q\u0314",                                                                        // LATIN SMALL LETTER Q WITH HOOK
+	0x02A3:  "dz",                                                                             // LATIN SMALL LETTER DZ DIGRAPH
+	0x02A4:  "d\u021d",                                                                        // LATIN SMALL LETTER DEZH DIGRAPH
+	0x02A5:  "d\u0291",                                                                        // LATIN SMALL LETTER DZ DIGRAPH WITH CURL
+	0x02A6:  "ts",                                                                             // LATIN SMALL LETTER TS DIGRAPH
+	0x02A7:  "t\u0283",                                                                        // LATIN SMALL LETTER TESH DIGRAPH
+	0x02A8:  "t\u0255",                                                                        // LATIN SMALL LETTER TC DIGRAPH WITH CURL
+	0x02A9:  "f\u014b",                                                                        // LATIN SMALL LETTER FENG DIGRAPH
+	0x02AA:  "ls",                                                                             // LATIN SMALL LETTER LS DIGRAPH
+	0x02AB:  "lz",                                                                             // LATIN SMALL LETTER LZ DIGRAPH
+	0x02B3:  "\u18f4",                                                                         // MODIFIER LETTER SMALL R
+	0x02B9:  "'",                                                                              // MODIFIER LETTER PRIME
+	0x02BA:  "''",                                                                             // MODIFIER LETTER DOUBLE PRIME
+	0x02BB:  "'",                                                                              // MODIFIER LETTER TURNED COMMA
+	0x02BC:  "'",                                                                              // MODIFIER LETTER APOSTROPHE
+	0x02BD:  "'",                                                                              // MODIFIER LETTER REVERSED COMMA
+	0x02BE:  "'",                                                                              // MODIFIER LETTER RIGHT HALF RING
+	0x02BF:  "\u0559",                                                                         // MODIFIER LETTER LEFT HALF RING
+	0x02C2:  "<",                                                                              // MODIFIER LETTER LEFT ARROWHEAD
+	0x02C3:  ">",                                                                              // MODIFIER LETTER RIGHT ARROWHEAD
+	0x02C4:  "^",                                                                              // MODIFIER LETTER UP ARROWHEAD
+	0x02C6:  "^",                                                                              // MODIFIER LETTER CIRCUMFLEX ACCENT
+	0x02C8:  "'",                                                                              // MODIFIER LETTER VERTICAL LINE
+	0x02CA:  "'",                                                                              // MODIFIER LETTER ACUTE ACCENT
+	0x02CB:  "'",                                                                              // MODIFIER LETTER GRAVE ACCENT
+	0x02D0:  ":",                                                                              // MODIFIER LETTER TRIANGULAR COLON
+	0x02D3:  "\u0559",                                                                         // MODIFIER LETTER CENTRED LEFT HALF RING
+	0x02D7:  "-",                                                                              // MODIFIER LETTER MINUS SIGN
+	0x02D8:  "\u02c7",                                                                         // BREVE
+	0x02D9:  "\u0971",                                                                         // DOT ABOVE
+	0x02DA:  "\u00b0",                                                                         // RING ABOVE
+	0x02DB:  "i",                                                                              // OGONEK
+	0x02DC:  "~",                                                                              // SMALL TILDE
+	0x02DD:  "''",                                                                             // DOUBLE ACUTE ACCENT
+	0x02E1:  "\u18f3",                                                                         // MODIFIER LETTER SMALL L
+	0x02E2:  "\u18f5",                                                                         // MODIFIER LETTER SMALL S
+	0x02E4:  "\u02c1",                                                                         // MODIFIER LETTER SMALL REVERSED GLOTTAL STOP
+	0x02EE:  "''",                                                                             // MODIFIER LETTER DOUBLE APOSTROPHE
+	0x02F4:  "'",                                                                              // MODIFIER LETTER MIDDLE GRAVE ACCENT
+	0x02F6:  "''",                                                                             // MODIFIER LETTER MIDDLE DOUBLE ACUTE ACCENT
+	0x02F8:  ":",                                                                              // MODIFIER LETTER RAISED COLON
+	0x02FB:  "\u02ea",                                                                         // MODIFIER LETTER BEGIN LOW TONE
+	0x0305:  "\u0304",                                                                         // COMBINING OVERLINE
+	0x030C:  "\u0306",                                                                         // COMBINING CARON
+	0x030D:  "\u0670",                                                                         // COMBINING VERTICAL LINE ABOVE
+	0x0310:  "\u0306\u0307",                                                                   // COMBINING CANDRABINDU
+	0x0311:  "\u0302",                                                                         // COMBINING INVERTED BREVE
+	0x0315:  "\u0313",                                                                         // COMBINING COMMA ABOVE RIGHT
+	0x0317:  "\u0650",                                                                         // COMBINING ACUTE ACCENT BELOW
+	0x0320:  "\u0331",                                                                         // COMBINING MINUS SIGN BELOW
+	0x0321:  "\u0326",                                                                         // COMBINING PALATALIZED HOOK BELOW
+	0x0322:  "\u0328",                                                                         // COMBINING RETROFLEX HOOK BELOW
+	0x0327:  "\u0326",                                                                         // COMBINING CEDILLA
+	0x0336:  "\u0335",                                                                         // COMBINING LONG STROKE OVERLAY
+	0x0337:  "\u0338",                                                                         // COMBINING SHORT SOLIDUS OVERLAY
+	0x0339:  "\u0326",                                                                         // COMBINING RIGHT HALF RING BELOW
+	0x0340:  "\u0300",                                                                         // COMBINING GRAVE TONE MARK
+	0x0341:  "\u0301",                                                                         // COMBINING ACUTE TONE MARK
+	0x0342:  "\u0303",                                                                         // COMBINING GREEK PERISPOMENI
+	0x0343:  "\u0313",                                                                         // COMBINING GREEK KORONIS
+	0x0345:  "\u0328",                                                                         // COMBINING GREEK YPOGEGRAMMENI
+	0x0347:  "\u0333",                                                                         // COMBINING EQUALS SIGN BELOW
+	0x0357:  "\u0350",                                                                         // COMBINING RIGHT HALF RING ABOVE
+	0x0358:  "\u0307",                                                                         // COMBINING DOT ABOVE RIGHT
+	0x0366:  "\u030a",                                                                         // COMBINING LATIN SMALL LETTER O
+	0x036E:  "\u0306",                                                                         // COMBINING LATIN SMALL LETTER V
+	0x0370:  "\u2c75",                                                                         // GREEK CAPITAL LETTER HETA
+	0x0374:  "'",                                                                              // GREEK NUMERAL SIGN
+	0x0375:  "\u02cf",                                                                         // GREEK LOWER NUMERAL SIGN
+	0x0376:  "\u0418",                                                                         // GREEK CAPITAL LETTER PAMPHYLIAN DIGAMMA
+	0x0377:  "\u1d0e",                                                                         // GREEK SMALL LETTER PAMPHYLIAN DIGAMMA
+	0x037A:  "i",                                                                              // GREEK YPOGEGRAMMENI
+	0x037B:  "\u0254",                                                                         // GREEK SMALL REVERSED LUNATE SIGMA SYMBOL
+	0x037D:  "\ua73f",                                                                         // GREEK SMALL REVERSED DOTTED LUNATE SIGMA SYMBOL
+	0x037E:  ";",                                                                              // GREEK QUESTION MARK
+	0x037F:  "J",                                                                              // GREEK CAPITAL LETTER YOT
+	0x0384:  "'",                                                                              // GREEK TONOS
+	0x0387:  "\u00b7",                                                                         // GREEK ANO TELEIA
+	0x0391:  "A",                                                                              // GREEK CAPITAL LETTER ALPHA
+	0x0392:  "B",                                                                              // GREEK CAPITAL LETTER BETA
+	0x0395:  "E",                                                                              // GREEK CAPITAL LETTER EPSILON
+	0x0396:  "Z",                                                                              // GREEK CAPITAL LETTER ZETA
+	0x0397:  "H",                                                                              // GREEK CAPITAL LETTER ETA
+	0x0398:  "O\u0335",                                                                        // GREEK CAPITAL LETTER THETA
+	0x0399:  "l",                                                                              // GREEK CAPITAL LETTER IOTA
+	0x039A:  "K",                                                                              // GREEK CAPITAL LETTER KAPPA
+	0x039B:  "\u0245",                                                                         // GREEK CAPITAL LETTER LAMDA
+	0x039C:  "M",                                                                              // GREEK CAPITAL LETTER MU
+	0x039D:  "N",                                                                              // GREEK CAPITAL LETTER NU
+	0x039F:  "O",                                                                              // GREEK CAPITAL LETTER OMICRON
+	0x03A1:  "P",                                                                              // GREEK CAPITAL LETTER RHO
+	0x03A3:  "\u01a9",                                                                         // GREEK CAPITAL LETTER SIGMA
+	0x03A4:  "T",                                                                              // GREEK CAPITAL LETTER TAU
+	0x03A5:  "Y",                                                                              // GREEK CAPITAL LETTER UPSILON
+	0x03A7:  "X",                                                                              // GREEK CAPITAL LETTER CHI
+	0x03B1:  "a",                                                                              // GREEK SMALL LETTER ALPHA
+	0x03B2:  "\u00df",                                                                         // GREEK SMALL LETTER BETA
+	0x03B3:  "y",                                                                              // GREEK SMALL LETTER GAMMA
+	0x03B4:  "\u1e9f",                                                                         // GREEK SMALL LETTER DELTA
+	0x03B5:  "\ua793",                                                                         // GREEK SMALL LETTER EPSILON
+	0x03B7:  "n\u0329",                                                                        // GREEK SMALL LETTER ETA
+	0x03B8:  "O\u0335",                                                                        // GREEK SMALL LETTER THETA
+	0x03B9:  "i",                                                                              // GREEK SMALL LETTER IOTA
+	0x03BA:  "\u0138",                                                                         // GREEK SMALL LETTER KAPPA
+	0x03BD:  "v",                                                                              // GREEK SMALL LETTER NU
+	0x03BF:  "o",                                                                              // GREEK SMALL LETTER OMICRON
+	0x03C1:  "p",                                                                              // GREEK SMALL LETTER RHO
+	0x03C3:  "o",                                                                              // GREEK SMALL LETTER SIGMA
+	0x03C4:  "\u1d1b",                                                                         // GREEK SMALL LETTER TAU
+	0x03C5:  "u",                                                                              // GREEK SMALL LETTER UPSILON
+	0x03C6:  "\u0278",                                                                         // GREEK SMALL LETTER PHI
+	0x03D0:  "\u00df",                                                                         // GREEK BETA SYMBOL
+	0x03D1:  "O\u0335",                                                                        // GREEK THETA SYMBOL
+	0x03D2:  "Y",                                                                              // GREEK UPSILON WITH HOOK SYMBOL
+	0x03D5:  "\u0278",                                                                         // GREEK PHI SYMBOL
+	0x03D6:  "\u03c0",                                                                         // GREEK PI SYMBOL
+	0x03DB:  "\u03c2",                                                                         // GREEK SMALL LETTER STIGMA
+	0x03DC:  "F",                                                                              // GREEK LETTER DIGAMMA
+	0x03E8:  "2",                                                                              // COPTIC CAPITAL LETTER HORI
+	0x03E9:  "\u01a8",                                                                         // COPTIC SMALL LETTER HORI
+	0x03F0:  "\u0138",                                                                         // GREEK KAPPA SYMBOL
+	0x03F1:  "p",                                                                              // GREEK RHO SYMBOL
+	0x03F2:  "c",                                                                              // GREEK LUNATE SIGMA SYMBOL
+	0x03F3:  "j",                                                                              // GREEK LETTER YOT
+	0x03F4:  "O\u0335",                                                                        // GREEK CAPITAL THETA SYMBOL
+	0x03F5:  "\ua793",                                                                         // GREEK LUNATE EPSILON SYMBOL
+	0x03F7:  "\u00de",                                                                         // GREEK CAPITAL LETTER SHO
+	0x03F8:  "\u00fe",                                                                         // GREEK SMALL LETTER SHO
+	0x03F9:  "C",                                                                              // GREEK CAPITAL LUNATE SIGMA SYMBOL
+	0x03FA:  "M",                                                                              // GREEK CAPITAL LETTER SAN
+	0x03FD:  "\u0186",                                                                         // GREEK CAPITAL REVERSED LUNATE SIGMA SYMBOL
+	0x03FF:  "\ua73e",                                                                         // GREEK CAPITAL REVERSED DOTTED LUNATE SIGMA SYMBOL
+	0x0404:  "\ua792",                                                                         // CYRILLIC CAPITAL LETTER UKRAINIAN IE
+	0x0405:  "S",                                                                              // CYRILLIC CAPITAL LETTER DZE
+	0x0406:  "l",                                                                              // CYRILLIC CAPITAL LETTER BYELORUSSIAN-UKRAINIAN I
+	0x0408:  "J",                                                                              // CYRILLIC CAPITAL LETTER JE
+	0x0410:  "A",                                                                              // CYRILLIC CAPITAL LETTER A
+	0x0411:  "b\u0304",                                                                        // CYRILLIC CAPITAL LETTER BE
+	0x0412:  "B",                                                                              // CYRILLIC CAPITAL LETTER VE
+	0x0413:  "\u0393",                                                                         // CYRILLIC CAPITAL LETTER GHE
+	0x0415:  "E",                                                                              // CYRILLIC CAPITAL LETTER IE
+	0x0417:  "3",                                                                              // CYRILLIC CAPITAL LETTER ZE
+	0x0419:  "\u040d",                                                                         // CYRILLIC CAPITAL LETTER SHORT I
+	0x041A:  "K",                                                                              // CYRILLIC CAPITAL LETTER KA
+	0x041B:  "\u0245",                                                                         // CYRILLIC CAPITAL LETTER EL
+	0x041C:  "M",                                                                              // CYRILLIC CAPITAL LETTER EM
+	0x041D:  "H",                                                                              // CYRILLIC CAPITAL LETTER EN
+	0x041E:  "O",                                                                              // CYRILLIC CAPITAL LETTER O
+	0x041F:  "\u03a0",                                                                         // CYRILLIC CAPITAL LETTER PE
+	0x0420:  "P",                                                                              // CYRILLIC CAPITAL LETTER ER
+	0x0421:  "C",                                                                              // CYRILLIC CAPITAL LETTER ES
+	0x0422:  "T",                                                                              // CYRILLIC CAPITAL LETTER TE
+	0x0423:  "Y",                                                                              // CYRILLIC CAPITAL LETTER U
+	0x0424:  "\u03a6",                                                                         // CYRILLIC CAPITAL LETTER EF
+	0x0425:  "X",                                                                              // CYRILLIC CAPITAL LETTER HA
+	0x042B:  "bl",                                                                             // CYRILLIC CAPITAL LETTER YERU
+	0x042C:  "b",                                                                              // CYRILLIC CAPITAL LETTER SOFT SIGN
+	0x042E:  "lO",                                                                             // CYRILLIC CAPITAL LETTER YU
+	0x0430:  "a",                                                                              // CYRILLIC SMALL LETTER A
+	0x0431:  "6",                                                                              // CYRILLIC SMALL LETTER BE
+	0x0432:  "\u0299",                                                                         // CYRILLIC SMALL LETTER VE
+	0x0433:  "r",                                                                              // CYRILLIC SMALL LETTER GHE
+	0x0435:  "e",                                                                              // CYRILLIC SMALL LETTER IE
+	0x0437:  "\u025c",                                                                         // CYRILLIC SMALL LETTER ZE
+	0x0438:  "\u1d0e",                                                                         // CYRILLIC SMALL LETTER I
+	0x043A:  "\u0138",                                                                         // CYRILLIC SMALL LETTER KA
+	0x043C:  "\u028d",                                                                         // CYRILLIC SMALL LETTER EM
+	0x043D:  "\u029c",                                                                         // CYRILLIC SMALL LETTER EN
+	0x043E:  "o",                                                                              // CYRILLIC SMALL LETTER O
+	0x043F:  "\u03c0",                                                                         // CYRILLIC SMALL LETTER PE
+	0x0440:  "p",                                                                              // CYRILLIC SMALL LETTER ER
+	0x0441:  "c",                                                                              // CYRILLIC SMALL LETTER ES
+	0x0442:  "\u1d1b",                                                                         // CYRILLIC SMALL LETTER TE
+	0x0443:  "y",                                                                              // CYRILLIC SMALL LETTER U
+	0x0444:  "\u0278",                                                                         // CYRILLIC SMALL LETTER EF
+	0x0445:  "x",                                                                              // CYRILLIC SMALL LETTER HA
+	0x044A:  "\u02c9b",                                                                        // CYRILLIC SMALL LETTER HARD SIGN
+	0x044B:  "\u0185i",                                                                        // CYRILLIC SMALL LETTER YERU
+	0x044C:  "\u0185",                                                                         // CYRILLIC SMALL LETTER SOFT SIGN
+	0x044F:  "\u1d19",                                                                         // CYRILLIC SMALL LETTER YA
+	0x0454:  "\ua793",                                                                         // CYRILLIC SMALL LETTER UKRAINIAN IE
+	0x0455:  "s",                                                                              // CYRILLIC SMALL LETTER DZE
+	0x0456:  "i",                                                                              // CYRILLIC SMALL LETTER BYELORUSSIAN-UKRAINIAN I
+	0x0458:  "j",                                                                              // CYRILLIC SMALL LETTER JE
+	0x045B:  "h\u0335",                                                                        // CYRILLIC SMALL LETTER TSHE
+	0x045D:  "\u0439",                                                                         // CYRILLIC SMALL LETTER I WITH GRAVE
+	0x0461:  "w",                                                                              // CYRILLIC SMALL LETTER OMEGA
+	0x0462:  "b\u0335",                                                                        // CYRILLIC CAPITAL LETTER YAT
+	0x0463:  "b\u0335",                                                                        // CYRILLIC SMALL LETTER YAT
+	0x0470:  "\u03a8",                                                                         // CYRILLIC CAPITAL LETTER PSI
+	0x0471:  "\u03c8",                                                                         // CYRILLIC SMALL LETTER PSI
+	0x0472:  "O\u0335",                                                                        // CYRILLIC CAPITAL LETTER FITA
+	0x0473:  "o\u0335",                                                                        // CYRILLIC SMALL LETTER FITA
+	0x0474:  "V",                                                                              // CYRILLIC CAPITAL LETTER IZHITSA
+	0x0475:  "v",                                                                              // CYRILLIC SMALL LETTER IZHITSA
+	0x047C:  "\u0460\u0486\u0487",                                                             // CYRILLIC CAPITAL LETTER OMEGA WITH TITLO
+	0x047D:  "w\u0486\u0487",                                                                  // CYRILLIC SMALL LETTER OMEGA WITH TITLO
+	0x048A:  "\u040d\u0326",                                                                   // CYRILLIC CAPITAL LETTER SHORT I WITH TAIL
+	0x048B:  "\u0439\u0326",                                                                   // CYRILLIC SMALL LETTER SHORT I WITH TAIL
+	0x048C:  "b\u0335",                                                                        // CYRILLIC CAPITAL LETTER SEMISOFT SIGN
+	0x048D:  "b\u0335",                                                                        // CYRILLIC SMALL LETTER SEMISOFT SIGN
+	0x0490:  "\u0393'",                                                                        // CYRILLIC CAPITAL LETTER GHE WITH UPTURN
+	0x0491:  "r'",                                                                             // CYRILLIC SMALL LETTER GHE WITH UPTURN
+	0x0492:  "\u0393\u0335",                                                                   // CYRILLIC CAPITAL LETTER GHE WITH STROKE
+	0x0493:  "r\u0335",                                                                        // CYRILLIC SMALL LETTER GHE WITH STROKE
+	0x0496:  "\u0416\u0329",                                                                   // CYRILLIC CAPITAL LETTER ZHE WITH DESCENDER
+	0x0497:  "\u0436\u0329",                                                                   // CYRILLIC SMALL LETTER ZHE WITH DESCENDER
+	0x0498:  "3\u0326",                                                                        // CYRILLIC CAPITAL LETTER ZE WITH DESCENDER
+	0x0499:  "\u025c\u0326",                                                                   // CYRILLIC SMALL LETTER ZE WITH DESCENDER
+	0x049A:  "K\u0329",                                                                        // CYRILLIC CAPITAL LETTER KA WITH DESCENDER
+	0x049B:  "\u0138\u0329",                                                                   // CYRILLIC SMALL LETTER KA WITH DESCENDER
+	0x049E:  "K\u0335",                                                                        // CYRILLIC CAPITAL LETTER KA WITH STROKE
+	0x049F:  "\u0138\u0335",                                                                   // CYRILLIC SMALL LETTER KA WITH STROKE
+	0x04A2:  "H\u0329",                                                                        // CYRILLIC CAPITAL LETTER EN WITH DESCENDER
+	0x04A3:  "\u029c\u0329",                                                                   // CYRILLIC SMALL LETTER EN WITH DESCENDER
+	0x04AA:  "C\u0326",                                                                        // CYRILLIC CAPITAL LETTER ES WITH DESCENDER
+	0x04AB:  "c\u0326",                                                                        // CYRILLIC SMALL LETTER ES WITH DESCENDER
+	0x04AC:  "T\u0329",                                                                        // CYRILLIC CAPITAL LETTER TE WITH DESCENDER
+	0x04AD:  "\u1d1b\u0329",                                                                   // CYRILLIC SMALL LETTER TE WITH DESCENDER
+	0x04AE:  "Y",                                                                              // CYRILLIC CAPITAL LETTER STRAIGHT U
+	0x04AF:  "y",                                                                              // CYRILLIC SMALL LETTER STRAIGHT U
+	0x04B0:  "Y\u0335",                                                                        // CYRILLIC CAPITAL LETTER STRAIGHT U WITH STROKE
+	0x04B1:  "y\u0335",                                                                        // CYRILLIC SMALL LETTER STRAIGHT U WITH STROKE
+	0x04B2:  "X\u0329",                                                                        // CYRILLIC CAPITAL LETTER HA WITH DESCENDER
+	0x04BB:  "h",                                                                              // CYRILLIC SMALL LETTER SHHA
+	0x04BD:  "e",                                                                              // CYRILLIC SMALL LETTER ABKHASIAN CHE
+	0x04BE:  "\u04bc\u0328",                                                                   // CYRILLIC CAPITAL LETTER ABKHASIAN CHE WITH DESCENDER
+	0x04BF:  "e\u0328",                                                                        // CYRILLIC SMALL LETTER ABKHASIAN CHE WITH DESCENDER
+	0x04C0:  "l",                                                                              // CYRILLIC LETTER PALOCHKA
+	0x04C5:  "\u0245\u0326",                                                                   // CYRILLIC CAPITAL LETTER EL WITH TAIL
+	0x04C6:  "\u043b\u0326",                                                                   // CYRILLIC SMALL LETTER EL WITH TAIL
+	0x04C7:  "H\u0326",                                                                        // CYRILLIC CAPITAL LETTER EN WITH HOOK
+	0x04C8:  "\u029c\u0326",                                                                   // CYRILLIC SMALL LETTER EN WITH HOOK
+	0x04C9:  "H\u0326",                                                                        // CYRILLIC CAPITAL LETTER EN WITH TAIL
+	0x04CA:  "\u029c\u0326",                                                                   // CYRILLIC SMALL LETTER EN WITH TAIL
+	0x04CB:  "\u04b6",                                                                         // CYRILLIC CAPITAL LETTER KHAKASSIAN CHE
+	0x04CC:  "\u04b7",                                                                         // CYRILLIC SMALL LETTER KHAKASSIAN CHE
+	0x04CD:  "M\u0326",                                                                        // CYRILLIC CAPITAL LETTER EM WITH TAIL
+	0x04CE:  "\u028d\u0326",                                                                   // CYRILLIC SMALL LETTER EM WITH TAIL
+	0x04CF:  "i",                                                                              // CYRILLIC SMALL LETTER PALOCHKA
+	0x04D4:  "AE",                                                                             // CYRILLIC CAPITAL LIGATURE A IE
+	0x04D5:  "ae",                                                                             // CYRILLIC SMALL LIGATURE A IE
+	0x04D8:  "\u018f",                                                                         // CYRILLIC CAPITAL LETTER SCHWA
+	0x04D9:  "\u01dd",                                                                         // CYRILLIC SMALL LETTER SCHWA
+	0x04E0:  "3",                                                                              // CYRILLIC CAPITAL LETTER ABKHASIAN DZE
+	0x04E1:  "\u021d",                                                                         // CYRILLIC SMALL LETTER ABKHASIAN DZE
+	0x04E8:  "O\u0335",                                                                        // CYRILLIC CAPITAL LETTER BARRED O
+	0x04E9:  "o\u0335",                                                                        // CYRILLIC SMALL LETTER BARRED O
+	0x0501:  "d",                                                                              // CYRILLIC SMALL LETTER KOMI DE
+	0x050A:  "\u01f6",                                                                         // CYRILLIC CAPITAL LETTER KOMI NJE
+	0x050C:  "G",                                                                              // CYRILLIC CAPITAL LETTER KOMI SJE
+	0x050D:  "\u0262",                                                                         // CYRILLIC SMALL LETTER KOMI SJE
+	0x0510:  "\u0190",                                                                         // CYRILLIC CAPITAL LETTER REVERSED ZE
+	0x0511:  "\ua793",                                                                         // CYRILLIC SMALL LETTER REVERSED ZE
+	0x051B:  "q",                                                                              // CYRILLIC SMALL LETTER QA
+	0x051C:  "W",                                                                              // CYRILLIC CAPITAL LETTER WE
+	0x051D:  "w",                                                                              // CYRILLIC SMALL LETTER WE
+	0x053B:  "\u12ae",                                                                         // ARMENIAN CAPITAL LETTER INI
+	0x0544:  "\u1206",                                                                         // ARMENIAN CAPITAL LETTER MEN
+	0x054A:  "\u1323",                                                                         // ARMENIAN CAPITAL LETTER PEH
+	0x054C:  "\u1261",                                                                         // ARMENIAN CAPITAL LETTER RA
+	0x054D:  "U",                                                                              // ARMENIAN CAPITAL LETTER SEH
+	0x054F:  "S",                                                                              // ARMENIAN CAPITAL LETTER TIWN
+	0x0553:  "\u03a6",                                                                         // ARMENIAN CAPITAL LETTER PIWR
+	0x0555:  "O",                                                                              // ARMENIAN CAPITAL LETTER OH
+	0x055A:  "'",                                                                              // ARMENIAN APOSTROPHE
+	0x055D:  "'",                                                                              // ARMENIAN COMMA
+	0x0561:  "w",                                                                              // ARMENIAN SMALL LETTER AYB
+	0x0563:  "q",                                                                              // ARMENIAN SMALL LETTER GIM
+	0x0566:  "q",                                                                              // ARMENIAN SMALL LETTER ZA
+	0x056E:  "\u1e9f",                                                                         // ARMENIAN SMALL LETTER CA
+	0x0570:  "h",                                                                              // ARMENIAN SMALL LETTER HO
+	0x0575:  "\u0237",                                                                         // ARMENIAN SMALL LETTER YI
+	0x0578:  "n",                                                                              // ARMENIAN SMALL LETTER VO
+	0x057A:  "\u0270",                                                                         // ARMENIAN SMALL LETTER PEH
+	0x057C:  "n",                                                                              // ARMENIAN SMALL LETTER RA
+	0x057D:  "u",                                                                              // ARMENIAN SMALL LETTER SEH
+	0x0581:  "g",                                                                              // ARMENIAN SMALL LETTER CO
+	0x0584:  "f",                                                                              // ARMENIAN SMALL LETTER KEH
+	0x0585:  "o",                                                                              // ARMENIAN SMALL LETTER OH
+	0x0587:  "\u0565\u0582",                                                                   // ARMENIAN SMALL LIGATURE ECH YIWN
+	0x0589:  ":",                                                                              // ARMENIAN FULL STOP
+	0x059C:  "\u0301",                                                                         // HEBREW ACCENT GERESH
+	0x059D:  "\u0301",                                                                         // HEBREW ACCENT GERESH MUQDAM
+	0x05A4:  "\u059a",                                                                         // HEBREW ACCENT MAHAPAKH
+	0x05A8:  "\u0599",                                                                         // HEBREW ACCENT QADMA
+	0x05AD:  "\u0596",                                                                         // HEBREW ACCENT DEHI
+	0x05AE:  "\u0598",                                                                         // HEBREW ACCENT ZINOR
+	0x05AF:  "\u030a",                                                                         // HEBREW MARK MASORA CIRCLE
+	0x05B4:  "\u0323",                                                                         // HEBREW POINT HIRIQ
+	0x05B9:  "\u0307",                                                                         // HEBREW POINT HOLAM
+	0x05BA:  "\u0307",                                                                         // HEBREW POINT HOLAM HASER FOR VAV
+	0x05C0:  "l",                                                                              // HEBREW PUNCTUATION PASEQ
+	0x05C1:  "\u0307",                                                                         // HEBREW POINT SHIN DOT
+	0x05C2:  "\u0307",                                                                         // HEBREW POINT SIN DOT
+	0x05C3:  ":",                                                                              // HEBREW PUNCTUATION SOF PASUQ
+	0x05C4:  "\u0307",                                                                         // HEBREW MARK UPPER DOT
+	0x05C5:  "\u0323",                                                                         // HEBREW MARK LOWER DOT
+	0x05D5:  "l",                                                                              // HEBREW LETTER VAV
+	0x05D8:  "v",                                                                              // HEBREW LETTER TET
+	0x05D9:  "'",                                                                              // HEBREW LETTER YOD
+	0x05DF:  "l",                                                                              // HEBREW LETTER FINAL NUN
+	0x05E1:  "o",                                                                              // HEBREW LETTER SAMEKH
+	0x05F0:  "ll",                                                                             // HEBREW LIGATURE YIDDISH DOUBLE VAV
+	0x05F1:  "l'",                                                                             // HEBREW LIGATURE YIDDISH VAV YOD
+	0x05F2:  "''",                                                                             // HEBREW LIGATURE YIDDISH DOUBLE YOD
+	0x05F3:  "'",                                                                              // HEBREW PUNCTUATION GERESH
+	0x05F4:  "''",                                                                             // HEBREW PUNCTUATION GERSHAYIM
+	0x0609:  "\u00ba/\u2080\u2080",                                                            // ARABIC-INDIC PER MILLE SIGN
+	0x060A:  "\u00ba/\u2080\u2080\u2080",                                                      // ARABIC-INDIC PER TEN THOUSAND SIGN
+	0x060D:  ",",                                                                              // ARABIC DATE SEPARATOR
+	0x060F:  "\u0639",                                                                         // ARABIC SIGN MISRA
+	0x0618:  "\u0301",                                                                         // ARABIC SMALL FATHA
+	0x0619:  "\u0313",                                                                         // ARABIC SMALL DAMMA
+	0x061A:  "\u0650",                                                                         // ARABIC SMALL KASRA
+	0x0623:  "l\u0674",                                                                        // ARABIC LETTER ALEF WITH HAMZA ABOVE
+	0x0624:  "\u0648\u0674",                                                                   // ARABIC LETTER WAW WITH HAMZA ABOVE
+	0x0625:  "l\u0655",                                                                        // ARABIC LETTER ALEF WITH HAMZA BELOW
+	0x0626:  "\u0649\u0674",                                                                   // ARABIC LETTER YEH WITH HAMZA ABOVE
+	0x0627:  "l",                                                                              // ARABIC LETTER ALEF
+	0x062B:  "\u0649\u06db",                                                                   // ARABIC LETTER THEH
+	0x0634:  "\u0633\u06db",                                                                   // ARABIC LETTER SHEEN
+	0x063D:  "\u0649\u0302",                                                                   // ARABIC LETTER FARSI YEH WITH INVERTED V
+	0x063F:  "\u0649\u06db",                                                                   // ARABIC LETTER FARSI YEH WITH THREE DOTS ABOVE
+	0x0647:  "o",                                                                              // ARABIC LETTER HEH
+	0x064A:  "\u0649",                                                                         // ARABIC LETTER YEH
+	0x064B:  "\u030b",                                                                         // ARABIC FATHATAN
+	0x064E:  "\u0301",                                                                         // ARABIC FATHA
+	0x064F:  "\u0313",                                                                         // ARABIC DAMMA
+	0x0652:  "\u030a",                                                                         // ARABIC SUKUN
+	0x0653:  "\u0303",                                                                         // ARABIC MADDAH ABOVE
+	0x0656:  "\u0329",                                                                         // ARABIC SUBSCRIPT ALEF
+	0x0657:  "\u0312",                                                                         // ARABIC INVERTED DAMMA
+	0x0658:  "\u0306",                                                                         // ARABIC MARK NOON GHUNNA
+	0x0659:  "\u0304",                                                                         // ARABIC ZWARAKAY
+	0x065A:  "\u0306",                                                                         // ARABIC VOWEL SIGN SMALL V ABOVE
+	0x065B:  "\u0302",                                                                         // ARABIC VOWEL SIGN INVERTED SMALL V ABOVE
+	0x065C:  "\u0323",                                                                         // ARABIC VOWEL SIGN DOT BELOW
+	0x065D:  "\u0314",                                                                         // ARABIC REVERSED DAMMA
+	0x065F:  "\u0655",                                                                         // ARABIC WAVY HAMZA BELOW
+	0x0660:  ".",                                                                              // ARABIC-INDIC DIGIT ZERO
+	0x0661:  "l",                                                                              // ARABIC-INDIC DIGIT ONE
+	0x0665:  "o",                                                                              // ARABIC-INDIC DIGIT FIVE
+	0x0667:  "V",                                                                              // ARABIC-INDIC DIGIT SEVEN
+	0x0668:  "\u0245",                                                                         // ARABIC-INDIC DIGIT EIGHT
+	0x066A:  "\u00ba/\u2080",                                                                  // ARABIC PERCENT SIGN
+	0x066B:  ",",                                                                              // ARABIC DECIMAL SEPARATOR
+	0x066C:  "\u060c",                                                                         // ARABIC THOUSANDS SEPARATOR
+	0x066D:  "*",                                                                              // ARABIC FIVE POINTED STAR
+	0x066E:  "\u0649",                                                                         // ARABIC LETTER DOTLESS BEH
+	0x066F:  "\u06a1",                                                                         // ARABIC LETTER DOTLESS QAF
+	0x0672:  "l\u0674",                                                                        // ARABIC LETTER ALEF WITH WAVY HAMZA ABOVE
+	0x0673:  "l\u0655",                                                                        // ARABIC LETTER ALEF WITH WAVY HAMZA BELOW
+	0x0675:  "l\u0674",                                                                        // ARABIC LETTER HIGH HAMZA ALEF
+	0x0676:  "\u0648\u0674",                                                                   // ARABIC LETTER HIGH HAMZA WAW
+	0x0677:  "\u0648\u0313\u0674",                                                             // ARABIC LETTER U WITH HAMZA ABOVE
+	0x0678:  "\u0649\u0674",                                                                   // ARABIC LETTER HIGH HAMZA YEH
+	0x0679:  "\u0649\u0615",                                                                   // ARABIC LETTER TTEH
+	0x067E:  "\u0649\u06db",                                                                   // ARABIC LETTER PEH
+	0x0681:  "\u062d\u0654",                                                                   // ARABIC LETTER HAH WITH HAMZA ABOVE
+	0x0685:  "\u062d\u06db",                                                                   // ARABIC LETTER HAH WITH THREE DOTS ABOVE
+	0x0688:  "\u062f\u0615",                                                                   // ARABIC LETTER DDAL
+	0x068B:  "\u068a\u0615",                                                                   // ARABIC LETTER DAL WITH DOT BELOW AND SMALL TAH
+	0x068E:  "\u062f\u06db",                                                                   // ARABIC LETTER DUL
+	0x0691:  "\u0631\u0615",                                                                   // ARABIC LETTER RREH
+	0x0692:  "\u0631\u0306",                                                                   // ARABIC LETTER REH WITH SMALL V
+	0x0698:  "\u0631\u06db",                                                                   // ARABIC LETTER JEH
+	0x069E:  "\u0635\u06db",                                                                   // ARABIC LETTER SAD WITH THREE DOTS ABOVE
+	0x069F:  "\u0637\u06db",                                                                   // ARABIC LETTER TAH WITH THREE DOTS ABOVE
+	0x06A4:  "\u06a1\u06db",                                                                   // ARABIC LETTER VEH
+	0x06A7:  "\u0641",                                                                         // ARABIC LETTER QAF WITH DOT ABOVE
+	0x06A8:  "\u06a1\u06db",                                                                   // ARABIC LETTER QAF WITH THREE DOTS ABOVE
+	0x06A9:  "\u0643",                                                                         // ARABIC LETTER KEHEH
+	0x06AA:  "\u0643",                                                                         // ARABIC LETTER SWASH KAF
+	0x06AD:  "\u0643\u06db",                                                                   // ARABIC LETTER NG
+	0x06B4:  "\u06af\u06db",                                                                   // ARABIC LETTER GAF WITH THREE DOTS ABOVE
+	0x06B5:  "\u0644\u0306",                                                                   // ARABIC LETTER LAM WITH SMALL V
+	0x06B7:  "\u0644\u06db",                                                                   // ARABIC LETTER LAM WITH THREE DOTS ABOVE
+	0x06BA:  "\u0649",                                                                         // ARABIC LETTER NOON GHUNNA
+	0x06BB:  "\u0649\u0615",                                                                   // ARABIC LETTER RNOON
+	0x06BD:  "\u0649\u06db",                                                                   // ARABIC LETTER NOON WITH THREE DOTS ABOVE
+	0x06BE:  "o",                                                                              // ARABIC LETTER HEH DOACHASHMEE
+	0x06C1:  "o",                                                                              // ARABIC LETTER HEH GOAL
+	0x06C2:  "\u06c0",                                                                         // ARABIC LETTER HEH GOAL WITH HAMZA ABOVE
+	0x06C3:  "\u0629",                                                                         // ARABIC LETTER TEH MARBUTA GOAL
+	0x06C6:  "\u0648\u0306",                                                                   // ARABIC LETTER OE
+	0x06C7:  "\u0648\u0313",                                                                   // ARABIC LETTER U
+	0x06C8:  "\u0648\u0670",                                                                   // ARABIC LETTER YU
+	0x06C9:  "\u0648\u0302",                                                                   // ARABIC LETTER KIRGHIZ YU
+	0x06CB:  "\u0648\u06db",                                                                   // ARABIC LETTER VE
+	0x06CC:  "\u0649",                                                                         // ARABIC LETTER FARSI YEH
+	0x06CE:  "\u0649\u0306",                                                                   // ARABIC LETTER YEH WITH SMALL V
+	0x06D0:  "\u067b",                                                                         // ARABIC LETTER E
+	0x06D1:  "\u0649\u06db",                                                                   // ARABIC LETTER YEH WITH THREE DOTS BELOW
+	0x06D2:  "\u0649",                                                                         // ARABIC LETTER YEH BARREE
+	0x06D4:  "-",                                                                              // ARABIC FULL STOP
+	0x06D5:  "o",                                                                              // ARABIC LETTER AE
+	0x06DF:  "\u030a",                                                                         // ARABIC SMALL HIGH ROUNDED ZERO
+	0x06E8:  "\u0306\u0307",                                                                   // ARABIC SMALL HIGH NOON
+	0x06EC:  "\u0307",                                                                         // ARABIC ROUNDED HIGH STOP WITH FILLED CENTRE
+	0x06EE:  "\u062f\u0302",                                                                   // ARABIC LETTER DAL WITH INVERTED V
+	0x06EF:  "\u0631\u0302",                                                                   // ARABIC LETTER REH WITH INVERTED V
+	0x06F0:  ".",                                                                              // EXTENDED ARABIC-INDIC DIGIT ZERO
+	0x06F1:  "l",                                                                              // EXTENDED ARABIC-INDIC DIGIT ONE
+	0x06F2:  "\u0662",                                                                         // EXTENDED ARABIC-INDIC DIGIT TWO
+	0x06F3:  "\u0663",                                                                         // EXTENDED ARABIC-INDIC DIGIT THREE
+	0x06F4:  "\u0664",                                                                         // EXTENDED ARABIC-INDIC DIGIT FOUR
+	0x06F5:  "o",                                                                              // EXTENDED ARABIC-INDIC DIGIT FIVE
+	0x06F6:  "\u0666",                                                                         // EXTENDED ARABIC-INDIC DIGIT SIX
+	0x06F7:  "V",                                                                              // EXTENDED ARABIC-INDIC DIGIT SEVEN
+	0x06F8:  "\u0245",                                                                         // EXTENDED ARABIC-INDIC DIGIT EIGHT
+	0x06F9:  "\u0669",                                                                         // EXTENDED ARABIC-INDIC DIGIT NINE
+	0x06FD:  "\u0621\u0348",                                                                   // ARABIC SIGN SINDHI AMPERSAND
+	0x06FE:  "\u0645\u0348",                                                                   // ARABIC SIGN SINDHI POSTPOSITION MEN
+	0x06FF:  "o\u0302",                                                                        // ARABIC LETTER HEH WITH INVERTED V
+	0x0701:  ".",                                                                              // SYRIAC SUPRALINEAR FULL STOP
+	0x0702:  ".",                                                                              // SYRIAC SUBLINEAR FULL STOP
+	0x0703:  ":",                                                                              // SYRIAC SUPRALINEAR COLON
+	0x0704:  ":",                                                                              // SYRIAC SUBLINEAR COLON
+	0x0740:  "\u0307",                                                                         // SYRIAC FEMININE DOT
+	0x0741:  "\u0307",                                                                         // SYRIAC QUSHSHAYA
+	0x0742:  "\u073c",                                                                         // SYRIAC RUKKAKHA
+	0x0747:  "\u0301",                                                                         // SYRIAC OBLIQUE LINE ABOVE
+	0x0751:  "\u0628\u06db",                                                                   // ARABIC LETTER BEH WITH DOT BELOW AND THREE DOTS ABOVE
+	0x0756:  "\u0649\u0306",                                                                   // ARABIC LETTER BEH WITH SMALL V
+	0x0762:  "\u06ac",                                                                         // ARABIC LETTER KEHEH WITH DOT ABOVE
+	0x0763:  "\u0643\u06db",                                                                   // ARABIC LETTER KEHEH WITH THREE DOTS ABOVE
+	0x0767:  "\u0754",                                                                         // ARABIC LETTER NOON WITH TWO DOTS BELOW
+	0x0768:  "\u0646\u0615",                                                                   // ARABIC LETTER NOON WITH SMALL TAH
+	0x0769:  "\u0646\u0306",                                                                   // ARABIC LETTER NOON WITH SMALL V
+	0x076C:  "\u0631\u0654",                                                                   // ARABIC LETTER REH WITH HAMZA ABOVE
+	0x0771:  "\u0697\u0615",                                                                   // ARABIC LETTER REH WITH SMALL ARABIC LETTER TAH AND TWO DOTS
+	0x0772:  "\u062d\u0654",                                                                   // ARABIC LETTER HAH WITH SMALL ARABIC LETTER TAH ABOVE
+	0x077E:  "\u0633\u0302",                                                                   // ARABIC LETTER SEEN WITH INVERTED V
+	0x07C0:  "O",                                                                              // NKO DIGIT ZERO
+	0x07CA:  "l",                                                                              // NKO LETTER A
+	0x07EB:  "\u0304",                                                                         // NKO COMBINING SHORT HIGH TONE
+	0x07ED:  "\u0307",                                                                         // NKO COMBINING SHORT RISING TONE
+	0x07EE:  "\u0302",                                                                         // NKO COMBINING LONG DESCENDING TONE
+	0x07F3:  "\u0308",                                                                         // NKO COMBINING DOUBLE DOT ABOVE
+	0x07F4:  "'",                                                                              // NKO HIGH TONE APOSTROPHE
+	0x07F5:  "'",                                                                              // NKO LOW TONE APOSTROPHE
+	0x07FA:  "_",                                                                              // NKO LAJANYALAN
+	0x08A1:  "\u0628\u0654",                                                                   // ARABIC LETTER BEH WITH HAMZA ABOVE
+	0x08A4:  "\u06a2\u06db",                                                                   // ARABIC LETTER FEH WITH DOT BELOW AND THREE DOTS ABOVE
+	0x08A7:  "\u0645\u06db",                                                                   // ARABIC LETTER MEEM WITH THREE DOTS ABOVE
+	0x08A8:  "\u0649\u0654",                                                                   // ARABIC LETTER YEH WITH TWO DOTS BELOW AND HAMZA ABOVE
+	0x08A9:  "\u0754",                                                                         // ARABIC LETTER YEH WITH TWO DOTS BELOW AND DOT ABOVE
+	0x08AE:  "\u062f\u0324\u0323",                                                             // ARABIC LETTER DAL WITH THREE DOTS BELOW
+	0x08AF:  "\u0635\u0324\u0323",                                                             // ARABIC LETTER SAD WITH THREE DOTS BELOW
+	0x08B0:  "\u06af",                                                                         // ARABIC LETTER GAF WITH INVERTED STROKE
+	0x08B1:  "\u0648",                                                                         // ARABIC LETTER STRAIGHT WAW
+	0x08B2:  "\u0632\u0302",                                                                   // ARABIC LETTER ZAIN WITH INVERTED V ABOVE
+	0x08B6:  "\u0628\u06e2",                                                                   // ARABIC LETTER BEH WITH SMALL MEEM ABOVE
+	0x08B7:  "\u0649\u06db\u06e2",                                                             // ARABIC LETTER PEH WITH SMALL MEEM ABOVE
+	0x08B9:  "\u0631\u0306\u0307",                                                             // ARABIC LETTER REH WITH SMALL NOON ABOVE
+	0x08BA:  "\u0649\u0306\u0307",                                                             // ARABIC LETTER YEH WITH TWO DOTS BELOW AND SMALL NOON ABOVE
+	0x08BB:  "\u06a1",                                                                         // ARABIC LETTER AFRICAN FEH
+	0x08BC:  "\u06a1",                                                                         // ARABIC LETTER AFRICAN QAF
+	0x08BD:  "\u0649",                                                                         // ARABIC LETTER AFRICAN NOON
+	0x08E5:  "\u064c",                                                                         // ARABIC CURLY DAMMA
+	0x08E8:  "\u064c",                                                                         // ARABIC CURLY DAMMATAN
+	0x08EA:  "\u0307",                                                                         // ARABIC TONE ONE DOT ABOVE
+	0x08EB:  "\u0308",                                                                         // ARABIC TONE TWO DOTS ABOVE
+	0x08ED:  "\u0323",                                                                         // ARABIC TONE ONE DOT BELOW
+	0x08EE:  "\u0324",                                                                         // ARABIC TONE TWO DOTS BELOW
+	0x08F0:  "\u030b",                                                                         // ARABIC OPEN FATHATAN
+	0x08F1:  "\u064c",                                                                         // ARABIC OPEN DAMMATAN
+	0x08F2:  "\u064d",                                                                         // ARABIC OPEN KASRATAN
+	0x08F3:  "\u0313",                                                                         // ARABIC SMALL HIGH WAW
+	0x08F8:  "\u0350",                                                                         // ARABIC RIGHT ARROWHEAD ABOVE
+	0x08F9:  "\u0354",                                                                         // ARABIC LEFT ARROWHEAD BELOW
+	0x08FA:  "\u0355",                                                                         // ARABIC RIGHT ARROWHEAD BELOW
+	0x08FF:  "\u0350",                                                                         // ARABIC MARK SIDEWAYS NOON GHUNNA
+	0x0900:  "\u0352",                                                                         // DEVANAGARI SIGN INVERTED CANDRABINDU
+	0x0901:  "\u0306\u0307",                                                                   // DEVANAGARI SIGN CANDRABINDU
+	0x0902:  "\u0307",                                                                         // DEVANAGARI SIGN ANUSVARA
+	0x0903:  ":",                                                                              // DEVANAGARI SIGN VISARGA
+	0x0904:  "\u0905\u0946",                                                                   // DEVANAGARI LETTER SHORT A
+	0x0906:  "\u0905\u093e",                                                                   // DEVANAGARI LETTER AA
+	0x0908:  "\u0930\u094d\u0907",                                                             // DEVANAGARI LETTER II
+	0x090D:  "\u090f\u0945",                                                                   // DEVANAGARI LETTER CANDRA E
+	0x090E:  "\u090f\u0946",                                                                   // DEVANAGARI LETTER SHORT E
+	0x0910:  "\u090f\u0947",                                                                   // DEVANAGARI LETTER AI
+	0x0911:  "\u0905\u0949",                                                                   // DEVANAGARI LETTER CANDRA O
+	0x0912:  "\u0905\u093e\u0946",                                                             // DEVANAGARI LETTER SHORT O
+	0x0913:  "\u0905\u093e\u0947",                                                             // DEVANAGARI LETTER O
+	0x0914:  "\u0905\u093e\u0948",                                                             // DEVANAGARI LETTER AU
+	0x093C:  "\u0323",                                                                         // DEVANAGARI SIGN NUKTA
+	0x0952:  "\u0331",                                                                         // DEVANAGARI STRESS SIGN ANUDATTA
+	0x0953:  "\u0300",                                                                         // DEVANAGARI GRAVE ACCENT
+	0x0954:  "\u0301",                                                                         // DEVANAGARI ACUTE ACCENT
+	0x0965:  "\u0964\u0964",                                                                   // DEVANAGARI DOUBLE DANDA
+	0x0966:  "o",                                                                              // DEVANAGARI DIGIT ZERO
+	0x0967:  "\u0669",                                                                         // DEVANAGARI DIGIT ONE
+	0x097D:  "?",                                                                              // DEVANAGARI LETTER GLOTTAL STOP
+	0x0981:  "\u0306\u0307",                                                                   // BENGALI SIGN CANDRABINDU
+	0x0986:  "\u0985\u09be",                                                                   // BENGALI LETTER AA
+	0x09BC:  "\u0323",                                                                         // BENGALI SIGN NUKTA
+	0x09E0:  "\u098b\u09c3",                                                                   // BENGALI LETTER VOCALIC RR
+	0x09E1:  "\u098b\u09c3",                                                                   // BENGALI LETTER VOCALIC LL
+	0x09E6:  "O",                                                                              // BENGALI DIGIT ZERO
+	0x09EA:  "8",                                                                              // BENGALI DIGIT FOUR
+	0x09ED:  "9",                                                                              // BENGALI DIGIT SEVEN
+	0x0A02:  "\u0307",                                                                         // GURMUKHI SIGN BINDI
+	0x0A03:  "\u0983",                                                                         // GURMUKHI SIGN VISARGA
+	0x0A06:  "\u0a05\u0a3e",                                                                   // GURMUKHI LETTER AA
+	0x0A07:  "\u0a72\u0a3f",                                                                   // GURMUKHI LETTER I
+	0x0A08:  "\u0a72\u0a40",                                                                   // GURMUKHI LETTER II
+	0x0A09:  "\u0a73\u0a41",                                                                   // GURMUKHI LETTER U
+	0x0A0A:  "\u0a73\u0a42",                                                                   // GURMUKHI LETTER UU
+	0x0A0F:  "\u0a72\u0a47",                                                                   // GURMUKHI LETTER EE
+	0x0A10:  "\u0a05\u0a48",                                                                   // GURMUKHI LETTER AI
+	0x0A14:  "\u0a05\u0a4c",                                                                   // GURMUKHI LETTER AU
+	0x0A3C:  "\u0323",                                                                         // GURMUKHI SIGN NUKTA
+	0x0A4B:  "\u0946",                                                                         // GURMUKHI VOWEL SIGN OO
+	0x0A4D:  "\u094d",                                                                         // GURMUKHI SIGN VIRAMA
+	0x0A66:  "o",                                                                              // GURMUKHI DIGIT ZERO
+	0x0A67:  "9",                                                                              // GURMUKHI DIGIT ONE
+	0x0A6A:  "8",                                                                              // GURMUKHI DIGIT FOUR
+	0x0A81:  "\u0306\u0307",                                                                   // GUJARATI SIGN CANDRABINDU
+	0x0A82:  "\u0307",                                                                         // GUJARATI SIGN ANUSVARA
+	0x0A83:  ":",                                                                              // GUJARATI SIGN VISARGA
+	0x0A86:  "\u0a85\u0abe",                                                                   // GUJARATI LETTER AA
+	0x0A8D:  "\u0a85\u0ac5",                                                                   // GUJARATI VOWEL CANDRA E
+	0x0A8F:  "\u0a85\u0ac7",                                                                   // GUJARATI LETTER E
+	0x0A90:  "\u0a85\u0ac8",                                                                   // GUJARATI LETTER AI
+	0x0A91:  "\u0a85\u0abe\u0ac5",                                                             // GUJARATI VOWEL CANDRA O
+	0x0A93:  "\u0a85\u0abe\u0ac7",                                                             // GUJARATI LETTER O
+	0x0A94:  "\u0a85\u0abe\u0ac8",                                                             // GUJARATI LETTER AU
+	0x0ABC:  "\u0323",                                                                         // GUJARATI SIGN NUKTA
+	0x0ABD:  "\u093d",                                                                         // GUJARATI SIGN AVAGRAHA
+	0x0AC1:  "\u0941",                                                                         // GUJARATI VOWEL SIGN U
+	0x0AC2:  "\u0942",                                                                         // GUJARATI VOWEL SIGN UU
+	0x0ACD:  "\u094d",                                                                         // GUJARATI SIGN VIRAMA
+	0x0AE6:  "o",                                                                              // GUJARATI DIGIT ZERO
+	0x0AE8:  "\u0968",                                                                         // GUJARATI DIGIT TWO
+	0x0AE9:  "\u0969",                                                                         // GUJARATI DIGIT THREE
+	0x0AEA:  "\u096a",                                                                         // GUJARATI DIGIT FOUR
+	0x0AEE:  "\u096e",                                                                         // GUJARATI DIGIT EIGHT
+	0x0AF0:  "\u0970",                                                                         // GUJARATI ABBREVIATION SIGN
+	0x0B01:  "\u0306\u0307",                                                                   // ORIYA SIGN CANDRABINDU
+	0x0B03:  "8",                                                                              // ORIYA SIGN VISARGA
+	0x0B06:  "\u0b05\u0b3e",                                                                   // ORIYA LETTER AA
+	0x0B20:  "O",                                                                              // ORIYA LETTER TTHA
+	0x0B3C:  "\u0323",                                                                         // ORIYA SIGN NUKTA
+	0x0B66:  "O",                                                                              // ORIYA DIGIT ZERO
+	0x0B68:  "9",                                                                              // ORIYA DIGIT TWO
+	0x0B82:  "\u030a",                                                                         // TAMIL SIGN ANUSVARA
+	0x0B8A:  "\u0b89\u0bb3",                                                                   // TAMIL LETTER UU
+	0x0B9C:  "\u0b90",                                                                         // TAMIL LETTER JA
+	0x0BB0:  "\u0b88",                                                                         // TAMIL LETTER RA
+	0x0BBE:  "\u0b88",                                                                         // TAMIL VOWEL SIGN AA
+	0x0BC8:  "\u0ba9",                                                                         // TAMIL VOWEL SIGN AI
+	0x0BCA:  "\u0bc6\u0b88",                                                                   // TAMIL VOWEL SIGN O
+	0x0BCB:  "\u0bc7\u0b88",                                                                   // TAMIL VOWEL SIGN OO
+	0x0BCC:  "\u0bc6\u0bb3",                                                                   // TAMIL VOWEL SIGN AU
+	0x0BCD:  "\u0307",                                                                         // TAMIL SIGN VIRAMA
+	0x0BD7:  "\u0bb3",                                                                         // TAMIL AU LENGTH MARK
+	0x0BE6:  "o",                                                                              // TAMIL DIGIT ZERO
+	0x0BE7:  "\u0b95",                                                                         // TAMIL DIGIT ONE
+	0x0BE8:  "\u0b89",                                                                         // TAMIL DIGIT TWO
+	0x0BEA:  "\u0b9a",                                                                         // TAMIL DIGIT FOUR
+	0x0BEB:  "\u0b88\u0bc1",                                                                   // TAMIL DIGIT FIVE
+	0x0BEC:  "\u0b9a\u0bc1",                                                                   // TAMIL DIGIT SIX
+	0x0BED:  "\u0b8e",                                                                         // TAMIL DIGIT SEVEN
+	0x0BEE:  "\u0b85",                                                                         // TAMIL DIGIT EIGHT
+	0x0BF0:  "\u0baf",                                                                         // TAMIL NUMBER TEN
+	0x0BF2:  "\u0b9a\u0bc2",                                                                   // TAMIL NUMBER ONE THOUSAND
+	0x0BF4:  "\u0bae\u0bc0",                                                                   // TAMIL MONTH SIGN
+	0x0BF5:  "\u0bf3",                                                                         // TAMIL YEAR SIGN
+	0x0BF7:  "\u0b8e\u0bb5",                                                                   // TAMIL CREDIT SIGN
+	0x0BF8:  "\u0bb7",                                                                         // TAMIL AS ABOVE SIGN
+	0x0BFA:  "\u0ba8\u0bc0",                                                                   // TAMIL NUMBER SIGN
+	0x0C00:  "\u0306\u0307",                                                                   // TELUGU SIGN COMBINING CANDRABINDU ABOVE
+	0x0C02:  "o",                                                                              // TELUGU SIGN ANUSVARA
+	0x0C03:  "\u0983",                                                                         // TELUGU SIGN VISARGA
+	0x0C13:  "\u0c12\u0c55",                                                                   // TELUGU LETTER OO
+	0x0C14:  "\u0c12\u0c4c",                                                                   // TELUGU LETTER AU
+	0x0C20:  "\u0c30\u05bc",                                                                   // TELUGU LETTER TTHA
+	0x0C22:  "\u0c21\u0323",                                                                   // TELUGU LETTER DDHA
+	0x0C25:  "\u0c27\u05bc",                                                                   // TELUGU LETTER THA
+	0x0C2D:  "\u0c2c\u0323",                                                                   // TELUGU LETTER BHA
+	0x0C2E:  "\u0c35\u0c41",                                                                   // TELUGU LETTER MA
+	0x0C37:  "\u0c35\u0323",                                                                   // TELUGU LETTER SSA
+	0x0C39:  "\u0c35\u0c3e",                                                                   // TELUGU LETTER HA
+	0x0C42:  "\u0c41\u0c3e",                                                                   // TELUGU VOWEL SIGN UU
+	0x0C44:  "\u0c43\u0c3e",                                                                   // TELUGU VOWEL SIGN VOCALIC RR
+	0x0C60:  "\u0c0b\u0c3e",                                                                   // TELUGU LETTER VOCALIC RR
+	0x0C61:  "\u0c0c\u0c3e",                                                                   // TELUGU LETTER VOCALIC LL
+	0x0C66:  "o",                                                                              // TELUGU DIGIT ZERO
+	0x0C81:  "\u0306\u0307",                                                                   // KANNADA SIGN CANDRABINDU
+	0x0C82:  "o",                                                                              // KANNADA SIGN ANUSVARA
+	0x0C83:  "\u0983",                                                                         // KANNADA SIGN VISARGA
+	0x0C85:  "\u0c05",                                                                         // KANNADA LETTER A
+	0x0C86:  "\u0c06",                                                                         // KANNADA LETTER AA
+	0x0C87:  "\u0c07",                                                                         // KANNADA LETTER I
+	0x0C92:  "\u0c12",                                                                         // KANNADA LETTER O
+	0x0C93:  "\u0c12\u0c55",                                                                   // KANNADA LETTER OO
+	0x0C94:  "\u0c12\u0c4c",                                                                   // KANNADA LETTER AU
+	0x0C9C:  "\u0c1c",                                                                         // KANNADA LETTER JA
+	0x0C9E:  "\u0c1e",                                                                         // KANNADA LETTER NYA
+	0x0CA3:  "\u0c23",                                                                         // KANNADA LETTER NNA
+	0x0CAF:  "\u0c2f",                                                                         // KANNADA LETTER YA
+	0x0CB1:  "\u0c31",                                                                         // KANNADA LETTER RRA
+	0x0CB2:  "\u0c32",                                                                         // KANNADA LETTER LA
+	0x0CE1:  "\u0c8c\u0cbe",                                                                   // KANNADA LETTER VOCALIC LL
+	0x0CE6:  "o",                                                                              // KANNADA DIGIT ZERO
+	0x0CE7:  "\u0c67",                                                                         // KANNADA DIGIT ONE
+	0x0CE8:  "\u0c68",                                                                         // KANNADA DIGIT TWO
+	0x0CEF:  "\u0c6f",                                                                         // KANNADA DIGIT NINE
+	0x0D01:  "\u0306\u0307",                                                                   // MALAYALAM SIGN CANDRABINDU
+	0x0D02:  "o",                                                                              // MALAYALAM SIGN ANUSVARA
+	0x0D03:  "\u0983",                                                                         // MALAYALAM SIGN VISARGA
+	0x0D08:  "\u0d07\u0d57",                                                                   // MALAYALAM LETTER II
+	0x0D09:  "\u0b89",                                                                         // MALAYALAM LETTER U
+	0x0D0A:  "\u0b89\u0d57",                                                                   // MALAYALAM LETTER UU
+	0x0D0C:  "\u0d28\u0d41",                                                                   // MALAYALAM LETTER VOCALIC L
+	0x0D10:  "\u0d0e\u0d46",                                                                   // MALAYALAM LETTER AI
+	0x0D13:  "\u0d12\u0d3e",                                                                   // MALAYALAM LETTER OO
+	0x0D14:  "\u0d12\u0d57",                                                                   // MALAYALAM LETTER AU
+	0x0D19:  "\u0d28\u0d41",                                                                   // MALAYALAM LETTER NGA
+	0x0D1C:  "\u0b90",                                                                         // MALAYALAM LETTER JA
+	0x0D20:  "o",                                                                              // MALAYALAM LETTER TTHA
+	0x0D23:  "\u0ba3",                                                                         // MALAYALAM LETTER NNA
+	0x0D31:  "\u0d30",                                                                         // MALAYALAM LETTER RRA
+	0x0D34:  "\u0bb4",                                                                         // MALAYALAM LETTER LLLA
+	0x0D36:  "\u0bb6",                                                                         // MALAYALAM LETTER SHA
+	0x0D3A:  "\u0b9f\u0bbf",                                                                   // MALAYALAM LETTER TTTA
+	0x0D3F:  "\u0bbf",                                                                         // MALAYALAM VOWEL SIGN I
+	0x0D40:  "\u0bbf",                                                                         // MALAYALAM VOWEL SIGN II
+	0x0D42:  "\u0d41",                                                                         // MALAYALAM VOWEL SIGN UU
+	0x0D43:  "\u0d41",                                                                         // MALAYALAM VOWEL SIGN VOCALIC R
+	0x0D48:  "\u0d46\u0d46",                                                                   // MALAYALAM VOWEL SIGN AI
+	0x0D4E:  "\u0971",                                                                         // MALAYALAM LETTER DOT REPH
+	0x0D5A:  "\u0d28\u0d4d\u0d2e",                                                             // MALAYALAM FRACTION THREE EIGHTIETHS
+	0x0D5F:  "o\u0d30o",                                                                       // MALAYALAM LETTER ARCHAIC II
+	0x0D61:  "\u0d1e",                                                                         // MALAYALAM LETTER VOCALIC LL
+	0x0D66:  "o",                                                                              // MALAYALAM DIGIT ZERO
+	0x0D6A:  "\u0d30\u0d4d",                                                                   // MALAYALAM DIGIT FOUR
+	0x0D6B:  "\u0d26\u0d4d\u0d30",                                                             // MALAYALAM DIGIT FIVE
+	0x0D6C:  "\u0d28\u0d4d\u0d28",                                                             // MALAYALAM DIGIT SIX
+	0x0D6D:  "9",                                                                              // MALAYALAM DIGIT SEVEN
+	0x0D6E:  "\u0d35\u0d4d\u0d30",                                                             // MALAYALAM DIGIT EIGHT
+	0x0D6F:  "\u0d28\u0d4d",                                                                   // MALAYALAM DIGIT NINE
+	0x0D76:  "\u0d39\u0d4d\u0d2e",                                                             // MALAYALAM FRACTION ONE SIXTEENTH
+	0x0D79:  "\u0d28\u0d41",                                                                   // MALAYALAM DATE MARK
+	0x0D7B:  "\u0d28\u0d4d",                                                                   // MALAYALAM LETTER CHILLU N
+	0x0D7C:  "\u0d30\u0d4d",                                                                   // MALAYALAM LETTER CHILLU RR
+	0x0D82:  "o",                                                                              // SINHALA SIGN ANUSVARAYA
+	0x0D83:  "\u0983",                                                                         // SINHALA SIGN VISARGAYA
+	0x0DE9:  "\u0de8\u0dcf",                                                                   // SINHALA LITH DIGIT THREE
+	0x0DEA:  "\u0da2",                                                                         // SINHALA LITH DIGIT FOUR
+	0x0DEB:  "\u0daf",                                                                         // SINHALA LITH DIGIT FIVE
+	0x0DEF:  "\u0de8\u0dd3",                                                                   // SINHALA LITH DIGIT NINE
+	0x0E03:  "\u0e02",                                                                         // THAI CHARACTER KHO KHUAT
+	0x0E0B:  "\u0e0a",                                                                         // THAI CHARACTER SO SO
+	0x0E0F:  "\u0e0e",                                                                         // THAI CHARACTER TO PATAK
+	0x0E14:  "\u0e04",                                                                         // THAI CHARACTER DO DEK
+	0x0E15:  "\u0e04",                                                                         // THAI CHARACTER TO TAO
+	0x0E17:  "\u0e11",                                                                         // THAI CHARACTER THO THAHAN
+	0x0E21:  "\u0e06",                                                                         // THAI CHARACTER MO MA
+	0x0E26:  "\u0e20",                                                                         // THAI CHARACTER LU
+	0x0E33:  "\u030a\u0e32",                                                                   // THAI CHARACTER SARA AM
+	0x0E41:  "\u0e40\u0e40",                                                                   // THAI CHARACTER SARA AE
+	0x0E45:  "\u0e32",                                                                         // THAI CHARACTER LAKKHANGYAO
+	0x0E4D:  "\u030a",                                                                         // THAI CHARACTER NIKHAHIT
+	0x0E50:  "o",                                                                              // THAI DIGIT ZERO
+	0x0E88:  "\u0e08",                                                                         // LAO LETTER CO
+	0x0E8D:  "\u0e22",                                                                         // LAO LETTER NYO
+	0x0E9A:  "\u0e1a",                                                                         // LAO LETTER BO
+	0x0E9B:  "\u0e1b",                                                                         // LAO LETTER PO
+	0x0E9D:  "\u0e1d",                                                                         // LAO LETTER FO TAM
+	0x0E9E:  "\u0e1e",                                                                         // LAO LETTER PHO TAM
+	0x0E9F:  "\u0e1f",                                                                         // LAO LETTER FO SUNG
+	0x0EB3:  "\u030a\u0eb2",                                                                   // LAO VOWEL SIGN AM
+	0x0EB8:  "\u0e38",                                                                         // LAO VOWEL SIGN U
+	0x0EB9:  "\u0e39",                                                                         // LAO VOWEL SIGN UU
+	0x0EC8:  "\u0e48",                                                                         // LAO TONE MAI EK
+	0x0EC9:  "\u0e49",                                                                         // LAO TONE MAI THO
+	0x0ECA:  "\u0e4a",                                                                         // LAO TONE MAI TI
+	0x0ECB:  "\u0e4b",                                                                         // LAO TONE MAI CATAWA
+	0x0ECD:  "\u030a",                                                                         // LAO NIGGAHITA
+	0x0ED0:  "o",                                                                              // LAO DIGIT ZERO
+	0x0EDC:  "\u0eab\u0e99",                                                                   // LAO HO NO
+	0x0EDD:  "\u0eab\u0ea1",                                                                   // LAO HO MO
+	0x0F00:  "\u0f68\u0f7c\u0f7e",                                                             // TIBETAN SYLLABLE OM
+	0x0F02:  "\u0f60\u0f74\u0f82\u0f7f",                                                       // TIBETAN MARK GTER YIG MGO -UM RNAM BCAD MA
+	0x0F03:  "\u0f60\u0f74\u0f82\u0f14",                                                       // TIBETAN MARK GTER YIG MGO -UM GTER TSHEG MA
+	0x0F0C:  "\u0f0b",                                                                         // TIBETAN MARK DELIMITER TSHEG BSTAR
+	0x0F0E:  "\u0f0d\u0f0d",                                                                   // TIBETAN MARK NYIS SHAD
+	0x0F1B:  "\u0f1a\u0f1a",                                                                   // TIBETAN SIGN RDEL DKAR GNYIS
+	0x0F1E:  "\u0f1d\u0f1d",                                                                   // TIBETAN SIGN RDEL NAG GNYIS
+	0x0F1F:  "\u0f1a\u0f1d",                                                                   // TIBETAN SIGN RDEL DKAR RDEL NAG
+	0x0F37:  "\u0325",                                                                         // TIBETAN MARK NGAS BZUNG SGOR RTAGS
+	0x0F6A:  "\u0f62",                                                                         // TIBETAN LETTER FIXED-FORM RA
+	0x0F77:  "\u0fb2\u0f71\u0f80",                                                             // TIBETAN VOWEL SIGN VOCALIC RR
+	0x0F79:  "\u0fb3\u0f71\u0f80",                                                             // TIBETAN VOWEL SIGN VOCALIC LL
+	0x0FCE:  "\u0f1d\u0f1a",                                                                   // TIBETAN SIGN RDEL NAG RDEL DKAR
+	0x0FD5:  "\u5350",                                                                         // RIGHT-FACING SVASTI SIGN
+	0x0FD6:  "\u534d",                                                                         // LEFT-FACING SVASTI SIGN
+	0x1000:  "\u1002\u102c",                                                                   // MYANMAR LETTER KA
+	0x1010:  "o\u102c",                                                                        // MYANMAR LETTER TA
+	0x101D:  "o",                                                                              // MYANMAR LETTER WA
+	0x101F:  "\u1015\u102c",                                                                   // MYANMAR LETTER HA
+	0x1029:  "\u101e\u103c",                                                                   // MYANMAR LETTER O
+	0x102A:  "\u101e\u103c\u1031\u102c\u103a",                                                 // MYANMAR LETTER AU
+	0x1036:  "\u030a",                                                                         // MYANMAR SIGN ANUSVARA
+	0x1038:  "\u0983",                                                                         // MYANMAR SIGN VISARGA
+	0x1040:  "o",                                                                              // MYANMAR DIGIT ZERO
+	0x104B:  "\u104a\u104a",                                                                   // MYANMAR SIGN SECTION
+	0x1065:  "\u1041",                                                                         // MYANMAR LETTER WESTERN PWO KAREN THA
+	0x1066:  "\u1015\u103e",                                                                   // MYANMAR LETTER WESTERN PWO KAREN PWA
+	0x106F:  "\u1015\u102c\u103e",                                                             // MYANMAR LETTER EASTERN PWO KAREN YWA
+	0x1070:  "\u1003\u103e",                                                                   // MYANMAR LETTER EASTERN PWO KAREN GHWA
+	0x107E:  "\u107d\u103e",                                                                   // MYANMAR LETTER SHAN FA
+	0x1081:  "\u1002\u103e",                                                                   // MYANMAR LETTER SHAN HA
+	0x109E:  "\u1083\u030a",                                                                   // MYANMAR SYMBOL SHAN ONE
+	0x10A0:  "\ua786",                                                                         // GEORGIAN CAPITAL LETTER AN
+	0x10E7:  "y",                                                                              // GEORGIAN LETTER QAR
+	0x10F3:  "\u021d",                                                                         // GEORGIAN LETTER WE
+	0x10FF:  "o",                                                                              // GEORGIAN LETTER LABIAL SIGN
+	0x1101:  "\u1100\u1100",                                                                   // HANGUL CHOSEONG SSANGKIYEOK
+	0x1104:  "\u1103\u1103",                                                                   // HANGUL CHOSEONG SSANGTIKEUT
+	0x1108:  "\u1107\u1107",                                                                   // HANGUL CHOSEONG SSANGPIEUP
+	0x110A:  "\u1109\u1109",                                                                   // HANGUL CHOSEONG SSANGSIOS
+	0x110D:  "\u110c\u110c",                                                                   // HANGUL CHOSEONG SSANGCIEUC
+	0x1113:  "\u1102\u1100",                                                                   // HANGUL CHOSEONG NIEUN-KIYEOK
+	0x1114:  "\u1102\u1102",                                                                   // HANGUL CHOSEONG SSANGNIEUN
+	0x1115:  "\u1102\u1103",                                                                   // HANGUL CHOSEONG NIEUN-TIKEUT
+	0x1116:  "\u1102\u1107",                                                                   // HANGUL CHOSEONG NIEUN-PIEUP
+	0x1117:  "\u1103\u1100",                                                                   // HANGUL CHOSEONG TIKEUT-KIYEOK
+	0x1118:  "\u1105\u1102",                                                                   // HANGUL CHOSEONG RIEUL-NIEUN
+	0x1119:  "\u1105\u1105",                                                                   // HANGUL CHOSEONG SSANGRIEUL
+	0x111A:  "\u1105\u1112",                                                                   // HANGUL CHOSEONG RIEUL-HIEUH
+	0x111B:  "\u1105\u110b",                                                                   // HANGUL CHOSEONG KAPYEOUNRIEUL
+	0x111C:  "\u1106\u1107",                                                                   // HANGUL CHOSEONG MIEUM-PIEUP
+	0x111D:  "\u1106\u110b",                                                                   // HANGUL CHOSEONG KAPYEOUNMIEUM
+	0x111E:  "\u1107\u1100",                                                                   // HANGUL CHOSEONG PIEUP-KIYEOK
+	0x111F:  "\u1107\u1102",                                                                   // HANGUL CHOSEONG PIEUP-NIEUN
+	0x1120:  "\u1107\u1103",                                                                   // HANGUL CHOSEONG PIEUP-TIKEUT
+	0x1121:  "\u1107\u1109",                                                                   // HANGUL CHOSEONG PIEUP-SIOS
+	0x1122:  "\u1107\u1109\u1100",                                                             // HANGUL CHOSEONG PIEUP-SIOS-KIYEOK
+	0x1123:  "\u1107\u1109\u1103",                                                             // HANGUL CHOSEONG PIEUP-SIOS-TIKEUT
+	0x1124:  "\u1107\u1109\u1107",                                                             // HANGUL CHOSEONG PIEUP-SIOS-PIEUP
+	0x1125:  "\u1107\u1109\u1109",                                                             // HANGUL CHOSEONG PIEUP-SSANGSIOS
+	0x1126:  "\u1107\u1109\u110c",                                                             // HANGUL CHOSEONG PIEUP-SIOS-CIEUC
+	0x1127:  "\u1107\u110c",                                                                   // HANGUL CHOSEONG PIEUP-CIEUC
+	0x1128:  "\u1107\u110e",                                                                   // HANGUL CHOSEONG PIEUP-CHIEUCH
+	0x1129:  "\u1107\u1110",                                                                   // HANGUL CHOSEONG PIEUP-THIEUTH
+	0x112A:  "\u1107\u1111",                                                                   // HANGUL CHOSEONG PIEUP-PHIEUPH
+	0x112B:  "\u1107\u110b",                                                                   // HANGUL CHOSEONG KAPYEOUNPIEUP
+	0x112C:  "\u1107\u1107\u110b",                                                             // HANGUL CHOSEONG KAPYEOUNSSANGPIEUP
+	0x112D:  "\u1109\u1100",                                                                   // HANGUL CHOSEONG SIOS-KIYEOK
+	0x112E:  "\u1109\u1102",                                                                   // HANGUL CHOSEONG SIOS-NIEUN
+	0x112F:  "\u1109\u1103",                                                                   // HANGUL CHOSEONG SIOS-TIKEUT
+	0x1130:  "\u1109\u1105",                                                                   // HANGUL CHOSEONG SIOS-RIEUL
+	0x1131:  "\u1109\u1106",                                                                   // HANGUL CHOSEONG SIOS-MIEUM
+	0x1132:  "\u1109\u1107",                                                                   // HANGUL CHOSEONG SIOS-PIEUP
+	0x1133:  "\u1109\u1107\u1100",                                                             // HANGUL CHOSEONG SIOS-PIEUP-KIYEOK
+	0x1134:  "\u1109\u1109\u1109",                                                             // HANGUL CHOSEONG SIOS-SSANGSIOS
+	0x1135:  "\u1109\u110b",                                                                   // HANGUL CHOSEONG SIOS-IEUNG
+	0x1136:  "\u1109\u110c",                                                                   // HANGUL CHOSEONG SIOS-CIEUC
+	0x1137:  "\u1109\u110e",                                                                   // HANGUL CHOSEONG SIOS-CHIEUCH
+	0x1138:  "\u1109\u110f",                                                                   // HANGUL CHOSEONG SIOS-KHIEUKH
+	0x1139:  "\u1109\u1110",                                                                   // HANGUL CHOSEONG SIOS-THIEUTH
+	0x113A:  "\u1109\u1111",                                                                   // HANGUL CHOSEONG SIOS-PHIEUPH
+	0x113B:  "\u1105\u1112",                                                                   // HANGUL CHOSEONG SIOS-HIEUH
+	0x113D:  "\u113c\u113c",                                                                   // HANGUL CHOSEONG CHITUEUMSSANGSIOS
+	0x113F:  "\u113e\u113e",                                                                   // HANGUL CHOSEONG CEONGCHIEUMSSANGSIOS
+	0x1141:  "\u110b\u1100",                                                                   // HANGUL CHOSEONG IEUNG-KIYEOK
+	0x1142:  "\u110b\u1103",                                                                   // HANGUL CHOSEONG IEUNG-TIKEUT
+	0x1143:  "\u110b\u1106",                                                                   // HANGUL CHOSEONG IEUNG-MIEUM
+	0x1144:  "\u110b\u1107",                                                                   // HANGUL CHOSEONG IEUNG-PIEUP
+	0x1145:  "\u110b\u1109",                                                                   // HANGUL CHOSEONG IEUNG-SIOS
+	0x1146:  "\u110b\u1140",                                                                   // HANGUL CHOSEONG IEUNG-PANSIOS
+	0x1147:  "\u110b\u110b",                                                                   // HANGUL CHOSEONG SSANGIEUNG
+	0x1148:  "\u110b\u110c",                                                                   // HANGUL CHOSEONG IEUNG-CIEUC
+	0x1149:  "\u110b\u110e",                                                                   // HANGUL CHOSEONG IEUNG-CHIEUCH
+	0x114A:  "\u110b\u1110",                                                                   // HANGUL CHOSEONG IEUNG-THIEUTH
+	0x114B:  "\u110b\u1111",                                                                   // HANGUL CHOSEONG IEUNG-PHIEUPH
+	0x114D:  "\u110c\u110b",                                                                   // HANGUL CHOSEONG CIEUC-IEUNG
+	0x114F:  "\u114e\u114e",                                                                   // HANGUL CHOSEONG CHITUEUMSSANGCIEUC
+	0x1151:  "\u1150\u1150",                                                                   // HANGUL CHOSEONG CEONGCHIEUMSSANGCIEUC
+	0x1152:  "\u110e\u110f",                                                                   // HANGUL CHOSEONG CHIEUCH-KHIEUKH
+	0x1153:  "\u110e\u1112",                                                                   // HANGUL CHOSEONG CHIEUCH-HIEUH
+	0x1156:  "\u1111\u1107",                                                                   // HANGUL CHOSEONG PHIEUPH-PIEUP
+	0x1157:  "\u1111\u110b",                                                                   // HANGUL CHOSEONG KAPYEOUNPHIEUPH
+	0x1158:  "\u1112\u1112",                                                                   // HANGUL CHOSEONG SSANGHIEUH
+	0x115A:  "\u1100\u1103",                                                                   // HANGUL CHOSEONG KIYEOK-TIKEUT
+	0x115B:  "\u1102\u1109",                                                                   // HANGUL CHOSEONG NIEUN-SIOS
+	0x115C:  "\u1102\u110c",                                                                   // HANGUL CHOSEONG NIEUN-CIEUC
+	0x115D:  "\u1102\u1112",                                                                   // HANGUL CHOSEONG NIEUN-HIEUH
+	0x115E:  "\u1103\u1105",                                                                   // HANGUL CHOSEONG TIKEUT-RIEUL
+	0x1162:  "\u1161\u4e28",                                                                   // HANGUL JUNGSEONG AE
+	0x1164:  "\u1163\u4e28",                                                                   // HANGUL JUNGSEONG YAE
+	0x1166:  "\u1165\u4e28",                                                                   // HANGUL JUNGSEONG E
+	0x1168:  "\u1167\u4e28",                                                                   // HANGUL JUNGSEONG YE
+	0x116A:  "\u1169\u1161",                                                                   // HANGUL JUNGSEONG WA
+	0x116B:  "\u1169\u1161\u4e28",                                                             // HANGUL JUNGSEONG WAE
+	0x116C:  "\u1169\u4e28",                                                                   // HANGUL JUNGSEONG OE
+	0x116F:  "\u116e\u1165",                                                                   // HANGUL JUNGSEONG WEO
+	0x1170:  "\u116e\u1165\u4e28",                                                             // HANGUL JUNGSEONG WE
+	0x1171:  "\u116e\u4e28",                                                                   // HANGUL JUNGSEONG WI
+	0x1173:  "\u30fc",                                                                         // HANGUL JUNGSEONG EU
+	0x1174:  "\u30fc\u4e28",                                                                   // HANGUL JUNGSEONG YI
+	0x1175:  "\u4e28",                                                                         // HANGUL JUNGSEONG I
+	0x1176:  "\u1161\u1169",                                                                   // HANGUL JUNGSEONG A-O
+	0x1177:  "\u1161\u116e",                                                                   // HANGUL JUNGSEONG A-U
+	0x1178:  "\u1163\u1169",                                                                   // HANGUL JUNGSEONG YA-O
+	0x1179:  "\u1163\u116d",                                                                   // HANGUL JUNGSEONG YA-YO
+	0x117A:  "\u1165\u1169",                                                                   // HANGUL JUNGSEONG EO-O
+	0x117B:  "\u1165\u116e",                                                                   // HANGUL JUNGSEONG EO-U
+	0x117C:  "\u1165\u30fc",                                                                   // HANGUL JUNGSEONG EO-EU
+	0x117D:  "\u1167\u1169",                                                                   // HANGUL JUNGSEONG YEO-O
+	0x117E:  "\u1167\u116e",                                                                   // HANGUL JUNGSEONG YEO-U
+	0x117F:  "\u1169\u1165",                                                                   // HANGUL JUNGSEONG O-EO
+	0x1180:  "\u1169\u1165\u4e28",                                                             // HANGUL JUNGSEONG O-E
+	0x1181:  "\u1169\u1167\u4e28",                                                             // HANGUL JUNGSEONG O-YE
+	0x1182:  "\u1169\u1169",                                                                   // HANGUL JUNGSEONG O-O
+	0x1183:  "\u1169\u116e",                                                                   // HANGUL JUNGSEONG O-U
+	0x1184:  "\u116d\u1163",                                                                   // HANGUL JUNGSEONG YO-YA
+	0x1185:  "\u116d\u1163\u4e28",                                                             // HANGUL JUNGSEONG YO-YAE
+	0x1186:  "\u116d\u1163",                                                                   // HANGUL JUNGSEONG YO-YEO
+	0x1187:  "\u116d\u1169",                                                                   // HANGUL JUNGSEONG YO-O
+	0x1188:  "\u116d\u4e28",                                                                   // HANGUL JUNGSEONG YO-I
+	0x1189:  "\u116e\u1161",                                                                   // HANGUL JUNGSEONG U-A
+	0x118A:  "\u116e\u1161\u4e28",                                                             // HANGUL JUNGSEONG U-AE
+	0x118B:  "\u116e\u1165\u30fc",                                                             // HANGUL JUNGSEONG U-EO-EU
+	0x118C:  "\u116e\u1167\u4e28",                                                             // HANGUL JUNGSEONG U-YE
+	0x118D:  "\u116e\u116e",                                                                   // HANGUL JUNGSEONG U-U
+	0x118E:  "\u1172\u1161",                                                                   // HANGUL JUNGSEONG YU-A
+	0x118F:  "\u1172\u1165",                                                                   // HANGUL JUNGSEONG YU-EO
+	0x1190:  "\u1172\u1165\u4e28",                                                             // HANGUL JUNGSEONG YU-E
+	0x1191:  "\u1172\u1167",                                                                   // HANGUL JUNGSEONG YU-YEO
+	0x1192:  "\u1172\u1167\u4e28",                                                             // HANGUL JUNGSEONG YU-YE
+	0x1193:  "\u1172\u116e",                                                                   // HANGUL JUNGSEONG YU-U
+	0x1194:  "\u1172\u4e28",                                                                   // HANGUL JUNGSEONG YU-I
+	0x1195:  "\u30fc\u116e",                                                                   // HANGUL JUNGSEONG EU-U
+	0x1196:  "\u30fc\u30fc",                                                                   // HANGUL JUNGSEONG EU-EU
+	0x1197:  "\u30fc\u4e28\u116e",                                                             // HANGUL JUNGSEONG YI-U
+	0x1198:  "\u4e28\u1161",                                                                   // HANGUL JUNGSEONG I-A
+	0x1199:  "\u4e28\u1163",                                                                   // HANGUL JUNGSEONG I-YA
+	0x119A:  "\u4e28\u1169",                                                                   // HANGUL JUNGSEONG I-O
+	0x119B:  "\u4e28\u116e",                                                                   // HANGUL JUNGSEONG I-U
+	0x119C:  "\u4e28\u30fc",                                                                   // HANGUL JUNGSEONG I-EU
+	0x119D:  "\u4e28\u119e",                                                                   // HANGUL JUNGSEONG I-ARAEA
+	0x119F:  "\u119e\u1165",                                                                   // HANGUL JUNGSEONG ARAEA-EO
+	0x11A0:  "\u119e\u116e",                                                                   // HANGUL JUNGSEONG ARAEA-U
+	0x11A1:  "\u119e\u4e28",                                                                   // HANGUL JUNGSEONG ARAEA-I
+	0x11A2:  "\u119e\u119e",                                                                   // HANGUL JUNGSEONG SSANGARAEA
+	0x11A3:  "\u1161\u30fc",                                                                   // HANGUL JUNGSEONG A-EU
+	0x11A4:  "\u1163\u116e",                                                                   // HANGUL JUNGSEONG YA-U
+	0x11A5:  "\u1167\u1163",                                                                   // HANGUL JUNGSEONG YEO-YA
+	0x11A6:  "\u1169\u1163",                                                                   // HANGUL JUNGSEONG O-YA
+	0x11A7:  "\u1169\u1163\u4e28",                                                             // HANGUL JUNGSEONG O-YAE
+	0x11A8:  "\u1100",                                                                         // HANGUL JONGSEONG KIYEOK
+	0x11A9:  "\u1100\u1100",                                                                   // HANGUL JONGSEONG SSANGKIYEOK
+	0x11AA:  "\u1100\u1109",                                                                   // HANGUL JONGSEONG KIYEOK-SIOS
+	0x11AB:  "\u1102",                                                                         // HANGUL JONGSEONG NIEUN
+	0x11AC:  "\u1102\u110c",                                                                   // HANGUL JONGSEONG NIEUN-CIEUC
+	0x11AD:  "\u1102\u1112",                                                                   // HANGUL JONGSEONG NIEUN-HIEUH
+	0x11AE:  "\u1103",                                                                         // HANGUL JONGSEONG TIKEUT
+	0x11AF:  "\u1105",                                                                         // HANGUL JONGSEONG RIEUL
+	0x11B0:  "\u1105\u1100",                                                                   // HANGUL JONGSEONG RIEUL-KIYEOK
+	0x11B1:  "\u1105\u1106",                                                                   // HANGUL JONGSEONG RIEUL-MIEUM
+	0x11B2:  "\u1105\u1107",                                                                   // HANGUL JONGSEONG RIEUL-PIEUP
+	0x11B3:  "\u1105\u1109",                                                                   // HANGUL JONGSEONG RIEUL-SIOS
+	0x11B4:  "\u1105\u1110",                                                                   // HANGUL JONGSEONG RIEUL-THIEUTH
+	0x11B5:  "\u1105\u1111",                                                                   // HANGUL JONGSEONG RIEUL-PHIEUPH
+	0x11B6:  "\u1105\u1112",                                                                   // HANGUL JONGSEONG RIEUL-HIEUH
+	0x11B7:  "\u1106",                                                                         // HANGUL JONGSEONG MIEUM
+	0x11B8:  "\u1107",                                                                         // HANGUL JONGSEONG PIEUP
+	0x11B9:  "\u1107\u1109",                                                                   // HANGUL JONGSEONG PIEUP-SIOS
+	0x11BA:  "\u1109",                                                                         // HANGUL JONGSEONG SIOS
+	0x11BB:  "\u1109\u1109",                                                                   // HANGUL JONGSEONG SSANGSIOS
+	0x11BC:  "\u110b",                                                                         // HANGUL JONGSEONG IEUNG
+	0x11BD:  "\u110c",                                                                         // HANGUL JONGSEONG CIEUC
+	0x11BE:  "\u110e",                                                                         // HANGUL JONGSEONG CHIEUCH
+	0x11BF:  "\u110f",                                                                         // HANGUL JONGSEONG KHIEUKH
+	0x11C0:  "\u1110",                                                                         // HANGUL JONGSEONG THIEUTH
+	0x11C1:  "\u1111",                                                                         // HANGUL JONGSEONG PHIEUPH
+	0x11C2:  "\u1112",                                                                         // HANGUL JONGSEONG HIEUH
+	0x11C3:  "\u1100\u1105",                                                                   // HANGUL JONGSEONG KIYEOK-RIEUL
+	0x11C4:  "\u1100\u1109\u1100",                                                             // HANGUL JONGSEONG KIYEOK-SIOS-KIYEOK
+	0x11C5:  "\u1102\u1100",                                                                   // HANGUL JONGSEONG NIEUN-KIYEOK
+	0x11C6:  "\u1102\u1103",                                                                   // HANGUL JONGSEONG NIEUN-TIKEUT
+	0x11C7:  "\u1102\u1109",                                                                   // HANGUL JONGSEONG NIEUN-SIOS
+	0x11C8:  "\u1102\u1140",                                                                   // HANGUL JONGSEONG NIEUN-PANSIOS
+	0x11C9:  "\u1102\u1110",                                                                   // HANGUL JONGSEONG NIEUN-THIEUTH
+	0x11CA:  "\u1103\u1100",                                                                   // HANGUL JONGSEONG TIKEUT-KIYEOK
+	0x11CB:  "\u1103\u1105",                                                                   // HANGUL JONGSEONG TIKEUT-RIEUL
+	0x11CC:  "\u1105\u1100\u1109",                                                             // HANGUL JONGSEONG RIEUL-KIYEOK-SIOS
+	0x11CD:  "\u1105\u1102",                                                                   // HANGUL JONGSEONG RIEUL-NIEUN
+	0x11CE:  "\u1105\u1103",                                                                   // HANGUL JONGSEONG RIEUL-TIKEUT
+	0x11CF:  "\u1105\u1103\u1112",                                                             // HANGUL JONGSEONG RIEUL-TIKEUT-HIEUH
+	0x11D0:  "\u1105\u1105",                                                                   // HANGUL JONGSEONG SSANGRIEUL
+	0x11D1:  "\u1105\u1106\u1100",                                                             // HANGUL JONGSEONG RIEUL-MIEUM-KIYEOK
+	0x11D2:  "\u1105\u1106\u1109",                                                             // HANGUL JONGSEONG RIEUL-MIEUM-SIOS
+	0x11D3:  "\u1105\u1107\u1109",                                                             // HANGUL JONGSEONG RIEUL-PIEUP-SIOS
+	0x11D4:  "\u1105\u1107\u1112",                                                             // HANGUL JONGSEONG RIEUL-PIEUP-HIEUH
+	0x11D5:  "\u1105\u1107\u110b",                                                             // HANGUL JONGSEONG RIEUL-KAPYEOUNPIEUP
+	0x11D6:  "\u1105\u1109\u1109",                                                             // HANGUL JONGSEONG RIEUL-SSANGSIOS
+	0x11D7:  "\u1105\u1140",                                                                   // HANGUL JONGSEONG RIEUL-PANSIOS
+	0x11D8:  "\u1105\u110f",                                                                   // HANGUL JONGSEONG RIEUL-KHIEUKH
+	0x11D9:  "\u1105\u1159",                                                                   // HANGUL JONGSEONG RIEUL-YEORINHIEUH
+	0x11DA:  "\u1106\u1100",                                                                   // HANGUL JONGSEONG MIEUM-KIYEOK
+	0x11DB:  "\u1106\u1105",                                                                   // HANGUL JONGSEONG MIEUM-RIEUL
+	0x11DC:  "\u1106\u1107",                                                                   // HANGUL JONGSEONG MIEUM-PIEUP
+	0x11DD:  "\u1106\u1109",                                                                   // HANGUL JONGSEONG MIEUM-SIOS
+	0x11DE:  "\u1106\u1109\u1109",                                                             // HANGUL JONGSEONG MIEUM-SSANGSIOS
+	0x11DF:  "\u1106\u1140",                                                                   // HANGUL JONGSEONG MIEUM-PANSIOS
+	0x11E0:  "\u1106\u110e",                                                                   // HANGUL JONGSEONG MIEUM-CHIEUCH
+	0x11E1:  "\u1106\u1112",                                                                   // HANGUL JONGSEONG MIEUM-HIEUH
+	0x11E2:  "\u1106\u110b",                                                                   // HANGUL JONGSEONG KAPYEOUNMIEUM
+	0x11E3:  "\u1107\u1105",                                                                   // HANGUL JONGSEONG PIEUP-RIEUL
+	0x11E4:  "\u1107\u1111",                                                                   // HANGUL JONGSEONG PIEUP-PHIEUPH
+	0x11E5:  "\u1107\u1112",                                                                   // HANGUL JONGSEONG PIEUP-HIEUH
+	0x11E6:  "\u1107\u110b",                                                                   // HANGUL JONGSEONG KAPYEOUNPIEUP
+	0x11E7:  "\u1109\u1100",                                                                   // HANGUL JONGSEONG SIOS-KIYEOK
+	0x11E8:  "\u1109\u1103",                                                                   // HANGUL JONGSEONG SIOS-TIKEUT
+	0x11E9:  "\u1109\u1105",                                                                   // HANGUL JONGSEONG SIOS-RIEUL
+	0x11EA:  "\u1109\u1107",                                                                   // HANGUL JONGSEONG SIOS-PIEUP
+	0x11EB:  "\u1140",                                                                         // HANGUL JONGSEONG PANSIOS
+	0x11EC:  "\u110b\u1100",                                                                   // HANGUL JONGSEONG IEUNG-KIYEOK
+	0x11ED:  "\u110b\u1100\u1100",                                                             // HANGUL JONGSEONG IEUNG-SSANGKIYEOK
+	0x11EE:  "\u110b\u110b",                                                                   // HANGUL JONGSEONG SSANGIEUNG
+	0x11EF:  "\u110b\u110f",                                                                   // HANGUL JONGSEONG IEUNG-KHIEUKH
+	0x11F0:  "\u114c",                                                                         // HANGUL JONGSEONG YESIEUNG
+	0x11F1:  "\u110b\u1109",                                                                   // HANGUL JONGSEONG YESIEUNG-SIOS
+	0x11F2:  "\u110b\u1140",                                                                   // HANGUL JONGSEONG YESIEUNG-PANSIOS
+	0x11F3:  "\u1111\u1107",                                                                   // HANGUL JONGSEONG PHIEUPH-PIEUP
+	0x11F4:  "\u1111\u110b",                                                                   // HANGUL JONGSEONG KAPYEOUNPHIEUPH
+	0x11F5:  "\u1112\u1102",                                                                   // HANGUL JONGSEONG HIEUH-NIEUN
+	0x11F6:  "\u1112\u1105",                                                                   // HANGUL JONGSEONG HIEUH-RIEUL
+	0x11F7:  "\u1112\u1106",                                                                   // HANGUL JONGSEONG HIEUH-MIEUM
+	0x11F8:  "\u1112\u1107",                                                                   // HANGUL JONGSEONG HIEUH-PIEUP
+	0x11F9:  "\u1159",                                                                         // HANGUL JONGSEONG YEORINHIEUH
+	0x11FA:  "\u1100\u1102",                                                                   // HANGUL JONGSEONG KIYEOK-NIEUN
+	0x11FB:  "\u1100\u1107",                                                                   // HANGUL JONGSEONG KIYEOK-PIEUP
+	0x11FC:  "\u1100\u110e",                                                                   // HANGUL JONGSEONG KIYEOK-CHIEUCH
+	0x11FD:  "\u1100\u110f",                                                                   // HANGUL JONGSEONG KIYEOK-KHIEUKH
+	0x11FE:  "\u1100\u1112",                                                                   // HANGUL JONGSEONG KIYEOK-HIEUH
+	0x11FF:  "\u1102\u1102",                                                                   // HANGUL JONGSEONG SSANGNIEUN
+	0x1200:  "U",                                                                              // ETHIOPIC SYLLABLE HA
+	0x1223:  "\u0270",                                                                         // ETHIOPIC SYLLABLE SZAA
+	0x1240:  "\u03a6",                                                                         // ETHIOPIC SYLLABLE QA
+	0x1260:  "\u0548",                                                                         // ETHIOPIC SYLLABLE BA
+	0x1294:  "\u0571",                                                                         // ETHIOPIC SYLLABLE NEE
+	0x12D0:  "O",                                                                              // ETHIOPIC SYLLABLE PHARYNGEAL A
+	0x13A0:  "D",                                                                              // CHEROKEE LETTER A
+	0x13A1:  "R",                                                                              // CHEROKEE LETTER E
+	0x13A2:  "T",                                                                              // CHEROKEE LETTER I
+	0x13A4:  "O'",                                                                             // CHEROKEE LETTER U
+	0x13A5:  "i",                                                                              // CHEROKEE LETTER V
+	0x13A8:  "\u2c75",                                                                         // CHEROKEE LETTER GE
+	0x13A9:  "Y",                                                                              // CHEROKEE LETTER GI
+	0x13AA:  "A",                                                                              // CHEROKEE LETTER GO
+	0x13AB:  "J",                                                                              // CHEROKEE LETTER GU
+	0x13AC:  "E",                                                                              // CHEROKEE LETTER GV
+	0x13AE:  "?",                                                                              // CHEROKEE LETTER HE
+	0x13B0:  "\u2c75",                                                                         // CHEROKEE LETTER HO
+	0x13B1:  "\u0393",                                                                         // CHEROKEE LETTER HU
+	0x13B3:  "W",                                                                              // CHEROKEE LETTER LA
+	0x13B7:  "M",                                                                              // CHEROKEE LETTER LU
+	0x13BB:  "H",                                                                              // CHEROKEE LETTER MI
+	0x13BD:  "Y",                                                                              // CHEROKEE LETTER MU
+	0x13BE:  "O\u0335",                                                                        // CHEROKEE LETTER NA
+	0x13BF:  "\u01ab",                                                                         // CHEROKEE LETTER HNA
+	0x13C0:  "G",                                                                              // CHEROKEE LETTER NAH
+	0x13C2:  "h",                                                                              // CHEROKEE LETTER NI
+	0x13C3:  "Z",                                                                              // CHEROKEE LETTER NO
+	0x13C7:  "\u0460",                                                                         // CHEROKEE LETTER QUE
+	0x13CB:  "\u0190",                                                                         // CHEROKEE LETTER QUV
+	0x13CC:  "U\u0335",                                                                        // CHEROKEE LETTER SA
+	0x13CE:  "4",                                                                              // CHEROKEE LETTER SE
+	0x13CF:  "b",                                                                              // CHEROKEE LETTER SI
+	0x13D2:  "R",                                                                              // CHEROKEE LETTER SV
+	0x13D4:  "W",                                                                              // CHEROKEE LETTER TA
+	0x13D5:  "S",                                                                              // CHEROKEE LETTER DE
+	0x13D9:  "V",                                                                              // CHEROKEE LETTER DO
+	0x13DA:  "S",                                                                              // CHEROKEE LETTER DU
+	0x13DE:  "L",                                                                              // CHEROKEE LETTER TLE
+	0x13DF:  "C",                                                                              // CHEROKEE LETTER TLI
+	0x13E2:  "P",                                                                              // CHEROKEE LETTER TLV
+	0x13E6:  "K",                                                                              // CHEROKEE LETTER TSO
+	0x13E7:  "d",                                                                              // CHEROKEE LETTER TSU
+	0x13EB:  "O\u0335",                                                                        // CHEROKEE LETTER WI
+	0x13EE:  "6",                                                                              // CHEROKEE LETTER WV
+	0x13F0:  "\u00df",                                                                         // CHEROKEE LETTER YE
+	0x13F2:  "h\u0314",                                                                        // CHEROKEE LETTER YO
+	0x13F3:  "G",                                                                              // CHEROKEE LETTER YU
+	0x13F4:  "B",                                                                              // CHEROKEE LETTER YV
+	0x13FB:  "\u0262",                                                                         // CHEROKEE SMALL LETTER YU
+	0x13FC:  "\u0299",                                                                         // CHEROKEE SMALL LETTER YV
+	0x1400:  "=",                                                                              // CANADIAN SYLLABICS HYPHEN
+	0x1403:  "\u0394",                                                                         // CANADIAN SYLLABICS I
+	0x140C:  "\u00b7\u1401",                                                                   // CANADIAN SYLLABICS WE
+	0x140D:  "\u1401\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE WE
+	0x140E:  "\u00b7\u0394",                                                                   // CANADIAN SYLLABICS WI
+	0x140F:  "\u0394\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE WI
+	0x1410:  "\u00b7\u1404",                                                                   // CANADIAN SYLLABICS WII
+	0x1411:  "\u1404\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE WII
+	0x1412:  "\u00b7\u1405",                                                                   // CANADIAN SYLLABICS WO
+	0x1413:  "\u1405\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE WO
+	0x1414:  "\u00b7\u1406",                                                                   // CANADIAN SYLLABICS WOO
+	0x1415:  "\u1406\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE WOO
+	0x1417:  "\u00b7\u140a",                                                                   // CANADIAN SYLLABICS WA
+	0x1418:  "\u140a\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE WA
+	0x1419:  "\u00b7\u140b",                                                                   // CANADIAN SYLLABICS WAA
+	0x141A:  "\u140b\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE WAA
+	0x1427:  "\u00b7",                                                                         // CANADIAN SYLLABICS FINAL MIDDLE DOT
+	0x142B:  "\u1401\u1420",                                                                   // CANADIAN SYLLABICS EN
+	0x142C:  "\u0394\u1420",                                                                   // CANADIAN SYLLABICS IN
+	0x142D:  "\u1405\u1420",                                                                   // CANADIAN SYLLABICS ON
+	0x142E:  "\u140a\u1420",                                                                   // CANADIAN SYLLABICS AN
+	0x142F:  "V",                                                                              // CANADIAN SYLLABICS PE
+	0x1431:  "\u0245",                                                                         // CANADIAN SYLLABICS PI
+	0x1433:  ">",                                                                              // CANADIAN SYLLABICS PO
+	0x1437:  "\u00b7>",                                                                        // CANADIAN SYLLABICS CARRIER HI
+	0x1438:  "<",                                                                              // CANADIAN SYLLABICS PA
+	0x143A:  "\u00b7V",                                                                        // CANADIAN SYLLABICS PWE
+	0x143B:  "V\u00b7",                                                                        // CANADIAN SYLLABICS WEST-CREE PWE
+	0x143C:  "\u00b7\u0245",                                                                   // CANADIAN SYLLABICS PWI
+	0x143D:  "\u0245\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE PWI
+	0x143E:  "\u00b7\u1432",                                                                   // CANADIAN SYLLABICS PWII
+	0x143F:  "\u1432\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE PWII
+	0x1440:  "\u00b7>",                                                                        // CANADIAN SYLLABICS PWO
+	0x1441:  ">\u00b7",                                                                        // CANADIAN SYLLABICS WEST-CREE PWO
+	0x1442:  "\u00b7\u1434",                                                                   // CANADIAN SYLLABICS PWOO
+	0x1443:  "\u1434\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE PWOO
+	0x1444:  "\u00b7<",                                                                        // CANADIAN SYLLABICS PWA
+	0x1445:  "<\u00b7",                                                                        // CANADIAN SYLLABICS WEST-CREE PWA
+	0x1446:  "\u00b7\u1439",                                                                   // CANADIAN SYLLABICS PWAA
+	0x1447:  "\u1439\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE PWAA
+	0x144A:  "'",                                                                              // CANADIAN SYLLABICS WEST-CREE P
+	0x144C:  "U",                                                                              // CANADIAN SYLLABICS TE
+	0x144E:  "\u0548",                                                                         // CANADIAN SYLLABICS TI
+	0x1454:  "\u00b7\u1450",                                                                   // CANADIAN SYLLABICS CARRIER DI
+	0x1457:  "\u00b7U",                                                                        // CANADIAN SYLLABICS TWE
+	0x1458:  "U\u00b7",                                                                        // CANADIAN SYLLABICS WEST-CREE TWE
+	0x1459:  "\u00b7\u0548",                                                                   // CANADIAN SYLLABICS TWI
+	0x145A:  "\u0548\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE TWI
+	0x145B:  "\u00b7\u144f",                                                                   // CANADIAN SYLLABICS TWII
+	0x145C:  "\u144f\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE TWII
+	0x145D:  "\u00b7\u1450",                                                                   // CANADIAN SYLLABICS TWO
+	0x145E:  "\u1450\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE TWO
+	0x145F:  "\u00b7\u1451",                                                                   // CANADIAN SYLLABICS TWOO
+	0x1460:  "\u1451\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE TWOO
+	0x1461:  "\u00b7\u1455",                                                                   // CANADIAN SYLLABICS TWA
+	0x1462:  "\u1455\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE TWA
+	0x1463:  "\u00b7\u1456",                                                                   // CANADIAN SYLLABICS TWAA
+	0x1464:  "\u1456\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE TWAA
+	0x1467:  "U'",                                                                             // CANADIAN SYLLABICS TTE
+	0x1468:  "\u0548'",                                                                        // CANADIAN SYLLABICS TTI
+	0x1469:  "\u1450'",                                                                        // CANADIAN SYLLABICS TTO
+	0x146A:  "\u1455'",                                                                        // CANADIAN SYLLABICS TTA
+	0x146D:  "P",                                                                              // CANADIAN SYLLABICS KI
+	0x146F:  "d",                                                                              // CANADIAN SYLLABICS KO
+	0x1472:  "b",                                                                              // CANADIAN SYLLABICS KA
+	0x1473:  "b\u0307",                                                                        // CANADIAN SYLLABICS KAA
+	0x1474:  "\u00b7\u146b",                                                                   // CANADIAN SYLLABICS KWE
+	0x1475:  "\u146b\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE KWE
+	0x1476:  "\u00b7P",                                                                        // CANADIAN SYLLABICS KWI
+	0x1477:  "p\u00b7",                                                                        // CANADIAN SYLLABICS WEST-CREE KWI
+	0x1478:  "\u00b7\u146e",                                                                   // CANADIAN SYLLABICS KWII
+	0x1479:  "\u146e\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE KWII
+	0x147A:  "\u00b7d",                                                                        // CANADIAN SYLLABICS KWO
+	0x147B:  "d\u00b7",                                                                        // CANADIAN SYLLABICS WEST-CREE KWO
+	0x147C:  "\u00b7\u1470",                                                                   // CANADIAN SYLLABICS KWOO
+	0x147D:  "\u1470\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE KWOO
+	0x147E:  "\u00b7b",                                                                        // CANADIAN SYLLABICS KWA
+	0x147F:  "b\u00b7",                                                                        // CANADIAN SYLLABICS WEST-CREE KWA
+	0x1480:  "\u00b7b\u0307",                                                                  // CANADIAN SYLLABICS KWAA
+	0x1481:  "b\u0307\u00b7",                                                                  // CANADIAN SYLLABICS WEST-CREE KWAA
+	0x1485:  "\u146b'",                                                                        // CANADIAN SYLLABICS SOUTH-SLAVEY KEH
+	0x1486:  "P'",                                                                             // CANADIAN SYLLABICS SOUTH-SLAVEY KIH
+	0x1487:  "d'",                                                                             // CANADIAN SYLLABICS SOUTH-SLAVEY KOH
+	0x1488:  "b'",                                                                             // CANADIAN SYLLABICS SOUTH-SLAVEY KAH
+	0x148D:  "J",                                                                              // CANADIAN SYLLABICS CO
+	0x1492:  "\u00b7\u1489",                                                                   // CANADIAN SYLLABICS CWE
+	0x1493:  "\u1489\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE CWE
+	0x1494:  "\u00b7\u148b",                                                                   // CANADIAN SYLLABICS CWI
+	0x1495:  "\u148b\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE CWI
+	0x1496:  "\u00b7\u148c",                                                                   // CANADIAN SYLLABICS CWII
+	0x1497:  "\u148c\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE CWII
+	0x1498:  "\u00b7J",                                                                        // CANADIAN SYLLABICS CWO
+	0x1499:  "J\u00b7",                                                                        // CANADIAN SYLLABICS WEST-CREE CWO
+	0x149A:  "\u00b7\u148e",                                                                   // CANADIAN SYLLABICS CWOO
+	0x149B:  "\u148e\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE CWOO
+	0x149C:  "\u00b7\u1490",                                                                   // CANADIAN SYLLABICS CWA
+	0x149D:  "\u1490\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE CWA
+	0x149E:  "\u00b7\u1491",                                                                   // CANADIAN SYLLABICS CWAA
+	0x149F:  "\u1491\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE CWAA
+	0x14A5:  "\u0393",                                                                         // CANADIAN SYLLABICS MI
+	0x14AA:  "L",                                                                              // CANADIAN SYLLABICS MA
+	0x14AC:  "\u00b7\u14a3",                                                                   // CANADIAN SYLLABICS MWE
+	0x14AD:  "\u14a3\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE MWE
+	0x14AE:  "\u00b7\u0393",                                                                   // CANADIAN SYLLABICS MWI
+	0x14AF:  "\u0393\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE MWI
+	0x14B0:  "\u00b7\u14a6",                                                                   // CANADIAN SYLLABICS MWII
+	0x14B1:  "\u14a6\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE MWII
+	0x14B2:  "\u00b7\u14a7",                                                                   // CANADIAN SYLLABICS MWO
+	0x14B3:  "\u14a7\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE MWO
+	0x14B4:  "\u00b7\u14a8",                                                                   // CANADIAN SYLLABICS MWOO
+	0x14B5:  "\u14a8\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE MWOO
+	0x14B6:  "\u00b7L",                                                                        // CANADIAN SYLLABICS MWA
+	0x14B7:  "l\u00b7",                                                                        // CANADIAN SYLLABICS WEST-CREE MWA
+	0x14B8:  "\u00b7\u14ab",                                                                   // CANADIAN SYLLABICS MWAA
+	0x14B9:  "\u14ab\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE MWAA
+	0x14BF:  "2",                                                                              // CANADIAN SYLLABICS SAYISI M
+	0x14C9:  "\u00b7\u14c0",                                                                   // CANADIAN SYLLABICS NWE
+	0x14CA:  "\u14c0\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE NWE
+	0x14CB:  "\u00b7\u14c7",                                                                   // CANADIAN SYLLABICS NWA
+	0x14CC:  "\u14c7\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE NWA
+	0x14CD:  "\u00b7\u14c8",                                                                   // CANADIAN SYLLABICS NWAA
+	0x14CE:  "\u14c8\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE NWAA
+	0x14D1:  "\u1421",                                                                         // CANADIAN SYLLABICS CARRIER NG
+	0x14DC:  "\u00b7\u14d3",                                                                   // CANADIAN SYLLABICS LWE
+	0x14DD:  "\u14d3\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE LWE
+	0x14DE:  "\u00b7\u14d5",                                                                   // CANADIAN SYLLABICS LWI
+	0x14DF:  "\u14d5\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE LWI
+	0x14E0:  "\u00b7\u14d6",                                                                   // CANADIAN SYLLABICS LWII
+	0x14E1:  "\u14d6\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE LWII
+	0x14E2:  "\u00b7\u14d7",                                                                   // CANADIAN SYLLABICS LWO
+	0x14E3:  "\u14d7\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE LWO
+	0x14E4:  "\u00b7\u14d8",                                                                   // CANADIAN SYLLABICS LWOO
+	0x14E5:  "\u14d8\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE LWOO
+	0x14E6:  "\u00b7\u14da",                                                                   // CANADIAN SYLLABICS LWA
+	0x14E7:  "\u14da\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE LWA
+	0x14E8:  "\u00b7\u14db",                                                                   // CANADIAN SYLLABICS LWAA
+	0x14E9:  "\u14db\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE LWAA
+	0x14F6:  "\u00b7\u14ed",                                                                   // CANADIAN SYLLABICS SWE
+	0x14F7:  "\u14ed\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE SWE
+	0x14F8:  "\u00b7\u14ef",                                                                   // CANADIAN SYLLABICS SWI
+	0x14F9:  "\u14ef\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE SWI
+	0x14FA:  "\u00b7\u14f0",                                                                   // CANADIAN SYLLABICS SWII
+	0x14FB:  "\u14f0\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE SWII
+	0x14FC:  "\u00b7\u14f1",                                                                   // CANADIAN SYLLABICS SWO
+	0x14FD:  "\u14f1\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE SWO
+	0x14FE:  "\u00b7\u14f2",                                                                   // CANADIAN SYLLABICS SWOO
+	0x14FF:  "\u14f2\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE SWOO
+	0x1500:  "\u00b7\u14f4",                                                                   // CANADIAN SYLLABICS SWA
+	0x1501:  "\u14f4\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE SWA
+	0x1502:  "\u00b7\u14f5",                                                                   // CANADIAN SYLLABICS SWAA
+	0x1503:  "\u14f5\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE SWAA
+	0x150C:  "\u150b<",                                                                        // CANADIAN SYLLABICS NASKAPI SPWA
+	0x150D:  "\u150b\u1455",                                                                   // CANADIAN SYLLABICS NASKAPI STWA
+	0x150E:  "\u150bb",                                                                        // CANADIAN SYLLABICS NASKAPI SKWA
+	0x150F:  "\u150b\u1490",                                                                   // CANADIAN SYLLABICS NASKAPI SCWA
+	0x1517:  "\u00b7\u1510",                                                                   // CANADIAN SYLLABICS SHWE
+	0x1518:  "\u1510\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE SHWE
+	0x1519:  "\u00b7\u1511",                                                                   // CANADIAN SYLLABICS SHWI
+	0x151A:  "\u1511\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE SHWI
+	0x151B:  "\u00b7\u1512",                                                                   // CANADIAN SYLLABICS SHWII
+	0x151C:  "\u1512\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE SHWII
+	0x151D:  "\u00b7\u1513",                                                                   // CANADIAN SYLLABICS SHWO
+	0x151E:  "\u1513\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE SHWO
+	0x151F:  "\u00b7\u1514",                                                                   // CANADIAN SYLLABICS SHWOO
+	0x1520:  "\u1514\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE SHWOO
+	0x1521:  "\u00b7\u1515",                                                                   // CANADIAN SYLLABICS SHWA
+	0x1522:  "\u1515\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE SHWA
+	0x1523:  "\u00b7\u1516",                                                                   // CANADIAN SYLLABICS SHWAA
+	0x1524:  "\u1516\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE SHWAA
+	0x152F:  "\u00b74",                                                                        // CANADIAN SYLLABICS YWE
+	0x1530:  "4\u00b7",                                                                        // CANADIAN SYLLABICS WEST-CREE YWE
+	0x1531:  "\u00b7\u1528",                                                                   // CANADIAN SYLLABICS YWI
+	0x1532:  "\u1528\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE YWI
+	0x1533:  "\u00b7\u1529",                                                                   // CANADIAN SYLLABICS YWII
+	0x1534:  "\u1529\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE YWII
+	0x1535:  "\u00b7\u152a",                                                                   // CANADIAN SYLLABICS YWO
+	0x1536:  "\u152a\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE YWO
+	0x1537:  "\u00b7\u152b",                                                                   // CANADIAN SYLLABICS YWOO
+	0x1538:  "\u152b\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE YWOO
+	0x1539:  "\u00b7\u152d",                                                                   // CANADIAN SYLLABICS YWA
+	0x153A:  "\u152d\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE YWA
+	0x153B:  "\u00b7\u152e",                                                                   // CANADIAN SYLLABICS YWAA
+	0x153C:  "\u152e\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE YWAA
+	0x1540:  "\u1429",                                                                         // CANADIAN SYLLABICS WEST-CREE Y
+	0x1541:  "x",                                                                              // CANADIAN SYLLABICS SAYISI YI
+	0x154E:  "\u00b7\u154c",                                                                   // CANADIAN SYLLABICS RWAA
+	0x154F:  "\u154c\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE RWAA
+	0x155B:  "\u00b7\u155a",                                                                   // CANADIAN SYLLABICS FWAA
+	0x155C:  "\u155a\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE FWAA
+	0x1568:  "\u00b7\u1567",                                                                   // CANADIAN SYLLABICS THWAA
+	0x1569:  "\u1567\u00b7",                                                                   // CANADIAN SYLLABICS WEST-CREE THWAA
+	0x1577:  "\u1e9f",                                                                         // CANADIAN SYLLABICS NUNAVIK HO
+	0x157C:  "H",                                                                              // CANADIAN SYLLABICS NUNAVUT H
+	0x157D:  "x",                                                                              // CANADIAN SYLLABICS HK
+	0x157E:  "\u1550\u146c",                                                                   // CANADIAN SYLLABICS QAAI
+	0x157F:  "\u1550P",                                                                        // CANADIAN SYLLABICS QI
+	0x1580:  "\u1550\u146e",                                                                   // CANADIAN SYLLABICS QII
+	0x1581:  "\u1550d",                                                                        // CANADIAN SYLLABICS QO
+	0x1582:  "\u1550\u1470",                                                                   // CANADIAN SYLLABICS QOO
+	0x1583:  "\u1550b",                                                                        // CANADIAN SYLLABICS QA
+	0x1584:  "\u1550b\u0307",                                                                  // CANADIAN SYLLABICS QAA
+	0x1585:  "\u1550\u1483",                                                                   // CANADIAN SYLLABICS Q
+	0x1587:  "R",                                                                              // CANADIAN SYLLABICS TLHI
+	0x158E:  "\u1595\u148a",                                                                   // CANADIAN SYLLABICS NGAAI
+	0x158F:  "\u1595\u148b",                                                                   // CANADIAN SYLLABICS NGI
+	0x1590:  "\u1595\u148c",                                                                   // CANADIAN SYLLABICS NGII
+	0x1591:  "\u1595J",                                                                        // CANADIAN SYLLABICS NGO
+	0x1592:  "\u1595\u148e",                                                                   // CANADIAN SYLLABICS NGOO
+	0x1593:  "\u1595\u1490",                                                                   // CANADIAN SYLLABICS NGA
+	0x1594:  "\u1595\u1491",                                                                   // CANADIAN SYLLABICS NGAA
+	0x15AF:  "b",                                                                              // CANADIAN SYLLABICS AIVILIK B
+	0x15B4:  "F",                                                                              // CANADIAN SYLLABICS BLACKFOOT WE
+	0x15B5:  "\u2132",                                                                         // CANADIAN SYLLABICS BLACKFOOT WI
+	0x15B7:  "\ua7fb",                                                                         // CANADIAN SYLLABICS BLACKFOOT WA
+	0x15C4:  "\u2c6f",                                                                         // CANADIAN SYLLABICS CARRIER GHU
+	0x15C5:  "A",                                                                              // CANADIAN SYLLABICS CARRIER GHO
+	0x15DE:  "D",                                                                              // CANADIAN SYLLABICS CARRIER THE
+	0x15EA:  "D",                                                                              // CANADIAN SYLLABICS CARRIER PE
+	0x15EF:  "\u0460",                                                                         // CANADIAN SYLLABICS CARRIER GU
+	0x15F0:  "M",                                                                              // CANADIAN SYLLABICS CARRIER GO
+	0x15F7:  "B",                                                                              // CANADIAN SYLLABICS CARRIER KHE
+	0x1602:  "\u1490",                                                                         // CANADIAN SYLLABICS CARRIER NU
+	0x1603:  "\u1489",                                                                         // CANADIAN SYLLABICS CARRIER NO
+	0x1604:  "\u14d3",                                                                         // CANADIAN SYLLABICS CARRIER NE
+	0x1607:  "\u14da",                                                                         // CANADIAN SYLLABICS CARRIER NA
+	0x1622:  "\u1543",                                                                         // CANADIAN SYLLABICS CARRIER LU
+	0x1623:  "\u1546",                                                                         // CANADIAN SYLLABICS CARRIER LO
+	0x1624:  "\u154a",                                                                         // CANADIAN SYLLABICS CARRIER LE
+	0x162E:  "\u01b1",                                                                         // CANADIAN SYLLABICS CARRIER LHU
+	0x162F:  "\u03a9",                                                                         // CANADIAN SYLLABICS CARRIER LHO
+	0x1634:  "\u01b1",                                                                         // CANADIAN SYLLABICS CARRIER TLHU
+	0x1635:  "\u03a9",                                                                         // CANADIAN SYLLABICS CARRIER TLHO
+	0x166D:  "X",                                                                              // CANADIAN SYLLABICS CHI SIGN
+	0x166E:  "x",                                                                              // CANADIAN SYLLABICS FULL STOP
+	0x166F:  "\u1550\u146b",                                                                   // CANADIAN SYLLABICS QAI
+	0x1670:  "\u1595\u1489",                                                                   // CANADIAN SYLLABICS NGAI
+	0x1671:  "\u1596\u148b",                                                                   // CANADIAN SYLLABICS NNGI
+	0x1672:  "\u1596\u148c",                                                                   // CANADIAN SYLLABICS NNGII
+	0x1673:  "\u1596J",                                                                        // CANADIAN SYLLABICS NNGO
+	0x1674:  "\u1596\u148e",                                                                   // CANADIAN SYLLABICS NNGOO
+	0x1675:  "\u1596\u1490",                                                                   // CANADIAN SYLLABICS NNGA
+	0x1676:  "\u1596\u1491",                                                                   // CANADIAN SYLLABICS NNGAA
+	0x1677:  "\u15a7\u00b7",                                                                   // CANADIAN SYLLABICS WOODS-CREE THWEE
+	0x1678:  "\u15a8\u00b7",                                                                   // CANADIAN SYLLABICS WOODS-CREE THWI
+	0x1679:  "\u15a9\u00b7",                                                                   // CANADIAN SYLLABICS WOODS-CREE THWII
+	0x167A:  "\u15aa\u00b7",                                                                   // CANADIAN SYLLABICS WOODS-CREE THWO
+	0x167B:  "\u15ab\u00b7",                                                                   // CANADIAN SYLLABICS WOODS-CREE THWOO
+	0x167C:  "\u15ac\u00b7",                                                                   // CANADIAN SYLLABICS WOODS-CREE THWA
+	0x167D:  "\u15ad\u00b7",                                                                   // CANADIAN SYLLABICS WOODS-CREE THWAA
+	0x1680:  " ",                                                                              // OGHAM SPACE MARK
+	0x16B2:  "<",                                                                              // RUNIC LETTER KAUNA
+	0x16B7:  "X",                                                                              // RUNIC LETTER GEBO GYFU G
+	0x16C1:  "l",                                                                              // RUNIC LETTER ISAZ IS ISS I
+	0x16C2:  "\u16bd",                                                                         // RUNIC LETTER E
+	0x16CC:  "'",                                                                              // RUNIC LETTER SHORT-TWIG-SOL S
+	0x16D5:  "K",                                                                              // RUNIC LETTER OPEN-P
+	0x16D6:  "M",                                                                              // RUNIC LETTER EHWAZ EH E
+	0x16D8:  "\u03a8",                                                                         // RUNIC LETTER LONG-BRANCH-MADR M
+	0x16E1:  "\u16bc",                                                                         // RUNIC LETTER IOR
+	0x16EB:  "\u00b7",                                                                         // RUNIC SINGLE PUNCTUATION
+	0x16EC:  ":",                                                                              // RUNIC MULTIPLE PUNCTUATION
+	0x16ED:  "+",                                                                              // RUNIC CROSS PUNCTUATION
+	0x16F0:  "\u03a6",                                                                         // RUNIC BELGTHOR SYMBOL
+	0x1735:  "/",                                                                              // PHILIPPINE SINGLE PUNCTUATION
+	0x17A3:  "\u17a2",                                                                         // KHMER INDEPENDENT VOWEL QAQ
+	0x17B7:  "\u0e34",                                                                         // KHMER VOWEL SIGN I
+	0x17B8:  "\u0e35",                                                                         // KHMER VOWEL SIGN II
+	0x17B9:  "\u0e36",                                                                         // KHMER VOWEL SIGN Y
+	0x17BA:  "\u0e37",                                                                         // KHMER VOWEL SIGN YY
+	0x17C6:  "\u030a",                                                                         // KHMER SIGN NIKAHIT
+	0x17CB:  "\u0e48",                                                                         // KHMER SIGN BANTOC
+	0x17D3:  "\u030a",                                                                         // KHMER SIGN BATHAMASAT
+	0x17D4:  "\u0e2f",                                                                         // KHMER SIGN KHAN
+	0x17D5:  "\u0e5a",                                                                         // KHMER SIGN BARIYOOSAN
+	0x17D9:  "\u0e4f",                                                                         // KHMER SIGN PHNAEK MUAN
+	0x17DA:  "\u0e5b",                                                                         // KHMER SIGN KOOMUUT
+	0x1803:  ":",                                                                              // MONGOLIAN FULL STOP
+	0x1809:  ":",                                                                              // MONGOLIAN MANCHU FULL STOP
+	0x1855:  "\u1835",                                                                         // MONGOLIAN LETTER TODO YA
+	0x1896:  "\u185c",                                                                         // MONGOLIAN LETTER ALI GALI ZA
+	0x18B3:  "\u00b7\u18b1",                                                                   // CANADIAN SYLLABICS WAY
+	0x18B6:  "\u00b7\u18b4",                                                                   // CANADIAN SYLLABICS PWOY
+	0x18B9:  "\u00b7\u18b8",                                                                   // CANADIAN SYLLABICS KWAY
+	0x18C2:  "\u00b7\u18c0",                                                                   // CANADIAN SYLLABICS SHWOY
+	0x18C6:  "\u00b7\u14c2",                                                                   // CANADIAN SYLLABICS NWI
+	0x18C7:  "\u14c2\u00b7",                                                                   // CANADIAN SYLLABICS OJIBWAY NWI
+	0x18C8:  "\u00b7\u14c3",                                                                   // CANADIAN SYLLABICS NWII
+	0x18C9:  "\u14c3\u00b7",                                                                   // CANADIAN SYLLABICS OJIBWAY NWII
+	0x18CA:  "\u00b7\u14c4",                                                                   // CANADIAN SYLLABICS NWO
+	0x18CB:  "\u14c4\u00b7",                                                                   // CANADIAN SYLLABICS OJIBWAY NWO
+	0x18CC:  "\u00b7\u14c5",                                                                   // CANADIAN SYLLABICS NWOO
+	0x18CD:  "\u14c5\u00b7",                                                                   // CANADIAN SYLLABICS OJIBWAY NWOO
+	0x18CE:  "\u00b7\u1543",                                                                   // CANADIAN SYLLABICS RWEE
+	0x18CF:  "\u00b7\u1546",                                                                   // CANADIAN SYLLABICS RWI
+	0x18D0:  "\u00b7\u1547",                                                                   // CANADIAN SYLLABICS RWII
+	0x18D1:  "\u00b7\u1548",                                                                   // CANADIAN SYLLABICS RWO
+	0x18D2:  "\u00b7\u1549",                                                                   // CANADIAN SYLLABICS RWOO
+	0x18D3:  "\u00b7\u154b",                                                                   // CANADIAN SYLLABICS RWA
+	0x18DB:  "\u18f5",                                                                         // CANADIAN SYLLABICS OJIBWAY SH
+	0x18DC:  "\u18df\u141e",                                                                   // CANADIAN SYLLABICS EASTERN W
+	0x18DD:  "\u141e\u18df",                                                                   // CANADIAN SYLLABICS WESTERN W
+	0x18E0:  "\u1543\u00b7",                                                                   // CANADIAN SYLLABICS R-CREE RWE
+	0x18E3:  "\u155e\u00b7",                                                                   // CANADIAN SYLLABICS THWE
+	0x18E4:  "\u1566\u00b7",                                                                   // CANADIAN SYLLABICS THWA
+	0x18E5:  "\u156b\u00b7",                                                                   // CANADIAN SYLLABICS TTHWE
+	0x18E8:  "\u1586\u00b7",                                                                   // CANADIAN SYLLABICS TLHWE
+	0x18EA:  "\u1597\u00b7",                                                                   // CANADIAN SYLLABICS SAYISI SHWE
+	0x18ED:  "\u0460\u00b7",                                                                   // CANADIAN SYLLABICS CARRIER GWU
+	0x18F0:  "\u15f4\u00b7",                                                                   // CANADIAN SYLLABICS CARRIER GWA
+	0x18F2:  "\u161b\u00b7",                                                                   // CANADIAN SYLLABICS CARRIER JWA
+	0x19D0:  "\u199e",                                                                         // NEW TAI LUE DIGIT ZERO
+	0x19D1:  "\u19b1",                                                                         // NEW TAI LUE DIGIT ONE
+	0x1A80:  "\u1a45",                                                                         // TAI THAM HORA DIGIT ZERO
+	0x1A90:  "\u1a45",                                                                         // TAI THAM THAM DIGIT ZERO
+	0x1AA9:  "\u1aa8\u1aa8",                                                                   // TAI THAM SIGN KAANKUU
+	0x1AAB:  "\u1aaa\u1aa8",                                                                   // TAI THAM SIGN SATKAANKUU
+	0x1AB4:  "\u06db",                                                                         // COMBINING TRIPLE DOT
+	0x1AB7:  "\u0328",                                                                         // COMBINING OPEN MARK BELOW
+	0x1B52:  "\u1b0d",                                                                         // BALINESE DIGIT TWO
+	0x1B53:  "\u1b11",                                                                         // BALINESE DIGIT THREE
+	0x1B58:  "\u1b28",                                                                         // BALINESE DIGIT EIGHT
+	0x1B5C:  "\u1b50",                                                                         // BALINESE WINDU
+	0x1B5F:  "\u1b5e\u1b5e",                                                                   // BALINESE CARIK PAREREN
+	0x1C3C:  "\u1c3b\u1c3b",                                                                   // LEPCHA PUNCTUATION NYET THYOOM TA-ROL
+	0x1C7F:  "\u1c7e\u1c7e",                                                                   // OL CHIKI PUNCTUATION DOUBLE MUCAAD
+	0x1CD0:  "\u0302",                                                                         // VEDIC TONE KARSHANA
+	0x1CD2:  "\u0304",                                                                         // VEDIC TONE PRENKHA
+	0x1CD3:  "''",                                                                             // VEDIC SIGN NIHSHVASA
+	0x1CD5:  "\u032b",                                                                         // VEDIC TONE YAJURVEDIC AGGRAVATED INDEPENDENT SVARITA
+	0x1CD8:  "\u032e",                                                                         // VEDIC TONE CANDRA BELOW
+	0x1CD9:  "\u032d",                                                                         // VEDIC TONE YAJURVEDIC KATHAKA INDEPENDENT SVARITA SCHROEDER
+	0x1CDA:  "\u030e",                                                                         // VEDIC TONE DOUBLE SVARITA
+	0x1CDC:  "\u0329",                                                                         // VEDIC TONE KATHAKA ANUDATTA
+	0x1CDD:  "\u0323",                                                                         // VEDIC TONE DOT BELOW
+	0x1CDE:  "\u0324",                                                                         // VEDIC TONE TWO DOTS BELOW
+	0x1CED:  "\u0316",                                                                         // VEDIC SIGN TIRYAK
+	0x1D04:  "c",                                                                              // LATIN LETTER SMALL CAPITAL C
+	0x1D08:  "\u025c",                                                                         // LATIN SMALL LETTER TURNED OPEN E
+	0x1D0B:  "\u0138",                                                                         // LATIN LETTER SMALL CAPITAL K
+	0x1D0D:  "\u028d",                                                                         // LATIN LETTER SMALL CAPITAL M
+	0x1D0F:  "o",                                                                              // LATIN LETTER SMALL CAPITAL O
+	0x1D10:  "\u0254",                                                                         // LATIN LETTER SMALL CAPITAL OPEN O
+	0x1D11:  "o",                                                                              // LATIN SMALL LETTER SIDEWAYS O
+	0x1D14:  "\u01ddo",                                                                        // LATIN SMALL LETTER TURNED OE
+	0x1D1C:  "u",                                                                              // LATIN LETTER SMALL CAPITAL U
+	0x1D20:  "v",                                                                              // LATIN LETTER SMALL CAPITAL V
+	0x1D21:  "w",                                                                              // LATIN LETTER SMALL CAPITAL W
+	0x1D22:  "z",                                                                              // LATIN LETTER SMALL CAPITAL Z
+	0x1D24:  "\u01a8",                                                                         // LATIN LETTER VOICED LARYNGEAL SPIRANT
+	0x1D26:  "r",                                                                              // GREEK LETTER SMALL CAPITAL GAMMA
+	0x1D27:  "\u028c",                                                                         // GREEK LETTER SMALL CAPITAL LAMDA
+	0x1D28:  "\u03c0",                                                                         // GREEK LETTER SMALL CAPITAL PI
+	0x1D29:  "\u1d18",                                                                         // GREEK LETTER SMALL CAPITAL RHO
+	0x1D2B:  "\u043b",                                                                         // CYRILLIC LETTER SMALL CAPITAL EL
+	0x1D3E:  "\u18d6",                                                                         // MODIFIER LETTER CAPITAL P
+	0x1D52:  "\u00ba",                                                                         // MODIFIER LETTER SMALL O
+	0x1D6B:  "ue",                                                                             // LATIN SMALL LETTER UE
+	0x1D6E:  "f\u0334",                                                                        // LATIN SMALL LETTER F WITH MIDDLE TILDE
+	0x1D6F:  "rn\u0334",                                                                       // LATIN SMALL LETTER M WITH MIDDLE TILDE
+	0x1D70:  "n\u0334",                                                                        // LATIN SMALL LETTER N WITH MIDDLE TILDE
+	0x1D72:  "r\u0334",                                                                        // LATIN SMALL LETTER R WITH MIDDLE TILDE
+	0x1D73:  "\u027e\u0334",                                                                   // LATIN SMALL LETTER R WITH FISHHOOK AND MIDDLE TILDE
+	0x1D74:  "s\u0334",                                                                        // LATIN SMALL LETTER S WITH MIDDLE TILDE
+	0x1D75:  "t\u0334",                                                                        // LATIN SMALL LETTER T WITH MIDDLE TILDE
+	0x1D76:  "z\u0334",                                                                        // LATIN SMALL LETTER Z WITH MIDDLE TILDE
+	0x1D78:  "\u1d34",                                                                         // MODIFIER LETTER CYRILLIC EN
+	0x1D7B:  "i\u0335",                                                                        // LATIN SMALL CAPITAL LETTER I WITH STROKE
+	0x1D7C:  "i\u0335",                                                                        // LATIN SMALL LETTER IOTA WITH STROKE
+	0x1D7D:  "p\u0335",                                                                        // LATIN SMALL LETTER P WITH STROKE
+	0x1D7E:  "u\u0335",                                                                        // LATIN SMALL CAPITAL LETTER U WITH STROKE
+	0x1D7F:  "\u028a\u0335",                                                                   // LATIN SMALL LETTER UPSILON WITH STROKE
+	0x1D83:  "g",                                                                              // LATIN SMALL LETTER G WITH PALATAL HOOK
+	0x1D8C:  "y",                                                                              // LATIN SMALL LETTER V WITH PALATAL HOOK
+	0x1D90:  "\u024b",                                                                         // LATIN SMALL LETTER ALPHA WITH RETROFLEX HOOK
+	0x1D9F:  "\u1d4b",                                                                         // MODIFIER LETTER SMALL REVERSED OPEN E
+	0x1DA2:  "\u1d4d",                                                                         // MODIFIER LETTER SMALL SCRIPT G
+	0x1DBA:  "\u18d4",                                                                         // MODIFIER LETTER SMALL TURNED V
+	0x1DBB:  "\u1646",                                                                         // MODIFIER LETTER SMALL Z
+	0x1DEE:  "\u2dec",                                                                         // COMBINING LATIN SMALL LETTER P
+	0x1E43:  "\uab51",                                                                         // LATIN SMALL LETTER M WITH DOT BELOW
+	0x1E9A:  "\u1ea3",                                                                         // LATIN SMALL LETTER A WITH RIGHT HALF RING
+	0x1E9D:  "f",                                                                              // LATIN SMALL LETTER LONG S WITH HIGH STROKE
+	0x1EFF:  "y",                                                                              // LATIN SMALL LETTER Y WITH LOOP
+	0x1F7D:  "\u1ff4",                                                                         // GREEK SMALL LETTER OMEGA WITH OXIA
+	0x1FBD:  "'",                                                                              // GREEK KORONIS
+	0x1FBE:  "i",                                                                              // GREEK PROSGEGRAMMENI
+	0x1FBF:  "'",                                                                              // GREEK PSILI
+	0x1FC0:  "~",                                                                              // GREEK PERISPOMENI
+	0x1FEF:  "'",                                                                              // GREEK VARIA
+	0x1FF6:  "\u13ef",                                                                         // GREEK SMALL LETTER OMEGA WITH PERISPOMENI
+	0x1FFD:  "'",                                                                              // GREEK OXIA
+	0x1FFE:  "'",                                                                              // GREEK DASIA
+	0x2000:  " ",                                                                              // EN QUAD
+	0x2001:  " ",                                                                              // EM QUAD
+	0x2002:  " ",                                                                              // EN SPACE
+	0x2003:  " ",                                                                              // EM SPACE
+	0x2004:  " ",                                                                              // THREE-PER-EM SPACE
+	0x2005:  " ",                                                                              // FOUR-PER-EM SPACE
+	0x2006:  " ",                                                                              // SIX-PER-EM SPACE
+	0x2007:  " ",                                                                              // FIGURE SPACE
+	0x2008:  " ",                                                                              // PUNCTUATION SPACE
+	0x2009:  " ",                                                                              // THIN SPACE
+	0x200A:  " ",                                                                              // HAIR SPACE
+	0x2010:  "-",                                                                              // HYPHEN
+	0x2011:  "-",                                                                              // NON-BREAKING HYPHEN
+	0x2012:  "-",                                                                              // FIGURE DASH
+	0x2013:  "-",                                                                              // EN DASH
+	0x2014:  "\u30fc",                                                                         // EM DASH
+	0x2015:  "\u30fc",                                                                         // HORIZONTAL BAR
+	0x2016:  "ll",                                                                             // DOUBLE VERTICAL LINE
+	0x2018:  "'",                                                                              // LEFT SINGLE QUOTATION MARK
+	0x2019:  "'",                                                                              // RIGHT SINGLE QUOTATION MARK
+	0x201A:  ",",                                                                              // SINGLE LOW-9 QUOTATION MARK
+	0x201B:  "'",                                                                              // SINGLE HIGH-REVERSED-9 QUOTATION MARK
+	0x201C:  "''",                                                                             // LEFT DOUBLE QUOTATION MARK
+	0x201D:  "''",                                                                             // RIGHT DOUBLE QUOTATION MARK
+	0x201F:  "''",                                                                             // DOUBLE HIGH-REVERSED-9 QUOTATION MARK
+	0x2022:  "\u00b7",                                                                         // BULLET
+	0x2024:  ".",                                                                              // ONE DOT LEADER
+	0x2025:  "..",                                                                             // TWO DOT LEADER
+	0x2026:  "...",                                                                            // HORIZONTAL ELLIPSIS
+	0x2027:  "\u00b7",                                                                         // HYPHENATION POINT
+	0x2028:  " ",                                                                              // LINE SEPARATOR
+	0x2029:  " ",                                                                              // PARAGRAPH SEPARATOR
+	0x202F:  " ",                                                                              // NARROW NO-BREAK SPACE
+	0x2030:  "\u00ba/\u2080\u2080",                                                            // PER MILLE SIGN
+	0x2031:  "\u00ba/\u2080\u2080\u2080",                                                      // PER TEN THOUSAND SIGN
+	0x2032:  "'",                                                                              // PRIME
+	0x2033:  "''",                                                                             // DOUBLE PRIME
+	0x2034:  "'''",                                                                            // TRIPLE PRIME
+	0x2035:  "'",                                                                              // REVERSED PRIME
+	0x2036:  "''",                                                                             // REVERSED DOUBLE PRIME
+	0x2037:  "'''",                                                                            // REVERSED TRIPLE PRIME
+	0x2039:  "<",                                                                              // SINGLE LEFT-POINTING ANGLE QUOTATION MARK
+	0x203A:  ">",                                                                              // SINGLE RIGHT-POINTING ANGLE QUOTATION MARK
+	0x203C:  "!!",                                                                             // DOUBLE EXCLAMATION MARK
+	0x203E:  "\u02c9",                                                                         // OVERLINE
+	0x2041:  "/",                                                                              // CARET INSERTION POINT
+	0x2043:  "-",                                                                              // HYPHEN BULLET
+	0x2044:  "/",                                                                              // FRACTION SLASH
+	0x2047:  "??",                                                                             // DOUBLE QUESTION MARK
+	0x2048:  "?!",                                                                             // QUESTION EXCLAMATION MARK
+	0x2049:  "!?",                                                                             // EXCLAMATION QUESTION MARK
+	0x204E:  "*",                                                                              // LOW ASTERISK
+	0x2052:  "\u00ba/\u2080",                                                                  // COMMERCIAL MINUS SIGN
+	0x2053:  "~",                                                                              // SWUNG DASH
+	0x2057:  "''''",                                                                           // QUADRUPLE PRIME
+	0x205A:  ":",                                                                              // TWO DOT PUNCTUATION
+	0x205D:  "\u2d57",                                                                         // TRICOLON
+	0x205E:  "\u2d42",                                                                         // VERTICAL FOUR DOTS
+	0x205F:  " ",                                                                              // MEDIUM MATHEMATICAL SPACE
+	0x2070:  "\u00ba",                                                                         // SUPERSCRIPT ZERO
+	0x2079:  "\ua770",                                                                         // SUPERSCRIPT NINE
+	0x20A1:  "C\u20eb",                                                                        // COLON SIGN
+	0x20A4:  "\u00a3",                                                                         // LIRA SIGN
+	0x20A5:  "rn\u0338",                                                                       // MILL SIGN
+	0x20A8:  "Rs",                                                                             // RUPEE SIGN
+	0x20A9:  "W\u0335",                                                                        // WON SIGN
+	0x20AB:  "d\u0335\u0331",                                                                  // DONG SIGN
+	0x20AC:  "\ua792",                                                                         // EURO SIGN
+	0x20AD:  "K\u0335",                                                                        // KIP SIGN
+	0x20AE:  "T\u20eb",                                                                        // TUGRIK SIGN
+	0x20B6:  "lt",                                                                             // LIVRE TOURNOIS SIGN
+	0x20BD:  "\u0554",                                                                         // RUBLE SIGN
+	0x20DB:  "\u06db",                                                                         // COMBINING THREE DOTS ABOVE
+	0x2100:  "a/c",                                                                            // ACCOUNT OF
+	0x2101:  "a/s",                                                                            // ADDRESSED TO THE SUBJECT
+	0x2102:  "C",                                                                              // DOUBLE-STRUCK CAPITAL C
+	0x2103:  "\u00b0C",                                                                        // DEGREE CELSIUS
+	0x2105:  "c/o",                                                                            // CARE OF
+	0x2106:  "c/u",                                                                            // CADA UNA
+	0x2107:  "\u0190",                                                                         // EULER CONSTANT
+	0x2108:  "\u042d",                                                                         // SCRUPLE
+	0x2109:  "\u00b0F",                                                                        // DEGREE FAHRENHEIT
+	0x210A:  "g",                                                                              // SCRIPT SMALL G
+	0x210B:  "H",                                                                              // SCRIPT CAPITAL H
+	0x210C:  "H",                                                                              // BLACK-LETTER CAPITAL H
+	0x210D:  "H",                                                                              // DOUBLE-STRUCK CAPITAL H
+	0x210E:  "h",                                                                              // PLANCK CONSTANT
+	0x210F:  "h\u0335",                                                                        // PLANCK CONSTANT OVER TWO PI
+	0x2110:  "l",                                                                              // SCRIPT CAPITAL I
+	0x2111:  "l",                                                                              // BLACK-LETTER CAPITAL I
+	0x2112:  "L",                                                                              // SCRIPT CAPITAL L
+	0x2113:  "l",                                                                              // SCRIPT SMALL L
+	0x2115:  "N",                                                                              // DOUBLE-STRUCK CAPITAL N
+	0x2116:  "No",                                                                             // NUMERO SIGN
+	0x2119:  "P",                                                                              // DOUBLE-STRUCK CAPITAL P
+	0x211A:  "Q",                                                                              // DOUBLE-STRUCK CAPITAL Q
+	0x211B:  "R",                                                                              // SCRIPT CAPITAL R
+	0x211C:  "R",                                                                              // BLACK-LETTER CAPITAL R
+	0x211D:  "R",                                                                              // DOUBLE-STRUCK CAPITAL R
+	0x2121:  "TEL",                                                                            // TELEPHONE SIGN
+	0x2124:  "Z",                                                                              // DOUBLE-STRUCK CAPITAL Z
+	0x2126:  "\u03a9",                                                                         // OHM SIGN
+	0x2127:  "\u01b1",                                                                         // INVERTED OHM SIGN
+	0x2128:  "Z",                                                                              // BLACK-LETTER CAPITAL Z
+	0x2129:  "\u027f",                                                                         // TURNED GREEK SMALL LETTER IOTA
+	0x212A:  "K",                                                                              // KELVIN SIGN
+	0x212C:  "B",                                                                              // SCRIPT CAPITAL B
+	0x212D:  "C",                                                                              // BLACK-LETTER CAPITAL C
+	0x212E:  "e",                                                                              // ESTIMATED SYMBOL
+	0x212F:  "e",                                                                              // SCRIPT SMALL E
+	0x2130:  "E",                                                                              // SCRIPT CAPITAL E
+	0x2131:  "F",                                                                              // SCRIPT CAPITAL F
+	0x2133:  "M",                                                                              // SCRIPT CAPITAL M
+	0x2134:  "o",                                                                              // SCRIPT SMALL O
+	0x2135:  "\u05d0",                                                                         // ALEF SYMBOL
+	0x2136:  "\u05d1",                                                                         // BET SYMBOL
+	0x2137:  "\u05d2",                                                                         // GIMEL SYMBOL
+	0x2138:  "\u05d3",                                                                         // DALET SYMBOL
+	0x2139:  "i",                                                                              // INFORMATION SOURCE
+	0x213B:  "FAX",                                                                            // FACSIMILE SIGN
+	0x213C:  "\u03c0",                                                                         // DOUBLE-STRUCK SMALL PI
+	0x213D:  "y",                                                                              // DOUBLE-STRUCK SMALL GAMMA
+	0x213E:  "\u0393",                                                                         // DOUBLE-STRUCK CAPITAL GAMMA
+	0x213F:  "\u03a0",                                                                         // DOUBLE-STRUCK CAPITAL PI
+	0x2140:  "\u01a9",                                                                         // DOUBLE-STRUCK N-ARY SUMMATION
+	0x2141:  "\ua4e8",                                                                         // TURNED SANS-SERIF CAPITAL G
+	0x2142:  "\ua4f6",                                                                         // TURNED SANS-SERIF CAPITAL L
+	0x2143:  "\U00016f00",                                                                     // REVERSED SANS-SERIF CAPITAL L
+	0x2145:  "D",                                                                              // DOUBLE-STRUCK ITALIC CAPITAL D
+	0x2146:  "d",                                                                              // DOUBLE-STRUCK ITALIC SMALL D
+	0x2147:  "e",                                                                              // DOUBLE-STRUCK ITALIC SMALL E
+	0x2148:  "i",                                                                              // DOUBLE-STRUCK ITALIC SMALL I
+	0x2149:  "j",                                                                              // DOUBLE-STRUCK ITALIC SMALL J
+	0x2160:  "l",                                                                              // ROMAN NUMERAL ONE
+	0x2161:  "ll",                                                                             // ROMAN NUMERAL TWO
+	0x2162:  "lll",                                                                            // ROMAN NUMERAL THREE
+	0x2163:  "lV",                                                                             // ROMAN NUMERAL FOUR
+	0x2164:  "V",                                                                              // ROMAN NUMERAL FIVE
+	0x2165:  "Vl",                                                                             // ROMAN NUMERAL SIX
+	0x2166:  "Vll",                                                                            // ROMAN NUMERAL SEVEN
+	0x2167:  "Vlll",                                                                           // ROMAN NUMERAL EIGHT
+	0x2168:  "lX",                                                                             // ROMAN NUMERAL NINE
+	0x2169:  "X",                                                                              // ROMAN NUMERAL TEN
+	0x216A:  "Xl",                                                                             // ROMAN NUMERAL ELEVEN
+	0x216B:  "Xll",                                                                            // ROMAN NUMERAL TWELVE
+	0x216C:  "L",                                                                              // ROMAN NUMERAL FIFTY
+	0x216D:  "C",                                                                              // ROMAN NUMERAL ONE HUNDRED
+	0x216E:  "D",                                                                              // ROMAN NUMERAL FIVE HUNDRED
+	0x216F:  "M",                                                                              // ROMAN NUMERAL ONE THOUSAND
+	0x2170:  "i",                                                                              // SMALL ROMAN NUMERAL ONE
+	0x2171:  "ii",                                                                             // SMALL ROMAN NUMERAL TWO
+	0x2172:  "iii",                                                                            // SMALL ROMAN NUMERAL THREE
+	0x2173:  "iv",                                                                             // SMALL ROMAN NUMERAL FOUR
+	0x2174:  "v",                                                                              // SMALL ROMAN NUMERAL FIVE
+	0x2175:  "vi",                                                                             // SMALL ROMAN NUMERAL SIX
+	0x2176:  "vii",                                                                            // SMALL ROMAN NUMERAL SEVEN
+	0x2177:  "viii",                                                                           // SMALL ROMAN NUMERAL EIGHT
+	0x2178:  "ix",                                                                             // SMALL ROMAN NUMERAL NINE
+	0x2179:  "x",                                                                              // SMALL ROMAN NUMERAL TEN
+	0x217A:  "xi",                                                                             // SMALL ROMAN NUMERAL ELEVEN
+	0x217B:  "xii",                                                                            // SMALL ROMAN NUMERAL TWELVE
+	0x217C:  "l",                                                                              // SMALL ROMAN NUMERAL FIFTY
+	0x217D:  "c",                                                                              // SMALL ROMAN NUMERAL ONE HUNDRED
+	0x217E:  "d",                                                                              // SMALL ROMAN NUMERAL FIVE HUNDRED
+	0x217F:  "rn",                                                                             // SMALL ROMAN NUMERAL ONE THOUSAND
+	0x2183:  "\u0186",                                                                         // ROMAN NUMERAL REVERSED ONE HUNDRED
+	0x2184:  "\u0254",                                                                         // LATIN SMALL LETTER REVERSED C
+	0x2191:  "\u16cf",                                                                         // UPWARDS ARROW
+	0x2195:  "\u16e8",                                                                         // UP DOWN ARROW
+	0x21B5:  "\u21b2",                                                                         // DOWNWARDS ARROW WITH CORNER LEFTWARDS
+	0x21BA:  "\U0001f10e",                                                                     // ANTICLOCKWISE OPEN CIRCLE ARROW
+	0x21BE:  "\u16da",                                                                         // UPWARDS HARPOON WITH BARB RIGHTWARDS
+	0x21BF:  "\u16d0",                                                                         // UPWARDS HARPOON WITH BARB LEFTWARDS
+	0x2200:  "\u2c6f",                                                                         // FOR ALL
+	0x2203:  "\u018e",                                                                         // THERE EXISTS
+	0x2206:  "\u0394",                                                                         // INCREMENT
+	0x220F:  "\u03a0",                                                                         // N-ARY PRODUCT
+	0x2211:  "\u01a9",                                                                         // N-ARY SUMMATION
+	0x2212:  "-",                                                                              // MINUS SIGN
+	0x2214:  "+\u0307",                                                                        // DOT PLUS
+	0x2215:  "/",                                                                              // DIVISION SLASH
+	0x2216:  "\\",                                                                             // SET MINUS
+	0x2217:  "*",                                                                              // ASTERISK OPERATOR
+	0x2218:  "\u00b0",                                                                         // RING OPERATOR
+	0x2219:  "\u00b7",                                                                         // BULLET OPERATOR
+	0x221E:  "oo",                                                                             // INFINITY
+	0x2223:  "l",                                                                              // DIVIDES
+	0x2225:  "ll",                                                                             // PARALLEL TO
+	0x2228:  "v",                                                                              // LOGICAL OR
+	0x2229:  "\u0548",                                                                         // INTERSECTION
+	0x222A:  "U",                                                                              // UNION
+	0x222B:  "\u0283",                                                                         // INTEGRAL
+	0x222C:  "\u0283\u0283",                                                                   // DOUBLE INTEGRAL
+	0x222D:  "\u0283\u0283\u0283",                                                             // TRIPLE INTEGRAL
+	0x222F:  "\u222e\u222e",                                                                   // SURFACE INTEGRAL
+	0x2230:  "\u222e\u222e\u222e",                                                             // VOLUME INTEGRAL
+	0x2236:  ":",                                                                              // RATIO
+	0x2238:  "-\u0307",                                                                        // DOT MINUS
+	0x223C:  "~",                                                                              // TILDE OPERATOR
+	0x2250:  "=\u0307",                                                                        // APPROACHES THE LIMIT
+	0x2251:  "=\u0307\u0323",                                                                  // GEOMETRICALLY EQUAL TO
+	0x2257:  "=\u030a",                                                                        // RING EQUAL TO
+	0x2259:  "=\u0302",                                                                        // ESTIMATES
+	0x225A:  "=\u0306",                                                                        // EQUIANGULAR TO
+	0x225E:  "=\u036b",                                                                        // MEASURED BY
+	0x2263:  "\u2261",                                                                         // STRICTLY EQUIVALENT TO
+	0x226A:  "<<",                                                                             // MUCH LESS-THAN
+	0x226B:  ">>",                                                                             // MUCH GREATER-THAN
+	0x2282:  "\u1455",                                                                         // SUBSET OF
+	0x2283:  "\u1450",                                                                         // SUPERSET OF
+	0x2295:  "\U000102a8",                                                                     // CIRCLED PLUS
+	0x2296:  "O\u0335",                                                                        // CIRCLED MINUS
+	0x2299:  "\u0298",                                                                         // CIRCLED DOT OPERATOR
+	0x229D:  "O\u0335",                                                                        // CIRCLED DASH
+	0x22A4:  "T",                                                                              // DOWN TACK
+	0x22A5:  "\ua4d5",                                                                         // UP TACK
+	0x22C0:  "\u2227",                                                                         // N-ARY LOGICAL AND
+	0x22C1:  "v",                                                                              // N-ARY LOGICAL OR
+	0x22C2:  "\u0548",                                                                         // N-ARY INTERSECTION
+	0x22C3:  "U",                                                                              // N-ARY UNION
+	0x22C4:  "\u16dc",                                                                         // DIAMOND OPERATOR
+	0x22C5:  "\u00b7",                                                                         // DOT OPERATOR
+	0x22C8:  "\u16de",                                                                         // BOWTIE
+	0x22D6:  "<\u00b7",                                                                        // LESS-THAN WITH DOT
+	0x22D7:  "\u00b7>",                                                                        // GREATER-THAN WITH DOT
+	0x22D8:  "<<<",                                                                            // VERY MUCH LESS-THAN
+	0x22D9:  ">>>",                                                                            // VERY MUCH GREATER-THAN
+	0x22EE:  "\u2d57",                                                                         // VERTICAL ELLIPSIS
+	0x22EF:  "\u00b7\u00b7\u00b7",                                                             // MIDLINE HORIZONTAL ELLIPSIS
+	0x22F4:  "\ua793",                                                                         // SMALL ELEMENT OF WITH VERTICAL BAR AT END OF HORIZONTAL STROKE
+	0x22FF:  "E",                                                                              // Z NOTATION BAG MEMBERSHIP
+	0x2300:  "\u2205",                                                                         // DIAMETER SIGN
+	0x2325:  "\u2324",                                                                         // OPTION KEY
+	0x2329:  "\u276c",                                                                         // LEFT-POINTING ANGLE BRACKET
+	0x232A:  "\u276d",                                                                         // RIGHT-POINTING ANGLE BRACKET
+	0x2341:  "\u303c",                                                                         // APL FUNCTIONAL SYMBOL QUAD SLASH
+	0x2359:  "\u0394\u0332",                                                                   // APL FUNCTIONAL SYMBOL DELTA UNDERBAR
+	0x235A:  "\u16dc\u0332",                                                                   // APL FUNCTIONAL SYMBOL DIAMOND UNDERBAR
+	0x235C:  "\u00b0\u0332",                                                                   // APL FUNCTIONAL SYMBOL CIRCLE UNDERBAR
+	0x235F:  "\u229b",                                                                         // APL FUNCTIONAL SYMBOL CIRCLE STAR
+	0x2361:  "T\u0308",                                                                        // APL FUNCTIONAL SYMBOL UP TACK DIAERESIS
+	0x2362:  "\u2207\u0308",                                                                   // APL FUNCTIONAL SYMBOL DEL DIAERESIS
+	0x2363:  "\u22c6\u0308",                                                                   // APL FUNCTIONAL SYMBOL STAR DIAERESIS
+	0x2364:  "\u00b0\u0308",                                                                   // APL FUNCTIONAL SYMBOL JOT DIAERESIS
+	0x2365:  "\u0629",                                                                         // APL FUNCTIONAL SYMBOL CIRCLE DIAERESIS
+	0x2368:  "~\u0308",                                                                        // APL FUNCTIONAL SYMBOL TILDE DIAERESIS
+	0x2369:  "\u1435",                                                                         // APL FUNCTIONAL SYMBOL GREATER-THAN DIAERESIS
+	0x236B:  "\u2207\u0334",                                                                   // APL FUNCTIONAL SYMBOL DEL TILDE
+	0x236C:  "O\u0335",                                                                        // APL FUNCTIONAL SYMBOL ZILDE
+	0x2373:  "i",                                                                              // APL FUNCTIONAL SYMBOL IOTA
+	0x2374:  "p",                                                                              // APL FUNCTIONAL SYMBOL RHO
+	0x2375:  "\u03c9",                                                                         // APL FUNCTIONAL SYMBOL OMEGA
+	0x2376:  "a\u0332",                                                                        // APL FUNCTIONAL SYMBOL ALPHA UNDERBAR
+	0x2377:  "\ua793\u0332",                                                                   // APL FUNCTIONAL SYMBOL EPSILON UNDERBAR
+	0x2378:  "i\u0332",                                                                        // APL FUNCTIONAL SYMBOL IOTA UNDERBAR
+	0x2379:  "\u03c9\u0332",                                                                   // APL FUNCTIONAL SYMBOL OMEGA UNDERBAR
+	0x237A:  "a",                                                                              // APL FUNCTIONAL SYMBOL ALPHA
+	0x237F:  "\u16bd",                                                                         // VERTICAL LINE WITH MIDDLE DOT
+	0x239C:  "\u4e28",                                                                         // LEFT PARENTHESIS EXTENSION
+	0x239F:  "\u4e28",                                                                         // RIGHT PARENTHESIS EXTENSION
+	0x23A2:  "\u4e28",                                                                         // LEFT SQUARE BRACKET EXTENSION
+	0x23A5:  "\u4e28",                                                                         // RIGHT SQUARE BRACKET EXTENSION
+	0x23AA:  "\u4e28",                                                                         // CURLY BRACKET EXTENSION
+	0x23AE:  "\u4e28",                                                                         // INTEGRAL EXTENSION
+	0x23C1:  "\u2355",                                                                         // DENTISTRY SYMBOL LIGHT DOWN AND HORIZONTAL WITH CIRCLE
+	0x23C2:  "\u234e",                                                                         // DENTISTRY SYMBOL LIGHT UP AND HORIZONTAL WITH CIRCLE
+	0x23C3:  "\u234b",                                                                         // DENTISTRY SYMBOL LIGHT VERTICAL WITH TRIANGLE
+	0x23C6:  "\u236d",                                                                         // DENTISTRY SYMBOL LIGHT VERTICAL AND WAVE
+	0x23E8:  "\u2081\u2080",                                                                   // DECIMAL EXPONENT SYMBOL
+	0x23FC:  "\u23fb",                                                                         // POWER ON-OFF SYMBOL
+	0x23FD:  "l",                                                                              // POWER ON SYMBOL
+	0x23FE:  "\u263e",                                                                         // POWER SLEEP SYMBOL
+	0x244A:  "\\\\",                                                                           // OCR DOUBLE BACKSLASH
+	0x2460:  "\u2780",                                                                         // CIRCLED DIGIT ONE
+	0x2461:  "\u2781",                                                                         // CIRCLED DIGIT TWO
+	0x2462:  "\u2782",                                                                         // CIRCLED DIGIT THREE
+	0x2463:  "\u2783",                                                                         // CIRCLED DIGIT FOUR
+	0x2464:  "\u2784",                                                                         // CIRCLED DIGIT FIVE
+	0x2465:  "\u2785",                                                                         // CIRCLED DIGIT SIX
+	0x2466:  "\u2786",                                                                         // CIRCLED DIGIT SEVEN
+	0x2467:  "\u2787",                                                                         // CIRCLED DIGIT EIGHT
+	0x2468:  "\u2788",                                                                         // CIRCLED DIGIT NINE
+	0x2469:  "\u2789",                                                                         // CIRCLED NUMBER TEN
+	0x2474:  "(l)",                                                                            // PARENTHESIZED DIGIT ONE
+	0x2475:  "(2)",                                                                            // PARENTHESIZED DIGIT TWO
+	0x2476:  "(3)",                                                                            // PARENTHESIZED DIGIT THREE
+	0x2477:  "(4)",                                                                            // PARENTHESIZED DIGIT FOUR
+	0x2478:  "(5)",                                                                            // PARENTHESIZED DIGIT FIVE
+	0x2479:  "(6)",                                                                            // PARENTHESIZED DIGIT SIX
+	0x247A:  "(7)",                                                                            // PARENTHESIZED DIGIT SEVEN
+	0x247B:  "(8)",                                                                            // PARENTHESIZED DIGIT EIGHT
+	0x247C:  "(9)",                                                                            // PARENTHESIZED DIGIT NINE
+	0x247D:  "(lO)",                                                                           // PARENTHESIZED NUMBER TEN
+	0x247E:  "(ll)",                                                                           // PARENTHESIZED NUMBER ELEVEN
+	0x247F:  "(l2)",                                                                           // PARENTHESIZED NUMBER TWELVE
+	0x2480:  "(l3)",                                                                           // PARENTHESIZED NUMBER THIRTEEN
+	0x2481:  "(l4)",                                                                           // PARENTHESIZED NUMBER FOURTEEN
+	0x2482:  "(l5)",                                                                           // PARENTHESIZED NUMBER FIFTEEN
+	0x2483:  "(l6)",                                                                           // PARENTHESIZED NUMBER SIXTEEN
+	0x2484:  "(l7)",                                                                           // PARENTHESIZED NUMBER SEVENTEEN
+	0x2485:  "(l8)",                                                                           // PARENTHESIZED NUMBER EIGHTEEN
+	0x2486:  "(l9)",                                                                           // PARENTHESIZED NUMBER NINETEEN
+	0x2487:  "(2O)",                                                                           // PARENTHESIZED NUMBER TWENTY
+	0x2488:  "l.",                                                                             // DIGIT ONE FULL STOP
+	0x2489:  "2.",                                                                             // DIGIT TWO FULL STOP
+	0x248A:  "3.",                                                                             // DIGIT THREE FULL STOP
+	0x248B:  "4.",                                                                             // DIGIT FOUR FULL STOP
+	0x248C:  "5.",                                                                             // DIGIT FIVE FULL STOP
+	0x248D:  "6.",                                                                             // DIGIT SIX FULL STOP
+	0x248E:  "7.",                                                                             // DIGIT SEVEN FULL STOP
+	0x248F:  "8.",                                                                             // DIGIT EIGHT FULL STOP
+	0x2490:  "9.",                                                                             // DIGIT NINE FULL STOP
+	0x2491:  "lO.",                                                                            // NUMBER TEN FULL STOP
+	0x2492:  "ll.",                                                                            // NUMBER ELEVEN FULL STOP
+	0x2493:  "l2.",                                                                            // NUMBER TWELVE FULL STOP
+	0x2494:  "l3.",                                                                            // NUMBER THIRTEEN FULL STOP
+	0x2495:  "l4.",                                                                            // NUMBER FOURTEEN FULL STOP
+	0x2496:  "l5.",                                                                            // NUMBER FIFTEEN FULL STOP
+	0x2497:  "l6.",                                                                            // NUMBER SIXTEEN FULL STOP
+	0x2498:  "l7.",                                                                            // NUMBER SEVENTEEN FULL STOP
+	0x2499:  "l8.",                                                                            // NUMBER EIGHTEEN FULL STOP
+	0x249A:  "l9.",                                                                            // NUMBER NINETEEN FULL STOP
+	0x249B:  "2O.",                                                                            // NUMBER TWENTY FULL STOP
+	0x249C:  "(a)",                                                                            // PARENTHESIZED LATIN SMALL LETTER A
+	0x249D:  "(b)",                                                                            // PARENTHESIZED LATIN SMALL LETTER B
+	0x249E:  "(c)",                                                                            // PARENTHESIZED LATIN SMALL LETTER C
+	0x249F:  "(d)",                                                                            // PARENTHESIZED LATIN SMALL LETTER D
+	0x24A0:  "(e)",                                                                            // PARENTHESIZED LATIN SMALL LETTER E
+	0x24A1:  "(f)",                                                                            // PARENTHESIZED LATIN SMALL LETTER F
+	0x24A2:  "(g)",                                                                            // PARENTHESIZED LATIN SMALL LETTER G
+	0x24A3:  "(h)",                                                                            // PARENTHESIZED LATIN SMALL LETTER H
+	0x24A4:  "(i)",                                                                            // PARENTHESIZED LATIN SMALL LETTER I
+	0x24A5:  "(j)",                                                                            // PARENTHESIZED LATIN SMALL LETTER J
+	0x24A6:  "(k)",                                                                            // PARENTHESIZED LATIN SMALL LETTER K
+	0x24A7:  "(l)",                                                                            // PARENTHESIZED LATIN SMALL LETTER L
+	0x24A8:  "(rn)",                                                                           // PARENTHESIZED LATIN SMALL LETTER M
+	0x24A9:  "(n)",                                                                            // PARENTHESIZED LATIN SMALL LETTER N
+	0x24AA:  "(o)",                                                                            // PARENTHESIZED LATIN SMALL LETTER O
+	0x24AB:  "(p)",                                                                            // PARENTHESIZED LATIN SMALL LETTER P
+	0x24AC:  "(q)",                                                                            // PARENTHESIZED LATIN SMALL LETTER Q
+	0x24AD:  "(r)",                                                                            // PARENTHESIZED LATIN SMALL LETTER R
+	0x24AE:  "(s)",                                                                            // PARENTHESIZED LATIN SMALL LETTER S
+	0x24AF:  "(t)",                                                                            // PARENTHESIZED LATIN SMALL LETTER T
+	0x24B0:  "(u)",                                                                            // PARENTHESIZED LATIN SMALL LETTER U
+	0x24B1:  "(v)",                                                                            // PARENTHESIZED LATIN SMALL LETTER V
+	0x24B2:  "(w)",                                                                            // PARENTHESIZED LATIN SMALL LETTER W
+	0x24B3:  "(x)",                                                                            // PARENTHESIZED LATIN SMALL LETTER X
+	0x24B4:  "(y)",                                                                            // PARENTHESIZED LATIN SMALL LETTER Y
+	0x24B5:  "(z)",                                                                            // PARENTHESIZED LATIN SMALL LETTER Z
+	0x24B8:  "\u00a9",                                                                         // CIRCLED LATIN CAPITAL LETTER C
+	0x24C5:  "\u2117",                                                                         // CIRCLED LATIN CAPITAL LETTER P
+	0x24C7:  "\u00ae",                                                                         // CIRCLED LATIN CAPITAL LETTER R
+	0x24DB:  "\u24be",                                                                         // CIRCLED LATIN SMALL LETTER L
+	0x24EA:  "\U0001f10d",                                                                     // CIRCLED DIGIT ZERO
+	0x2500:  "\u30fc",                                                                         // BOX DRAWINGS LIGHT HORIZONTAL
+	0x2501:  "\u30fc",                                                                         // BOX DRAWINGS HEAVY HORIZONTAL
+	0x2503:  "\u2502",                                                                         // BOX DRAWINGS HEAVY VERTICAL
+	0x250F:  "\u250c",                                                                         // BOX DRAWINGS HEAVY DOWN AND RIGHT
+	0x2523:  "\u251c",                                                                         // BOX DRAWINGS HEAVY VERTICAL AND RIGHT
+	0x2571:  "/",                                                                              // BOX DRAWINGS LIGHT DIAGONAL UPPER RIGHT TO LOWER LEFT
+	0x2573:  "X",                                                                              // BOX DRAWINGS LIGHT DIAGONAL CROSS
+	0x2588:  "\u220e",                                                                         // FULL BLOCK
+	0x2590:  "\u258c",                                                                         // RIGHT HALF BLOCK
+	0x2594:  "\u02c9",                                                                         // UPPER ONE EIGHTH BLOCK
+	0x2597:  "\u2596",                                                                         // QUADRANT LOWER RIGHT
+	0x259D:  "\u2598",                                                                         // QUADRANT UPPER RIGHT
+	0x25A0:  "\u220e",                                                                         // BLACK SQUARE
+	0x25B1:  "\u23e5",                                                                         // WHITE PARALLELOGRAM
+	0x25B3:  "\u0394",                                                                         // WHITE UP-POINTING TRIANGLE
+	0x25B7:  "\u22b3",                                                                         // WHITE RIGHT-POINTING TRIANGLE
+	0x25B8:  "\u25b6",                                                                         // BLACK RIGHT-POINTING SMALL TRIANGLE
+	0x25BA:  "\u25b6",                                                                         // BLACK RIGHT-POINTING POINTER
+	0x25BD:  "\U000102bc",                                                                     // WHITE DOWN-POINTING TRIANGLE
+	0x25C1:  "\u22b2",                                                                         // WHITE LEFT-POINTING TRIANGLE
+	0x25C7:  "\u16dc",                                                                         // WHITE DIAMOND
+	0x25CA:  "\u16dc",                                                                         // LOZENGE
+	0x25CB:  "\u00b0",                                                                         // WHITE CIRCLE
+	0x25CE:  "\u233e",                                                                         // BULLSEYE
+	0x25E0:  "\u2312",                                                                         // UPPER HALF CIRCLE
+	0x25E6:  "\u00b0",                                                                         // WHITE BULLET
+	0x2609:  "\u0298",                                                                         // SUN
+	0x2610:  "\u25a1",                                                                         // BALLOT BOX
+	0x2625:  "\U0001099e",                                                                     // ANKH
+	0x2630:  "\u2cb6",                                                                         // TRIGRAM FOR HEAVEN
+	0x2638:  "\u2388",                                                                         // WHEEL OF DHARMA
+	0x264E:  "\u224f",                                                                         // LIBRA
+	0x2662:  "\u16dc",                                                                         // WHITE DIAMOND SUIT
+	0x2669:  "\U0001d158\U0001d165",                                                           // QUARTER NOTE
+	0x266A:  "\U0001d158\U0001d165\U0001d16e",                                                 // EIGHTH NOTE
+	0x26AC:  "\u0970",                                                                         // MEDIUM SMALL WHITE CIRCLE
+	0x2768:  "(",                                                                              // MEDIUM LEFT PARENTHESIS ORNAMENT
+	0x2769:  ")",                                                                              // MEDIUM RIGHT PARENTHESIS ORNAMENT
+	0x276E:  "<",                                                                              // HEAVY LEFT-POINTING ANGLE QUOTATION MARK ORNAMENT
+	0x276F:  ">",                                                                              // HEAVY RIGHT-POINTING ANGLE QUOTATION MARK ORNAMENT
+	0x2772:  "(",                                                                              // LIGHT LEFT TORTOISE SHELL BRACKET ORNAMENT
+	0x2773:  ")",                                                                              // LIGHT RIGHT TORTOISE SHELL BRACKET ORNAMENT
+	0x2774:  "{",                                                                              // MEDIUM LEFT CURLY BRACKET ORNAMENT
+	0x2775:  "}",                                                                              // MEDIUM RIGHT CURLY BRACKET ORNAMENT
+	0x2795:  "+",                                                                              // HEAVY PLUS SIGN
+	0x2796:  "-",                                                                              // HEAVY MINUS SIGN
+	0x2797:  "\u00f7",                                                                         // HEAVY DIVISION SIGN
+	0x27C2:  "\ua4d5",                                                                         // PERPENDICULAR
+	0x27C8:  "\\\u1455",                                                                       // REVERSE SOLIDUS PRECEDING SUBSET
+	0x27C9:  "\u1450/",                                                                        // SUPERSET PRECEDING SOLIDUS
+	0x27CB:  "/",                                                                              // MATHEMATICAL RISING DIAGONAL
+	0x27CD:  "\\",                                                                             // MATHEMATICAL FALLING DIAGONAL
+	0x27D9:  "T",                                                                              // LARGE DOWN TACK
+	0x27E8:  "\u276c",                                                                         // MATHEMATICAL LEFT ANGLE BRACKET
+	0x27E9:  "\u276d",                                                                         // MATHEMATICAL RIGHT ANGLE BRACKET
+	0x292B:  "x",                                                                              // RISING DIAGONAL CROSSING FALLING DIAGONAL
+	0x292C:  "x",                                                                              // FALLING DIAGONAL CROSSING RISING DIAGONAL
+	0x2963:  "\u16d0\u16da",                                                                   // UPWARDS HARPOON WITH BARB LEFT BESIDE UPWARDS HARPOON WITH BARB RIGHT
+	0x2965:  "\u21c3\u21c2",                                                                   // DOWNWARDS HARPOON WITH BARB LEFT BESIDE DOWNWARDS HARPOON WITH BARB RIGHT
+	0x296E:  "\u16d0\u21c2",                                                                   // UPWARDS HARPOON WITH BARB LEFT BESIDE DOWNWARDS HARPOON WITH BARB RIGHT
+	0x296F:  "\u21c3\u16da",                                                                   // DOWNWARDS HARPOON WITH BARB LEFT BESIDE UPWARDS HARPOON WITH BARB RIGHT
+	0x2999:  "\u2d42",                                                                         // DOTTED FENCE
+	0x29B0:  "\u2349",                                                                         // REVERSED EMPTY SET
+	0x29BE:  "\u233e",                                                                         // CIRCLED WHITE BULLET
+	0x29C4:  "\u303c",                                                                         // SQUARED RISING DIAGONAL SLASH
+	0x29C5:  "\u2342",                                                                         // SQUARED FALLING DIAGONAL SLASH
+	0x29C7:  "\u233b",                                                                         // SQUARED SMALL CIRCLE
+	0x29D6:  "\U000102c0",                                                                     // WHITE HOURGLASS
+	0x29D9:  "\u299a",                                                                         // RIGHT WIGGLY FENCE
+	0x29F4:  ":\u2192",                                                                        // RULE-DELAYED
+	0x29F5:  "\\",                                                                             // REVERSE SOLIDUS OPERATOR
+	0x29F6:  "/\u0304",                                                                        // SOLIDUS WITH OVERBAR
+	0x29F8:  "/",                                                                              // BIG SOLIDUS
+	0x29F9:  "\\",                                                                             // BIG REVERSE SOLIDUS
+	0x2A00:  "\u0298",                                                                         // N-ARY CIRCLED DOT OPERATOR
+	0x2A01:  "\U000102a8",                                                                     // N-ARY CIRCLED PLUS OPERATOR
+	0x2A02:  "\u2297",                                                                         // N-ARY CIRCLED TIMES OPERATOR
+	0x2A03:  "\u228d",                                                                         // N-ARY UNION OPERATOR WITH DOT
+	0x2A04:  "\u228e",                                                                         // N-ARY UNION OPERATOR WITH PLUS
+	0x2A05:  "\u2293",                                                                         // N-ARY SQUARE INTERSECTION OPERATOR
+	0x2A06:  "\u2294",                                                                         // N-ARY SQUARE UNION OPERATOR
+	0x2A0C:  "\u0283\u0283\u0283\u0283",                                                       // QUADRUPLE INTEGRAL OPERATOR
+	0x2A1D:  "\u16de",                                                                         // JOIN
+	0x2A20:  ">>",                                                                             // Z NOTATION SCHEMA PIPING
+	0x2A21:  "\u16da",                                                                         // Z NOTATION SCHEMA PROJECTION
+	0x2A22:  "+\u030a",                                                                        // PLUS SIGN WITH SMALL CIRCLE ABOVE
+	0x2A23:  "+\u0302",                                                                        // PLUS SIGN WITH CIRCUMFLEX ACCENT ABOVE
+	0x2A24:  "+\u0303",                                                                        // PLUS SIGN WITH TILDE ABOVE
+	0x2A25:  "+\u0323",                                                                        // PLUS SIGN WITH DOT BELOW
+	0x2A26:  "+\u0330",                                                                        // PLUS SIGN WITH TILDE BELOW
+	0x2A27:  "+\u2082",                                                                        // PLUS SIGN WITH SUBSCRIPT TWO
+	0x2A29:  "-\u0313",                                                                        // MINUS SIGN WITH COMMA ABOVE
+	0x2A2A:  "-\u0323",                                                                        // MINUS SIGN WITH DOT BELOW
+	0x2A2F:  "x",                                                                              // VECTOR OR CROSS PRODUCT
+	0x2A30:  "x\u0307",                                                                        // MULTIPLICATION SIGN WITH DOT ABOVE
+	0x2A3D:  "\u2319",                                                                         // RIGHTHAND INTERIOR PRODUCT
+	0x2A3E:  "\u2a1f",                                                                         // Z NOTATION RELATIONAL COMPOSITION
+	0x2A3F:  "\u2210",                                                                         // AMALGAMATION OR COPRODUCT
+	0x2A6A:  "~\u0307",                                                                        // TILDE OPERATOR WITH DOT ABOVE
+	0x2A6E:  "=\u20f0",                                                                        // EQUALS WITH ASTERISK
+	0x2A74:  "::=",                                                                            // DOUBLE COLON EQUAL
+	0x2A75:  "==",                                                                             // TWO CONSECUTIVE EQUALS SIGNS
+	0x2A76:  "===",                                                                            // THREE CONSECUTIVE EQUALS SIGNS
+	0x2AA5:  "><",                                                                             // GREATER-THAN BESIDE LESS-THAN
+	0x2AAA:  "\u15d5",                                                                         // SMALLER THAN
+	0x2AAB:  "\u15d2",                                                                         // LARGER THAN
+	0x2AD7:  "\u1450\u1455",                                                                   // SUPERSET BESIDE SUBSET
+	0x2AFB:  "///",                                                                            // TRIPLE SOLIDUS BINARY RELATION
+	0x2AFD:  "//",                                                                             // DOUBLE SOLIDUS OPERATOR
+	0x2BEC:  "\u219e",                                                                         // LEFTWARDS TWO-HEADED ARROW WITH TRIANGLE ARROWHEADS
+	0x2BED:  "\u219f",                                                                         // UPWARDS TWO-HEADED ARROW WITH TRIANGLE ARROWHEADS
+	0x2BEE:  "\u21a0",                                                                         // RIGHTWARDS TWO-HEADED ARROW WITH TRIANGLE ARROWHEADS
+	0x2BEF:  "\u21a1",                                                                         // DOWNWARDS TWO-HEADED ARROW WITH TRIANGLE ARROWHEADS
+	0x2C67:  "H\u0329",                                                                        // LATIN CAPITAL LETTER H WITH DESCENDER
+	0x2C69:  "K\u0329",                                                                        // LATIN CAPITAL LETTER K WITH DESCENDER
+	0x2C84:  "\u0393",                                                                         // COPTIC CAPITAL LETTER GAMMA
+	0x2C85:  "r",                                                                              // COPTIC SMALL LETTER GAMMA
+	0x2C86:  "\u0394",                                                                         // COPTIC CAPITAL LETTER DALDA
+	0x2C88:  "\ua792",                                                                         // COPTIC CAPITAL LETTER EIE
+	0x2C89:  "\ua793",                                                                         // COPTIC SMALL LETTER EIE
+	0x2C8E:  "H",                                                                              // COPTIC CAPITAL LETTER HATE
+	0x2C92:  "l",                                                                              // COPTIC CAPITAL LETTER IAUDA
+	0x2C94:  "K",                                                                              // COPTIC CAPITAL LETTER KAPA
+	0x2C95:  "\u0138",                                                                         // COPTIC SMALL LETTER KAPA
+	0x2C96:  "\u03bb",                                                                         // COPTIC CAPITAL LETTER LAULA
+	0x2C98:  "M",                                                                              // COPTIC CAPITAL LETTER MI
+	0x2C9A:  "N",                                                                              // COPTIC CAPITAL LETTER NI
+	0x2C9E:  "O",                                                                              // COPTIC CAPITAL LETTER O
+	0x2C9F:  "o",                                                                              // COPTIC SMALL LETTER O
+	0x2CA0:  "\u03a0",                                                                         // COPTIC CAPITAL LETTER PI
+	0x2CA2:  "P",                                                                              // COPTIC CAPITAL LETTER RO
+	0x2CA3:  "p",                                                                              // COPTIC SMALL LETTER RO
+	0x2CA4:  "C",                                                                              // COPTIC CAPITAL LETTER SIMA
+	0x2CA5:  "c",                                                                              // COPTIC SMALL LETTER SIMA
+	0x2CA6:  "T",                                                                              // COPTIC CAPITAL LETTER TAU
+	0x2CA8:  "Y",                                                                              // COPTIC CAPITAL LETTER UA
+	0x2CAA:  "\u03a6",                                                                         // COPTIC CAPITAL LETTER FI
+	0x2CAB:  "\u0278",                                                                         // COPTIC SMALL LETTER FI
+	0x2CAC:  "X",                                                                              // COPTIC CAPITAL LETTER KHI
+	0x2CAD:  "\u03c7",                                                                         // COPTIC SMALL LETTER KHI
+	0x2CAE:  "\u03a8",                                                                         // COPTIC CAPITAL LETTER PSI
+	0x2CB1:  "\u03c9",                                                                         // COPTIC SMALL LETTER OOU
+	0x2CB4:  "<\u00b7",                                                                        // COPTIC CAPITAL LETTER OLD COPTIC AIN
+	0x2CBA:  "-",                                                                              // COPTIC CAPITAL LETTER DIALECT-P NI
+	0x2CBC:  "\u0428",                                                                         // COPTIC CAPITAL LETTER CRYPTOGRAMMIC NI
+	0x2CBD:  "\u0448",                                                                         // COPTIC SMALL LETTER CRYPTOGRAMMIC NI
+	0x2CC6:  "/",                                                                              // COPTIC CAPITAL LETTER OLD COPTIC ESH
+	0x2CCA:  "9",                                                                              // COPTIC CAPITAL LETTER DIALECT-P HORI
+	0x2CCC:  "3",                                                                              // COPTIC CAPITAL LETTER OLD COPTIC HORI
+	0x2CCD:  "\u021d",                                                                         // COPTIC SMALL LETTER OLD COPTIC HORI
+	0x2CD0:  "L",                                                                              // COPTIC CAPITAL LETTER L-SHAPED HA
+	0x2CD1:  "\u029f",                                                                         // COPTIC SMALL LETTER L-SHAPED HA
+	0x2CD2:  "6",                                                                              // COPTIC CAPITAL LETTER OLD COPTIC HEI
+	0x2CDC:  "\u03ec",                                                                         // COPTIC CAPITAL LETTER OLD NUBIAN SHIMA
+	0x2CE4:  "\u03d7",                                                                         // COPTIC SYMBOL KAI
+	0x2CE9:  "\u2627",                                                                         // COPTIC SYMBOL KHI RO
+	0x2CF9:  "\\\\",                                                                           // COPTIC OLD NUBIAN FULL STOP
+	0x2D31:  "O\u0335",                                                                        // TIFINAGH LETTER YAB
+	0x2D37:  "\u0245",                                                                         // TIFINAGH LETTER YAD
+	0x2D38:  "V",                                                                              // TIFINAGH LETTER YADH
+	0x2D39:  "E",                                                                              // TIFINAGH LETTER YADD
+	0x2D3A:  "\u018e",                                                                         // TIFINAGH LETTER YADDH
+	0x2D41:  "O\u0338",                                                                        // TIFINAGH LETTER BERBER ACADEMY YAH
+	0x2D48:  "\u00b7\u00b7\u00b7",                                                             // TIFINAGH LETTER TUAREG YAQ
+	0x2D49:  "\u01a9",                                                                         // TIFINAGH LETTER YI
+	0x2D4F:  "l",                                                                              // TIFINAGH LETTER YAN
+	0x2D51:  "!",                                                                              // TIFINAGH LETTER TUAREG YANG
+	0x2D54:  "O",                                                                              // TIFINAGH LETTER YAR
+	0x2D55:  "Q",                                                                              // TIFINAGH LETTER YARR
+	0x2D59:  "\u0298",                                                                         // TIFINAGH LETTER YAS
+	0x2D5D:  "X",                                                                              // TIFINAGH LETTER YATH
+	0x2D60:  "\u0394",                                                                         // TIFINAGH LETTER YAV
+	0x2D63:  "\u16ef",                                                                         // TIFINAGH LETTER YAZ
+	0x2DE8:  "\u1ddf",                                                                         // COMBINING CYRILLIC LETTER EM
+	0x2DEA:  "\u030a",                                                                         // COMBINING CYRILLIC LETTER O
+	0x2DED:  "\u0368",                                                                         // COMBINING CYRILLIC LETTER ES
+	0x2DEF:  "\u036f",                                                                         // COMBINING CYRILLIC LETTER HA
+	0x2DF6:  "\u0363",                                                                         // COMBINING CYRILLIC LETTER A
+	0x2DF7:  "\u0364",                                                                         // COMBINING CYRILLIC LETTER IE
+	0x2E1A:  "-\u0308",                                                                        // HYPHEN WITH DIAERESIS
+	0x2E1E:  "~\u0307",                                                                        // TILDE WITH DOT ABOVE
+	0x2E1F:  "~\u0323",                                                                        // TILDE WITH DOT BELOW
+	0x2E26:  "\u1455",                                                                         // LEFT SIDEWAYS U BRACKET
+	0x2E27:  "\u1450",                                                                         // RIGHT SIDEWAYS U BRACKET
+	0x2E28:  "((",                                                                             // LEFT DOUBLE PARENTHESIS
+	0x2E29:  "))",                                                                             // RIGHT DOUBLE PARENTHESIS
+	0x2E2A:  "\u2235",                                                                         // TWO DOTS OVER ONE DOT PUNCTUATION
+	0x2E2B:  "\u2234",                                                                         // ONE DOT OVER TWO DOTS PUNCTUATION
+	0x2E2C:  "\u2237",                                                                         // SQUARED FOUR DOT PUNCTUATION
+	0x2E2E:  "\u061f",                                                                         // REVERSED QUESTION MARK
+	0x2E30:  "\u00b0",                                                                         // RING POINT
+	0x2E31:  "\u00b7",                                                                         // WORD SEPARATOR MIDDLE DOT
+	0x2E32:  "\u060c",                                                                         // TURNED COMMA
+	0x2E35:  "\u061b",                                                                         // TURNED SEMICOLON
+	0x2E39:  "\u1e9f",                                                                         // TOP HALF SECTION SIGN
+	0x2E3D:  "\u2d42",                                                                         // VERTICAL SIX DOTS
+	0x2E3F:  "\u00b6",                                                                         // CAPITULUM
+	0x2E40:  "=",                                                                              // DOUBLE HYPHEN
+	0x2E82:  "\u4e5b",                                                                         // CJK RADICAL SECOND ONE
+	0x2E83:  "\u4e5a",                                                                         // CJK RADICAL SECOND TWO
+	0x2E85:  "\u4ebb",                                                                         // CJK RADICAL PERSON
+	0x2E89:  "\u5202",                                                                         // CJK RADICAL KNIFE TWO
+	0x2E8B:  "\u353e",                                                                         // CJK RADICAL SEAL
+	0x2E8E:  "\u5140",                                                                         // CJK RADICAL LAME ONE
+	0x2E8F:  "\u5c23",                                                                         // CJK RADICAL LAME TWO
+	0x2E90:  "\u5c22",                                                                         // CJK RADICAL LAME THREE
+	0x2E92:  "\u5df3",                                                                         // CJK RADICAL SNAKE
+	0x2E93:  "\u5e7a",                                                                         // CJK RADICAL THREAD
+	0x2E94:  "\u5f51",                                                                         // CJK RADICAL SNOUT ONE
+	0x2E96:  "\u5fc4",                                                                         // CJK RADICAL HEART ONE
+	0x2E97:  "\u38fa",                                                                         // CJK RADICAL HEART TWO
+	0x2E98:  "\u624c",                                                                         // CJK RADICAL HAND
+	0x2E99:  "\u6535",                                                                         // CJK RADICAL RAP
+	0x2E9B:  "\u65e1",                                                                         // CJK RADICAL CHOKE
+	0x2E9E:  "\u6b7a",                                                                         // CJK RADICAL DEATH
+	0x2E9F:  "\u6bcd",                                                                         // CJK RADICAL MOTHER
+	0x2EA0:  "\u6c11",                                                                         // CJK RADICAL CIVILIAN
+	0x2EA1:  "\u6c35",                                                                         // CJK RADICAL WATER ONE
+	0x2EA2:  "\u6c3a",                                                                         // CJK RADICAL WATER TWO
+	0x2EA3:  "\u706c",                                                                         // CJK RADICAL FIRE
+	0x2EA4:  "\u722b",                                                                         // CJK RADICAL PAW ONE
+	0x2EA6:  "\u4e2c",                                                                         // CJK RADICAL SIMPLIFIED HALF TREE TRUNK
+	0x2EA8:  "\u72ad",                                                                         // CJK RADICAL DOG
+	0x2EAB:  "\u7f52",                                                                         // CJK RADICAL EYE
+	0x2EAD:  "\u793b",                                                                         // CJK RADICAL SPIRIT TWO
+	0x2EAF:  "\u7cf9",                                                                         // CJK RADICAL SILK
+	0x2EB1:  "\u7f53",                                                                         // CJK RADICAL NET ONE
+	0x2EB2:  "\u7f52",                                                                         // CJK RADICAL NET TWO
+	0x2EB9:  "\u8002",                                                                         // CJK RADICAL OLD
+	0x2EBA:  "\u8080",                                                                         // CJK RADICAL BRUSH ONE
+	0x2EBE:  "\u8279",                                                                         // CJK RADICAL GRASS ONE
+	0x2EBF:  "\u8279",                                                                         // CJK RADICAL GRASS TWO
+	0x2EC0:  "\u8279",                                                                         // CJK RADICAL GRASS THREE
+	0x2EC1:  "\u864e",                                                                         // CJK RADICAL TIGER
+	0x2EC2:  "\u8864",                                                                         // CJK RADICAL CLOTHES
+	0x2EC3:  "\u8980",                                                                         // CJK RADICAL WEST ONE
+	0x2EC4:  "\u897f",                                                                         // CJK RADICAL WEST TWO
+	0x2EC5:  "\u89c1",                                                                         // CJK RADICAL C-SIMPLIFIED SEE
+	0x2EC8:  "\u8ba0",                                                                         // CJK RADICAL C-SIMPLIFIED SPEECH
+	0x2EC9:  "\u8d1d",                                                                         // CJK RADICAL C-SIMPLIFIED SHELL
+	0x2ECB:  "\u8f66",                                                                         // CJK RADICAL C-SIMPLIFIED CART
+	0x2ECC:  "\u8fb6",                                                                         // CJK RADICAL SIMPLIFIED WALK
+	0x2ECD:  "\u8fb6",                                                                         // CJK RADICAL WALK ONE
+	0x2ECF:  "\u961d",                                                                         // CJK RADICAL CITY
+	0x2ED0:  "\u9485",                                                                         // CJK RADICAL C-SIMPLIFIED GOLD
+	0x2ED1:  "\u9577",                                                                         // CJK RADICAL LONG ONE
+	0x2ED2:  "\u9578",                                                                         // CJK RADICAL LONG TWO
+	0x2ED3:  "\u957f",                                                                         // CJK RADICAL C-SIMPLIFIED LONG
+	0x2ED4:  "\u95e8",                                                                         // CJK RADICAL C-SIMPLIFIED GATE
+	0x2ED6:  "\u961d",                                                                         // CJK RADICAL MOUND TWO
+	0x2ED8:  "\u9752",                                                                         // CJK RADICAL BLUE
+	0x2ED9:  "\u97e6",                                                                         // CJK RADICAL C-SIMPLIFIED TANNED LEATHER
+	0x2EDA:  "\u9875",                                                                         // CJK RADICAL C-SIMPLIFIED LEAF
+	0x2EDB:  "\u98ce",                                                                         // CJK RADICAL C-SIMPLIFIED WIND
+	0x2EDC:  "\u98de",                                                                         // CJK RADICAL C-SIMPLIFIED FLY
+	0x2EDD:  "\u98df",                                                                         // CJK RADICAL EAT ONE
+	0x2EDF:  "\u98e0",                                                                         // CJK RADICAL EAT THREE
+	0x2EE0:  "\u9963",                                                                         // CJK RADICAL C-SIMPLIFIED EAT
+	0x2EE2:  "\u9a6c",                                                                         // CJK RADICAL C-SIMPLIFIED HORSE
+	0x2EE4:  "\u9b3c",                                                                         // CJK RADICAL GHOST
+	0x2EE5:  "\u9c7c",                                                                         // CJK RADICAL C-SIMPLIFIED FISH
+	0x2EE8:  "\u9ea6",                                                                         // CJK RADICAL SIMPLIFIED WHEAT
+	0x2EE9:  "\u9ec4",                                                                         // CJK RADICAL SIMPLIFIED YELLOW
+	0x2EEB:  "\u6589",                                                                         // CJK RADICAL J-SIMPLIFIED EVEN
+	0x2EEC:  "\u9f50",                                                                         // CJK RADICAL C-SIMPLIFIED EVEN
+	0x2EED:  "\u6b6f",                                                                         // CJK RADICAL J-SIMPLIFIED TOOTH
+	0x2EEE:  "\u9f7f",                                                                         // CJK RADICAL C-SIMPLIFIED TOOTH
+	0x2EEF:  "\u7adc",                                                                         // CJK RADICAL J-SIMPLIFIED DRAGON
+	0x2EF0:  "\u9f99",                                                                         // CJK RADICAL C-SIMPLIFIED DRAGON
+	0x2EF2:  "\u4e80",                                                                         // CJK RADICAL J-SIMPLIFIED TURTLE
+	0x2EF3:  "\u9f9f",                                                                         // CJK RADICAL C-SIMPLIFIED TURTLE
+	0x2F00:  "\u30fc",                                                                         // KANGXI RADICAL ONE
+	0x2F01:  "\u4e28",                                                                         // KANGXI RADICAL LINE
+	0x2F02:  "\\",                                                                             // KANGXI RADICAL DOT
+	0x2F03:  "/",                                                                              // KANGXI RADICAL SLASH
+	0x2F04:  "\u4e59",                                                                         // KANGXI RADICAL SECOND
+	0x2F05:  "\u4e85",                                                                         // KANGXI RADICAL HOOK
+	0x2F06:  "\u4e8c",                                                                         // KANGXI RADICAL TWO
+	0x2F07:  "\u4ea0",                                                                         // KANGXI RADICAL LID
+	0x2F08:  "\u4eba",                                                                         // KANGXI RADICAL MAN
+	0x2F09:  "\u513f",                                                                         // KANGXI RADICAL LEGS
+	0x2F0A:  "\u5165",                                                                         // KANGXI RADICAL ENTER
+	0x2F0B:  "\u516b",                                                                         // KANGXI RADICAL EIGHT
+	0x2F0C:  "\u5182",                                                                         // KANGXI RADICAL DOWN BOX
+	0x2F0D:  "\u5196",                                                                         // KANGXI RADICAL COVER
+	0x2F0E:  "\u51ab",                                                                         // KANGXI RADICAL ICE
+	0x2F0F:  "\u51e0",                                                                         // KANGXI RADICAL TABLE
+	0x2F10:  "\u51f5",                                                                         // KANGXI RADICAL OPEN BOX
+	0x2F11:  "\u5200",                                                                         // KANGXI RADICAL KNIFE
+	0x2F12:  "\u529b",                                                                         // KANGXI RADICAL POWER
+	0x2F13:  "\u52f9",                                                                         // KANGXI RADICAL WRAP
+	0x2F14:  "\u5315",                                                                         // KANGXI RADICAL SPOON
+	0x2F15:  "\u531a",                                                                         // KANGXI RADICAL RIGHT OPEN BOX
+	0x2F16:  "\u5338",                                                                         // KANGXI RADICAL HIDING ENCLOSURE
+	0x2F17:  "\u5341",                                                                         // KANGXI RADICAL TEN
+	0x2F18:  "\u535c",                                                                         // KANGXI RADICAL DIVINATION
+	0x2F19:  "\u5369",                                                                         // KANGXI RADICAL SEAL
+	0x2F1A:  "\u5382",                                                                         // KANGXI RADICAL CLIFF
+	0x2F1B:  "\u53b6",                                                                         // KANGXI RADICAL PRIVATE
+	0x2F1C:  "\u53c8",                                                                         // KANGXI RADICAL AGAIN
+	0x2F1D:  "\u53e3",                                                                         // KANGXI RADICAL MOUTH
+	0x2F1E:  "\u53e3",                                                                         // KANGXI RADICAL ENCLOSURE
+	0x2F1F:  "\u571f",                                                                         // KANGXI RADICAL EARTH
+	0x2F20:  "\u571f",                                                                         // KANGXI RADICAL SCHOLAR
+	0x2F21:  "\u5902",                                                                         // KANGXI RADICAL GO
+	0x2F22:  "\u590a",                                                                         // KANGXI RADICAL GO SLOWLY
+	0x2F23:  "\u5915",                                                                         // KANGXI RADICAL EVENING
+	0x2F24:  "\u5927",                                                                         // KANGXI RADICAL BIG
+	0x2F25:  "\u5973",                                                                         // KANGXI RADICAL WOMAN
+	0x2F26:  "\u5b50",                                                                         // KANGXI RADICAL CHILD
+	0x2F27:  "\u5b80",                                                                         // KANGXI RADICAL ROOF
+	0x2F28:  "\u5bf8",                                                                         // KANGXI RADICAL INCH
+	0x2F29:  "\u5c0f",                                                                         // KANGXI RADICAL SMALL
+	0x2F2A:  "\u5c22",                                                                         // KANGXI RADICAL LAME
+	0x2F2B:  "\u5c38",                                                                         // KANGXI RADICAL CORPSE
+	0x2F2C:  "\u5c6e",                                                                         // KANGXI RADICAL SPROUT
+	0x2F2D:  "\u5c71",                                                                         // KANGXI RADICAL MOUNTAIN
+	0x2F2E:  "\u5ddb",                                                                         // KANGXI RADICAL RIVER
+	0x2F2F:  "\u5de5",                                                                         // KANGXI RADICAL WORK
+	0x2F30:  "\u5df1",                                                                         // KANGXI RADICAL ONESELF
+	0x2F31:  "\u5dfe",                                                                         // KANGXI RADICAL TURBAN
+	0x2F32:  "\u5e72",                                                                         // KANGXI RADICAL DRY
+	0x2F33:  "\u5e7a",                                                                         // KANGXI RADICAL SHORT THREAD
+	0x2F34:  "\u5e7f",                                                                         // KANGXI RADICAL DOTTED CLIFF
+	0x2F35:  "\u5ef4",                                                                         // KANGXI RADICAL LONG STRIDE
+	0x2F36:  "\u5efe",                                                                         // KANGXI RADICAL TWO HANDS
+	0x2F37:  "\u5f0b",                                                                         // KANGXI RADICAL SHOOT
+	0x2F38:  "\u5f13",                                                                         // KANGXI RADICAL BOW
+	0x2F39:  "\u5f50",                                                                         // KANGXI RADICAL SNOUT
+	0x2F3A:  "\u5f61",                                                                         // KANGXI RADICAL BRISTLE
+	0x2F3B:  "\u5f73",                                                                         // KANGXI RADICAL STEP
+	0x2F3C:  "\u5fc3",                                                                         // KANGXI RADICAL HEART
+	0x2F3D:  "\u6208",                                                                         // KANGXI RADICAL HALBERD
+	0x2F3E:  "\u6236",                                                                         // KANGXI RADICAL DOOR
+	0x2F3F:  "\u624b",                                                                         // KANGXI RADICAL HAND
+	0x2F40:  "\u652f",                                                                         // KANGXI RADICAL BRANCH
+	0x2F41:  "\u6534",                                                                         // KANGXI RADICAL RAP
+	0x2F42:  "\u6587",                                                                         // KANGXI RADICAL SCRIPT
+	0x2F43:  "\u6597",                                                                         // KANGXI RADICAL DIPPER
+	0x2F44:  "\u65a4",                                                                         // KANGXI RADICAL AXE
+	0x2F45:  "\u65b9",                                                                         // KANGXI RADICAL SQUARE
+	0x2F46:  "\u65e0",                                                                         // KANGXI RADICAL NOT
+	0x2F47:  "\u65e5",                                                                         // KANGXI RADICAL SUN
+	0x2F48:  "\u66f0",                                                                         // KANGXI RADICAL SAY
+	0x2F49:  "\u6708",                                                                         // KANGXI RADICAL MOON
+	0x2F4A:  "\u6728",                                                                         // KANGXI RADICAL TREE
+	0x2F4B:  "\u6b20",                                                                         // KANGXI RADICAL LACK
+	0x2F4C:  "\u6b62",                                                                         // KANGXI RADICAL STOP
+	0x2F4D:  "\u6b79",                                                                         // KANGXI RADICAL DEATH
+	0x2F4E:  "\u6bb3",                                                                         // KANGXI RADICAL WEAPON
+	0x2F4F:  "\u6bcb",                                                                         // KANGXI RADICAL DO NOT
+	0x2F50:  "\u6bd4",                                                                         // KANGXI RADICAL COMPARE
+	0x2F51:  "\u6bdb",                                                                         // KANGXI RADICAL FUR
+	0x2F52:  "\u6c0f",                                                                         // KANGXI RADICAL CLAN
+	0x2F53:  "\u6c14",                                                                         // KANGXI RADICAL STEAM
+	0x2F54:  "\u6c34",                                                                         // KANGXI RADICAL WATER
+	0x2F55:  "\u706b",                                                                         // KANGXI RADICAL FIRE
+	0x2F56:  "\u722a",                                                                         // KANGXI RADICAL CLAW
+	0x2F57:  "\u7236",                                                                         // KANGXI RADICAL FATHER
+	0x2F58:  "\u723b",                                                                         // KANGXI RADICAL DOUBLE X
+	0x2F59:  "\u723f",                                                                         // KANGXI RADICAL HALF TREE TRUNK
+	0x2F5A:  "\u7247",                                                                         // KANGXI RADICAL SLICE
+	0x2F5B:  "\u7259",                                                                         // KANGXI RADICAL FANG
+	0x2F5C:  "\u725b",                                                                         // KANGXI RADICAL COW
+	0x2F5D:  "\u72ac",                                                                         // KANGXI RADICAL DOG
+	0x2F5E:  "\u7384",                                                                         // KANGXI RADICAL PROFOUND
+	0x2F5F:  "\u7389",                                                                         // KANGXI RADICAL JADE
+	0x2F60:  "\u74dc",                                                                         // KANGXI RADICAL MELON
+	0x2F61:  "\u74e6",                                                                         // KANGXI RADICAL TILE
+	0x2F62:  "\u7518",                                                                         // KANGXI RADICAL SWEET
+	0x2F63:  "\u751f",                                                                         // KANGXI RADICAL LIFE
+	0x2F64:  "\u7528",                                                                         // KANGXI RADICAL USE
+	0x2F65:  "\u7530",                                                                         // KANGXI RADICAL FIELD
+	0x2F66:  "\u758b",                                                                         // KANGXI RADICAL BOLT OF CLOTH
+	0x2F67:  "\u7592",                                                                         // KANGXI RADICAL SICKNESS
+	0x2F68:  "\u7676",                                                                         // KANGXI RADICAL DOTTED TENT
+	0x2F69:  "\u767d",                                                                         // KANGXI RADICAL WHITE
+	0x2F6A:  "\u76ae",                                                                         // KANGXI RADICAL SKIN
+	0x2F6B:  "\u76bf",                                                                         // KANGXI RADICAL DISH
+	0x2F6C:  "\u76ee",                                                                         // KANGXI RADICAL EYE
+	0x2F6D:  "\u77db",                                                                         // KANGXI RADICAL SPEAR
+	0x2F6E:  "\u77e2",                                                                         // KANGXI RADICAL ARROW
+	0x2F6F:  "\u77f3",                                                                         // KANGXI RADICAL STONE
+	0x2F70:  "\u793a",                                                                         // KANGXI RADICAL SPIRIT
+	0x2F71:  "\u79b8",                                                                         // KANGXI RADICAL TRACK
+	0x2F72:  "\u79be",                                                                         // KANGXI RADICAL GRAIN
+	0x2F73:  "\u7a74",                                                                         // KANGXI RADICAL CAVE
+	0x2F74:  "\u7acb",                                                                         // KANGXI RADICAL STAND
+	0x2F75:  "\u7af9",                                                                         // KANGXI RADICAL BAMBOO
+	0x2F76:  "\u7c73",                                                                         // KANGXI RADICAL RICE
+	0x2F77:  "\u7cf8",                                                                         // KANGXI RADICAL SILK
+	0x2F78:  "\u7f36",                                                                         // KANGXI RADICAL JAR
+	0x2F79:  "\u7f51",                                                                         // KANGXI RADICAL NET
+	0x2F7A:  "\u7f8a",                                                                         // KANGXI RADICAL SHEEP
+	0x2F7B:  "\u7fbd",                                                                         // KANGXI RADICAL FEATHER
+	0x2F7C:  "\u8001",                                                                         // KANGXI RADICAL OLD
+	0x2F7D:  "\u800c",                                                                         // KANGXI RADICAL AND
+	0x2F7E:  "\u8012",                                                                         // KANGXI RADICAL PLOW
+	0x2F7F:  "\u8033",                                                                         // KANGXI RADICAL EAR
+	0x2F80:  "\u807f",                                                                         // KANGXI RADICAL BRUSH
+	0x2F81:  "\u8089",                                                                         // KANGXI RADICAL MEAT
+	0x2F82:  "\u81e3",                                                                         // KANGXI RADICAL MINISTER
+	0x2F83:  "\u81ea",                                                                         // KANGXI RADICAL SELF
+	0x2F84:  "\u81f3",                                                                         // KANGXI RADICAL ARRIVE
+	0x2F85:  "\u81fc",                                                                         // KANGXI RADICAL MORTAR
+	0x2F86:  "\u820c",                                                                         // KANGXI RADICAL TONGUE
+	0x2F87:  "\u821b",                                                                         // KANGXI RADICAL OPPOSE
+	0x2F88:  "\u821f",                                                                         // KANGXI RADICAL BOAT
+	0x2F89:  "\u826e",                                                                         // KANGXI RADICAL STOPPING
+	0x2F8A:  "\u8272",                                                                         // KANGXI RADICAL COLOR
+	0x2F8B:  "\u8278",                                                                         // KANGXI RADICAL GRASS
+	0x2F8C:  "\u864d",                                                                         // KANGXI RADICAL TIGER
+	0x2F8D:  "\u866b",                                                                         // KANGXI RADICAL INSECT
+	0x2F8E:  "\u8840",                                                                         // KANGXI RADICAL BLOOD
+	0x2F8F:  "\u884c",                                                                         // KANGXI RADICAL WALK ENCLOSURE
+	0x2F90:  "\u8863",                                                                         // KANGXI RADICAL CLOTHES
+	0x2F91:  "\u897e",                                                                         // KANGXI RADICAL WEST
+	0x2F92:  "\u898b",                                                                         // KANGXI RADICAL SEE
+	0x2F93:  "\u89d2",                                                                         // KANGXI RADICAL HORN
+	0x2F94:  "\u8a00",                                                                         // KANGXI RADICAL SPEECH
+	0x2F95:  "\u8c37",                                                                         // KANGXI RADICAL VALLEY
+	0x2F96:  "\u8c46",                                                                         // KANGXI RADICAL BEAN
+	0x2F97:  "\u8c55",                                                                         // KANGXI RADICAL PIG
+	0x2F98:  "\u8c78",                                                                         // KANGXI RADICAL BADGER
+	0x2F99:  "\u8c9d",                                                                         // KANGXI RADICAL SHELL
+	0x2F9A:  "\u8d64",                                                                         // KANGXI RADICAL RED
+	0x2F9B:  "\u8d70",                                                                         // KANGXI RADICAL RUN
+	0x2F9C:  "\u8db3",                                                                         // KANGXI RADICAL FOOT
+	0x2F9D:  "\u8eab",                                                                         // KANGXI RADICAL BODY
+	0x2F9E:  "\u8eca",                                                                         // KANGXI RADICAL CART
+	0x2F9F:  "\u8f9b",                                                                         // KANGXI RADICAL BITTER
+	0x2FA0:  "\u8fb0",                                                                         // KANGXI RADICAL MORNING
+	0x2FA1:  "\u8fb5",                                                                         // KANGXI RADICAL WALK
+	0x2FA2:  "\u9091",                                                                         // KANGXI RADICAL CITY
+	0x2FA3:  "\u9149",                                                                         // KANGXI RADICAL WINE
+	0x2FA4:  "\u91c6",                                                                         // KANGXI RADICAL DISTINGUISH
+	0x2FA5:  "\u91cc",                                                                         // KANGXI RADICAL VILLAGE
+	0x2FA6:  "\u91d1",                                                                         // KANGXI RADICAL GOLD
+	0x2FA7:  "\u9577",                                                                         // KANGXI RADICAL LONG
+	0x2FA8:  "\u9580",                                                                         // KANGXI RADICAL GATE
+	0x2FA9:  "\u961c",                                                                         // KANGXI RADICAL MOUND
+	0x2FAA:  "\u96b6",                                                                         // KANGXI RADICAL SLAVE
+	0x2FAB:  "\u96b9",                                                                         // KANGXI RADICAL SHORT TAILED BIRD
+	0x2FAC:  "\u96e8",                                                                         // KANGXI RADICAL RAIN
+	0x2FAD:  "\u9751",                                                                         // KANGXI RADICAL BLUE
+	0x2FAE:  "\u975e",                                                                         // KANGXI RADICAL WRONG
+	0x2FAF:  "\u9762",                                                                         // KANGXI RADICAL FACE
+	0x2FB0:  "\u9769",                                                                         // KANGXI RADICAL LEATHER
+	0x2FB1:  "\u97cb",                                                                         // KANGXI RADICAL TANNED LEATHER
+	0x2FB2:  "\u97ed",                                                                         // KANGXI RADICAL LEEK
+	0x2FB3:  "\u97f3",                                                                         // KANGXI RADICAL SOUND
+	0x2FB4:  "\u9801",                                                                         // KANGXI RADICAL LEAF
+	0x2FB5:  "\u98a8",                                                                         // KANGXI RADICAL WIND
+	0x2FB6:  "\u98db",                                                                         // KANGXI RADICAL FLY
+	0x2FB7:  "\u98df",                                                                         // KANGXI RADICAL EAT
+	0x2FB8:  "\u9996",                                                                         // KANGXI RADICAL HEAD
+	0x2FB9:  "\u9999",                                                                         // KANGXI RADICAL FRAGRANT
+	0x2FBA:  "\u99ac",                                                                         // KANGXI RADICAL HORSE
+	0x2FBB:  "\u9aa8",                                                                         // KANGXI RADICAL BONE
+	0x2FBC:  "\u9ad8",                                                                         // KANGXI RADICAL TALL
+	0x2FBD:  "\u9adf",                                                                         // KANGXI RADICAL HAIR
+	0x2FBE:  "\u9b25",                                                                         // KANGXI RADICAL FIGHT
+	0x2FBF:  "\u9b2f",                                                                         // KANGXI RADICAL SACRIFICIAL WINE
+	0x2FC0:  "\u9b32",                                                                         // KANGXI RADICAL CAULDRON
+	0x2FC1:  "\u9b3c",                                                                         // KANGXI RADICAL GHOST
+	0x2FC2:  "\u9b5a",                                                                         // KANGXI RADICAL FISH
+	0x2FC3:  "\u9ce5",                                                                         // KANGXI RADICAL BIRD
+	0x2FC4:  "\u9e75",                                                                         // KANGXI RADICAL SALT
+	0x2FC5:  "\u9e7f",                                                                         // KANGXI RADICAL DEER
+	0x2FC6:  "\u9ea5",                                                                         // KANGXI RADICAL WHEAT
+	0x2FC7:  "\u9ebb",                                                                         // KANGXI RADICAL HEMP
+	0x2FC8:  "\u9ec3",                                                                         // KANGXI RADICAL YELLOW
+	0x2FC9:  "\u9ecd",                                                                         // KANGXI RADICAL MILLET
+	0x2FCA:  "\u9ed1",                                                                         // KANGXI RADICAL BLACK
+	0x2FCB:  "\u9ef9",                                                                         // KANGXI RADICAL EMBROIDERY
+	0x2FCC:  "\u9efd",                                                                         // KANGXI RADICAL FROG
+	0x2FCD:  "\u9f0e",                                                                         // KANGXI RADICAL TRIPOD
+	0x2FCE:  "\u9f13",                                                                         // KANGXI RADICAL DRUM
+	0x2FCF:  "\u9f20",                                                                         // KANGXI RADICAL RAT
+	0x2FD0:  "\u9f3b",                                                                         // KANGXI RADICAL NOSE
+	0x2FD1:  "\u9f4a",                                                                         // KANGXI RADICAL EVEN
+	0x2FD2:  "\u9f52",                                                                         // KANGXI RADICAL TOOTH
+	0x2FD3:  "\u9f8d",                                                                         // KANGXI RADICAL DRAGON
+	0x2FD4:  "\u9f9c",                                                                         // KANGXI RADICAL TURTLE
+	0x2FD5:  "\u9fa0",                                                                         // KANGXI RADICAL FLUTE
+	0x3002:  "\u02f3",                                                                         // IDEOGRAPHIC FULL STOP
+	0x3003:  "''",                                                                             // DITTO MARK
+	0x3007:  "O",                                                                              // IDEOGRAPHIC NUMBER ZERO
+	0x3008:  "\u276c",                                                                         // LEFT ANGLE BRACKET
+	0x3009:  "\u276d",                                                                         // RIGHT ANGLE BRACKET
+	0x3012:  "\u20b8",                                                                         // POSTAL MARK
+	0x3014:  "(",                                                                              // LEFT TORTOISE SHELL BRACKET
+	0x3015:  ")",                                                                              // RIGHT TORTOISE SHELL BRACKET
+	0x301A:  "\u27e6",                                                                         // LEFT WHITE SQUARE BRACKET
+	0x301B:  "\u27e7",                                                                         // RIGHT WHITE SQUARE BRACKET
+	0x302C:  "\u0309",                                                                         // IDEOGRAPHIC DEPARTING TONE MARK
+	0x302D:  "\u0325",                                                                         // IDEOGRAPHIC ENTERING TONE MARK
+	0x3033:  "/",                                                                              // VERTICAL KANA REPEAT MARK UPPER HALF
+	0x3036:  "\u20b8",                                                                         // CIRCLED POSTAL MARK
+	0x3038:  "\u5341",                                                                         // HANGZHOU NUMERAL TEN
+	0x3039:  "\u5344",                                                                         // HANGZHOU NUMERAL TWENTY
+	0x303A:  "\u5345",                                                                         // HANGZHOU NUMERAL THIRTY
+	0x304F:  "\u276c",                                                                         // HIRAGANA LETTER KU
+	0x309A:  "\u030a",                                                                         // COMBINING KATAKANA-HIRAGANA SEMI-VOICED SOUND MARK
+	0x309B:  "\uff9e",                                                                         // KATAKANA-HIRAGANA VOICED SOUND MARK
+	0x309C:  "\uff9f",                                                                         // KATAKANA-HIRAGANA SEMI-VOICED SOUND MARK
+	0x30A0:  "=",                                                                              // KATAKANA-HIRAGANA DOUBLE HYPHEN
+	0x30A4:  "\u4ebb",                                                                         // KATAKANA LETTER I
+	0x30A8:  "\u5de5",                                                                         // KATAKANA LETTER E
+	0x30AB:  "\u529b",                                                                         // KATAKANA LETTER KA
+	0x30BF:  "\u5915",                                                                         // KATAKANA LETTER TA
+	0x30C8:  "\u535c",                                                                         // KATAKANA LETTER TO
+	0x30CB:  "\u4e8c",                                                                         // KATAKANA LETTER NI
+	0x30CE:  "/",                                                                              // KATAKANA LETTER NO
+	0x30CF:  "\u516b",                                                                         // KATAKANA LETTER HA
+	0x30D8:  "\u3078",                                                                         // KATAKANA LETTER HE
+	0x30ED:  "\u53e3",                                                                         // KATAKANA LETTER RO
+	0x30FB:  "\u00b7",                                                                         // KATAKANA MIDDLE DOT
+	0x3131:  "\u1100",                                                                         // HANGUL LETTER KIYEOK
+	0x3132:  "\u1100\u1100",                                                                   // HANGUL LETTER SSANGKIYEOK
+	0x3133:  "\u1100\u1109",                                                                   // HANGUL LETTER KIYEOK-SIOS
+	0x3134:  "\u1102",                                                                         // HANGUL LETTER NIEUN
+	0x3135:  "\u1102\u110c",                                                                   // HANGUL LETTER NIEUN-CIEUC
+	0x3136:  "\u1102\u1112",                                                                   // HANGUL LETTER NIEUN-HIEUH
+	0x3137:  "\u1103",                                                                         // HANGUL LETTER TIKEUT
+	0x3138:  "\u1103\u1103",                                                                   // HANGUL LETTER SSANGTIKEUT
+	0x3139:  "\u1105",                                                                         // HANGUL LETTER RIEUL
+	0x313A:  "\u1105\u1100",                                                                   // HANGUL LETTER RIEUL-KIYEOK
+	0x313B:  "\u1105\u1106",                                                                   // HANGUL LETTER RIEUL-MIEUM
+	0x313C:  "\u1105\u1107",                                                                   // HANGUL LETTER RIEUL-PIEUP
+	0x313D:  "\u1105\u1109",                                                                   // HANGUL LETTER RIEUL-SIOS
+	0x313E:  "\u1105\u1110",                                                                   // HANGUL LETTER RIEUL-THIEUTH
+	0x313F:  "\u1105\u1111",                                                                   // HANGUL LETTER RIEUL-PHIEUPH
+	0x3140:  "\u1105\u1112",                                                                   // HANGUL LETTER RIEUL-HIEUH
+	0x3141:  "\u1106",                                                                         // HANGUL LETTER MIEUM
+	0x3142:  "\u1107",                                                                         // HANGUL LETTER PIEUP
+	0x3143:  "\u1107\u1107",                                                                   // HANGUL LETTER SSANGPIEUP
+	0x3144:  "\u1107\u1109",                                                                   // HANGUL LETTER PIEUP-SIOS
+	0x3145:  "\u1109",                                                                         // HANGUL LETTER SIOS
+	0x3146:  "\u1109\u1109",                                                                   // HANGUL LETTER SSANGSIOS
+	0x3147:  "\u110b",                                                                         // HANGUL LETTER IEUNG
+	0x3148:  "\u110c",                                                                         // HANGUL LETTER CIEUC
+	0x3149:  "\u110c\u110c",                                                                   // HANGUL LETTER SSANGCIEUC
+	0x314A:  "\u110e",                                                                         // HANGUL LETTER CHIEUCH
+	0x314B:  "\u110f",                                                                         // HANGUL LETTER KHIEUKH
+	0x314C:  "\u1110",                                                                         // HANGUL LETTER THIEUTH
+	0x314D:  "\u1111",                                                                         // HANGUL LETTER PHIEUPH
+	0x314E:  "\u1112",                                                                         // HANGUL LETTER HIEUH
+	0x314F:  "\u1161",                                                                         // HANGUL LETTER A
+	0x3150:  "\u1161\u4e28",                                                                   // HANGUL LETTER AE
+	0x3151:  "\u1163",                                                                         // HANGUL LETTER YA
+	0x3152:  "\u1163\u4e28",                                                                   // HANGUL LETTER YAE
+	0x3153:  "\u1165",                                                                         // HANGUL LETTER EO
+	0x3154:  "\u1165\u4e28",                                                                   // HANGUL LETTER E
+	0x3155:  "\u1167",                                                                         // HANGUL LETTER YEO
+	0x3156:  "\u1167\u4e28",                                                                   // HANGUL LETTER YE
+	0x3157:  "\u1169",                                                                         // HANGUL LETTER O
+	0x3158:  "\u1169\u1161",                                                                   // HANGUL LETTER WA
+	0x3159:  "\u1169\u1161\u4e28",                                                             // HANGUL LETTER WAE
+	0x315A:  "\u1169\u4e28",                                                                   // HANGUL LETTER OE
+	0x315B:  "\u116d",                                                                         // HANGUL LETTER YO
+	0x315C:  "\u116e",                                                                         // HANGUL LETTER U
+	0x315D:  "\u116e\u1165",                                                                   // HANGUL LETTER WEO
+	0x315E:  "\u116e\u1165\u4e28",                                                             // HANGUL LETTER WE
+	0x315F:  "\u116e\u4e28",                                                                   // HANGUL LETTER WI
+	0x3160:  "\u1172",                                                                         // HANGUL LETTER YU
+	0x3161:  "\u30fc",                                                                         // HANGUL LETTER EU
+	0x3162:  "\u30fc\u4e28",                                                                   // HANGUL LETTER YI
+	0x3163:  "\u4e28",                                                                         // HANGUL LETTER I
+	0x3164:  "\u1160",                                                                         // HANGUL FILLER
+	0x3165:  "\u1102\u1102",                                                                   // HANGUL LETTER SSANGNIEUN
+	0x3166:  "\u1102\u1103",                                                                   // HANGUL LETTER NIEUN-TIKEUT
+	0x3167:  "\u1102\u1109",                                                                   // HANGUL LETTER NIEUN-SIOS
+	0x3168:  "\u1102\u1140",                                                                   // HANGUL LETTER NIEUN-PANSIOS
+	0x3169:  "\u1105\u1100\u1109",                                                             // HANGUL LETTER RIEUL-KIYEOK-SIOS
+	0x316A:  "\u1105\u1103",                                                                   // HANGUL LETTER RIEUL-TIKEUT
+	0x316B:  "\u1105\u1107\u1109",                                                             // HANGUL LETTER RIEUL-PIEUP-SIOS
+	0x316C:  "\u1105\u1140",                                                                   // HANGUL LETTER RIEUL-PANSIOS
+	0x316D:  "\u1105\u1159",                                                                   // HANGUL LETTER RIEUL-YEORINHIEUH
+	0x316E:  "\u1106\u1107",                                                                   // HANGUL LETTER MIEUM-PIEUP
+	0x316F:  "\u1106\u1109",                                                                   // HANGUL LETTER MIEUM-SIOS
+	0x3170:  "\u1106\u1140",                                                                   // HANGUL LETTER MIEUM-PANSIOS
+	0x3171:  "\u1106\u110b",                                                                   // HANGUL LETTER KAPYEOUNMIEUM
+	0x3172:  "\u1107\u1100",                                                                   // HANGUL LETTER PIEUP-KIYEOK
+	0x3173:  "\u1107\u1103",                                                                   // HANGUL LETTER PIEUP-TIKEUT
+	0x3174:  "\u1107\u1109\u1100",                                                             // HANGUL LETTER PIEUP-SIOS-KIYEOK
+	0x3175:  "\u1107\u1109\u1103",                                                             // HANGUL LETTER PIEUP-SIOS-TIKEUT
+	0x3176:  "\u1107\u110c",                                                                   // HANGUL LETTER PIEUP-CIEUC
+	0x3177:  "\u1107\u1110",                                                                   // HANGUL LETTER PIEUP-THIEUTH
+	0x3178:  "\u1107\u110b",                                                                   // HANGUL LETTER KAPYEOUNPIEUP
+	0x3179:  "\u1107\u1107\u110b",                                                             // HANGUL LETTER KAPYEOUNSSANGPIEUP
+	0x317A:  "\u1109\u1100",                                                                   // HANGUL LETTER SIOS-KIYEOK
+	0x317B:  "\u1109\u1102",                                                                   // HANGUL LETTER SIOS-NIEUN
+	0x317C:  "\u1109\u1103",                                                                   // HANGUL LETTER SIOS-TIKEUT
+	0x317D:  "\u1109\u1107",                                                                   // HANGUL LETTER SIOS-PIEUP
+	0x317E:  "\u1109\u110c",                                                                   // HANGUL LETTER SIOS-CIEUC
+	0x317F:  "\u1140",                                                                         // HANGUL LETTER PANSIOS
+	0x3180:  "\u110b\u110b",                                                                   // HANGUL LETTER SSANGIEUNG
+	0x3181:  "\u114c",                                                                         // HANGUL LETTER YESIEUNG
+	0x3182:  "\u110b\u1109",                                                                   // HANGUL LETTER YESIEUNG-SIOS
+	0x3183:  "\u110b\u1140",                                                                   // HANGUL LETTER YESIEUNG-PANSIOS
+	0x3184:  "\u1111\u110b",                                                                   // HANGUL LETTER KAPYEOUNPHIEUPH
+	0x3185:  "\u1112\u1112",                                                                   // HANGUL LETTER SSANGHIEUH
+	0x3186:  "\u1159",                                                                         // HANGUL LETTER YEORINHIEUH
+	0x3187:  "\u116d\u1163",                                                                   // HANGUL LETTER YO-YA
+	0x3188:  "\u116d\u1163\u4e28",                                                             // HANGUL LETTER YO-YAE
+	0x3189:  "\u116d\u4e28",                                                                   // HANGUL LETTER YO-I
+	0x318A:  "\u1172\u1167",                                                                   // HANGUL LETTER YU-YEO
+	0x318B:  "\u1172\u1167\u4e28",                                                             // HANGUL LETTER YU-YE
+	0x318C:  "\u1172\u4e28",                                                                   // HANGUL LETTER YU-I
+	0x318D:  "\u119e",                                                                         // HANGUL LETTER ARAEA
+	0x318E:  "\u119e\u4e28",                                                                   // HANGUL LETTER ARAEAE
+	0x31D0:  "\u30fc",                                                                         // CJK STROKE H
+	0x31D1:  "\u4e28",                                                                         // CJK STROKE S
+	0x31D3:  "/",                                                                              // CJK STROKE SP
+	0x31D4:  "\\",                                                                             // CJK STROKE D
+	0x31D6:  "\u4e5b",                                                                         // CJK STROKE HG
+	0x31DA:  "\u4e85",                                                                         // CJK STROKE SG
+	0x31DB:  "\u276c",                                                                         // CJK STROKE PD
+	0x31DF:  "\u4e5a",                                                                         // CJK STROKE SWG
+	0x31E0:  "\u4e59",                                                                         // CJK STROKE HXWG
+	0x3200:  "(\u1100)",                                                                       // PARENTHESIZED HANGUL KIYEOK
+	0x3201:  "(\u1102)",                                                                       // PARENTHESIZED HANGUL NIEUN
+	0x3202:  "(\u1103)",                                                                       // PARENTHESIZED HANGUL TIKEUT
+	0x3203:  "(\u1105)",                                                                       // PARENTHESIZED HANGUL RIEUL
+	0x3204:  "(\u1106)",                                                                       // PARENTHESIZED HANGUL MIEUM
+	0x3205:  "(\u1107)",                                                                       // PARENTHESIZED HANGUL PIEUP
+	0x3206:  "(\u1109)",                                                                       // PARENTHESIZED HANGUL SIOS
+	0x3207:  "(\u110b)",                                                                       // PARENTHESIZED HANGUL IEUNG
+	0x3208:  "(\u110c)",                                                                       // PARENTHESIZED HANGUL CIEUC
+	0x3209:  "(\u110e)",                                                                       // PARENTHESIZED HANGUL CHIEUCH
+	0x320A:  "(\u110f)",                                                                       // PARENTHESIZED HANGUL KHIEUKH
+	0x320B:  "(\u1110)",                                                                       // PARENTHESIZED HANGUL THIEUTH
+	0x320C:  "(\u1111)",                                                                       // PARENTHESIZED HANGUL PHIEUPH
+	0x320D:  "(\u1112)",                                                                       // PARENTHESIZED HANGUL HIEUH
+	0x320E:  "(\uac00)",                                                                       // PARENTHESIZED HANGUL KIYEOK A
+	0x320F:  "(\ub098)",                                                                       // PARENTHESIZED HANGUL NIEUN A
+	0x3210:  "(\ub2e4)",                                                                       // PARENTHESIZED HANGUL TIKEUT A
+	0x3211:  "(\ub77c)",                                                                       // PARENTHESIZED HANGUL RIEUL A
+	0x3212:  "(\ub9c8)",                                                                       // PARENTHESIZED HANGUL MIEUM A
+	0x3213:  "(\ubc14)",                                                                       // PARENTHESIZED HANGUL PIEUP A
+	0x3214:  "(\uc0ac)",                                                                       // PARENTHESIZED HANGUL SIOS A
+	0x3215:  "(\uc544)",                                                                       // PARENTHESIZED HANGUL IEUNG A
+	0x3216:  "(\uc790)",                                                                       // PARENTHESIZED HANGUL CIEUC A
+	0x3217:  "(\ucc28)",                                                                       // PARENTHESIZED HANGUL CHIEUCH A
+	0x3218:  "(\uce74)",                                                                       // PARENTHESIZED HANGUL KHIEUKH A
+	0x3219:  "(\ud0c0)",                                                                       // PARENTHESIZED HANGUL THIEUTH A
+	0x321A:  "(\ud30c)",                                                                       // PARENTHESIZED HANGUL PHIEUPH A
+	0x321B:  "(\ud558)",                                                                       // PARENTHESIZED HANGUL HIEUH A
+	0x321C:  "(\uc8fc)",                                                                       // PARENTHESIZED HANGUL CIEUC U
+	0x321D:  "(\uc624\uc804)",                                                                 // PARENTHESIZED KOREAN CHARACTER OJEON
+	0x321E:  "(\uc624\ud6c4)",                                                                 // PARENTHESIZED KOREAN CHARACTER O HU
+	0x3220:  "(\u30fc)",                                                                       // PARENTHESIZED IDEOGRAPH ONE
+	0x3221:  "(\u4e8c)",                                                                       // PARENTHESIZED IDEOGRAPH TWO
+	0x3222:  "(\u4e09)",                                                                       // PARENTHESIZED IDEOGRAPH THREE
+	0x3223:  "(\u56db)",                                                                       // PARENTHESIZED IDEOGRAPH FOUR
+	0x3224:  "(\u4e94)",                                                                       // PARENTHESIZED IDEOGRAPH FIVE
+	0x3225:  "(\u516d)",                                                                       // PARENTHESIZED IDEOGRAPH SIX
+	0x3226:  "(\u4e03)",                                                                       // PARENTHESIZED IDEOGRAPH SEVEN
+	0x3227:  "(\u516b)",                                                                       // PARENTHESIZED IDEOGRAPH EIGHT
+	0x3228:  "(\u4e5d)",                                                                       // PARENTHESIZED IDEOGRAPH NINE
+	0x3229:  "(\u5341)",                                                                       // PARENTHESIZED IDEOGRAPH TEN
+	0x322A:  "(\u6708)",                                                                       // PARENTHESIZED IDEOGRAPH MOON
+	0x322B:  "(\u706b)",                                                                       // PARENTHESIZED IDEOGRAPH FIRE
+	0x322C:  "(\u6c34)",                                                                       // PARENTHESIZED IDEOGRAPH WATER
+	0x322D:  "(\u6728)",                                                                       // PARENTHESIZED IDEOGRAPH WOOD
+	0x322E:  "(\u91d1)",                                                                       // PARENTHESIZED IDEOGRAPH METAL
+	0x322F:  "(\u571f)",                                                                       // PARENTHESIZED IDEOGRAPH EARTH
+	0x3230:  "(\u65e5)",                                                                       // PARENTHESIZED IDEOGRAPH SUN
+	0x3231:  "(\u682a)",                                                                       // PARENTHESIZED IDEOGRAPH STOCK
+	0x3232:  "(\u6709)",                                                                       // PARENTHESIZED IDEOGRAPH HAVE
+	0x3233:  "(\u793e)",                                                                       // PARENTHESIZED IDEOGRAPH SOCIETY
+	0x3234:  "(\u540d)",                                                                       // PARENTHESIZED IDEOGRAPH NAME
+	0x3235:  "(\u7279)",                                                                       // PARENTHESIZED IDEOGRAPH SPECIAL
+	0x3236:  "(\u8ca1)",                                                                       // PARENTHESIZED IDEOGRAPH FINANCIAL
+	0x3237:  "(\u795d)",                                                                       // PARENTHESIZED IDEOGRAPH CONGRATULATION
+	0x3238:  "(\u52b4)",                                                                       // PARENTHESIZED IDEOGRAPH LABOR
+	0x3239:  "(\u4ee3)",                                                                       // PARENTHESIZED IDEOGRAPH REPRESENT
+	0x323A:  "(\u547c)",                                                                       // PARENTHESIZED IDEOGRAPH CALL
+	0x323B:  "(\u5b66)",                                                                       // PARENTHESIZED IDEOGRAPH STUDY
+	0x323C:  "(\u76e3)",                                                                       // PARENTHESIZED IDEOGRAPH SUPERVISE
+	0x323D:  "(\u4f01)",                                                                       // PARENTHESIZED IDEOGRAPH ENTERPRISE
+	0x323E:  "(\u8cc7)",                                                                       // PARENTHESIZED IDEOGRAPH RESOURCE
+	0x323F:  "(\u5354)",                                                                       // PARENTHESIZED IDEOGRAPH ALLIANCE
+	0x3240:  "(\u796d)",                                                                       // PARENTHESIZED IDEOGRAPH FESTIVAL
+	0x3241:  "(\u4f11)",                                                                       // PARENTHESIZED IDEOGRAPH REST
+	0x3242:  "(\u81ea)",                                                                       // PARENTHESIZED IDEOGRAPH SELF
+	0x3243:  "(\u81f3)",                                                                       // PARENTHESIZED IDEOGRAPH REACH
+	0x32C0:  "l\u6708",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR JANUARY
+	0x32C1:  "2\u6708",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR FEBRUARY
+	0x32C2:  "3\u6708",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR MARCH
+	0x32C3:  "4\u6708",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR APRIL
+	0x32C4:  "5\u6708",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR MAY
+	0x32C5:  "6\u6708",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR JUNE
+	0x32C6:  "7\u6708",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR JULY
+	0x32C7:  "8\u6708",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR AUGUST
+	0x32C8:  "9\u6708",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR SEPTEMBER
+	0x32C9:  "lO\u6708",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR OCTOBER
+	0x32CA:  "ll\u6708",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR NOVEMBER
+	0x32CB:  "l2\u6708",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DECEMBER
+	0x3358:  "O\u70b9",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR ZERO
+	0x3359:  "l\u70b9",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR ONE
+	0x335A:  "2\u70b9",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWO
+	0x335B:  "3\u70b9",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR THREE
+	0x335C:  "4\u70b9",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR FOUR
+	0x335D:  "5\u70b9",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR FIVE
+	0x335E:  "6\u70b9",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR SIX
+	0x335F:  "7\u70b9",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR SEVEN
+	0x3360:  "8\u70b9",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR EIGHT
+	0x3361:  "9\u70b9",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR NINE
+	0x3362:  "lO\u70b9",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TEN
+	0x3363:  "ll\u70b9",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR ELEVEN
+	0x3364:  "l2\u70b9",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWELVE
+	0x3365:  "l3\u70b9",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR THIRTEEN
+	0x3366:  "l4\u70b9",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR FOURTEEN
+	0x3367:  "l5\u70b9",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR FIFTEEN
+	0x3368:  "l6\u70b9",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR SIXTEEN
+	0x3369:  "l7\u70b9",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR SEVENTEEN
+	0x336A:  "l8\u70b9",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR EIGHTEEN
+	0x336B:  "l9\u70b9",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR NINETEEN
+	0x336C:  "2O\u70b9",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWENTY
+	0x336D:  "2l\u70b9",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWENTY-ONE
+	0x336E:  "22\u70b9",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWENTY-TWO
+	0x336F:  "23\u70b9",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWENTY-THREE
+	0x3370:  "24\u70b9",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWENTY-FOUR
+	0x33E0:  "l\u65e5",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY ONE
+	0x33E1:  "2\u65e5",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWO
+	0x33E2:  "3\u65e5",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY THREE
+	0x33E3:  "4\u65e5",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY FOUR
+	0x33E4:  "5\u65e5",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY FIVE
+	0x33E5:  "6\u65e5",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY SIX
+	0x33E6:  "7\u65e5",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY SEVEN
+	0x33E7:  "8\u65e5",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY EIGHT
+	0x33E8:  "9\u65e5",                                                                        // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY NINE
+	0x33E9:  "lO\u65e5",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TEN
+	0x33EA:  "ll\u65e5",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY ELEVEN
+	0x33EB:  "l2\u65e5",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWELVE
+	0x33EC:  "l3\u65e5",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY THIRTEEN
+	0x33ED:  "l4\u65e5",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY FOURTEEN
+	0x33EE:  "l5\u65e5",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY FIFTEEN
+	0x33EF:  "l6\u65e5",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY SIXTEEN
+	0x33F0:  "l7\u65e5",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY SEVENTEEN
+	0x33F1:  "l8\u65e5",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY EIGHTEEN
+	0x33F2:  "l9\u65e5",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY NINETEEN
+	0x33F3:  "2O\u65e5",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY
+	0x33F4:  "2l\u65e5",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-ONE
+	0x33F5:  "22\u65e5",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-TWO
+	0x33F6:  "23\u65e5",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-THREE
+	0x33F7:  "24\u65e5",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-FOUR
+	0x33F8:  "25\u65e5",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-FIVE
+	0x33F9:  "26\u65e5",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-SIX
+	0x33FA:  "27\u65e5",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-SEVEN
+	0x33FB:  "28\u65e5",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-EIGHT
+	0x33FC:  "29\u65e5",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-NINE
+	0x33FD:  "3O\u65e5",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY THIRTY
+	0x33FE:  "3l\u65e5",                                                                       // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY THIRTY-ONE
+	0x39B3:  "\u363d",                                                                         // <CJK Ideograph Extension A>
+	0x439B:  "\u3588",                                                                         // <CJK Ideograph Extension A>
+	0x4420:  "\u3b3b",                                                                         // <CJK Ideograph Extension A>
+	0x4E00:  "\u30fc",                                                                         // <CJK Ideograph>
+	0x4E36:  "\\",                                                                             // <CJK Ideograph>
+	0x4E3F:  "/",                                                                              // <CJK Ideograph>
+	0x5002:  "\u4f75",                                                                         // <CJK Ideograph>
+	0x503C:  "\u5024",                                                                         // <CJK Ideograph>
+	0x555F:  "\u5553",                                                                         // <CJK Ideograph>
+	0x56D7:  "\u53e3",                                                                         // <CJK Ideograph>
+	0x586B:  "\u5861",                                                                         // <CJK Ideograph>
+	0x58EB:  "\u571f",                                                                         // <CJK Ideograph>
+	0x58FF:  "\u58ab",                                                                         // <CJK Ideograph>
+	0x5B00:  "\u5aaf",                                                                         // <CJK Ideograph>
+	0x5E32:  "\u5e21",                                                                         // <CJK Ideograph>
+	0x5E50:  "\u3b3a",                                                                         // <CJK Ideograph>
+	0x6238:  "\u6236",                                                                         // <CJK Ideograph>
+	0x6409:  "\u3a41",                                                                         // <CJK Ideograph>
+	0x6663:  "\u403f",                                                                         // <CJK Ideograph>
+	0x6669:  "\u665a",                                                                         // <CJK Ideograph>
+	0x66F6:  "\u3ada",                                                                         // <CJK Ideograph>
+	0x6726:  "\u4443",                                                                         // <CJK Ideograph>
+	0x67FF:  "\u676e",                                                                         // <CJK Ideograph>
+	0x69E9:  "\u3ba3",                                                                         // <CJK Ideograph>
+	0x6A27:  "\u699d",                                                                         // <CJK Ideograph>
+	0x6F59:  "\u6e88",                                                                         // <CJK Ideograph>
+	0x784F:  "\u7814",                                                                         // <CJK Ideograph>
+	0x7D76:  "\u7d55",                                                                         // <CJK Ideograph>
+	0x80A6:  "\u670c",                                                                         // <CJK Ideograph>
+	0x80CA:  "\u6710",                                                                         // <CJK Ideograph>
+	0x80D0:  "\u670f",                                                                         // <CJK Ideograph>
+	0x80F6:  "\u3b35",                                                                         // <CJK Ideograph>
+	0x8101:  "\u6713",                                                                         // <CJK Ideograph>
+	0x8127:  "\u6718",                                                                         // <CJK Ideograph>
+	0x8141:  "\u80fc",                                                                         // <CJK Ideograph>
+	0x81A7:  "\u6723",                                                                         // <CJK Ideograph>
+	0x853F:  "\u848d",                                                                         // <CJK Ideograph>
+	0x8641:  "\u8637",                                                                         // <CJK Ideograph>
+	0x8A1E:  "\u46b6",                                                                         // <CJK Ideograph>
+	0x8A7D:  "\u8a2e",                                                                         // <CJK Ideograph>
+	0x8B8F:  "\u8b86",                                                                         // <CJK Ideograph>
+	0x8C63:  "\u8c5c",                                                                         // <CJK Ideograph>
+	0x8D86:  "\u8d7f",                                                                         // <CJK Ideograph>
+	0x8DFA:  "\u8de5",                                                                         // <CJK Ideograph>
+	0x8E9B:  "\u8e97",                                                                         // <CJK Ideograph>
+	0x8F27:  "\u8eff",                                                                         // <CJK Ideograph>
+	0x90DE:  "\u90ce",                                                                         // <CJK Ideograph>
+	0x93AE:  "\u93ad",                                                                         // <CJK Ideograph>
+	0x96B8:  "\u96b7",                                                                         // <CJK Ideograph>
+	0x9E43:  "\u9e42",                                                                         // <CJK Ideograph>
+	0x9ED2:  "\u9ed1",                                                                         // <CJK Ideograph>
+	0x9FC3:  "\u4039",                                                                         // <CJK Ideograph>
+	0xA494:  "\ua2cd",                                                                         // YI RADICAL CYP
+	0xA49C:  "\ua0c0",                                                                         // YI RADICAL MOP
+	0xA49E:  "\ua04a",                                                                         // YI RADICAL PUT
+	0xA4A7:  "\ua458",                                                                         // YI RADICAL NYOP
+	0xA4A8:  "\ua132",                                                                         // YI RADICAL TU
+	0xA4AC:  "\ua050",                                                                         // YI RADICAL PYT
+	0xA4B0:  "\ua3c2",                                                                         // YI RADICAL SHY
+	0xA4BA:  "\ua3bf",                                                                         // YI RADICAL SHUR
+	0xA4BE:  "\ua2b1",                                                                         // YI RADICAL CIP
+	0xA4BF:  "\ua259",                                                                         // YI RADICAL HXOP
+	0xA4C0:  "\ua3ab",                                                                         // YI RADICAL SHAT
+	0xA4C2:  "\ua3b5",                                                                         // YI RADICAL SHOP
+	0xA4D0:  "B",                                                                              // LISU LETTER BA
+	0xA4D1:  "P",                                                                              // LISU LETTER PA
+	0xA4D2:  "d",                                                                              // LISU LETTER PHA
+	0xA4D3:  "D",                                                                              // LISU LETTER DA
+	0xA4D4:  "T",                                                                              // LISU LETTER TA
+	0xA4D6:  "G",                                                                              // LISU LETTER GA
+	0xA4D7:  "K",                                                                              // LISU LETTER KA
+	0xA4D9:  "J",                                                                              // LISU LETTER JA
+	0xA4DA:  "C",                                                                              // LISU LETTER CA
+	0xA4DB:  "\u0186",                                                                         // LISU LETTER CHA
+	0xA4DC:  "Z",                                                                              // LISU LETTER DZA
+	0xA4DD:  "F",                                                                              // LISU LETTER TSA
+	0xA4DE:  "\u2132",                                                                         // LISU LETTER TSHA
+	0xA4DF:  "M",                                                                              // LISU LETTER MA
+	0xA4E0:  "N",                                                                              // LISU LETTER NA
+	0xA4E1:  "L",                                                                              // LISU LETTER LA
+	0xA4E2:  "S",                                                                              // LISU LETTER SA
+	0xA4E3:  "R",                                                                              // LISU LETTER ZHA
+	0xA4E5:  "\u0245",                                                                         // LISU LETTER NGA
+	0xA4E6:  "V",                                                                              // LISU LETTER HA
+	0xA4E7:  "H",                                                                              // LISU LETTER XA
+	0xA4EA:  "W",                                                                              // LISU LETTER WA
+	0xA4EB:  "X",                                                                              // LISU LETTER SHA
+	0xA4EC:  "Y",                                                                              // LISU LETTER YA
+	0xA4ED:  "\u1660",                                                                         // LISU LETTER GHA
+	0xA4EE:  "A",                                                                              // LISU LETTER A
+	0xA4EF:  "\u2c6f",                                                                         // LISU LETTER AE
+	0xA4F0:  "E",                                                                              // LISU LETTER E
+	0xA4F1:  "\u018e",                                                                         // LISU LETTER EU
+	0xA4F2:  "l",                                                                              // LISU LETTER I
+	0xA4F3:  "O",                                                                              // LISU LETTER O
+	0xA4F4:  "U",                                                                              // LISU LETTER U
+	0xA4F5:  "\u0548",                                                                         // LISU LETTER UE
+	0xA4F7:  "\u15e1",                                                                         // LISU LETTER OE
+	0xA4F8:  ".",                                                                              // LISU LETTER TONE MYA TI
+	0xA4F9:  ",",                                                                              // LISU LETTER TONE NA PO
+	0xA4FA:  "..",                                                                             // LISU LETTER TONE MYA CYA
+	0xA4FB:  ".,",                                                                             // LISU LETTER TONE MYA BO
+	0xA4FD:  ":",                                                                              // LISU LETTER TONE MYA JEU
+	0xA4FE:  "-.",                                                                             // LISU PUNCTUATION COMMA
+	0xA4FF:  "=",                                                                              // LISU PUNCTUATION FULL STOP
+	0xA60E:  ".",                                                                              // VAI FULL STOP
+	0xA644:  "2",                                                                              // CYRILLIC CAPITAL LETTER REVERSED DZE
+	0xA645:  "\u01a8",                                                                         // CYRILLIC SMALL LETTER REVERSED DZE
+	0xA647:  "i",                                                                              // CYRILLIC SMALL LETTER IOTA
+	0xA64D:  "\u03c9",                                                                         // CYRILLIC SMALL LETTER BROAD OMEGA
+	0xA650:  "\u042al",                                                                        // CYRILLIC CAPITAL LETTER YERU WITH BACK YER
+	0xA651:  "\u02c9bi",                                                                       // CYRILLIC SMALL LETTER YERU WITH BACK YER
+	0xA668:  "\u0298",                                                                         // CYRILLIC CAPITAL LETTER MONOCULAR O
+	0xA66F:  "\u20e9",                                                                         // COMBINING CYRILLIC VZMET
+	0xA67C:  "\u0306",                                                                         // COMBINING CYRILLIC KAVYKA
+	0xA67E:  "\u02c7",                                                                         // CYRILLIC KAVYKA
+	0xA695:  "h\u0314",                                                                        // CYRILLIC SMALL LETTER HWE
+	0xA698:  "OO",                                                                             // CYRILLIC CAPITAL LETTER DOUBLE O
+	0xA699:  "oo",                                                                             // CYRILLIC SMALL LETTER DOUBLE O
+	0xA69A:  "\U000102a8",                                                                     // CYRILLIC CAPITAL LETTER CROSSED O
+	0xA6A1:  "\u0418",                                                                         // BAMUM LETTER KA
+	0xA6B0:  "\u16b9",                                                                         // BAMUM LETTER TAA
+	0xA6B1:  "\u2c75",                                                                         // BAMUM LETTER NDAA
+	0xA6CD:  "\u02a1",                                                                         // BAMUM LETTER LU
+	0xA6CE:  "\u0245",                                                                         // BAMUM LETTER MI
+	0xA6DB:  "\u03a0",                                                                         // BAMUM LETTER NA
+	0xA6DF:  "V",                                                                              // BAMUM LETTER KO
+	0xA6EB:  "?",                                                                              // BAMUM LETTER NTUU
+	0xA6EF:  "2",                                                                              // BAMUM LETTER KOGHOM
+	0xA6F0:  "\u0302",                                                                         // BAMUM COMBINING MARK KOQNDON
+	0xA6F1:  "\u0304",                                                                         // BAMUM COMBINING MARK TUKWENTIS
+	0xA6F4:  "\ua6f3\ua6f3",                                                                   // BAMUM COLON
+	0xA714:  "\u02eb",                                                                         // MODIFIER LETTER MID LEFT-STEM TONE BAR
+	0xA716:  "\u02ea",                                                                         // MODIFIER LETTER EXTRA-LOW LEFT-STEM TONE BAR
+	0xA728:  "T3",                                                                             // LATIN CAPITAL LETTER TZ
+	0xA729:  "t\u021d",                                                                        // LATIN SMALL LETTER TZ
+	0xA731:  "s",                                                                              // LATIN LETTER SMALL CAPITAL S
+	0xA732:  "AA",                                                                             // LATIN CAPITAL LETTER AA
+	0xA733:  "aa",                                                                             // LATIN SMALL LETTER AA
+	0xA734:  "AO",                                                                             // LATIN CAPITAL LETTER AO
+	0xA735:  "ao",                                                                             // LATIN SMALL LETTER AO
+	0xA736:  "AU",                                                                             // LATIN CAPITAL LETTER AU
+	0xA737:  "au",                                                                             // LATIN SMALL LETTER AU
+	0xA738:  "AV",                                                                             // LATIN CAPITAL LETTER AV
+	0xA739:  "av",                                                                             // LATIN SMALL LETTER AV
+	0xA73A:  "AV",                                                                             // LATIN CAPITAL LETTER AV WITH HORIZONTAL BAR
+	0xA73B:  "av",                                                                             // LATIN SMALL LETTER AV WITH HORIZONTAL BAR
+	0xA73C:  "AY",                                                                             // LATIN CAPITAL LETTER AY
+	0xA73D:  "ay",                                                                             // LATIN SMALL LETTER AY
+	0xA740:  "K\u0335",                                                                        // LATIN CAPITAL LETTER K WITH STROKE
+	0xA74A:  "O\u0335",                                                                        // LATIN CAPITAL LETTER O WITH LONG STROKE OVERLAY
+	0xA74B:  "o\u0335",                                                                        // LATIN SMALL LETTER O WITH LONG STROKE OVERLAY
+	0xA74E:  "OO",                                                                             // LATIN CAPITAL LETTER OO
+	0xA74F:  "oo",                                                                             // LATIN SMALL LETTER OO
+	0xA75A:  "2",                                                                              // LATIN CAPITAL LETTER R ROTUNDA
+	0xA761:  "w\u0326",                                                                        // LATIN SMALL LETTER VY
+	0xA76A:  "3",                                                                              // LATIN CAPITAL LETTER ET
+	0xA76B:  "\u021d",                                                                         // LATIN SMALL LETTER ET
+	0xA76E:  "9",                                                                              // LATIN CAPITAL LETTER CON
+	0xA777:  "tf",                                                                             // LATIN SMALL LETTER TUM
+	0xA778:  "&",                                                                              // LATIN SMALL LETTER UM
+	0xA77A:  "\ua779",                                                                         // LATIN SMALL LETTER INSULAR D
+	0xA789:  ":",                                                                              // MODIFIER LETTER COLON
+	0xA78C:  "'",                                                                              // LATIN SMALL LETTER SALTILLO
+	0xA78F:  "\u00b7",                                                                         // LATIN LETTER SINOLOGICAL DOT
+	0xA795:  "\ua727",                                                                         // LATIN SMALL LETTER H WITH PALATAL HOOK
+	0xA798:  "F",                                                                              // LATIN CAPITAL LETTER F WITH STROKE
+	0xA799:  "f",                                                                              // LATIN SMALL LETTER F WITH STROKE
+	0xA79A:  "\U00010412",                                                                     // LATIN CAPITAL LETTER VOLAPUK AE
+	0xA79B:  "\U0001043a",                                                                     // LATIN SMALL LETTER VOLAPUK AE
+	0xA79D:  "\u029a",                                                                         // LATIN SMALL LETTER VOLAPUK OE
+	0xA79E:  "\ua4e4",                                                                         // LATIN CAPITAL LETTER VOLAPUK UE
+	0xA79F:  "u",                                                                              // LATIN SMALL LETTER VOLAPUK UE
+	0xA7AB:  "3",                                                                              // LATIN CAPITAL LETTER REVERSED OPEN E
+	0xA7B1:  "\ua4d5",                                                                         // LATIN CAPITAL LETTER TURNED T
+	0xA7B2:  "J",                                                                              // LATIN CAPITAL LETTER J WITH CROSSED-TAIL
+	0xA7B3:  "X",                                                                              // LATIN CAPITAL LETTER CHI
+	0xA7B4:  "B",                                                                              // LATIN CAPITAL LETTER BETA
+	0xA7B5:  "\u00df",                                                                         // LATIN SMALL LETTER BETA
+	0xA7B6:  "\ua64c",                                                                         // LATIN CAPITAL LETTER OMEGA
+	0xA7B7:  "\u03c9",                                                                         // LATIN SMALL LETTER OMEGA
+	0xA7F7:  "\u30fc",                                                                         // LATIN EPIGRAPHIC LETTER SIDEWAYS I
+	0xA830:  "\u0964",                                                                         // NORTH INDIC FRACTION ONE QUARTER
+	0xA960:  "\u1103\u1106",                                                                   // HANGUL CHOSEONG TIKEUT-MIEUM
+	0xA961:  "\u1103\u1107",                                                                   // HANGUL CHOSEONG TIKEUT-PIEUP
+	0xA962:  "\u1103\u1109",                                                                   // HANGUL CHOSEONG TIKEUT-SIOS
+	0xA963:  "\u1103\u110c",                                                                   // HANGUL CHOSEONG TIKEUT-CIEUC
+	0xA964:  "\u1105\u1100",                                                                   // HANGUL CHOSEONG RIEUL-KIYEOK
+	0xA965:  "\u1105\u1100\u1100",                                                             // HANGUL CHOSEONG RIEUL-SSANGKIYEOK
+	0xA966:  "\u1105\u1103",                                                                   // HANGUL CHOSEONG RIEUL-TIKEUT
+	0xA967:  "\u1105\u1103\u1103",                                                             // HANGUL CHOSEONG RIEUL-SSANGTIKEUT
+	0xA968:  "\u1105\u1106",                                                                   // HANGUL CHOSEONG RIEUL-MIEUM
+	0xA969:  "\u1105\u1107",                                                                   // HANGUL CHOSEONG RIEUL-PIEUP
+	0xA96A:  "\u1105\u1107\u1107",                                                             // HANGUL CHOSEONG RIEUL-SSANGPIEUP
+	0xA96B:  "\u1105\u1107\u110b",                                                             // HANGUL CHOSEONG RIEUL-KAPYEOUNPIEUP
+	0xA96C:  "\u1105\u1109",                                                                   // HANGUL CHOSEONG RIEUL-SIOS
+	0xA96D:  "\u1105\u110c",                                                                   // HANGUL CHOSEONG RIEUL-CIEUC
+	0xA96E:  "\u1105\u110f",                                                                   // HANGUL CHOSEONG RIEUL-KHIEUKH
+	0xA96F:  "\u1106\u1100",                                                                   // HANGUL CHOSEONG MIEUM-KIYEOK
+	0xA970:  "\u1106\u1103",                                                                   // HANGUL CHOSEONG MIEUM-TIKEUT
+	0xA971:  "\u1106\u1109",                                                                   // HANGUL CHOSEONG MIEUM-SIOS
+	0xA972:  "\u1107\u1109\u1110",                                                             // HANGUL CHOSEONG PIEUP-SIOS-THIEUTH
+	0xA973:  "\u1107\u110f",                                                                   // HANGUL CHOSEONG PIEUP-KHIEUKH
+	0xA974:  "\u1107\u1112",                                                                   // HANGUL CHOSEONG PIEUP-HIEUH
+	0xA975:  "\u1109\u1109\u1107",                                                             // HANGUL CHOSEONG SSANGSIOS-PIEUP
+	0xA976:  "\u110b\u1105",                                                                   // HANGUL CHOSEONG IEUNG-RIEUL
+	0xA977:  "\u110b\u1112",                                                                   // HANGUL CHOSEONG IEUNG-HIEUH
+	0xA978:  "\u110c\u110c\u1112",                                                             // HANGUL CHOSEONG SSANGCIEUC-HIEUH
+	0xA979:  "\u1110\u1110",                                                                   // HANGUL CHOSEONG SSANGTHIEUTH
+	0xA97A:  "\u1111\u1112",                                                                   // HANGUL CHOSEONG PHIEUPH-HIEUH
+	0xA97B:  "\u1112\u1109",                                                                   // HANGUL CHOSEONG HIEUH-SIOS
+	0xA97C:  "\u1159\u1159",                                                                   // HANGUL CHOSEONG SSANGYEORINHIEUH
+	0xA992:  "\u2c3f",                                                                         // JAVANESE LETTER GA
+	0xA9A3:  "\ua99d",                                                                         // JAVANESE LETTER DA MAHAPRANA
+	0xA9C6:  "\ua9d0",                                                                         // JAVANESE PADA WINDU
+	0xA9CF:  "\u0662",                                                                         // JAVANESE PANGRANGKEP
+	0xAA53:  "\uaa01",                                                                         // CHAM DIGIT THREE
+	0xAA56:  "\uaa23",                                                                         // CHAM DIGIT SIX
+	0xAB32:  "e",                                                                              // LATIN SMALL LETTER BLACKLETTER E
+	0xAB35:  "f",                                                                              // LATIN SMALL LETTER LENIS F
+	0xAB3D:  "o",                                                                              // LATIN SMALL LETTER BLACKLETTER O
+	0xAB3E:  "o\u0338",                                                                        // LATIN SMALL LETTER BLACKLETTER O WITH STROKE
+	0xAB3F:  "\u0254\u0338",                                                                   // LATIN SMALL LETTER OPEN O WITH STROKE
+	0xAB41:  "\u01ddo\u0338",                                                                  // LATIN SMALL LETTER TURNED OE WITH STROKE
+	0xAB42:  "\u01ddo\u0335",                                                                  // LATIN SMALL LETTER TURNED OE WITH HORIZONTAL STROKE
+	0xAB47:  "r",                                                                              // LATIN SMALL LETTER R WITHOUT HANDLE
+	0xAB48:  "r",                                                                              // LATIN SMALL LETTER DOUBLE R
+	0xAB4D:  "\u0283",                                                                         // LATIN SMALL LETTER BASELINE ESH
+	0xAB4E:  "u",                                                                              // LATIN SMALL LETTER U WITH SHORT RIGHT LEG
+	0xAB52:  "u",                                                                              // LATIN SMALL LETTER U WITH LEFT HOOK
+	0xAB53:  "\u03c7",                                                                         // LATIN SMALL LETTER CHI
+	0xAB55:  "\u03c7",                                                                         // LATIN SMALL LETTER CHI WITH LOW LEFT SERIF
+	0xAB5A:  "y",                                                                              // LATIN SMALL LETTER Y WITH SHORT RIGHT LEG
+	0xAB60:  "\u0459",                                                                         // LATIN SMALL LETTER SAKHA YAT
+	0xAB62:  "\u0254e",                                                                        // LATIN SMALL LETTER OPEN OE
+	0xAB63:  "uo",                                                                             // LATIN SMALL LETTER UO
+	0xAB70:  "\u1d05",                                                                         // CHEROKEE SMALL LETTER A
+	0xAB71:  "\u0280",                                                                         // CHEROKEE SMALL LETTER E
+	0xAB72:  "\u1d1b",                                                                         // CHEROKEE SMALL LETTER I
+	0xAB74:  "o\u031b",                                                                        // CHEROKEE SMALL LETTER U
+	0xAB75:  "i",                                                                              // CHEROKEE SMALL LETTER V
+	0xAB7A:  "\u1d00",                                                                         // CHEROKEE SMALL LETTER GO
+	0xAB7B:  "\u1d0a",                                                                         // CHEROKEE SMALL LETTER GU
+	0xAB7C:  "\u1d07",                                                                         // CHEROKEE SMALL LETTER GV
+	0xAB7E:  "\u0242",                                                                         // CHEROKEE SMALL LETTER HE
+	0xAB80:  "\u2c76",                                                                         // CHEROKEE SMALL LETTER HO
+	0xAB81:  "r",                                                                              // CHEROKEE SMALL LETTER HU
+	0xAB83:  "w",                                                                              // CHEROKEE SMALL LETTER LA
+	0xAB87:  "\u028d",                                                                         // CHEROKEE SMALL LETTER LU
+	0xAB8B:  "\u029c",                                                                         // CHEROKEE SMALL LETTER MI
+	0xAB8E:  "o\u0335",                                                                        // CHEROKEE SMALL LETTER NA
+	0xAB90:  "\u0262",                                                                         // CHEROKEE SMALL LETTER NAH
+	0xAB93:  "z",                                                                              // CHEROKEE SMALL LETTER NO
+	0xAB9B:  "\ua793",                                                                         // CHEROKEE SMALL LETTER QUV
+	0xAB9C:  "u\u0335",                                                                        // CHEROKEE SMALL LETTER SA
+	0xAB9F:  "\u0185",                                                                         // CHEROKEE SMALL LETTER SI
+	0xABA2:  "\u0280",                                                                         // CHEROKEE SMALL LETTER SV
+	0xABA9:  "v",                                                                              // CHEROKEE SMALL LETTER DO
+	0xABAA:  "s",                                                                              // CHEROKEE SMALL LETTER DU
+	0xABAE:  "\u029f",                                                                         // CHEROKEE SMALL LETTER TLE
+	0xABAF:  "c",                                                                              // CHEROKEE SMALL LETTER TLI
+	0xABB2:  "\u1d18",                                                                         // CHEROKEE SMALL LETTER TLV
+	0xABB6:  "\u0138",                                                                         // CHEROKEE SMALL LETTER TSO
+	0xABBB:  "o\u0335",                                                                        // CHEROKEE SMALL LETTER WI
+	0xD7B0:  "\u1169\u1167",                                                                   // HANGUL JUNGSEONG O-YEO
+	0xD7B1:  "\u1169\u1169\u4e28",                                                             // HANGUL JUNGSEONG O-O-I
+	0xD7B2:  "\u116d\u1161",                                                                   // HANGUL JUNGSEONG YO-A
+	0xD7B3:  "\u116d\u1161\u4e28",                                                             // HANGUL JUNGSEONG YO-AE
+	0xD7B4:  "\u116d\u1165",                                                                   // HANGUL JUNGSEONG YO-EO
+	0xD7B5:  "\u116e\u1167",                                                                   // HANGUL JUNGSEONG U-YEO
+	0xD7B6:  "\u116e\u4e28\u4e28",                                                             // HANGUL JUNGSEONG U-I-I
+	0xD7B7:  "\u1172\u1161\u4e28",                                                             // HANGUL JUNGSEONG YU-AE
+	0xD7B8:  "\u1172\u1169",                                                                   // HANGUL JUNGSEONG YU-O
+	0xD7B9:  "\u30fc\u1161",                                                                   // HANGUL JUNGSEONG EU-A
+	0xD7BA:  "\u30fc\u1165",                                                                   // HANGUL JUNGSEONG EU-EO
+	0xD7BB:  "\u30fc\u1165\u4e28",                                                             // HANGUL JUNGSEONG EU-E
+	0xD7BC:  "\u30fc\u1169",                                                                   // HANGUL JUNGSEONG EU-O
+	0xD7BD:  "\u4e28\u1163\u1169",                                                             // HANGUL JUNGSEONG I-YA-O
+	0xD7BE:  "\u4e28\u1163\u4e28",                                                             // HANGUL JUNGSEONG I-YAE
+	0xD7BF:  "\u4e28\u1167",                                                                   // HANGUL JUNGSEONG I-YEO
+	0xD7C0:  "\u4e28\u1167\u4e28",                                                             // HANGUL JUNGSEONG I-YE
+	0xD7C1:  "\u4e28\u1169\u4e28",                                                             // HANGUL JUNGSEONG I-O-I
+	0xD7C2:  "\u4e28\u116d",                                                                   // HANGUL JUNGSEONG I-YO
+	0xD7C3:  "\u4e28\u1172",                                                                   // HANGUL JUNGSEONG I-YU
+	0xD7C4:  "\u4e28\u4e28",                                                                   // HANGUL JUNGSEONG I-I
+	0xD7C5:  "\u119e\u1161",                                                                   // HANGUL JUNGSEONG ARAEA-A
+	0xD7C6:  "\u119e\u1165\u4e28",                                                             // HANGUL JUNGSEONG ARAEA-E
+	0xD7CB:  "\u1102\u1105",                                                                   // HANGUL JONGSEONG NIEUN-RIEUL
+	0xD7CC:  "\u1102\u110e",                                                                   // HANGUL JONGSEONG NIEUN-CHIEUCH
+	0xD7CD:  "\u1103\u1103",                                                                   // HANGUL JONGSEONG SSANGTIKEUT
+	0xD7CE:  "\u1103\u1103\u1107",                                                             // HANGUL JONGSEONG SSANGTIKEUT-PIEUP
+	0xD7CF:  "\u1103\u1107",                                                                   // HANGUL JONGSEONG TIKEUT-PIEUP
+	0xD7D0:  "\u1103\u1109",                                                                   // HANGUL JONGSEONG TIKEUT-SIOS
+	0xD7D1:  "\u1103\u1109\u1100",                                                             // HANGUL JONGSEONG TIKEUT-SIOS-KIYEOK
+	0xD7D2:  "\u1103\u110c",                                                                   // HANGUL JONGSEONG TIKEUT-CIEUC
+	0xD7D3:  "\u1103\u110e",                                                                   // HANGUL JONGSEONG TIKEUT-CHIEUCH
+	0xD7D4:  "\u1103\u1110",                                                                   // HANGUL JONGSEONG TIKEUT-THIEUTH
+	0xD7D5:  "\u1105\u1100\u1100",                                                             // HANGUL JONGSEONG RIEUL-SSANGKIYEOK
+	0xD7D6:  "\u1105\u1100\u1112",                                                             // HANGUL JONGSEONG RIEUL-KIYEOK-HIEUH
+	0xD7D7:  "\u1105\u1105\u110f",                                                             // HANGUL JONGSEONG SSANGRIEUL-KHIEUKH
+	0xD7D8:  "\u1105\u1106\u1112",                                                             // HANGUL JONGSEONG RIEUL-MIEUM-HIEUH
+	0xD7D9:  "\u1105\u1107\u1103",                                                             // HANGUL JONGSEONG RIEUL-PIEUP-TIKEUT
+	0xD7DA:  "\u1105\u1107\u1111",                                                             // HANGUL JONGSEONG RIEUL-PIEUP-PHIEUPH
+	0xD7DB:  "\u1105\u114c",                                                                   // HANGUL JONGSEONG RIEUL-YESIEUNG
+	0xD7DC:  "\u1105\u1159\u1112",                                                             // HANGUL JONGSEONG RIEUL-YEORINHIEUH-HIEUH
+	0xD7DD:  "\u1105\u110b",                                                                   // HANGUL JONGSEONG KAPYEOUNRIEUL
+	0xD7DE:  "\u1106\u1102",                                                                   // HANGUL JONGSEONG MIEUM-NIEUN
+	0xD7DF:  "\u1106\u1102\u1102",                                                             // HANGUL JONGSEONG MIEUM-SSANGNIEUN
+	0xD7E0:  "\u1106\u1106",                                                                   // HANGUL JONGSEONG SSANGMIEUM
+	0xD7E1:  "\u1106\u1107\u1109",                                                             // HANGUL JONGSEONG MIEUM-PIEUP-SIOS
+	0xD7E2:  "\u1106\u110c",                                                                   // HANGUL JONGSEONG MIEUM-CIEUC
+	0xD7E3:  "\u1107\u1103",                                                                   // HANGUL JONGSEONG PIEUP-TIKEUT
+	0xD7E4:  "\u1107\u1105\u1111",                                                             // HANGUL JONGSEONG PIEUP-RIEUL-PHIEUPH
+	0xD7E5:  "\u1107\u1106",                                                                   // HANGUL JONGSEONG PIEUP-MIEUM
+	0xD7E6:  "\u1107\u1107",                                                                   // HANGUL JONGSEONG SSANGPIEUP
+	0xD7E7:  "\u1107\u1109\u1103",                                                             // HANGUL JONGSEONG PIEUP-SIOS-TIKEUT
+	0xD7E8:  "\u1107\u110c",                                                                   // HANGUL JONGSEONG PIEUP-CIEUC
+	0xD7E9:  "\u1107\u110e",                                                                   // HANGUL JONGSEONG PIEUP-CHIEUCH
+	0xD7EA:  "\u1109\u1106",                                                                   // HANGUL JONGSEONG SIOS-MIEUM
+	0xD7EB:  "\u1109\u1107\u110b",                                                             // HANGUL JONGSEONG SIOS-KAPYEOUNPIEUP
+	0xD7EC:  "\u1109\u1109\u1100",                                                             // HANGUL JONGSEONG SSANGSIOS-KIYEOK
+	0xD7ED:  "\u1109\u1109\u1103",                                                             // HANGUL JONGSEONG SSANGSIOS-TIKEUT
+	0xD7EE:  "\u1109\u1140",                                                                   // HANGUL JONGSEONG SIOS-PANSIOS
+	0xD7EF:  "\u1109\u110c",                                                                   // HANGUL JONGSEONG SIOS-CIEUC
+	0xD7F0:  "\u1109\u110e",                                                                   // HANGUL JONGSEONG SIOS-CHIEUCH
+	0xD7F1:  "\u1109\u1110",                                                                   // HANGUL JONGSEONG SIOS-THIEUTH
+	0xD7F2:  "\u1105\u1112",                                                                   // HANGUL JONGSEONG SIOS-HIEUH
+	0xD7F3:  "\u1140\u1107",                                                                   // HANGUL JONGSEONG PANSIOS-PIEUP
+	0xD7F4:  "\u1140\u1107\u110b",                                                             // HANGUL JONGSEONG PANSIOS-KAPYEOUNPIEUP
+	0xD7F5:  "\u114c\u1106",                                                                   // HANGUL JONGSEONG YESIEUNG-MIEUM
+	0xD7F6:  "\u114c\u1112",                                                                   // HANGUL JONGSEONG YESIEUNG-HIEUH
+	0xD7F7:  "\u110c\u1107",                                                                   // HANGUL JONGSEONG CIEUC-PIEUP
+	0xD7F8:  "\u110c\u1107\u1107",                                                             // HANGUL JONGSEONG CIEUC-SSANGPIEUP
+	0xD7F9:  "\u110c\u110c",                                                                   // HANGUL JONGSEONG SSANGCIEUC
+	0xD7FA:  "\u1111\u1109",                                                                   // HANGUL JONGSEONG PHIEUPH-SIOS
+	0xD7FB:  "\u1111\u1110",                                                                   // HANGUL JONGSEONG PHIEUPH-THIEUTH
+	0xF900:  "\u8c48",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F900
+	0xF901:  "\u66f4",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F901
+	0xF902:  "\u8eca",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F902
+	0xF903:  "\u8cc8",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F903
+	0xF904:  "\u6ed1",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F904
+	0xF905:  "\u4e32",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F905
+	0xF906:  "\u53e5",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F906
+	0xF907:  "\u9f9c",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F907
+	0xF908:  "\u9f9c",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F908
+	0xF909:  "\u5951",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F909
+	0xF90A:  "\u91d1",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F90A
+	0xF90B:  "\u5587",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F90B
+	0xF90C:  "\u5948",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F90C
+	0xF90D:  "\u61f6",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F90D
+	0xF90E:  "\u7669",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F90E
+	0xF90F:  "\u7f85",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F90F
+	0xF910:  "\u863f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F910
+	0xF911:  "\u87ba",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F911
+	0xF912:  "\u88f8",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F912
+	0xF913:  "\u908f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F913
+	0xF914:  "\u6a02",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F914
+	0xF915:  "\u6d1b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F915
+	0xF916:  "\u70d9",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F916
+	0xF917:  "\u73de",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F917
+	0xF918:  "\u843d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F918
+	0xF919:  "\u916a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F919
+	0xF91A:  "\u99f1",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F91A
+	0xF91B:  "\u4e82",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F91B
+	0xF91C:  "\u5375",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F91C
+	0xF91D:  "\u6b04",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F91D
+	0xF91E:  "\u721b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F91E
+	0xF91F:  "\u862d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F91F
+	0xF920:  "\u9e1e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F920
+	0xF921:  "\u5d50",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F921
+	0xF922:  "\u6feb",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F922
+	0xF923:  "\u85cd",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F923
+	0xF924:  "\u8964",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F924
+	0xF925:  "\u62c9",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F925
+	0xF926:  "\u81d8",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F926
+	0xF927:  "\u881f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F927
+	0xF928:  "\u5eca",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F928
+	0xF929:  "\u6717",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F929
+	0xF92A:  "\u6d6a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F92A
+	0xF92B:  "\u72fc",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F92B
+	0xF92C:  "\u90ce",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F92C
+	0xF92D:  "\u4f86",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F92D
+	0xF92E:  "\u51b7",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F92E
+	0xF92F:  "\u52de",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F92F
+	0xF930:  "\u64c4",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F930
+	0xF931:  "\u6ad3",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F931
+	0xF932:  "\u7210",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F932
+	0xF933:  "\u76e7",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F933
+	0xF934:  "\u8001",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F934
+	0xF935:  "\u8606",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F935
+	0xF936:  "\u865c",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F936
+	0xF937:  "\u8def",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F937
+	0xF938:  "\u9732",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F938
+	0xF939:  "\u9b6f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F939
+	0xF93A:  "\u9dfa",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F93A
+	0xF93B:  "\u788c",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F93B
+	0xF93C:  "\u797f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F93C
+	0xF93D:  "\u7da0",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F93D
+	0xF93E:  "\u83c9",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F93E
+	0xF93F:  "\u9304",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F93F
+	0xF940:  "\u9e7f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F940
+	0xF941:  "\u8ad6",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F941
+	0xF942:  "\u58df",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F942
+	0xF943:  "\u5f04",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F943
+	0xF944:  "\u7c60",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F944
+	0xF945:  "\u807e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F945
+	0xF946:  "\u7262",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F946
+	0xF947:  "\u78ca",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F947
+	0xF948:  "\u8cc2",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F948
+	0xF949:  "\u96f7",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F949
+	0xF94A:  "\u58d8",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F94A
+	0xF94B:  "\u5c62",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F94B
+	0xF94C:  "\u6a13",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F94C
+	0xF94D:  "\u6dda",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F94D
+	0xF94E:  "\u6f0f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F94E
+	0xF94F:  "\u7d2f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F94F
+	0xF950:  "\u7e37",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F950
+	0xF951:  "\u964b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F951
+	0xF952:  "\u52d2",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F952
+	0xF953:  "\u808b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F953
+	0xF954:  "\u51dc",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F954
+	0xF955:  "\u51cc",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F955
+	0xF956:  "\u7a1c",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F956
+	0xF957:  "\u7dbe",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F957
+	0xF958:  "\u83f1",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F958
+	0xF959:  "\u9675",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F959
+	0xF95A:  "\u8b80",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F95A
+	0xF95B:  "\u62cf",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F95B
+	0xF95C:  "\u6a02",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F95C
+	0xF95D:  "\u8afe",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F95D
+	0xF95E:  "\u4e39",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F95E
+	0xF95F:  "\u5be7",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F95F
+	0xF960:  "\u6012",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F960
+	0xF961:  "\u7387",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F961
+	0xF962:  "\u7570",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F962
+	0xF963:  "\u5317",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F963
+	0xF964:  "\u78fb",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F964
+	0xF965:  "\u4fbf",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F965
+	0xF966:  "\u5fa9",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F966
+	0xF967:  "\u4e0d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F967
+	0xF968:  "\u6ccc",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F968
+	0xF969:  "\u6578",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F969
+	0xF96A:  "\u7d22",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F96A
+	0xF96B:  "\u53c3",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F96B
+	0xF96C:  "\u585e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F96C
+	0xF96D:  "\u7701",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F96D
+	0xF96E:  "\u8449",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F96E
+	0xF96F:  "\u8aaa",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F96F
+	0xF970:  "\u6bba",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F970
+	0xF971:  "\u8fb0",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F971
+	0xF972:  "\u6c88",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F972
+	0xF973:  "\u62fe",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F973
+	0xF974:  "\u82e5",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F974
+	0xF975:  "\u63a0",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F975
+	0xF976:  "\u7565",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F976
+	0xF977:  "\u4eae",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F977
+	0xF978:  "\u5169",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F978
+	0xF979:  "\u51c9",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F979
+	0xF97A:  "\u6881",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F97A
+	0xF97B:  "\u7ce7",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F97B
+	0xF97C:  "\u826f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F97C
+	0xF97D:  "\u8ad2",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F97D
+	0xF97E:  "\u91cf",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F97E
+	0xF97F:  "\u52f5",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F97F
+	0xF980:  "\u5442",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F980
+	0xF981:  "\u5973",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F981
+	0xF982:  "\u5eec",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F982
+	0xF983:  "\u65c5",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F983
+	0xF984:  "\u6ffe",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F984
+	0xF985:  "\u792a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F985
+	0xF986:  "\u95ad",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F986
+	0xF987:  "\u9a6a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F987
+	0xF988:  "\u9e97",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F988
+	0xF989:  "\u9ece",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F989
+	0xF98A:  "\u529b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F98A
+	0xF98B:  "\u66c6",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F98B
+	0xF98C:  "\u6b77",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F98C
+	0xF98D:  "\u8f62",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F98D
+	0xF98E:  "\u5e74",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F98E
+	0xF98F:  "\u6190",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F98F
+	0xF990:  "\u6200",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F990
+	0xF991:  "\u649a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F991
+	0xF992:  "\u6f23",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F992
+	0xF993:  "\u7149",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F993
+	0xF994:  "\u7489",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F994
+	0xF995:  "\u79ca",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F995
+	0xF996:  "\u7df4",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F996
+	0xF997:  "\u806f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F997
+	0xF998:  "\u8f26",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F998
+	0xF999:  "\u84ee",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F999
+	0xF99A:  "\u9023",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F99A
+	0xF99B:  "\u934a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F99B
+	0xF99C:  "\u5217",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F99C
+	0xF99D:  "\u52a3",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F99D
+	0xF99E:  "\u54bd",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F99E
+	0xF99F:  "\u70c8",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F99F
+	0xF9A0:  "\u88c2",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9A0
+	0xF9A1:  "\u8aaa",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9A1
+	0xF9A2:  "\u5ec9",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9A2
+	0xF9A3:  "\u5ff5",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9A3
+	0xF9A4:  "\u637b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9A4
+	0xF9A5:  "\u6bae",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9A5
+	0xF9A6:  "\u7c3e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9A6
+	0xF9A7:  "\u7375",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9A7
+	0xF9A8:  "\u4ee4",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9A8
+	0xF9A9:  "\u56f9",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9A9
+	0xF9AA:  "\u5be7",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9AA
+	0xF9AB:  "\u5dba",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9AB
+	0xF9AC:  "\u601c",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9AC
+	0xF9AD:  "\u73b2",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9AD
+	0xF9AE:  "\u7469",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9AE
+	0xF9AF:  "\u7f9a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9AF
+	0xF9B0:  "\u8046",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9B0
+	0xF9B1:  "\u9234",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9B1
+	0xF9B2:  "\u96f6",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9B2
+	0xF9B3:  "\u9748",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9B3
+	0xF9B4:  "\u9818",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9B4
+	0xF9B5:  "\u4f8b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9B5
+	0xF9B6:  "\u79ae",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9B6
+	0xF9B7:  "\u91b4",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9B7
+	0xF9B8:  "\u96b7",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9B8
+	0xF9B9:  "\u60e1",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9B9
+	0xF9BA:  "\u4e86",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9BA
+	0xF9BB:  "\u50da",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9BB
+	0xF9BC:  "\u5bee",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9BC
+	0xF9BD:  "\u5c3f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9BD
+	0xF9BE:  "\u6599",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9BE
+	0xF9BF:  "\u6a02",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9BF
+	0xF9C0:  "\u71ce",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9C0
+	0xF9C1:  "\u7642",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9C1
+	0xF9C2:  "\u84fc",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9C2
+	0xF9C3:  "\u907c",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9C3
+	0xF9C4:  "\u9f8d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9C4
+	0xF9C5:  "\u6688",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9C5
+	0xF9C6:  "\u962e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9C6
+	0xF9C7:  "\u5289",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9C7
+	0xF9C8:  "\u677b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9C8
+	0xF9C9:  "\u67f3",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9C9
+	0xF9CA:  "\u6d41",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9CA
+	0xF9CB:  "\u6e9c",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9CB
+	0xF9CC:  "\u7409",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9CC
+	0xF9CD:  "\u7559",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9CD
+	0xF9CE:  "\u786b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9CE
+	0xF9CF:  "\u7d10",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9CF
+	0xF9D0:  "\u985e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9D0
+	0xF9D1:  "\u516d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9D1
+	0xF9D2:  "\u622e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9D2
+	0xF9D3:  "\u9678",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9D3
+	0xF9D4:  "\u502b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9D4
+	0xF9D5:  "\u5d19",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9D5
+	0xF9D6:  "\u6dea",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9D6
+	0xF9D7:  "\u8f2a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9D7
+	0xF9D8:  "\u5f8b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9D8
+	0xF9D9:  "\u6144",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9D9
+	0xF9DA:  "\u6817",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9DA
+	0xF9DB:  "\u7387",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9DB
+	0xF9DC:  "\u9686",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9DC
+	0xF9DD:  "\u5229",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9DD
+	0xF9DE:  "\u540f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9DE
+	0xF9DF:  "\u5c65",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9DF
+	0xF9E0:  "\u6613",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9E0
+	0xF9E1:  "\u674e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9E1
+	0xF9E2:  "\u68a8",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9E2
+	0xF9E3:  "\u6ce5",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9E3
+	0xF9E4:  "\u7406",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9E4
+	0xF9E5:  "\u75e2",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9E5
+	0xF9E6:  "\u7f79",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9E6
+	0xF9E7:  "\u88cf",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9E7
+	0xF9E8:  "\u88e1",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9E8
+	0xF9E9:  "\u91cc",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9E9
+	0xF9EA:  "\u96e2",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9EA
+	0xF9EB:  "\u533f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9EB
+	0xF9EC:  "\u6eba",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9EC
+	0xF9ED:  "\u541d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9ED
+	0xF9EE:  "\u71d0",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9EE
+	0xF9EF:  "\u7498",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9EF
+	0xF9F0:  "\u85fa",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9F0
+	0xF9F1:  "\u96a3",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9F1
+	0xF9F2:  "\u9c57",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9F2
+	0xF9F3:  "\u9e9f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9F3
+	0xF9F4:  "\u6797",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9F4
+	0xF9F5:  "\u6dcb",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9F5
+	0xF9F6:  "\u81e8",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9F6
+	0xF9F7:  "\u7acb",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9F7
+	0xF9F8:  "\u7b20",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9F8
+	0xF9F9:  "\u7c92",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9F9
+	0xF9FA:  "\u72c0",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9FA
+	0xF9FB:  "\u7099",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9FB
+	0xF9FC:  "\u8b58",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9FC
+	0xF9FD:  "\u4ec0",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9FD
+	0xF9FE:  "\u8336",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9FE
+	0xF9FF:  "\u523a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-F9FF
+	0xFA00:  "\u5207",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA00
+	0xFA01:  "\u5ea6",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA01
+	0xFA02:  "\u62d3",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA02
+	0xFA03:  "\u7cd6",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA03
+	0xFA04:  "\u5b85",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA04
+	0xFA05:  "\u6d1e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA05
+	0xFA06:  "\u66b4",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA06
+	0xFA07:  "\u8f3b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA07
+	0xFA08:  "\u884c",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA08
+	0xFA09:  "\u964d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA09
+	0xFA0A:  "\u898b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA0A
+	0xFA0B:  "\u5ed3",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA0B
+	0xFA0C:  "\u5140",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA0C
+	0xFA0D:  "\u55c0",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA0D
+	0xFA10:  "\u585a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA10
+	0xFA12:  "\u6674",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA12
+	0xFA15:  "\u51de",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA15
+	0xFA16:  "\u732a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA16
+	0xFA17:  "\u76ca",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA17
+	0xFA18:  "\u793c",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA18
+	0xFA19:  "\u795e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA19
+	0xFA1A:  "\u7965",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA1A
+	0xFA1B:  "\u798f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA1B
+	0xFA1C:  "\u9756",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA1C
+	0xFA1D:  "\u7cbe",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA1D
+	0xFA1E:  "\u7fbd",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA1E
+	0xFA20:  "\u8612",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA20
+	0xFA22:  "\u8af8",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA22
+	0xFA25:  "\u9038",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA25
+	0xFA26:  "\u90fd",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA26
+	0xFA2A:  "\u98ef",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA2A
+	0xFA2B:  "\u98fc",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA2B
+	0xFA2C:  "\u9928",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA2C
+	0xFA2D:  "\u9db4",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA2D
+	0xFA2E:  "\u90ce",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA2E
+	0xFA2F:  "\u96b7",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA2F
+	0xFA30:  "\u4fae",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA30
+	0xFA31:  "\u50e7",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA31
+	0xFA32:  "\u514d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA32
+	0xFA33:  "\u52c9",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA33
+	0xFA34:  "\u52e4",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA34
+	0xFA35:  "\u5351",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA35
+	0xFA36:  "\u559d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA36
+	0xFA37:  "\u5606",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA37
+	0xFA38:  "\u5668",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA38
+	0xFA39:  "\u5840",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA39
+	0xFA3A:  "\u58a8",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA3A
+	0xFA3B:  "\u5c64",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA3B
+	0xFA3C:  "\u5c6e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA3C
+	0xFA3D:  "\u6094",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA3D
+	0xFA3E:  "\u6168",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA3E
+	0xFA3F:  "\u618e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA3F
+	0xFA40:  "\u61f2",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA40
+	0xFA41:  "\u654f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA41
+	0xFA42:  "\u65e2",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA42
+	0xFA43:  "\u6691",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA43
+	0xFA44:  "\u6885",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA44
+	0xFA45:  "\u6d77",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA45
+	0xFA46:  "\u6e1a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA46
+	0xFA47:  "\u6f22",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA47
+	0xFA48:  "\u716e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA48
+	0xFA49:  "\u722b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA49
+	0xFA4A:  "\u7422",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA4A
+	0xFA4B:  "\u7891",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA4B
+	0xFA4C:  "\u793e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA4C
+	0xFA4D:  "\u7949",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA4D
+	0xFA4E:  "\u7948",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA4E
+	0xFA4F:  "\u7950",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA4F
+	0xFA50:  "\u7956",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA50
+	0xFA51:  "\u795d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA51
+	0xFA52:  "\u798d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA52
+	0xFA53:  "\u798e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA53
+	0xFA54:  "\u7a40",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA54
+	0xFA55:  "\u7a81",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA55
+	0xFA56:  "\u7bc0",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA56
+	0xFA57:  "\u7df4",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA57
+	0xFA58:  "\u7e09",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA58
+	0xFA59:  "\u7e41",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA59
+	0xFA5A:  "\u7f72",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA5A
+	0xFA5B:  "\u8005",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA5B
+	0xFA5C:  "\u81ed",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA5C
+	0xFA5D:  "\u8279",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA5D
+	0xFA5E:  "\u8279",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA5E
+	0xFA5F:  "\u8457",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA5F
+	0xFA60:  "\u8910",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA60
+	0xFA61:  "\u8996",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA61
+	0xFA62:  "\u8b01",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA62
+	0xFA63:  "\u8b39",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA63
+	0xFA64:  "\u8cd3",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA64
+	0xFA65:  "\u8d08",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA65
+	0xFA66:  "\u8fb6",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA66
+	0xFA67:  "\u9038",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA67
+	0xFA68:  "\u96e3",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA68
+	0xFA69:  "\u97ff",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA69
+	0xFA6A:  "\u983b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA6A
+	0xFA6B:  "\u6075",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA6B
+	0xFA6C:  "\U000242ee",                                                                     // CJK COMPATIBILITY IDEOGRAPH-FA6C
+	0xFA6D:  "\u8218",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA6D
+	0xFA70:  "\u4e26",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA70
+	0xFA71:  "\u51b5",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA71
+	0xFA72:  "\u5168",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA72
+	0xFA73:  "\u4f80",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA73
+	0xFA74:  "\u5145",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA74
+	0xFA75:  "\u5180",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA75
+	0xFA76:  "\u52c7",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA76
+	0xFA77:  "\u52fa",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA77
+	0xFA78:  "\u559d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA78
+	0xFA79:  "\u5555",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA79
+	0xFA7A:  "\u5599",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA7A
+	0xFA7B:  "\u55e2",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA7B
+	0xFA7C:  "\u585a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA7C
+	0xFA7D:  "\u58b3",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA7D
+	0xFA7E:  "\u5944",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA7E
+	0xFA7F:  "\u5954",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA7F
+	0xFA80:  "\u5a62",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA80
+	0xFA81:  "\u5b28",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA81
+	0xFA82:  "\u5ed2",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA82
+	0xFA83:  "\u5ed9",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA83
+	0xFA84:  "\u5f69",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA84
+	0xFA85:  "\u5fad",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA85
+	0xFA86:  "\u60d8",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA86
+	0xFA87:  "\u614e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA87
+	0xFA88:  "\u6108",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA88
+	0xFA89:  "\u618e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA89
+	0xFA8A:  "\u6160",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA8A
+	0xFA8B:  "\u61f2",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA8B
+	0xFA8C:  "\u6234",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA8C
+	0xFA8D:  "\u63c4",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA8D
+	0xFA8E:  "\u641c",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA8E
+	0xFA8F:  "\u6452",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA8F
+	0xFA90:  "\u6556",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA90
+	0xFA91:  "\u6674",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA91
+	0xFA92:  "\u6717",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA92
+	0xFA93:  "\u671b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA93
+	0xFA94:  "\u6756",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA94
+	0xFA95:  "\u6b79",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA95
+	0xFA96:  "\u6bba",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA96
+	0xFA97:  "\u6d41",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA97
+	0xFA98:  "\u6edb",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA98
+	0xFA99:  "\u6ecb",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA99
+	0xFA9A:  "\u6f22",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA9A
+	0xFA9B:  "\u701e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA9B
+	0xFA9C:  "\u716e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA9C
+	0xFA9D:  "\u77a7",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA9D
+	0xFA9E:  "\u7235",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA9E
+	0xFA9F:  "\u72af",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FA9F
+	0xFAA0:  "\u732a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAA0
+	0xFAA1:  "\u7471",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAA1
+	0xFAA2:  "\u7506",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAA2
+	0xFAA3:  "\u753b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAA3
+	0xFAA4:  "\u761d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAA4
+	0xFAA5:  "\u761f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAA5
+	0xFAA6:  "\u76ca",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAA6
+	0xFAA7:  "\u76db",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAA7
+	0xFAA8:  "\u76f4",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAA8
+	0xFAA9:  "\u774a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAA9
+	0xFAAA:  "\u7740",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAAA
+	0xFAAB:  "\u78cc",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAAB
+	0xFAAC:  "\u7ab1",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAAC
+	0xFAAD:  "\u7bc0",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAAD
+	0xFAAE:  "\u7c7b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAAE
+	0xFAAF:  "\u7d5b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAAF
+	0xFAB0:  "\u7df4",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAB0
+	0xFAB1:  "\u7f3e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAB1
+	0xFAB2:  "\u8005",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAB2
+	0xFAB3:  "\u8352",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAB3
+	0xFAB4:  "\u83ef",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAB4
+	0xFAB5:  "\u8779",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAB5
+	0xFAB6:  "\u8941",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAB6
+	0xFAB7:  "\u8986",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAB7
+	0xFAB8:  "\u8996",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAB8
+	0xFAB9:  "\u8abf",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAB9
+	0xFABA:  "\u8af8",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FABA
+	0xFABB:  "\u8acb",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FABB
+	0xFABC:  "\u8b01",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FABC
+	0xFABD:  "\u8afe",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FABD
+	0xFABE:  "\u8aed",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FABE
+	0xFABF:  "\u8b39",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FABF
+	0xFAC0:  "\u8b8a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAC0
+	0xFAC1:  "\u8d08",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAC1
+	0xFAC2:  "\u8f38",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAC2
+	0xFAC3:  "\u9072",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAC3
+	0xFAC4:  "\u9199",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAC4
+	0xFAC5:  "\u9276",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAC5
+	0xFAC6:  "\u967c",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAC6
+	0xFAC7:  "\u96e3",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAC7
+	0xFAC8:  "\u9756",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAC8
+	0xFAC9:  "\u97db",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAC9
+	0xFACA:  "\u97ff",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FACA
+	0xFACB:  "\u980b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FACB
+	0xFACC:  "\u983b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FACC
+	0xFACD:  "\u9b12",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FACD
+	0xFACE:  "\u9f9c",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FACE
+	0xFACF:  "\U0002284a",                                                                     // CJK COMPATIBILITY IDEOGRAPH-FACF
+	0xFAD0:  "\U00022844",                                                                     // CJK COMPATIBILITY IDEOGRAPH-FAD0
+	0xFAD1:  "\U000233d5",                                                                     // CJK COMPATIBILITY IDEOGRAPH-FAD1
+	0xFAD2:  "\u3b9d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAD2
+	0xFAD3:  "\u4018",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAD3
+	0xFAD4:  "\u4039",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAD4
+	0xFAD5:  "\U00025249",                                                                     // CJK COMPATIBILITY IDEOGRAPH-FAD5
+	0xFAD6:  "\U00025cd0",                                                                     // CJK COMPATIBILITY IDEOGRAPH-FAD6
+	0xFAD7:  "\U00027ed3",                                                                     // CJK COMPATIBILITY IDEOGRAPH-FAD7
+	0xFAD8:  "\u9f43",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAD8
+	0xFAD9:  "\u9f8e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-FAD9
+	0xFB00:  "ff",                                                                             // LATIN SMALL LIGATURE FF
+	0xFB01:  "fi",                                                                             // LATIN SMALL LIGATURE FI
+	0xFB02:  "fl",                                                                             // LATIN SMALL LIGATURE FL
+	0xFB03:  "ffi",                                                                            // LATIN SMALL LIGATURE FFI
+	0xFB04:  "ffl",                                                                            // LATIN SMALL LIGATURE FFL
+	0xFB06:  "st",                                                                             // LATIN SMALL LIGATURE ST
+	0xFB13:  "\u0574\u0576",                                                                   // ARMENIAN SMALL LIGATURE MEN NOW
+	0xFB14:  "\u0574\u0565",                                                                   // ARMENIAN SMALL LIGATURE MEN ECH
+	0xFB15:  "\u0574\u056b",                                                                   // ARMENIAN SMALL LIGATURE MEN INI
+	0xFB16:  "\u057e\u0576",                                                                   // ARMENIAN SMALL LIGATURE VEW NOW
+	0xFB17:  "\u0574\u056d",                                                                   // ARMENIAN SMALL LIGATURE MEN XEH
+	0xFB20:  "\u05e2",                                                                         // HEBREW LETTER ALTERNATIVE AYIN
+	0xFB21:  "\u05d0",                                                                         // HEBREW LETTER WIDE ALEF
+	0xFB22:  "\u05d3",                                                                         // HEBREW LETTER WIDE DALET
+	0xFB23:  "\u05d4",                                                                         // HEBREW LETTER WIDE HE
+	0xFB24:  "\u05db",                                                                         // HEBREW LETTER WIDE KAF
+	0xFB25:  "\u05dc",                                                                         // HEBREW LETTER WIDE LAMED
+	0xFB26:  "\u05dd",                                                                         // HEBREW LETTER WIDE FINAL MEM
+	0xFB27:  "\u05e8",                                                                         // HEBREW LETTER WIDE RESH
+	0xFB28:  "\u05ea",                                                                         // HEBREW LETTER WIDE TAV
+	0xFB29:  "-\u0307",                                                                        // HEBREW LETTER ALTERNATIVE PLUS SIGN
+	0xFB2B:  "\ufb2a",                                                                         // HEBREW LETTER SHIN WITH SIN DOT
+	0xFB2D:  "\ufb2c",                                                                         // HEBREW LETTER SHIN WITH DAGESH AND SIN DOT
+	0xFB2F:  "\ufb2e",                                                                         // HEBREW LETTER ALEF WITH QAMATS
+	0xFB30:  "\ufb2e",                                                                         // HEBREW LETTER ALEF WITH MAPIQ
+	0xFB39:  "\ufb1d",                                                                         // HEBREW LETTER YOD WITH DAGESH
+	0xFB49:  "\ufb2a",                                                                         // HEBREW LETTER SHIN WITH DAGESH
+	0xFB4F:  "\u05d0\u05dc",                                                                   // HEBREW LIGATURE ALEF LAMED
+	0xFB50:  "\u0671",                                                                         // ARABIC LETTER ALEF WASLA ISOLATED FORM
+	0xFB51:  "\u0671",                                                                         // ARABIC LETTER ALEF WASLA FINAL FORM
+	0xFB52:  "\u067b",                                                                         // ARABIC LETTER BEEH ISOLATED FORM
+	0xFB53:  "\u067b",                                                                         // ARABIC LETTER BEEH FINAL FORM
+	0xFB54:  "\u067b",                                                                         // ARABIC LETTER BEEH INITIAL FORM
+	0xFB55:  "\u067b",                                                                         // ARABIC LETTER BEEH MEDIAL FORM
+	0xFB56:  "\u0649\u06db",                                                                   // ARABIC LETTER PEH ISOLATED FORM
+	0xFB57:  "\u0649\u06db",                                                                   // ARABIC LETTER PEH FINAL FORM
+	0xFB58:  "\u0649\u06db",                                                                   // ARABIC LETTER PEH INITIAL FORM
+	0xFB59:  "\u0649\u06db",                                                                   // ARABIC LETTER PEH MEDIAL FORM
+	0xFB5A:  "\u0680",                                                                         // ARABIC LETTER BEHEH ISOLATED FORM
+	0xFB5B:  "\u0680",                                                                         // ARABIC LETTER BEHEH FINAL FORM
+	0xFB5C:  "\u0680",                                                                         // ARABIC LETTER BEHEH INITIAL FORM
+	0xFB5D:  "\u0680",                                                                         // ARABIC LETTER BEHEH MEDIAL FORM
+	0xFB5E:  "\u067a",                                                                         // ARABIC LETTER TTEHEH ISOLATED FORM
+	0xFB5F:  "\u067a",                                                                         // ARABIC LETTER TTEHEH FINAL FORM
+	0xFB60:  "\u067a",                                                                         // ARABIC LETTER TTEHEH INITIAL FORM
+	0xFB61:  "\u067a",                                                                         // ARABIC LETTER TTEHEH MEDIAL FORM
+	0xFB62:  "\u067f",                                                                         // ARABIC LETTER TEHEH ISOLATED FORM
+	0xFB63:  "\u067f",                                                                         // ARABIC LETTER TEHEH FINAL FORM
+	0xFB64:  "\u067f",                                                                         // ARABIC LETTER TEHEH INITIAL FORM
+	0xFB65:  "\u067f",                                                                         // ARABIC LETTER TEHEH MEDIAL FORM
+	0xFB66:  "\u0649\u0615",                                                                   // ARABIC LETTER TTEH ISOLATED FORM
+	0xFB67:  "\u0649\u0615",                                                                   // ARABIC LETTER TTEH FINAL FORM
+	0xFB68:  "\u0649\u0615",                                                                   // ARABIC LETTER TTEH INITIAL FORM
+	0xFB69:  "\u0649\u0615",                                                                   // ARABIC LETTER TTEH MEDIAL FORM
+	0xFB6A:  "\u06a1\u06db",                                                                   // ARABIC LETTER VEH ISOLATED FORM
+	0xFB6B:  "\u06a1\u06db",                                                                   // ARABIC LETTER VEH FINAL FORM
+	0xFB6C:  "\u06a1\u06db",                                                                   // ARABIC LETTER VEH INITIAL FORM
+	0xFB6D:  "\u06a1\u06db",                                                                   // ARABIC LETTER VEH MEDIAL FORM
+	0xFB6E:  "\u06a6",                                                                         // ARABIC LETTER PEHEH ISOLATED FORM
+	0xFB6F:  "\u06a6",                                                                         // ARABIC LETTER PEHEH FINAL FORM
+	0xFB70:  "\u06a6",                                                                         // ARABIC LETTER PEHEH INITIAL FORM
+	0xFB71:  "\u06a6",                                                                         // ARABIC LETTER PEHEH MEDIAL FORM
+	0xFB72:  "\u0684",                                                                         // ARABIC LETTER DYEH ISOLATED FORM
+	0xFB73:  "\u0684",                                                                         // ARABIC LETTER DYEH FINAL FORM
+	0xFB74:  "\u0684",                                                                         // ARABIC LETTER DYEH INITIAL FORM
+	0xFB75:  "\u0684",                                                                         // ARABIC LETTER DYEH MEDIAL FORM
+	0xFB76:  "\u0683",                                                                         // ARABIC LETTER NYEH ISOLATED FORM
+	0xFB77:  "\u0683",                                                                         // ARABIC LETTER NYEH FINAL FORM
+	0xFB78:  "\u0683",                                                                         // ARABIC LETTER NYEH INITIAL FORM
+	0xFB79:  "\u0683",                                                                         // ARABIC LETTER NYEH MEDIAL FORM
+	0xFB7A:  "\u0686",                                                                         // ARABIC LETTER TCHEH ISOLATED FORM
+	0xFB7B:  "\u0686",                                                                         // ARABIC LETTER TCHEH FINAL FORM
+	0xFB7C:  "\u0686",                                                                         // ARABIC LETTER TCHEH INITIAL FORM
+	0xFB7D:  "\u0686",                                                                         // ARABIC LETTER TCHEH MEDIAL FORM
+	0xFB7E:  "\u0687",                                                                         // ARABIC LETTER TCHEHEH ISOLATED FORM
+	0xFB7F:  "\u0687",                                                                         // ARABIC LETTER TCHEHEH FINAL FORM
+	0xFB80:  "\u0687",                                                                         // ARABIC LETTER TCHEHEH INITIAL FORM
+	0xFB81:  "\u0687",                                                                         // ARABIC LETTER TCHEHEH MEDIAL FORM
+	0xFB82:  "\u068d",                                                                         // ARABIC LETTER DDAHAL ISOLATED FORM
+	0xFB83:  "\u068d",                                                                         // ARABIC LETTER DDAHAL FINAL FORM
+	0xFB84:  "\u068c",                                                                         // ARABIC LETTER DAHAL ISOLATED FORM
+	0xFB85:  "\u068c",                                                                         // ARABIC LETTER DAHAL FINAL FORM
+	0xFB86:  "\u062f\u06db",                                                                   // ARABIC LETTER DUL ISOLATED FORM
+	0xFB87:  "\u062f\u06db",                                                                   // ARABIC LETTER DUL FINAL FORM
+	0xFB88:  "\u062f\u0615",                                                                   // ARABIC LETTER DDAL ISOLATED FORM
+	0xFB89:  "\u062f\u0615",                                                                   // ARABIC LETTER DDAL FINAL FORM
+	0xFB8A:  "\u0631\u06db",                                                                   // ARABIC LETTER JEH ISOLATED FORM
+	0xFB8B:  "\u0631\u06db",                                                                   // ARABIC LETTER JEH FINAL FORM
+	0xFB8C:  "\u0631\u0615",                                                                   // ARABIC LETTER RREH ISOLATED FORM
+	0xFB8D:  "\u0631\u0615",                                                                   // ARABIC LETTER RREH FINAL FORM
+	0xFB8E:  "\u0643",                                                                         // ARABIC LETTER KEHEH ISOLATED FORM
+	0xFB8F:  "\u0643",                                                                         // ARABIC LETTER KEHEH FINAL FORM
+	0xFB90:  "\u0643",                                                                         // ARABIC LETTER KEHEH INITIAL FORM
+	0xFB91:  "\u0643",                                                                         // ARABIC LETTER KEHEH MEDIAL FORM
+	0xFB92:  "\u06af",                                                                         // ARABIC LETTER GAF ISOLATED FORM
+	0xFB93:  "\u06af",                                                                         // ARABIC LETTER GAF FINAL FORM
+	0xFB94:  "\u06af",                                                                         // ARABIC LETTER GAF INITIAL FORM
+	0xFB95:  "\u06af",                                                                         // ARABIC LETTER GAF MEDIAL FORM
+	0xFB96:  "\u06b3",                                                                         // ARABIC LETTER GUEH ISOLATED FORM
+	0xFB97:  "\u06b3",                                                                         // ARABIC LETTER GUEH FINAL FORM
+	0xFB98:  "\u06b3",                                                                         // ARABIC LETTER GUEH INITIAL FORM
+	0xFB99:  "\u06b3",                                                                         // ARABIC LETTER GUEH MEDIAL FORM
+	0xFB9A:  "\u06b1",                                                                         // ARABIC LETTER NGOEH ISOLATED FORM
+	0xFB9B:  "\u06b1",                                                                         // ARABIC LETTER NGOEH FINAL FORM
+	0xFB9C:  "\u06b1",                                                                         // ARABIC LETTER NGOEH INITIAL FORM
+	0xFB9D:  "\u06b1",                                                                         // ARABIC LETTER NGOEH MEDIAL FORM
+	0xFB9E:  "\u0649",                                                                         // ARABIC LETTER NOON GHUNNA ISOLATED FORM
+	0xFB9F:  "\u0649",                                                                         // ARABIC LETTER NOON GHUNNA FINAL FORM
+	0xFBA0:  "\u0649\u0615",                                                                   // ARABIC LETTER RNOON ISOLATED FORM
+	0xFBA1:  "\u0649\u0615",                                                                   // ARABIC LETTER RNOON FINAL FORM
+	0xFBA2:  "\u0649\u0615",                                                                   // ARABIC LETTER RNOON INITIAL FORM
+	0xFBA3:  "\u0649\u0615",                                                                   // ARABIC LETTER RNOON MEDIAL FORM
+	0xFBA4:  "\u06c0",                                                                         // ARABIC LETTER HEH WITH YEH ABOVE ISOLATED FORM
+	0xFBA5:  "\u06c0",                                                                         // ARABIC LETTER HEH WITH YEH ABOVE FINAL FORM
+	0xFBA6:  "o",                                                                              // ARABIC LETTER HEH GOAL ISOLATED FORM
+	0xFBA7:  "o",                                                                              // ARABIC LETTER HEH GOAL FINAL FORM
+	0xFBA8:  "o",                                                                              // ARABIC LETTER HEH GOAL INITIAL FORM
+	0xFBA9:  "o",                                                                              // ARABIC LETTER HEH GOAL MEDIAL FORM
+	0xFBAA:  "o",                                                                              // ARABIC LETTER HEH DOACHASHMEE ISOLATED FORM
+	0xFBAB:  "o",                                                                              // ARABIC LETTER HEH DOACHASHMEE FINAL FORM
+	0xFBAC:  "o",                                                                              // ARABIC LETTER HEH DOACHASHMEE INITIAL FORM
+	0xFBAD:  "o",                                                                              // ARABIC LETTER HEH DOACHASHMEE MEDIAL FORM
+	0xFBAE:  "\u0649",                                                                         // ARABIC LETTER YEH BARREE ISOLATED FORM
+	0xFBAF:  "\u0649",                                                                         // ARABIC LETTER YEH BARREE FINAL FORM
+	0xFBB0:  "\u06d3",                                                                         // ARABIC LETTER YEH BARREE WITH HAMZA ABOVE ISOLATED FORM
+	0xFBB1:  "\u06d3",                                                                         // ARABIC LETTER YEH BARREE WITH HAMZA ABOVE FINAL FORM
+	0xFBD3:  "\u0643\u06db",                                                                   // ARABIC LETTER NG ISOLATED FORM
+	0xFBD4:  "\u0643\u06db",                                                                   // ARABIC LETTER NG FINAL FORM
+	0xFBD5:  "\u0643\u06db",                                                                   // ARABIC LETTER NG INITIAL FORM
+	0xFBD6:  "\u0643\u06db",                                                                   // ARABIC LETTER NG MEDIAL FORM
+	0xFBD7:  "\u0648\u0313",                                                                   // ARABIC LETTER U ISOLATED FORM
+	0xFBD8:  "\u0648\u0313",                                                                   // ARABIC LETTER U FINAL FORM
+	0xFBD9:  "\u0648\u0306",                                                                   // ARABIC LETTER OE ISOLATED FORM
+	0xFBDA:  "\u0648\u0306",                                                                   // ARABIC LETTER OE FINAL FORM
+	0xFBDB:  "\u0648\u0670",                                                                   // ARABIC LETTER YU ISOLATED FORM
+	0xFBDC:  "\u0648\u0670",                                                                   // ARABIC LETTER YU FINAL FORM
+	0xFBDD:  "\u0648\u0313\u0674",                                                             // ARABIC LETTER U WITH HAMZA ABOVE ISOLATED FORM
+	0xFBDE:  "\u0648\u06db",                                                                   // ARABIC LETTER VE ISOLATED FORM
+	0xFBDF:  "\u0648\u06db",                                                                   // ARABIC LETTER VE FINAL FORM
+	0xFBE0:  "\u06c5",                                                                         // ARABIC LETTER KIRGHIZ OE ISOLATED FORM
+	0xFBE1:  "\u06c5",                                                                         // ARABIC LETTER KIRGHIZ OE FINAL FORM
+	0xFBE2:  "\u0648\u0302",                                                                   // ARABIC LETTER KIRGHIZ YU ISOLATED FORM
+	0xFBE3:  "\u0648\u0302",                                                                   // ARABIC LETTER KIRGHIZ YU FINAL FORM
+	0xFBE4:  "\u067b",                                                                         // ARABIC LETTER E ISOLATED FORM
+	0xFBE5:  "\u067b",                                                                         // ARABIC LETTER E FINAL FORM
+	0xFBE6:  "\u067b",                                                                         // ARABIC LETTER E INITIAL FORM
+	0xFBE7:  "\u067b",                                                                         // ARABIC LETTER E MEDIAL FORM
+	0xFBE8:  "\u0649",                                                                         // ARABIC LETTER UIGHUR KAZAKH KIRGHIZ ALEF MAKSURA INITIAL FORM
+	0xFBE9:  "\u0649",                                                                         // ARABIC LETTER UIGHUR KAZAKH KIRGHIZ ALEF MAKSURA MEDIAL FORM
+	0xFBEA:  "\u0649\u0674l",                                                                  // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH ALEF ISOLATED FORM
+	0xFBEB:  "\u0649\u0674l",                                                                  // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH ALEF FINAL FORM
+	0xFBEC:  "\u0649\u0674o",                                                                  // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH AE ISOLATED FORM
+	0xFBED:  "\u0649\u0674o",                                                                  // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH AE FINAL FORM
+	0xFBEE:  "\u0649\u0674\u0648",                                                             // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH WAW ISOLATED FORM
+	0xFBEF:  "\u0649\u0674\u0648",                                                             // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH WAW FINAL FORM
+	0xFBF0:  "\u0649\u0674\u0648\u0313",                                                       // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH U ISOLATED FORM
+	0xFBF1:  "\u0649\u0674\u0648\u0313",                                                       // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH U FINAL FORM
+	0xFBF2:  "\u0649\u0674\u0648\u0306",                                                       // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH OE ISOLATED FORM
+	0xFBF3:  "\u0649\u0674\u0648\u0306",                                                       // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH OE FINAL FORM
+	0xFBF4:  "\u0649\u0674\u0648\u0670",                                                       // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH YU ISOLATED FORM
+	0xFBF5:  "\u0649\u0674\u0648\u0670",                                                       // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH YU FINAL FORM
+	0xFBF6:  "\u0649\u0674\u067b",                                                             // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH E ISOLATED FORM
+	0xFBF7:  "\u0649\u0674\u067b",                                                             // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH E FINAL FORM
+	0xFBF8:  "\u0649\u0674\u067b",                                                             // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH E INITIAL FORM
+	0xFBF9:  "\u0649\u0674\u0649",                                                             // ARABIC LIGATURE UIGHUR KIRGHIZ YEH WITH HAMZA ABOVE WITH ALEF MAKSURA ISOLATED FORM
+	0xFBFA:  "\u0649\u0674\u0649",                                                             // ARABIC LIGATURE UIGHUR KIRGHIZ YEH WITH HAMZA ABOVE WITH ALEF MAKSURA FINAL FORM
+	0xFBFB:  "\u0649\u0674\u0649",                                                             // ARABIC LIGATURE UIGHUR KIRGHIZ YEH WITH HAMZA ABOVE WITH ALEF MAKSURA INITIAL FORM
+	0xFBFC:  "\u0649",                                                                         // ARABIC LETTER FARSI YEH ISOLATED FORM
+	0xFBFD:  "\u0649",                                                                         // ARABIC LETTER FARSI YEH FINAL FORM
+	0xFBFE:  "\u0649",                                                                         // ARABIC LETTER FARSI YEH INITIAL FORM
+	0xFBFF:  "\u0649",                                                                         // ARABIC LETTER FARSI YEH MEDIAL FORM
+	0xFC00:  "\u0649\u0674\u062c",                                                             // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH JEEM ISOLATED FORM
+	0xFC01:  "\u0649\u0674\u062d",                                                             // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH HAH ISOLATED FORM
+	0xFC02:  "\u0649\u0674\u0645",                                                             // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH MEEM ISOLATED FORM
+	0xFC03:  "\u0649\u0674\u0649",                                                             // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH ALEF MAKSURA ISOLATED FORM
+	0xFC04:  "\u0649\u0674\u0649",                                                             // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH YEH ISOLATED FORM
+	0xFC05:  "\u0628\u062c",                                                                   // ARABIC LIGATURE BEH WITH JEEM ISOLATED FORM
+	0xFC06:  "\u0628\u062d",                                                                   // ARABIC LIGATURE BEH WITH HAH ISOLATED FORM
+	0xFC07:  "\u0628\u062e",                                                                   // ARABIC LIGATURE BEH WITH KHAH ISOLATED FORM
+	0xFC08:  "\u0628\u0645",                                                                   // ARABIC LIGATURE BEH WITH MEEM ISOLATED FORM
+	0xFC09:  "\u0628\u0649",                                                                   // ARABIC LIGATURE BEH WITH ALEF MAKSURA ISOLATED FORM
+	0xFC0A:  "\u0628\u0649",                                                                   // ARABIC LIGATURE BEH WITH YEH ISOLATED FORM
+	0xFC0B:  "\u062a\u062c",                                                                   // ARABIC LIGATURE TEH WITH JEEM ISOLATED FORM
+	0xFC0C:  "\u062a\u062d",                                                                   // ARABIC LIGATURE TEH WITH HAH ISOLATED FORM
+	0xFC0D:  "\u062a\u062e",                                                                   // ARABIC LIGATURE TEH WITH KHAH ISOLATED FORM
+	0xFC0E:  "\u062a\u0645",                                                                   // ARABIC LIGATURE TEH WITH MEEM ISOLATED FORM
+	0xFC0F:  "\u062a\u0649",                                                                   // ARABIC LIGATURE TEH WITH ALEF MAKSURA ISOLATED FORM
+	0xFC10:  "\u062a\u0649",                                                                   // ARABIC LIGATURE TEH WITH YEH ISOLATED FORM
+	0xFC11:  "\u0649\u06db\u062c",                                                             // ARABIC LIGATURE THEH WITH JEEM ISOLATED FORM
+	0xFC12:  "\u0649\u06db\u0645",                                                             // ARABIC LIGATURE THEH WITH MEEM ISOLATED FORM
+	0xFC13:  "\u0649\u06db\u0649",                                                             // ARABIC LIGATURE THEH WITH ALEF MAKSURA ISOLATED FORM
+	0xFC14:  "\u0649\u06db\u0649",                                                             // ARABIC LIGATURE THEH WITH YEH ISOLATED FORM
+	0xFC15:  "\u062c\u062d",                                                                   // ARABIC LIGATURE JEEM WITH HAH ISOLATED FORM
+	0xFC16:  "\u062c\u0645",                                                                   // ARABIC LIGATURE JEEM WITH MEEM ISOLATED FORM
+	0xFC17:  "\u062d\u062c",                                                                   // ARABIC LIGATURE HAH WITH JEEM ISOLATED FORM
+	0xFC18:  "\u062d\u0645",                                                                   // ARABIC LIGATURE HAH WITH MEEM ISOLATED FORM
+	0xFC19:  "\u062e\u062c",                                                                   // ARABIC LIGATURE KHAH WITH JEEM ISOLATED FORM
+	0xFC1A:  "\u062e\u062d",                                                                   // ARABIC LIGATURE KHAH WITH HAH ISOLATED FORM
+	0xFC1B:  "\u062e\u0645",                                                                   // ARABIC LIGATURE KHAH WITH MEEM ISOLATED FORM
+	0xFC1C:  "\u0633\u062c",                                                                   // ARABIC LIGATURE SEEN WITH JEEM ISOLATED FORM
+	0xFC1D:  "\u0633\u062d",                                                                   // ARABIC LIGATURE SEEN WITH HAH ISOLATED FORM
+	0xFC1E:  "\u0633\u062e",                                                                   // ARABIC LIGATURE SEEN WITH KHAH ISOLATED FORM
+	0xFC1F:  "\u0633\u0645",                                                                   // ARABIC LIGATURE SEEN WITH MEEM ISOLATED FORM
+	0xFC20:  "\u0635\u062d",                                                                   // ARABIC LIGATURE SAD WITH HAH ISOLATED FORM
+	0xFC21:  "\u0635\u0645",                                                                   // ARABIC LIGATURE SAD WITH MEEM ISOLATED FORM
+	0xFC22:  "\u0636\u062c",                                                                   // ARABIC LIGATURE DAD WITH JEEM ISOLATED FORM
+	0xFC23:  "\u0636\u062d",                                                                   // ARABIC LIGATURE DAD WITH HAH ISOLATED FORM
+	0xFC24:  "\u0636\u062e",                                                                   // ARABIC LIGATURE DAD WITH KHAH ISOLATED FORM
+	0xFC25:  "\u0636\u0645",                                                                   // ARABIC LIGATURE DAD WITH MEEM ISOLATED FORM
+	0xFC26:  "\u0637\u062d",                                                                   // ARABIC LIGATURE TAH WITH HAH ISOLATED FORM
+	0xFC27:  "\u0637\u0645",                                                                   // ARABIC LIGATURE TAH WITH MEEM ISOLATED FORM
+	0xFC28:  "\u0638\u0645",                                                                   // ARABIC LIGATURE ZAH WITH MEEM ISOLATED FORM
+	0xFC29:  "\u0639\u062c",                                                                   // ARABIC LIGATURE AIN WITH JEEM ISOLATED FORM
+	0xFC2A:  "\u0639\u0645",                                                                   // ARABIC LIGATURE AIN WITH MEEM ISOLATED FORM
+	0xFC2B:  "\u063a\u062c",                                                                   // ARABIC LIGATURE GHAIN WITH JEEM ISOLATED FORM
+	0xFC2C:  "\u063a\u0645",                                                                   // ARABIC LIGATURE GHAIN WITH MEEM ISOLATED FORM
+	0xFC2D:  "\u0641\u062c",                                                                   // ARABIC LIGATURE FEH WITH JEEM ISOLATED FORM
+	0xFC2E:  "\u0641\u062d",                                                                   // ARABIC LIGATURE FEH WITH HAH ISOLATED FORM
+	0xFC2F:  "\u0641\u062e",                                                                   // ARABIC LIGATURE FEH WITH KHAH ISOLATED FORM
+	0xFC30:  "\u0641\u0645",                                                                   // ARABIC LIGATURE FEH WITH MEEM ISOLATED FORM
+	0xFC31:  "\u0641\u0649",                                                                   // ARABIC LIGATURE FEH WITH ALEF MAKSURA ISOLATED FORM
+	0xFC32:  "\u0641\u0649",                                                                   // ARABIC LIGATURE FEH WITH YEH ISOLATED FORM
+	0xFC33:  "\u0642\u062d",                                                                   // ARABIC LIGATURE QAF WITH HAH ISOLATED FORM
+	0xFC34:  "\u0642\u0645",                                                                   // ARABIC LIGATURE QAF WITH MEEM ISOLATED FORM
+	0xFC35:  "\u0642\u0649",                                                                   // ARABIC LIGATURE QAF WITH ALEF MAKSURA ISOLATED FORM
+	0xFC36:  "\u0642\u0649",                                                                   // ARABIC LIGATURE QAF WITH YEH ISOLATED FORM
+	0xFC37:  "\u0643l",                                                                        // ARABIC LIGATURE KAF WITH ALEF ISOLATED FORM
+	0xFC38:  "\u0643\u062c",                                                                   // ARABIC LIGATURE KAF WITH JEEM ISOLATED FORM
+	0xFC39:  "\u0643\u062d",                                                                   // ARABIC LIGATURE KAF WITH HAH ISOLATED FORM
+	0xFC3A:  "\u0643\u062e",                                                                   // ARABIC LIGATURE KAF WITH KHAH ISOLATED FORM
+	0xFC3B:  "\u0643\u0644",                                                                   // ARABIC LIGATURE KAF WITH LAM ISOLATED FORM
+	0xFC3C:  "\u0643\u0645",                                                                   // ARABIC LIGATURE KAF WITH MEEM ISOLATED FORM
+	0xFC3D:  "\u0643\u0649",                                                                   // ARABIC LIGATURE KAF WITH ALEF MAKSURA ISOLATED FORM
+	0xFC3E:  "\u0643\u0649",                                                                   // ARABIC LIGATURE KAF WITH YEH ISOLATED FORM
+	0xFC3F:  "\u0644\u062c",                                                                   // ARABIC LIGATURE LAM WITH JEEM ISOLATED FORM
+	0xFC40:  "\u0644\u062d",                                                                   // ARABIC LIGATURE LAM WITH HAH ISOLATED FORM
+	0xFC41:  "\u0644\u062e",                                                                   // ARABIC LIGATURE LAM WITH KHAH ISOLATED FORM
+	0xFC42:  "\u0644\u0645",                                                                   // ARABIC LIGATURE LAM WITH MEEM ISOLATED FORM
+	0xFC43:  "\u0644\u0649",                                                                   // ARABIC LIGATURE LAM WITH ALEF MAKSURA ISOLATED FORM
+	0xFC44:  "\u0644\u0649",                                                                   // ARABIC LIGATURE LAM WITH YEH ISOLATED FORM
+	0xFC45:  "\u0645\u062c",                                                                   // ARABIC LIGATURE MEEM WITH JEEM ISOLATED FORM
+	0xFC46:  "\u0645\u062d",                                                                   // ARABIC LIGATURE MEEM WITH HAH ISOLATED FORM
+	0xFC47:  "\u0645\u062e",                                                                   // ARABIC LIGATURE MEEM WITH KHAH ISOLATED FORM
+	0xFC48:  "\u0645\u0645",                                                                   // ARABIC LIGATURE MEEM WITH MEEM ISOLATED FORM
+	0xFC49:  "\u0645\u0649",                                                                   // ARABIC LIGATURE MEEM WITH ALEF MAKSURA ISOLATED FORM
+	0xFC4A:  "\u0645\u0649",                                                                   // ARABIC LIGATURE MEEM WITH YEH ISOLATED FORM
+	0xFC4B:  "\u0628\u062e",                                                                   // ARABIC LIGATURE NOON WITH JEEM ISOLATED FORM
+	0xFC4C:  "\u0646\u062d",                                                                   // ARABIC LIGATURE NOON WITH HAH ISOLATED FORM
+	0xFC4D:  "\u0646\u062e",                                                                   // ARABIC LIGATURE NOON WITH KHAH ISOLATED FORM
+	0xFC4E:  "\u0646\u0645",                                                                   // ARABIC LIGATURE NOON WITH MEEM ISOLATED FORM
+	0xFC4F:  "\u0646\u0649",                                                                   // ARABIC LIGATURE NOON WITH ALEF MAKSURA ISOLATED FORM
+	0xFC50:  "\u0646\u0649",                                                                   // ARABIC LIGATURE NOON WITH YEH ISOLATED FORM
+	0xFC51:  "o\u062c",                                                                        // ARABIC LIGATURE HEH WITH JEEM ISOLATED FORM
+	0xFC52:  "o\u0645",                                                                        // ARABIC LIGATURE HEH WITH MEEM ISOLATED FORM
+	0xFC53:  "o\u0649",                                                                        // ARABIC LIGATURE HEH WITH ALEF MAKSURA ISOLATED FORM
+	0xFC54:  "o\u0649",                                                                        // ARABIC LIGATURE HEH WITH YEH ISOLATED FORM
+	0xFC55:  "\u0649\u062c",                                                                   // ARABIC LIGATURE YEH WITH JEEM ISOLATED FORM
+	0xFC56:  "\u0649\u062d",                                                                   // ARABIC LIGATURE YEH WITH HAH ISOLATED FORM
+	0xFC57:  "\u0649\u062e",                                                                   // ARABIC LIGATURE YEH WITH KHAH ISOLATED FORM
+	0xFC58:  "\u0649\u0645",                                                                   // ARABIC LIGATURE YEH WITH MEEM ISOLATED FORM
+	0xFC59:  "\u0649\u0649",                                                                   // ARABIC LIGATURE YEH WITH ALEF MAKSURA ISOLATED FORM
+	0xFC5A:  "\u0649\u0649",                                                                   // ARABIC LIGATURE YEH WITH YEH ISOLATED FORM
+	0xFC5B:  "\u0630\u0670",                                                                   // ARABIC LIGATURE THAL WITH SUPERSCRIPT ALEF ISOLATED FORM
+	0xFC5C:  "\u0631\u0670",                                                                   // ARABIC LIGATURE REH WITH SUPERSCRIPT ALEF ISOLATED FORM
+	0xFC5D:  "\u0649\u0670",                                                                   // ARABIC LIGATURE ALEF MAKSURA WITH SUPERSCRIPT ALEF ISOLATED FORM
+	0xFC5E:  "\ufe72\u0651",                                                                   // ARABIC LIGATURE SHADDA WITH DAMMATAN ISOLATED FORM
+	0xFC5F:  "\ufe74\u0651",                                                                   // ARABIC LIGATURE SHADDA WITH KASRATAN ISOLATED FORM
+	0xFC60:  "\ufe76\u0651",                                                                   // ARABIC LIGATURE SHADDA WITH FATHA ISOLATED FORM
+	0xFC61:  "\ufe78\u0651",                                                                   // ARABIC LIGATURE SHADDA WITH DAMMA ISOLATED FORM
+	0xFC62:  "\ufe7a\u0651",                                                                   // ARABIC LIGATURE SHADDA WITH KASRA ISOLATED FORM
+	0xFC63:  "\ufe7c\u0670",                                                                   // ARABIC LIGATURE SHADDA WITH SUPERSCRIPT ALEF ISOLATED FORM
+	0xFC64:  "\u0649\u0674\u0631",                                                             // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH REH FINAL FORM
+	0xFC65:  "\u0649\u0674\u0632",                                                             // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH ZAIN FINAL FORM
+	0xFC66:  "\u0649\u0674\u0645",                                                             // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH MEEM FINAL FORM
+	0xFC67:  "\u0649\u0674\u0646",                                                             // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH NOON FINAL FORM
+	0xFC68:  "\u0649\u0674\u0649",                                                             // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH ALEF MAKSURA FINAL FORM
+	0xFC69:  "\u0649\u0674\u0649",                                                             // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH YEH FINAL FORM
+	0xFC6A:  "\u0628\u0631",                                                                   // ARABIC LIGATURE BEH WITH REH FINAL FORM
+	0xFC6B:  "\u0628\u0632",                                                                   // ARABIC LIGATURE BEH WITH ZAIN FINAL FORM
+	0xFC6C:  "\u0628\u0645",                                                                   // ARABIC LIGATURE BEH WITH MEEM FINAL FORM
+	0xFC6D:  "\u0628\u0646",                                                                   // ARABIC LIGATURE BEH WITH NOON FINAL FORM
+	0xFC6E:  "\u0628\u0649",                                                                   // ARABIC LIGATURE BEH WITH ALEF MAKSURA FINAL FORM
+	0xFC6F:  "\u0628\u0649",                                                                   // ARABIC LIGATURE BEH WITH YEH FINAL FORM
+	0xFC70:  "\u062a\u0631",                                                                   // ARABIC LIGATURE TEH WITH REH FINAL FORM
+	0xFC71:  "\u062a\u0632",                                                                   // ARABIC LIGATURE TEH WITH ZAIN FINAL FORM
+	0xFC72:  "\u062a\u0645",                                                                   // ARABIC LIGATURE TEH WITH MEEM FINAL FORM
+	0xFC73:  "\u062a\u0646",                                                                   // ARABIC LIGATURE TEH WITH NOON FINAL FORM
+	0xFC74:  "\u062a\u0649",                                                                   // ARABIC LIGATURE TEH WITH ALEF MAKSURA FINAL FORM
+	0xFC75:  "\u062a\u0649",                                                                   // ARABIC LIGATURE TEH WITH YEH FINAL FORM
+	0xFC76:  "\u0649\u06db\u0631",                                                             // ARABIC LIGATURE THEH WITH REH FINAL FORM
+	0xFC77:  "\u0649\u06db\u0632",                                                             // ARABIC LIGATURE THEH WITH ZAIN FINAL FORM
+	0xFC78:  "\u0649\u06db\u0645",                                                             // ARABIC LIGATURE THEH WITH MEEM FINAL FORM
+	0xFC79:  "\u0649\u06db\u0646",                                                             // ARABIC LIGATURE THEH WITH NOON FINAL FORM
+	0xFC7A:  "\u0649\u06db\u0649",                                                             // ARABIC LIGATURE THEH WITH ALEF MAKSURA FINAL FORM
+	0xFC7B:  "\u0649\u06db\u0649",                                                             // ARABIC LIGATURE THEH WITH YEH FINAL FORM
+	0xFC7C:  "\u0641\u0649",                                                                   // ARABIC LIGATURE FEH WITH ALEF MAKSURA FINAL FORM
+	0xFC7D:  "\u0641\u0649",                                                                   // ARABIC LIGATURE FEH WITH YEH FINAL FORM
+	0xFC7E:  "\u0642\u0649",                                                                   // ARABIC LIGATURE QAF WITH ALEF MAKSURA FINAL FORM
+	0xFC7F:  "\u0642\u0649",                                                                   // ARABIC LIGATURE QAF WITH YEH FINAL FORM
+	0xFC80:  "\u0643l",                                                                        // ARABIC LIGATURE KAF WITH ALEF FINAL FORM
+	0xFC81:  "\u0643\u0644",                                                                   // ARABIC LIGATURE KAF WITH LAM FINAL FORM
+	0xFC82:  "\u0643\u0645",                                                                   // ARABIC LIGATURE KAF WITH MEEM FINAL FORM
+	0xFC83:  "\u0643\u0649",                                                                   // ARABIC LIGATURE KAF WITH ALEF MAKSURA FINAL FORM
+	0xFC84:  "\u0643\u0649",                                                                   // ARABIC LIGATURE KAF WITH YEH FINAL FORM
+	0xFC85:  "\u0644\u0645",                                                                   // ARABIC LIGATURE LAM WITH MEEM FINAL FORM
+	0xFC86:  "\u0644\u0649",                                                                   // ARABIC LIGATURE LAM WITH ALEF MAKSURA FINAL FORM
+	0xFC87:  "\u0644\u0649",                                                                   // ARABIC LIGATURE LAM WITH YEH FINAL FORM
+	0xFC88:  "\u0645l",                                                                        // ARABIC LIGATURE MEEM WITH ALEF FINAL FORM
+	0xFC89:  "\u0645\u0645",                                                                   // ARABIC LIGATURE MEEM WITH MEEM FINAL FORM
+	0xFC8A:  "\u0646\u0631",                                                                   // ARABIC LIGATURE NOON WITH REH FINAL FORM
+	0xFC8B:  "\u0646\u0632",                                                                   // ARABIC LIGATURE NOON WITH ZAIN FINAL FORM
+	0xFC8C:  "\u0646\u0645",                                                                   // ARABIC LIGATURE NOON WITH MEEM FINAL FORM
+	0xFC8D:  "\u0646\u0646",                                                                   // ARABIC LIGATURE NOON WITH NOON FINAL FORM
+	0xFC8E:  "\u0646\u0649",                                                                   // ARABIC LIGATURE NOON WITH ALEF MAKSURA FINAL FORM
+	0xFC8F:  "\u0646\u0649",                                                                   // ARABIC LIGATURE NOON WITH YEH FINAL FORM
+	0xFC90:  "\u0649\u0670",                                                                   // ARABIC LIGATURE ALEF MAKSURA WITH SUPERSCRIPT ALEF FINAL FORM
+	0xFC91:  "\u0649\u0631",                                                                   // ARABIC LIGATURE YEH WITH REH FINAL FORM
+	0xFC92:  "\u0649\u0632",                                                                   // ARABIC LIGATURE YEH WITH ZAIN FINAL FORM
+	0xFC93:  "\u0649\u0645",                                                                   // ARABIC LIGATURE YEH WITH MEEM FINAL FORM
+	0xFC94:  "\u0649\u0646",                                                                   // ARABIC LIGATURE YEH WITH NOON FINAL FORM
+	0xFC95:  "\u0649\u0649",                                                                   // ARABIC LIGATURE YEH WITH ALEF MAKSURA FINAL FORM
+	0xFC96:  "\u0649\u0649",                                                                   // ARABIC LIGATURE YEH WITH YEH FINAL FORM
+	0xFC97:  "\u0649\u0674\u062c",                                                             // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH JEEM INITIAL FORM
+	0xFC98:  "\u0649\u0674\u062d",                                                             // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH HAH INITIAL FORM
+	0xFC99:  "\u0649\u0674\u062e",                                                             // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH KHAH INITIAL FORM
+	0xFC9A:  "\u0649\u0674\u0645",                                                             // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH MEEM INITIAL FORM
+	0xFC9B:  "\u0649\u0674o",                                                                  // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH HEH INITIAL FORM
+	0xFC9C:  "\u0628\u062c",                                                                   // ARABIC LIGATURE BEH WITH JEEM INITIAL FORM
+	0xFC9D:  "\u0628\u062d",                                                                   // ARABIC LIGATURE BEH WITH HAH INITIAL FORM
+	0xFC9E:  "\u0628\u062e",                                                                   // ARABIC LIGATURE BEH WITH KHAH INITIAL FORM
+	0xFC9F:  "\u0628\u0645",                                                                   // ARABIC LIGATURE BEH WITH MEEM INITIAL FORM
+	0xFCA0:  "\u0628o",                                                                        // ARABIC LIGATURE BEH WITH HEH INITIAL FORM
+	0xFCA1:  "\u062a\u062c",                                                                   // ARABIC LIGATURE TEH WITH JEEM INITIAL FORM
+	0xFCA2:  "\u062a\u062d",                                                                   // ARABIC LIGATURE TEH WITH HAH INITIAL FORM
+	0xFCA3:  "\u062a\u062e",                                                                   // ARABIC LIGATURE TEH WITH KHAH INITIAL FORM
+	0xFCA4:  "\u062a\u0645",                                                                   // ARABIC LIGATURE TEH WITH MEEM INITIAL FORM
+	0xFCA5:  "\u062ao",                                                                        // ARABIC LIGATURE TEH WITH HEH INITIAL FORM
+	0xFCA6:  "\u0649\u06db\u0645",                                                             // ARABIC LIGATURE THEH WITH MEEM INITIAL FORM
+	0xFCA7:  "\u062c\u062d",                                                                   // ARABIC LIGATURE JEEM WITH HAH INITIAL FORM
+	0xFCA8:  "\u062c\u0645",                                                                   // ARABIC LIGATURE JEEM WITH MEEM INITIAL FORM
+	0xFCA9:  "\u062d\u062c",                                                                   // ARABIC LIGATURE HAH WITH JEEM INITIAL FORM
+	0xFCAA:  "\u062d\u0645",                                                                   // ARABIC LIGATURE HAH WITH MEEM INITIAL FORM
+	0xFCAB:  "\u062e\u062c",                                                                   // ARABIC LIGATURE KHAH WITH JEEM INITIAL FORM
+	0xFCAC:  "\u062e\u0645",                                                                   // ARABIC LIGATURE KHAH WITH MEEM INITIAL FORM
+	0xFCAD:  "\u0633\u062c",                                                                   // ARABIC LIGATURE SEEN WITH JEEM INITIAL FORM
+	0xFCAE:  "\u0633\u062d",                                                                   // ARABIC LIGATURE SEEN WITH HAH INITIAL FORM
+	0xFCAF:  "\u0633\u062e",                                                                   // ARABIC LIGATURE SEEN WITH KHAH INITIAL FORM
+	0xFCB0:  "\u0633\u0645",                                                                   // ARABIC LIGATURE SEEN WITH MEEM INITIAL FORM
+	0xFCB1:  "\u0635\u062d",                                                                   // ARABIC LIGATURE SAD WITH HAH INITIAL FORM
+	0xFCB2:  "\u0635\u062e",                                                                   // ARABIC LIGATURE SAD WITH KHAH INITIAL FORM
+	0xFCB3:  "\u0635\u0645",                                                                   // ARABIC LIGATURE SAD WITH MEEM INITIAL FORM
+	0xFCB4:  "\u0636\u062c",                                                                   // ARABIC LIGATURE DAD WITH JEEM INITIAL FORM
+	0xFCB5:  "\u0636\u062d",                                                                   // ARABIC LIGATURE DAD WITH HAH INITIAL FORM
+	0xFCB6:  "\u0636\u062e",                                                                   // ARABIC LIGATURE DAD WITH KHAH INITIAL FORM
+	0xFCB7:  "\u0636\u0645",                                                                   // ARABIC LIGATURE DAD WITH MEEM INITIAL FORM
+	0xFCB8:  "\u0637\u062d",                                                                   // ARABIC LIGATURE TAH WITH HAH INITIAL FORM
+	0xFCB9:  "\u0638\u0645",                                                                   // ARABIC LIGATURE ZAH WITH MEEM INITIAL FORM
+	0xFCBA:  "\u0639\u062c",                                                                   // ARABIC LIGATURE AIN WITH JEEM INITIAL FORM
+	0xFCBB:  "\u0639\u0645",                                                                   // ARABIC LIGATURE AIN WITH MEEM INITIAL FORM
+	0xFCBC:  "\u063a\u062c",                                                                   // ARABIC LIGATURE GHAIN WITH JEEM INITIAL FORM
+	0xFCBD:  "\u063a\u0645",                                                                   // ARABIC LIGATURE GHAIN WITH MEEM INITIAL FORM
+	0xFCBE:  "\u0641\u062c",                                                                   // ARABIC LIGATURE FEH WITH JEEM INITIAL FORM
+	0xFCBF:  "\u0641\u062d",                                                                   // ARABIC LIGATURE FEH WITH HAH INITIAL FORM
+	0xFCC0:  "\u0641\u062e",                                                                   // ARABIC LIGATURE FEH WITH KHAH INITIAL FORM
+	0xFCC1:  "\u0641\u0645",                                                                   // ARABIC LIGATURE FEH WITH MEEM INITIAL FORM
+	0xFCC2:  "\u0642\u062d",                                                                   // ARABIC LIGATURE QAF WITH HAH INITIAL FORM
+	0xFCC3:  "\u0642\u0645",                                                                   // ARABIC LIGATURE QAF WITH MEEM INITIAL FORM
+	0xFCC4:  "\u0643\u062c",                                                                   // ARABIC LIGATURE KAF WITH JEEM INITIAL FORM
+	0xFCC5:  "\u0643\u062d",                                                                   // ARABIC LIGATURE KAF WITH HAH INITIAL FORM
+	0xFCC6:  "\u0643\u062e",                                                                   // ARABIC LIGATURE KAF WITH KHAH INITIAL FORM
+	0xFCC7:  "\u0643\u0644",                                                                   // ARABIC LIGATURE KAF WITH LAM INITIAL FORM
+	0xFCC8:  "\u0643\u0645",                                                                   // ARABIC LIGATURE KAF WITH MEEM INITIAL FORM
+	0xFCC9:  "\u0644\u062c",                                                                   // ARABIC LIGATURE LAM WITH JEEM INITIAL FORM
+	0xFCCA:  "\u0644\u062d",                                                                   // ARABIC LIGATURE LAM WITH HAH INITIAL FORM
+	0xFCCB:  "\u0644\u062e",                                                                   // ARABIC LIGATURE LAM WITH KHAH INITIAL FORM
+	0xFCCC:  "\u0644\u0645",                                                                   // ARABIC LIGATURE LAM WITH MEEM INITIAL FORM
+	0xFCCD:  "\u0644o",                                                                        // ARABIC LIGATURE LAM WITH HEH INITIAL FORM
+	0xFCCE:  "\u0645\u062c",                                                                   // ARABIC LIGATURE MEEM WITH JEEM INITIAL FORM
+	0xFCCF:  "\u0645\u062d",                                                                   // ARABIC LIGATURE MEEM WITH HAH INITIAL FORM
+	0xFCD0:  "\u0645\u062e",                                                                   // ARABIC LIGATURE MEEM WITH KHAH INITIAL FORM
+	0xFCD1:  "\u0645\u0645",                                                                   // ARABIC LIGATURE MEEM WITH MEEM INITIAL FORM
+	0xFCD2:  "\u0628\u062e",                                                                   // ARABIC LIGATURE NOON WITH JEEM INITIAL FORM
+	0xFCD3:  "\u0646\u062d",                                                                   // ARABIC LIGATURE NOON WITH HAH INITIAL FORM
+	0xFCD4:  "\u0646\u062e",                                                                   // ARABIC LIGATURE NOON WITH KHAH INITIAL FORM
+	0xFCD5:  "\u0646\u0645",                                                                   // ARABIC LIGATURE NOON WITH MEEM INITIAL FORM
+	0xFCD6:  "\u0646o",                                                                        // ARABIC LIGATURE NOON WITH HEH INITIAL FORM
+	0xFCD7:  "o\u062c",                                                                        // ARABIC LIGATURE HEH WITH JEEM INITIAL FORM
+	0xFCD8:  "o\u0645",                                                                        // ARABIC LIGATURE HEH WITH MEEM INITIAL FORM
+	0xFCD9:  "o\u0670",                                                                        // ARABIC LIGATURE HEH WITH SUPERSCRIPT ALEF INITIAL FORM
+	0xFCDA:  "\u0649\u062c",                                                                   // ARABIC LIGATURE YEH WITH JEEM INITIAL FORM
+	0xFCDB:  "\u0649\u062d",                                                                   // ARABIC LIGATURE YEH WITH HAH INITIAL FORM
+	0xFCDC:  "\u0649\u062e",                                                                   // ARABIC LIGATURE YEH WITH KHAH INITIAL FORM
+	0xFCDD:  "\u0649\u0645",                                                                   // ARABIC LIGATURE YEH WITH MEEM INITIAL FORM
+	0xFCDE:  "\u0649o",                                                                        // ARABIC LIGATURE YEH WITH HEH INITIAL FORM
+	0xFCDF:  "\u0649\u0674\u0645",                                                             // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH MEEM MEDIAL FORM
+	0xFCE0:  "\u0649\u0674o",                                                                  // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH HEH MEDIAL FORM
+	0xFCE1:  "\u0628\u0645",                                                                   // ARABIC LIGATURE BEH WITH MEEM MEDIAL FORM
+	0xFCE2:  "\u0628o",                                                                        // ARABIC LIGATURE BEH WITH HEH MEDIAL FORM
+	0xFCE3:  "\u062a\u0645",                                                                   // ARABIC LIGATURE TEH WITH MEEM MEDIAL FORM
+	0xFCE4:  "\u062ao",                                                                        // ARABIC LIGATURE TEH WITH HEH MEDIAL FORM
+	0xFCE5:  "\u0649\u06db\u0645",                                                             // ARABIC LIGATURE THEH WITH MEEM MEDIAL FORM
+	0xFCE6:  "\u0649\u06dbo",                                                                  // ARABIC LIGATURE THEH WITH HEH MEDIAL FORM
+	0xFCE7:  "\u0633\u0645",                                                                   // ARABIC LIGATURE SEEN WITH MEEM MEDIAL FORM
+	0xFCE8:  "\u0633o",                                                                        // ARABIC LIGATURE SEEN WITH HEH MEDIAL FORM
+	0xFCE9:  "\u0633\u06db\u0645",                                                             // ARABIC LIGATURE SHEEN WITH MEEM MEDIAL FORM
+	0xFCEA:  "\u0633\u06dbo",                                                                  // ARABIC LIGATURE SHEEN WITH HEH MEDIAL FORM
+	0xFCEB:  "\u0643\u0644",                                                                   // ARABIC LIGATURE KAF WITH LAM MEDIAL FORM
+	0xFCEC:  "\u0643\u0645",                                                                   // ARABIC LIGATURE KAF WITH MEEM MEDIAL FORM
+	0xFCED:  "\u0644\u0645",                                                                   // ARABIC LIGATURE LAM WITH MEEM MEDIAL FORM
+	0xFCEE:  "\u0646\u0645",                                                                   // ARABIC LIGATURE NOON WITH MEEM MEDIAL FORM
+	0xFCEF:  "\u0646o",                                                                        // ARABIC LIGATURE NOON WITH HEH MEDIAL FORM
+	0xFCF0:  "\u0649\u0645",                                                                   // ARABIC LIGATURE YEH WITH MEEM MEDIAL FORM
+	0xFCF1:  "\u0649o",                                                                        // ARABIC LIGATURE YEH WITH HEH MEDIAL FORM
+	0xFCF2:  "\ufe77\u0651",                                                                   // ARABIC LIGATURE SHADDA WITH FATHA MEDIAL FORM
+	0xFCF3:  "\ufe79\u0651",                                                                   // ARABIC LIGATURE SHADDA WITH DAMMA MEDIAL FORM
+	0xFCF4:  "\ufe7b\u0651",                                                                   // ARABIC LIGATURE SHADDA WITH KASRA MEDIAL FORM
+	0xFCF5:  "\u0637\u0649",                                                                   // ARABIC LIGATURE TAH WITH ALEF MAKSURA ISOLATED FORM
+	0xFCF6:  "\u0637\u0649",                                                                   // ARABIC LIGATURE TAH WITH YEH ISOLATED FORM
+	0xFCF7:  "\u0639\u0649",                                                                   // ARABIC LIGATURE AIN WITH ALEF MAKSURA ISOLATED FORM
+	0xFCF8:  "\u0639\u0649",                                                                   // ARABIC LIGATURE AIN WITH YEH ISOLATED FORM
+	0xFCF9:  "\u063a\u0649",                                                                   // ARABIC LIGATURE GHAIN WITH ALEF MAKSURA ISOLATED FORM
+	0xFCFA:  "\u063a\u0649",                                                                   // ARABIC LIGATURE GHAIN WITH YEH ISOLATED FORM
+	0xFCFB:  "\u0633\u0649",                                                                   // ARABIC LIGATURE SEEN WITH ALEF MAKSURA ISOLATED FORM
+	0xFCFC:  "\u0633\u0649",                                                                   // ARABIC LIGATURE SEEN WITH YEH ISOLATED FORM
+	0xFCFD:  "\u0633\u06db\u0649",                                                             // ARABIC LIGATURE SHEEN WITH ALEF MAKSURA ISOLATED FORM
+	0xFCFE:  "\u0633\u06db\u0649",                                                             // ARABIC LIGATURE SHEEN WITH YEH ISOLATED FORM
+	0xFCFF:  "\u062d\u0649",                                                                   // ARABIC LIGATURE HAH WITH ALEF MAKSURA ISOLATED FORM
+	0xFD00:  "\u062d\u0649",                                                                   // ARABIC LIGATURE HAH WITH YEH ISOLATED FORM
+	0xFD01:  "\u062c\u0649",                                                                   // ARABIC LIGATURE JEEM WITH ALEF MAKSURA ISOLATED FORM
+	0xFD02:  "\u062c\u0649",                                                                   // ARABIC LIGATURE JEEM WITH YEH ISOLATED FORM
+	0xFD03:  "\u062e\u0649",                                                                   // ARABIC LIGATURE KHAH WITH ALEF MAKSURA ISOLATED FORM
+	0xFD04:  "\u062e\u0649",                                                                   // ARABIC LIGATURE KHAH WITH YEH ISOLATED FORM
+	0xFD05:  "\u0635\u0649",                                                                   // ARABIC LIGATURE SAD WITH ALEF MAKSURA ISOLATED FORM
+	0xFD06:  "\u0635\u0649",                                                                   // ARABIC LIGATURE SAD WITH YEH ISOLATED FORM
+	0xFD07:  "\u0636\u0649",                                                                   // ARABIC LIGATURE DAD WITH ALEF MAKSURA ISOLATED FORM
+	0xFD08:  "\u0636\u0649",                                                                   // ARABIC LIGATURE DAD WITH YEH ISOLATED FORM
+	0xFD09:  "\u0633\u06db\u062c",                                                             // ARABIC LIGATURE SHEEN WITH JEEM ISOLATED FORM
+	0xFD0A:  "\u0633\u06db\u062d",                                                             // ARABIC LIGATURE SHEEN WITH HAH ISOLATED FORM
+	0xFD0B:  "\u0633\u06db\u062e",                                                             // ARABIC LIGATURE SHEEN WITH KHAH ISOLATED FORM
+	0xFD0C:  "\u0633\u06db\u0645",                                                             // ARABIC LIGATURE SHEEN WITH MEEM ISOLATED FORM
+	0xFD0D:  "\u0633\u06db\u0631",                                                             // ARABIC LIGATURE SHEEN WITH REH ISOLATED FORM
+	0xFD0E:  "\u0633\u0631",                                                                   // ARABIC LIGATURE SEEN WITH REH ISOLATED FORM
+	0xFD0F:  "\u0635\u0631",                                                                   // ARABIC LIGATURE SAD WITH REH ISOLATED FORM
+	0xFD10:  "\u0636\u0631",                                                                   // ARABIC LIGATURE DAD WITH REH ISOLATED FORM
+	0xFD11:  "\u0637\u0649",                                                                   // ARABIC LIGATURE TAH WITH ALEF MAKSURA FINAL FORM
+	0xFD12:  "\u0637\u0649",                                                                   // ARABIC LIGATURE TAH WITH YEH FINAL FORM
+	0xFD13:  "\u0639\u0649",                                                                   // ARABIC LIGATURE AIN WITH ALEF MAKSURA FINAL FORM
+	0xFD14:  "\u0639\u0649",                                                                   // ARABIC LIGATURE AIN WITH YEH FINAL FORM
+	0xFD15:  "\u063a\u0649",                                                                   // ARABIC LIGATURE GHAIN WITH ALEF MAKSURA FINAL FORM
+	0xFD16:  "\u063a\u0649",                                                                   // ARABIC LIGATURE GHAIN WITH YEH FINAL FORM
+	0xFD17:  "\u0633\u0649",                                                                   // ARABIC LIGATURE SEEN WITH ALEF MAKSURA FINAL FORM
+	0xFD18:  "\u0633\u0649",                                                                   // ARABIC LIGATURE SEEN WITH YEH FINAL FORM
+	0xFD19:  "\u0633\u06db\u0649",                                                             // ARABIC LIGATURE SHEEN WITH ALEF MAKSURA FINAL FORM
+	0xFD1A:  "\u0633\u06db\u0649",                                                             // ARABIC LIGATURE SHEEN WITH YEH FINAL FORM
+	0xFD1B:  "\u062d\u0649",                                                                   // ARABIC LIGATURE HAH WITH ALEF MAKSURA FINAL FORM
+	0xFD1C:  "\u062d\u0649",                                                                   // ARABIC LIGATURE HAH WITH YEH FINAL FORM
+	0xFD1D:  "\u062c\u0649",                                                                   // ARABIC LIGATURE JEEM WITH ALEF MAKSURA FINAL FORM
+	0xFD1E:  "\u062c\u0649",                                                                   // ARABIC LIGATURE JEEM WITH YEH FINAL FORM
+	0xFD1F:  "\u062e\u0649",                                                                   // ARABIC LIGATURE KHAH WITH ALEF MAKSURA FINAL FORM
+	0xFD20:  "\u062e\u0649",                                                                   // ARABIC LIGATURE KHAH WITH YEH FINAL FORM
+	0xFD21:  "\u0635\u0649",                                                                   // ARABIC LIGATURE SAD WITH ALEF MAKSURA FINAL FORM
+	0xFD22:  "\u0635\u0649",                                                                   // ARABIC LIGATURE SAD WITH YEH FINAL FORM
+	0xFD23:  "\u0636\u0649",                                                                   // ARABIC LIGATURE DAD WITH ALEF MAKSURA FINAL FORM
+	0xFD24:  "\u0636\u0649",                                                                   // ARABIC LIGATURE DAD WITH YEH FINAL FORM
+	0xFD25:  "\u0633\u06db\u062c",                                                             // ARABIC LIGATURE SHEEN WITH JEEM FINAL FORM
+	0xFD26:  "\u0633\u06db\u062d",                                                             // ARABIC LIGATURE SHEEN WITH HAH FINAL FORM
+	0xFD27:  "\u0633\u06db\u062e",                                                             // ARABIC LIGATURE SHEEN WITH KHAH FINAL FORM
+	0xFD28:  "\u0633\u06db\u0645",                                                             // ARABIC LIGATURE SHEEN WITH MEEM FINAL FORM
+	0xFD29:  "\u0633\u06db\u0631",                                                             // ARABIC LIGATURE SHEEN WITH REH FINAL FORM
+	0xFD2A:  "\u0633\u0631",                                                                   // ARABIC LIGATURE SEEN WITH REH FINAL FORM
+	0xFD2B:  "\u0635\u0631",                                                                   // ARABIC LIGATURE SAD WITH REH FINAL FORM
+	0xFD2C:  "\u0636\u0631",                                                                   // ARABIC LIGATURE DAD WITH REH FINAL FORM
+	0xFD2D:  "\u0633\u06db\u062c",                                                             // ARABIC LIGATURE SHEEN WITH JEEM INITIAL FORM
+	0xFD2E:  "\u0633\u06db\u062d",                                                             // ARABIC LIGATURE SHEEN WITH HAH INITIAL FORM
+	0xFD2F:  "\u0633\u06db\u062e",                                                             // ARABIC LIGATURE SHEEN WITH KHAH INITIAL FORM
+	0xFD30:  "\u0633\u06db\u0645",                                                             // ARABIC LIGATURE SHEEN WITH MEEM INITIAL FORM
+	0xFD31:  "\u0633o",                                                                        // ARABIC LIGATURE SEEN WITH HEH INITIAL FORM
+	0xFD32:  "\u0633\u06dbo",                                                                  // ARABIC LIGATURE SHEEN WITH HEH INITIAL FORM
+	0xFD33:  "\u0637\u0645",                                                                   // ARABIC LIGATURE TAH WITH MEEM INITIAL FORM
+	0xFD34:  "\u0633\u062c",                                                                   // ARABIC LIGATURE SEEN WITH JEEM MEDIAL FORM
+	0xFD35:  "\u0633\u062d",                                                                   // ARABIC LIGATURE SEEN WITH HAH MEDIAL FORM
+	0xFD36:  "\u0633\u062e",                                                                   // ARABIC LIGATURE SEEN WITH KHAH MEDIAL FORM
+	0xFD37:  "\u0633\u06db\u062c",                                                             // ARABIC LIGATURE SHEEN WITH JEEM MEDIAL FORM
+	0xFD38:  "\u0633\u06db\u062d",                                                             // ARABIC LIGATURE SHEEN WITH HAH MEDIAL FORM
+	0xFD39:  "\u0633\u06db\u062e",                                                             // ARABIC LIGATURE SHEEN WITH KHAH MEDIAL FORM
+	0xFD3A:  "\u0637\u0645",                                                                   // ARABIC LIGATURE TAH WITH MEEM MEDIAL FORM
+	0xFD3B:  "\u0638\u0645",                                                                   // ARABIC LIGATURE ZAH WITH MEEM MEDIAL FORM
+	0xFD3C:  "l\u030b",                                                                        // ARABIC LIGATURE ALEF WITH FATHATAN FINAL FORM
+	0xFD3D:  "l\u030b",                                                                        // ARABIC LIGATURE ALEF WITH FATHATAN ISOLATED FORM
+	0xFD3E:  "(",                                                                              // ORNATE LEFT PARENTHESIS
+	0xFD3F:  ")",                                                                              // ORNATE RIGHT PARENTHESIS
+	0xFD50:  "\u062a\u062c\u0645",                                                             // ARABIC LIGATURE TEH WITH JEEM WITH MEEM INITIAL FORM
+	0xFD51:  "\u062a\u062d\u062c",                                                             // ARABIC LIGATURE TEH WITH HAH WITH JEEM FINAL FORM
+	0xFD52:  "\u062a\u062d\u062c",                                                             // ARABIC LIGATURE TEH WITH HAH WITH JEEM INITIAL FORM
+	0xFD53:  "\u062a\u062d\u0645",                                                             // ARABIC LIGATURE TEH WITH HAH WITH MEEM INITIAL FORM
+	0xFD54:  "\u062a\u062e\u0645",                                                             // ARABIC LIGATURE TEH WITH KHAH WITH MEEM INITIAL FORM
+	0xFD55:  "\u062a\u0645\u062c",                                                             // ARABIC LIGATURE TEH WITH MEEM WITH JEEM INITIAL FORM
+	0xFD56:  "\u062a\u0645\u062d",                                                             // ARABIC LIGATURE TEH WITH MEEM WITH HAH INITIAL FORM
+	0xFD57:  "\u062a\u0645\u062e",                                                             // ARABIC LIGATURE TEH WITH MEEM WITH KHAH INITIAL FORM
+	0xFD58:  "\u062c\u0645\u062d",                                                             // ARABIC LIGATURE JEEM WITH MEEM WITH HAH FINAL FORM
+	0xFD59:  "\u062c\u0645\u062d",                                                             // ARABIC LIGATURE JEEM WITH MEEM WITH HAH INITIAL FORM
+	0xFD5A:  "\u062d\u0645\u0649",                                                             // ARABIC LIGATURE HAH WITH MEEM WITH YEH FINAL FORM
+	0xFD5B:  "\u062d\u0645\u0649",                                                             // ARABIC LIGATURE HAH WITH MEEM WITH ALEF MAKSURA FINAL FORM
+	0xFD5C:  "\u0633\u062d\u062c",                                                             // ARABIC LIGATURE SEEN WITH HAH WITH JEEM INITIAL FORM
+	0xFD5D:  "\u0633\u062c\u062d",                                                             // ARABIC LIGATURE SEEN WITH JEEM WITH HAH INITIAL FORM
+	0xFD5E:  "\u0633\u062c\u0649",                                                             // ARABIC LIGATURE SEEN WITH JEEM WITH ALEF MAKSURA FINAL FORM
+	0xFD5F:  "\u0633\u0645\u062d",                                                             // ARABIC LIGATURE SEEN WITH MEEM WITH HAH FINAL FORM
+	0xFD60:  "\u0633\u0645\u062d",                                                             // ARABIC LIGATURE SEEN WITH MEEM WITH HAH INITIAL FORM
+	0xFD61:  "\u0633\u0645\u062c",                                                             // ARABIC LIGATURE SEEN WITH MEEM WITH JEEM INITIAL FORM
+	0xFD62:  "\u0633\u0645\u0645",                                                             // ARABIC LIGATURE SEEN WITH MEEM WITH MEEM FINAL FORM
+	0xFD63:  "\u0633\u0645\u0645",                                                             // ARABIC LIGATURE SEEN WITH MEEM WITH MEEM INITIAL FORM
+	0xFD64:  "\u0635\u062d\u062d",                                                             // ARABIC LIGATURE SAD WITH HAH WITH HAH FINAL FORM
+	0xFD65:  "\u0635\u062d\u062d",                                                             // ARABIC LIGATURE SAD WITH HAH WITH HAH INITIAL FORM
+	0xFD66:  "\u0635\u0645\u0645",                                                             // ARABIC LIGATURE SAD WITH MEEM WITH MEEM FINAL FORM
+	0xFD67:  "\u0633\u06db\u062d\u0645",                                                       // ARABIC LIGATURE SHEEN WITH HAH WITH MEEM FINAL FORM
+	0xFD68:  "\u0633\u06db\u062d\u0645",                                                       // ARABIC LIGATURE SHEEN WITH HAH WITH MEEM INITIAL FORM
+	0xFD69:  "\u0633\u06db\u062c\u0649",                                                       // ARABIC LIGATURE SHEEN WITH JEEM WITH YEH FINAL FORM
+	0xFD6A:  "\u0633\u06db\u0645\u062e",                                                       // ARABIC LIGATURE SHEEN WITH MEEM WITH KHAH FINAL FORM
+	0xFD6B:  "\u0633\u06db\u0645\u062e",                                                       // ARABIC LIGATURE SHEEN WITH MEEM WITH KHAH INITIAL FORM
+	0xFD6C:  "\u0633\u06db\u0645\u0645",                                                       // ARABIC LIGATURE SHEEN WITH MEEM WITH MEEM FINAL FORM
+	0xFD6D:  "\u0633\u06db\u0645\u0645",                                                       // ARABIC LIGATURE SHEEN WITH MEEM WITH MEEM INITIAL FORM
+	0xFD6E:  "\u0636\u062d\u0649",                                                             // ARABIC LIGATURE DAD WITH HAH WITH ALEF MAKSURA FINAL FORM
+	0xFD6F:  "\u0636\u062e\u0645",                                                             // ARABIC LIGATURE DAD WITH KHAH WITH MEEM FINAL FORM
+	0xFD70:  "\u0636\u062e\u0645",                                                             // ARABIC LIGATURE DAD WITH KHAH WITH MEEM INITIAL FORM
+	0xFD71:  "\u0637\u0645\u062d",                                                             // ARABIC LIGATURE TAH WITH MEEM WITH HAH FINAL FORM
+	0xFD72:  "\u0637\u0645\u062d",                                                             // ARABIC LIGATURE TAH WITH MEEM WITH HAH INITIAL FORM
+	0xFD73:  "\u0637\u0645\u0645",                                                             // ARABIC LIGATURE TAH WITH MEEM WITH MEEM INITIAL FORM
+	0xFD74:  "\u0637\u0645\u0649",                                                             // ARABIC LIGATURE TAH WITH MEEM WITH YEH FINAL FORM
+	0xFD75:  "\u0639\u062c\u0645",                                                             // ARABIC LIGATURE AIN WITH JEEM WITH MEEM FINAL FORM
+	0xFD76:  "\u0639\u0645\u0645",                                                             // ARABIC LIGATURE AIN WITH MEEM WITH MEEM FINAL FORM
+	0xFD77:  "\u0639\u0645\u0645",                                                             // ARABIC LIGATURE AIN WITH MEEM WITH MEEM INITIAL FORM
+	0xFD78:  "\u0639\u0645\u0649",                                                             // ARABIC LIGATURE AIN WITH MEEM WITH ALEF MAKSURA FINAL FORM
+	0xFD79:  "\u063a\u0645\u0645",                                                             // ARABIC LIGATURE GHAIN WITH MEEM WITH MEEM FINAL FORM
+	0xFD7A:  "\u063a\u0645\u0649",                                                             // ARABIC LIGATURE GHAIN WITH MEEM WITH YEH FINAL FORM
+	0xFD7B:  "\u063a\u0645\u0649",                                                             // ARABIC LIGATURE GHAIN WITH MEEM WITH ALEF MAKSURA FINAL FORM
+	0xFD7C:  "\u0641\u062e\u0645",                                                             // ARABIC LIGATURE FEH WITH KHAH WITH MEEM FINAL FORM
+	0xFD7D:  "\u0641\u062e\u0645",                                                             // ARABIC LIGATURE FEH WITH KHAH WITH MEEM INITIAL FORM
+	0xFD7E:  "\u0642\u0645\u062d",                                                             // ARABIC LIGATURE QAF WITH MEEM WITH HAH FINAL FORM
+	0xFD7F:  "\u0642\u0645\u0645",                                                             // ARABIC LIGATURE QAF WITH MEEM WITH MEEM FINAL FORM
+	0xFD80:  "\u0644\u062d\u0645",                                                             // ARABIC LIGATURE LAM WITH HAH WITH MEEM FINAL FORM
+	0xFD81:  "\u0644\u062d\u0649",                                                             // ARABIC LIGATURE LAM WITH HAH WITH YEH FINAL FORM
+	0xFD82:  "\u0644\u062d\u0649",                                                             // ARABIC LIGATURE LAM WITH HAH WITH ALEF MAKSURA FINAL FORM
+	0xFD83:  "\u0644\u062c\u062c",                                                             // ARABIC LIGATURE LAM WITH JEEM WITH JEEM INITIAL FORM
+	0xFD84:  "\u0644\u062c\u062c",                                                             // ARABIC LIGATURE LAM WITH JEEM WITH JEEM FINAL FORM
+	0xFD85:  "\u0644\u062e\u0645",                                                             // ARABIC LIGATURE LAM WITH KHAH WITH MEEM FINAL FORM
+	0xFD86:  "\u0644\u062e\u0645",                                                             // ARABIC LIGATURE LAM WITH KHAH WITH MEEM INITIAL FORM
+	0xFD87:  "\u0644\u0645\u062d",                                                             // ARABIC LIGATURE LAM WITH MEEM WITH HAH FINAL FORM
+	0xFD88:  "\u0644\u0645\u062d",                                                             // ARABIC LIGATURE LAM WITH MEEM WITH HAH INITIAL FORM
+	0xFD89:  "\u0645\u062d\u062c",                                                             // ARABIC LIGATURE MEEM WITH HAH WITH JEEM INITIAL FORM
+	0xFD8A:  "\u0645\u062d\u0645",                                                             // ARABIC LIGATURE MEEM WITH HAH WITH MEEM INITIAL FORM
+	0xFD8B:  "\u0645\u062d\u0649",                                                             // ARABIC LIGATURE MEEM WITH HAH WITH YEH FINAL FORM
+	0xFD8C:  "\u0645\u062c\u062d",                                                             // ARABIC LIGATURE MEEM WITH JEEM WITH HAH INITIAL FORM
+	0xFD8D:  "\u0645\u062c\u0645",                                                             // ARABIC LIGATURE MEEM WITH JEEM WITH MEEM INITIAL FORM
+	0xFD8E:  "\u0645\u062e\u062c",                                                             // ARABIC LIGATURE MEEM WITH KHAH WITH JEEM INITIAL FORM
+	0xFD8F:  "\u0645\u062e\u0645",                                                             // ARABIC LIGATURE MEEM WITH KHAH WITH MEEM INITIAL FORM
+	0xFD92:  "\u0645\u062c\u062e",                                                             // ARABIC LIGATURE MEEM WITH JEEM WITH KHAH INITIAL FORM
+	0xFD93:  "o\u0645\u062c",                                                                  // ARABIC LIGATURE HEH WITH MEEM WITH JEEM INITIAL FORM
+	0xFD94:  "o\u0645\u0645",                                                                  // ARABIC LIGATURE HEH WITH MEEM WITH MEEM INITIAL FORM
+	0xFD95:  "\u0646\u062d\u0645",                                                             // ARABIC LIGATURE NOON WITH HAH WITH MEEM INITIAL FORM
+	0xFD96:  "\u0646\u062d\u0649",                                                             // ARABIC LIGATURE NOON WITH HAH WITH ALEF MAKSURA FINAL FORM
+	0xFD97:  "\u0646\u062c\u0645",                                                             // ARABIC LIGATURE NOON WITH JEEM WITH MEEM FINAL FORM
+	0xFD98:  "\u0646\u062c\u0645",                                                             // ARABIC LIGATURE NOON WITH JEEM WITH MEEM INITIAL FORM
+	0xFD99:  "\u0646\u062c\u0649",                                                             // ARABIC LIGATURE NOON WITH JEEM WITH ALEF MAKSURA FINAL FORM
+	0xFD9A:  "\u0646\u0645\u0649",                                                             // ARABIC LIGATURE NOON WITH MEEM WITH YEH FINAL FORM
+	0xFD9B:  "\u0646\u0645\u0649",                                                             // ARABIC LIGATURE NOON WITH MEEM WITH ALEF MAKSURA FINAL FORM
+	0xFD9C:  "\u0649\u0645\u0645",                                                             // ARABIC LIGATURE YEH WITH MEEM WITH MEEM FINAL FORM
+	0xFD9D:  "\u0649\u0645\u0645",                                                             // ARABIC LIGATURE YEH WITH MEEM WITH MEEM INITIAL FORM
+	0xFD9E:  "\u0628\u062e\u0649",                                                             // ARABIC LIGATURE BEH WITH KHAH WITH YEH FINAL FORM
+	0xFD9F:  "\u062a\u062c\u0649",                                                             // ARABIC LIGATURE TEH WITH JEEM WITH YEH FINAL FORM
+	0xFDA0:  "\u062a\u062c\u0649",                                                             // ARABIC LIGATURE TEH WITH JEEM WITH ALEF MAKSURA FINAL FORM
+	0xFDA1:  "\u062a\u062e\u0649",                                                             // ARABIC LIGATURE TEH WITH KHAH WITH YEH FINAL FORM
+	0xFDA2:  "\u062a\u062e\u0649",                                                             // ARABIC LIGATURE TEH WITH KHAH WITH ALEF MAKSURA FINAL FORM
+	0xFDA3:  "\u062a\u0645\u0649",                                                             // ARABIC LIGATURE TEH WITH MEEM WITH YEH FINAL FORM
+	0xFDA4:  "\u062a\u0645\u0649",                                                             // ARABIC LIGATURE TEH WITH MEEM WITH ALEF MAKSURA FINAL FORM
+	0xFDA5:  "\u062c\u0645\u0649",                                                             // ARABIC LIGATURE JEEM WITH MEEM WITH YEH FINAL FORM
+	0xFDA6:  "\u062c\u062d\u0649",                                                             // ARABIC LIGATURE JEEM WITH HAH WITH ALEF MAKSURA FINAL FORM
+	0xFDA7:  "\u062c\u0645\u0649",                                                             // ARABIC LIGATURE JEEM WITH MEEM WITH ALEF MAKSURA FINAL FORM
+	0xFDA8:  "\u0633\u062e\u0649",                                                             // ARABIC LIGATURE SEEN WITH KHAH WITH ALEF MAKSURA FINAL FORM
+	0xFDA9:  "\u0635\u062d\u0649",                                                             // ARABIC LIGATURE SAD WITH HAH WITH YEH FINAL FORM
+	0xFDAA:  "\u0633\u06db\u062d\u0649",                                                       // ARABIC LIGATURE SHEEN WITH HAH WITH YEH FINAL FORM
+	0xFDAB:  "\u0636\u062d\u0649",                                                             // ARABIC LIGATURE DAD WITH HAH WITH YEH FINAL FORM
+	0xFDAC:  "\u0644\u062c\u0649",                                                             // ARABIC LIGATURE LAM WITH JEEM WITH YEH FINAL FORM
+	0xFDAD:  "\u0644\u0645\u0649",                                                             // ARABIC LIGATURE LAM WITH MEEM WITH YEH FINAL FORM
+	0xFDAE:  "\u0649\u062d\u0649",                                                             // ARABIC LIGATURE YEH WITH HAH WITH YEH FINAL FORM
+	0xFDAF:  "\u0649\u062c\u0649",                                                             // ARABIC LIGATURE YEH WITH JEEM WITH YEH FINAL FORM
+	0xFDB0:  "\u0649\u0645\u0649",                                                             // ARABIC LIGATURE YEH WITH MEEM WITH YEH FINAL FORM
+	0xFDB1:  "\u0645\u0645\u0649",                                                             // ARABIC LIGATURE MEEM WITH MEEM WITH YEH FINAL FORM
+	0xFDB2:  "\u0642\u0645\u0649",                                                             // ARABIC LIGATURE QAF WITH MEEM WITH YEH FINAL FORM
+	0xFDB3:  "\u0646\u062d\u0649",                                                             // ARABIC LIGATURE NOON WITH HAH WITH YEH FINAL FORM
+	0xFDB4:  "\u0642\u0645\u062d",                                                             // ARABIC LIGATURE QAF WITH MEEM WITH HAH INITIAL FORM
+	0xFDB5:  "\u0644\u062d\u0645",                                                             // ARABIC LIGATURE LAM WITH HAH WITH MEEM INITIAL FORM
+	0xFDB6:  "\u0639\u0645\u0649",                                                             // ARABIC LIGATURE AIN WITH MEEM WITH YEH FINAL FORM
+	0xFDB7:  "\u0643\u0645\u0649",                                                             // ARABIC LIGATURE KAF WITH MEEM WITH YEH FINAL FORM
+	0xFDB8:  "\u0646\u062c\u062d",                                                             // ARABIC LIGATURE NOON WITH JEEM WITH HAH INITIAL FORM
+	0xFDB9:  "\u0645\u062e\u0649",                                                             // ARABIC LIGATURE MEEM WITH KHAH WITH YEH FINAL FORM
+	0xFDBA:  "\u0644\u062c\u0645",                                                             // ARABIC LIGATURE LAM WITH JEEM WITH MEEM INITIAL FORM
+	0xFDBB:  "\u0643\u0645\u0645",                                                             // ARABIC LIGATURE KAF WITH MEEM WITH MEEM FINAL FORM
+	0xFDBC:  "\u0644\u062c\u0645",                                                             // ARABIC LIGATURE LAM WITH JEEM WITH MEEM FINAL FORM
+	0xFDBD:  "\u0646\u062c\u062d",                                                             // ARABIC LIGATURE NOON WITH JEEM WITH HAH FINAL FORM
+	0xFDBE:  "\u062c\u062d\u0649",                                                             // ARABIC LIGATURE JEEM WITH HAH WITH YEH FINAL FORM
+	0xFDBF:  "\u062d\u062c\u0649",                                                             // ARABIC LIGATURE HAH WITH JEEM WITH YEH FINAL FORM
+	0xFDC0:  "\u0645\u062c\u0649",                                                             // ARABIC LIGATURE MEEM WITH JEEM WITH YEH FINAL FORM
+	0xFDC1:  "\u0641\u0645\u0649",                                                             // ARABIC LIGATURE FEH WITH MEEM WITH YEH FINAL FORM
+	0xFDC2:  "\u0628\u062d\u0649",                                                             // ARABIC LIGATURE BEH WITH HAH WITH YEH FINAL FORM
+	0xFDC3:  "\u0643\u0645\u0645",                                                             // ARABIC LIGATURE KAF WITH MEEM WITH MEEM INITIAL FORM
+	0xFDC4:  "\u0639\u062c\u0645",                                                             // ARABIC LIGATURE AIN WITH JEEM WITH MEEM INITIAL FORM
+	0xFDC5:  "\u0635\u0645\u0645",                                                             // ARABIC LIGATURE SAD WITH MEEM WITH MEEM INITIAL FORM
+	0xFDC6:  "\u0633\u062e\u0649",                                                             // ARABIC LIGATURE SEEN WITH KHAH WITH YEH FINAL FORM
+	0xFDC7:  "\u0646\u062c\u0649",                                                             // ARABIC LIGATURE NOON WITH JEEM WITH YEH FINAL FORM
+	0xFDF0:  "\u0635\u0644\u0649",                                                             // ARABIC LIGATURE SALLA USED AS KORANIC STOP SIGN ISOLATED FORM
+	0xFDF1:  "\u0642\u0644\u0649",                                                             // ARABIC LIGATURE QALA USED AS KORANIC STOP SIGN ISOLATED FORM
+	0xFDF2:  "l\u0644\u0644\u0651\u0670o",                                                     // ARABIC LIGATURE ALLAH ISOLATED FORM
+	0xFDF3:  "l\u0643\u0628\u0631",                                                            // ARABIC LIGATURE AKBAR ISOLATED FORM
+	0xFDF4:  "\u0645\u062d\u0645\u062f",                                                       // ARABIC LIGATURE MOHAMMAD ISOLATED FORM
+	0xFDF5:  "\u0635\u0644\u0639\u0645",                                                       // ARABIC LIGATURE SALAM ISOLATED FORM
+	0xFDF6:  "\u0631\u0633\u0648\u0644",                                                       // ARABIC LIGATURE RASOUL ISOLATED FORM
+	0xFDF7:  "\u0639\u0644\u0649o",                                                            // ARABIC LIGATURE ALAYHE ISOLATED FORM
+	0xFDF8:  "\u0648\u0633\u0644\u0645",                                                       // ARABIC LIGATURE WASALLAM ISOLATED FORM
+	0xFDF9:  "\u0635\u0644\u0649",                                                             // ARABIC LIGATURE SALLA ISOLATED FORM
+	0xFDFA:  "\u0635\u0644\u0649 l\u0644\u0644o \u0639\u0644\u0649o \u0648\u0633\u0644\u0645", // ARABIC LIGATURE SALLALLAHOU ALAYHE WASALLAM
+	0xFDFB:  "\u062c\u0644 \u062c\u0644l\u0644o",                                              // ARABIC LIGATURE JALLAJALALOUHOU
+	0xFDFC:  "\u0631\u0649l\u0644",                                                            // RIAL SIGN
+	0xFE19:  "\u2d57",                                                                         // PRESENTATION FORM FOR VERTICAL HORIZONTAL ELLIPSIS
+	0xFE30:  ":",                                                                              // PRESENTATION FORM FOR VERTICAL TWO DOT LEADER
+	0xFE31:  "\u2502",                                                                         // PRESENTATION FORM FOR VERTICAL EM DASH
+	0xFE34:  "\u2307",                                                                         // PRESENTATION FORM FOR VERTICAL WAVY LOW LINE
+	0xFE35:  "\u23dc",                                                                         // PRESENTATION FORM FOR VERTICAL LEFT PARENTHESIS
+	0xFE36:  "\u23dd",                                                                         // PRESENTATION FORM FOR VERTICAL RIGHT PARENTHESIS
+	0xFE37:  "\u23de",                                                                         // PRESENTATION FORM FOR VERTICAL LEFT CURLY BRACKET
+	0xFE38:  "\u23df",                                                                         // PRESENTATION FORM FOR VERTICAL RIGHT CURLY BRACKET
+	0xFE39:  "\u23e0",                                                                         // PRESENTATION FORM FOR VERTICAL LEFT TORTOISE SHELL BRACKET
+	0xFE3A:  "\u23e1",                                                                         // PRESENTATION FORM FOR VERTICAL RIGHT TORTOISE SHELL BRACKET
+	0xFE49:  "\u02c9",                                                                         // DASHED OVERLINE
+	0xFE4A:  "\u02c9",                                                                         // CENTRELINE OVERLINE
+	0xFE4B:  "\u02c9",                                                                         // WAVY OVERLINE
+	0xFE4C:  "\u02c9",                                                                         // DOUBLE WAVY OVERLINE
+	0xFE4D:  "_",                                                                              // DASHED LOW LINE
+	0xFE4E:  "_",                                                                              // CENTRELINE LOW LINE
+	0xFE4F:  "_",                                                                              // WAVY LOW LINE
+	0xFE58:  "-",                                                                              // SMALL EM DASH
+	0xFE68:  "\\",                                                                             // SMALL REVERSE SOLIDUS
+	0xFE80:  "\u0621",                                                                         // ARABIC LETTER HAMZA ISOLATED FORM
+	0xFE81:  "\u0622",                                                                         // ARABIC LETTER ALEF WITH MADDA ABOVE ISOLATED FORM
+	0xFE82:  "\u0622",                                                                         // ARABIC LETTER ALEF WITH MADDA ABOVE FINAL FORM
+	0xFE83:  "l\u0674",                                                                        // ARABIC LETTER ALEF WITH HAMZA ABOVE ISOLATED FORM
+	0xFE84:  "l\u0674",                                                                        // ARABIC LETTER ALEF WITH HAMZA ABOVE FINAL FORM
+	0xFE85:  "\u0648\u0674",                                                                   // ARABIC LETTER WAW WITH HAMZA ABOVE ISOLATED FORM
+	0xFE86:  "\u0648\u0674",                                                                   // ARABIC LETTER WAW WITH HAMZA ABOVE FINAL FORM
+	0xFE87:  "l\u0655",                                                                        // ARABIC LETTER ALEF WITH HAMZA BELOW ISOLATED FORM
+	0xFE88:  "l\u0655",                                                                        // ARABIC LETTER ALEF WITH HAMZA BELOW FINAL FORM
+	0xFE89:  "\u0649\u0674",                                                                   // ARABIC LETTER YEH WITH HAMZA ABOVE ISOLATED FORM
+	0xFE8A:  "\u0649\u0674",                                                                   // ARABIC LETTER YEH WITH HAMZA ABOVE FINAL FORM
+	0xFE8B:  "\u0649\u0674",                                                                   // ARABIC LETTER YEH WITH HAMZA ABOVE INITIAL FORM
+	0xFE8C:  "\u0649\u0674",                                                                   // ARABIC LETTER YEH WITH HAMZA ABOVE MEDIAL FORM
+	0xFE8D:  "l",                                                                              // ARABIC LETTER ALEF ISOLATED FORM
+	0xFE8E:  "l",                                                                              // ARABIC LETTER ALEF FINAL FORM
+	0xFE8F:  "\u0628",                                                                         // ARABIC LETTER BEH ISOLATED FORM
+	0xFE90:  "\u0628",                                                                         // ARABIC LETTER BEH FINAL FORM
+	0xFE91:  "\u0628",                                                                         // ARABIC LETTER BEH INITIAL FORM
+	0xFE92:  "\u0628",                                                                         // ARABIC LETTER BEH MEDIAL FORM
+	0xFE93:  "\u0629",                                                                         // ARABIC LETTER TEH MARBUTA ISOLATED FORM
+	0xFE94:  "\u0629",                                                                         // ARABIC LETTER TEH MARBUTA FINAL FORM
+	0xFE95:  "\u062a",                                                                         // ARABIC LETTER TEH ISOLATED FORM
+	0xFE96:  "\u062a",                                                                         // ARABIC LETTER TEH FINAL FORM
+	0xFE97:  "\u062a",                                                                         // ARABIC LETTER TEH INITIAL FORM
+	0xFE98:  "\u062a",                                                                         // ARABIC LETTER TEH MEDIAL FORM
+	0xFE99:  "\u0649\u06db",                                                                   // ARABIC LETTER THEH ISOLATED FORM
+	0xFE9A:  "\u0649\u06db",                                                                   // ARABIC LETTER THEH FINAL FORM
+	0xFE9B:  "\u0649\u06db",                                                                   // ARABIC LETTER THEH INITIAL FORM
+	0xFE9C:  "\u0649\u06db",                                                                   // ARABIC LETTER THEH MEDIAL FORM
+	0xFE9D:  "\u062c",                                                                         // ARABIC LETTER JEEM ISOLATED FORM
+	0xFE9E:  "\u062c",                                                                         // ARABIC LETTER JEEM FINAL FORM
+	0xFE9F:  "\u062c",                                                                         // ARABIC LETTER JEEM INITIAL FORM
+	0xFEA0:  "\u062c",                                                                         // ARABIC LETTER JEEM MEDIAL FORM
+	0xFEA1:  "\u062d",                                                                         // ARABIC LETTER HAH ISOLATED FORM
+	0xFEA2:  "\u062d",                                                                         // ARABIC LETTER HAH FINAL FORM
+	0xFEA3:  "\u062d",                                                                         // ARABIC LETTER HAH INITIAL FORM
+	0xFEA4:  "\u062d",                                                                         // ARABIC LETTER HAH MEDIAL FORM
+	0xFEA5:  "\u062e",                                                                         // ARABIC LETTER KHAH ISOLATED FORM
+	0xFEA6:  "\u062e",                                                                         // ARABIC LETTER KHAH FINAL FORM
+	0xFEA7:  "\u062e",                                                                         // ARABIC LETTER KHAH INITIAL FORM
+	0xFEA8:  "\u062e",                                                                         // ARABIC LETTER KHAH MEDIAL FORM
+	0xFEA9:  "\u062f",                                                                         // ARABIC LETTER DAL ISOLATED FORM
+	0xFEAA:  "\u062f",                                                                         // ARABIC LETTER DAL FINAL FORM
+	0xFEAB:  "\u0630",                                                                         // ARABIC LETTER THAL ISOLATED FORM
+	0xFEAC:  "\u0630",                                                                         // ARABIC LETTER THAL FINAL FORM
+	0xFEAD:  "\u0631",                                                                         // ARABIC LETTER REH ISOLATED FORM
+	0xFEAE:  "\u0631",                                                                         // ARABIC LETTER REH FINAL FORM
+	0xFEAF:  "\u0632",                                                                         // ARABIC LETTER ZAIN ISOLATED FORM
+	0xFEB0:  "\u0632",                                                                         // ARABIC LETTER ZAIN FINAL FORM
+	0xFEB1:  "\u0633",                                                                         // ARABIC LETTER SEEN ISOLATED FORM
+	0xFEB2:  "\u0633",                                                                         // ARABIC LETTER SEEN FINAL FORM
+	0xFEB3:  "\u0633",                                                                         // ARABIC LETTER SEEN INITIAL FORM
+	0xFEB4:  "\u0633",                                                                         // ARABIC LETTER SEEN MEDIAL FORM
+	0xFEB5:  "\u0633\u06db",                                                                   // ARABIC LETTER SHEEN ISOLATED FORM
+	0xFEB6:  "\u0633\u06db",                                                                   // ARABIC LETTER SHEEN FINAL FORM
+	0xFEB7:  "\u0633\u06db",                                                                   // ARABIC LETTER SHEEN INITIAL FORM
+	0xFEB8:  "\u0633\u06db",                                                                   // ARABIC LETTER SHEEN MEDIAL FORM
+	0xFEB9:  "\u0635",                                                                         // ARABIC LETTER SAD ISOLATED FORM
+	0xFEBA:  "\u0635",                                                                         // ARABIC LETTER SAD FINAL FORM
+	0xFEBB:  "\u0635",                                                                         // ARABIC LETTER SAD INITIAL FORM
+	0xFEBC:  "\u0635",                                                                         // ARABIC LETTER SAD MEDIAL FORM
+	0xFEBD:  "\u0636",                                                                         // ARABIC LETTER DAD ISOLATED FORM
+	0xFEBE:  "\u0636",                                                                         // ARABIC LETTER DAD FINAL FORM
+	0xFEBF:  "\u0636",                                                                         // ARABIC LETTER DAD INITIAL FORM
+	0xFEC0:  "\u0636",                                                                         // ARABIC LETTER DAD MEDIAL FORM
+	0xFEC1:  "\u0637",                                                                         // ARABIC LETTER TAH ISOLATED FORM
+	0xFEC2:  "\u0637",                                                                         // ARABIC LETTER TAH FINAL FORM
+	0xFEC3:  "\u0637",                                                                         // ARABIC LETTER TAH INITIAL FORM
+	0xFEC4:  "\u0637",                                                                         // ARABIC LETTER TAH MEDIAL FORM
+	0xFEC5:  "\u0638",                                                                         // ARABIC LETTER ZAH ISOLATED FORM
+	0xFEC6:  "\u0638",                                                                         // ARABIC LETTER ZAH FINAL FORM
+	0xFEC7:  "\u0638",                                                                         // ARABIC LETTER ZAH INITIAL FORM
+	0xFEC8:  "\u0638",                                                                         // ARABIC LETTER ZAH MEDIAL FORM
+	0xFEC9:  "\u0639",                                                                         // ARABIC LETTER AIN ISOLATED FORM
+	0xFECA:  "\u0639",                                                                         // ARABIC LETTER AIN FINAL FORM
+	0xFECB:  "\u0639",                                                                         // ARABIC LETTER AIN INITIAL FORM
+	0xFECC:  "\u0639",                                                                         // ARABIC LETTER AIN MEDIAL FORM
+	0xFECD:  "\u063a",                                                                         // ARABIC LETTER GHAIN ISOLATED FORM
+	0xFECE:  "\u063a",                                                                         // ARABIC LETTER GHAIN FINAL FORM
+	0xFECF:  "\u063a",                                                                         // ARABIC LETTER GHAIN INITIAL FORM
+	0xFED0:  "\u063a",                                                                         // ARABIC LETTER GHAIN MEDIAL FORM
+	0xFED1:  "\u0641",                                                                         // ARABIC LETTER FEH ISOLATED FORM
+	0xFED2:  "\u0641",                                                                         // ARABIC LETTER FEH FINAL FORM
+	0xFED3:  "\u0641",                                                                         // ARABIC LETTER FEH INITIAL FORM
+	0xFED4:  "\u0641",                                                                         // ARABIC LETTER FEH MEDIAL FORM
+	0xFED5:  "\u0642",                                                                         // ARABIC LETTER QAF ISOLATED FORM
+	0xFED6:  "\u0642",                                                                         // ARABIC LETTER QAF FINAL FORM
+	0xFED7:  "\u0642",                                                                         // ARABIC LETTER QAF INITIAL FORM
+	0xFED8:  "\u0642",                                                                         // ARABIC LETTER QAF MEDIAL FORM
+	0xFED9:  "\u0643",                                                                         // ARABIC LETTER KAF ISOLATED FORM
+	0xFEDA:  "\u0643",                                                                         // ARABIC LETTER KAF FINAL FORM
+	0xFEDB:  "\u0643",                                                                         // ARABIC LETTER KAF INITIAL FORM
+	0xFEDC:  "\u0643",                                                                         // ARABIC LETTER KAF MEDIAL FORM
+	0xFEDD:  "\u0644",                                                                         // ARABIC LETTER LAM ISOLATED FORM
+	0xFEDE:  "\u0644",                                                                         // ARABIC LETTER LAM FINAL FORM
+	0xFEDF:  "\u0644",                                                                         // ARABIC LETTER LAM INITIAL FORM
+	0xFEE0:  "\u0644",                                                                         // ARABIC LETTER LAM MEDIAL FORM
+	0xFEE1:  "\u0645",                                                                         // ARABIC LETTER MEEM ISOLATED FORM
+	0xFEE2:  "\u0645",                                                                         // ARABIC LETTER MEEM FINAL FORM
+	0xFEE3:  "\u0645",                                                                         // ARABIC LETTER MEEM INITIAL FORM
+	0xFEE4:  "\u0645",                                                                         // ARABIC LETTER MEEM MEDIAL FORM
+	0xFEE5:  "\u0646",                                                                         // ARABIC LETTER NOON ISOLATED FORM
+	0xFEE6:  "\u0646",                                                                         // ARABIC LETTER NOON FINAL FORM
+	0xFEE7:  "\u0646",                                                                         // ARABIC LETTER NOON INITIAL FORM
+	0xFEE8:  "\u0646",                                                                         // ARABIC LETTER NOON MEDIAL FORM
+	0xFEE9:  "o",                                                                              // ARABIC LETTER HEH ISOLATED FORM
+	0xFEEA:  "o",                                                                              // ARABIC LETTER HEH FINAL FORM
+	0xFEEB:  "o",                                                                              // ARABIC LETTER HEH INITIAL FORM
+	0xFEEC:  "o",                                                                              // ARABIC LETTER HEH MEDIAL FORM
+	0xFEED:  "\u0648",                                                                         // ARABIC LETTER WAW ISOLATED FORM
+	0xFEEE:  "\u0648",                                                                         // ARABIC LETTER WAW FINAL FORM
+	0xFEEF:  "\u0649",                                                                         // ARABIC LETTER ALEF MAKSURA ISOLATED FORM
+	0xFEF0:  "\u0649",                                                                         // ARABIC LETTER ALEF MAKSURA FINAL FORM
+	0xFEF1:  "\u0649",                                                                         // ARABIC LETTER YEH ISOLATED FORM
+	0xFEF2:  "\u0649",                                                                         // ARABIC LETTER YEH FINAL FORM
+	0xFEF3:  "\u0649",                                                                         // ARABIC LETTER YEH INITIAL FORM
+	0xFEF4:  "\u0649",                                                                         // ARABIC LETTER YEH MEDIAL FORM
+	0xFEF5:  "\u0644\u0622",                                                                   // ARABIC LIGATURE LAM WITH ALEF WITH MADDA ABOVE ISOLATED FORM
+	0xFEF6:  "\u0644\u0622",                                                                   // ARABIC LIGATURE LAM WITH ALEF WITH MADDA ABOVE FINAL FORM
+	0xFEF7:  "\u0644l\u0674",                                                                  // ARABIC LIGATURE LAM WITH ALEF WITH HAMZA ABOVE ISOLATED FORM
+	0xFEF8:  "\u0644l\u0674",                                                                  // ARABIC LIGATURE LAM WITH ALEF WITH HAMZA ABOVE FINAL FORM
+	0xFEF9:  "\u0644l\u0655",                                                                  // ARABIC LIGATURE LAM WITH ALEF WITH HAMZA BELOW ISOLATED FORM
+	0xFEFA:  "\u0644l\u0655",                                                                  // ARABIC LIGATURE LAM WITH ALEF WITH HAMZA BELOW FINAL FORM
+	0xFEFB:  "\u0644l",                                                                        // ARABIC LIGATURE LAM WITH ALEF ISOLATED FORM
+	0xFEFC:  "\u0644l",                                                                        // ARABIC LIGATURE LAM WITH ALEF FINAL FORM
+	0xFF01:  "!",                                                                              // FULLWIDTH EXCLAMATION MARK
+	0xFF02:  "''",                                                                             // FULLWIDTH QUOTATION MARK
+	0xFF07:  "'",                                                                              // FULLWIDTH APOSTROPHE
+	0xFF0D:  "\u30fc",                                                                         // FULLWIDTH HYPHEN-MINUS
+	0xFF1A:  ":",                                                                              // FULLWIDTH COLON
+	0xFF21:  "A",                                                                              // FULLWIDTH LATIN CAPITAL LETTER A
+	0xFF22:  "B",                                                                              // FULLWIDTH LATIN CAPITAL LETTER B
+	0xFF23:  "C",                                                                              // FULLWIDTH LATIN CAPITAL LETTER C
+	0xFF25:  "E",                                                                              // FULLWIDTH LATIN CAPITAL LETTER E
+	0xFF28:  "H",                                                                              // FULLWIDTH LATIN CAPITAL LETTER H
+	0xFF29:  "l",                                                                              // FULLWIDTH LATIN CAPITAL LETTER I
+	0xFF2A:  "J",                                                                              // FULLWIDTH LATIN CAPITAL LETTER J
+	0xFF2B:  "K",                                                                              // FULLWIDTH LATIN CAPITAL LETTER K
+	0xFF2D:  "M",                                                                              // FULLWIDTH LATIN CAPITAL LETTER M
+	0xFF2E:  "N",                                                                              // FULLWIDTH LATIN CAPITAL LETTER N
+	0xFF2F:  "O",                                                                              // FULLWIDTH LATIN CAPITAL LETTER O
+	0xFF30:  "P",                                                                              // FULLWIDTH LATIN CAPITAL LETTER P
+	0xFF33:  "S",                                                                              // FULLWIDTH LATIN CAPITAL LETTER S
+	0xFF34:  "T",                                                                              // FULLWIDTH LATIN CAPITAL LETTER T
+	0xFF38:  "X",                                                                              // FULLWIDTH LATIN CAPITAL LETTER X
+	0xFF39:  "Y",                                                                              // FULLWIDTH LATIN CAPITAL LETTER Y
+	0xFF3A:  "Z",                                                                              // FULLWIDTH LATIN CAPITAL LETTER Z
+	0xFF3B:  "(",                                                                              // FULLWIDTH LEFT SQUARE BRACKET
+	0xFF3C:  "\\",                                                                             // FULLWIDTH REVERSE SOLIDUS
+	0xFF3D:  ")",                                                                              // FULLWIDTH RIGHT SQUARE BRACKET
+	0xFF3E:  "\ufe3f",                                                                         // FULLWIDTH CIRCUMFLEX ACCENT
+	0xFF40:  "'",                                                                              // FULLWIDTH GRAVE ACCENT
+	0xFF41:  "a",                                                                              // FULLWIDTH LATIN SMALL LETTER A
+	0xFF43:  "c",                                                                              // FULLWIDTH LATIN SMALL LETTER C
+	0xFF45:  "e",                                                                              // FULLWIDTH LATIN SMALL LETTER E
+	0xFF47:  "g",                                                                              // FULLWIDTH LATIN SMALL LETTER G
+	0xFF48:  "h",                                                                              // FULLWIDTH LATIN SMALL LETTER H
+	0xFF49:  "i",                                                                              // FULLWIDTH LATIN SMALL LETTER I
+	0xFF4A:  "j",                                                                              // FULLWIDTH LATIN SMALL LETTER J
+	0xFF4C:  "l",                                                                              // FULLWIDTH LATIN SMALL LETTER L
+	0xFF4F:  "o",                                                                              // FULLWIDTH LATIN SMALL LETTER O
+	0xFF50:  "p",                                                                              // FULLWIDTH LATIN SMALL LETTER P
+	0xFF53:  "s",                                                                              // FULLWIDTH LATIN SMALL LETTER S
+	0xFF56:  "v",                                                                              // FULLWIDTH LATIN SMALL LETTER V
+	0xFF58:  "x",                                                                              // FULLWIDTH LATIN SMALL LETTER X
+	0xFF59:  "y",                                                                              // FULLWIDTH LATIN SMALL LETTER Y
+	0xFF5C:  "\u2502",                                                                         // FULLWIDTH VERTICAL LINE
+	0xFF5E:  "\u301c",                                                                         // FULLWIDTH TILDE
+	0xFF65:  "\u00b7",                                                                         // HALFWIDTH KATAKANA MIDDLE DOT
+	0xFFE3:  "\u02c9",                                                                         // FULLWIDTH MACRON
+	0xFFE8:  "l",                                                                              // HALFWIDTH FORMS LIGHT VERTICAL
+	0xFFED:  "\u25aa",                                                                         // HALFWIDTH BLACK SQUARE
+	0x10101: "\u00b7",                                                                         // AEGEAN WORD SEPARATOR DOT
+	0x1018E: "N\u030a",                                                                        // NOMISMA SIGN
+	0x10196: "X\u0335",                                                                        // ROMAN DENARIUS SIGN
+	0x10197: "V\u0335",                                                                        // ROMAN QUINARIUS SIGN
+	0x10198: "l\u0335l\u0335S\u0335",                                                          // ROMAN SESTERTIUS SIGN
+	0x10199: "l\u0335l\u0335",                                                                 // ROMAN DUPONDIUS SIGN
+	0x101A0: "\u2ce8",                                                                         // GREEK SYMBOL TAU RHO
+	0x10282: "B",                                                                              // LYCIAN LETTER B
+	0x10285: "\u0394",                                                                         // LYCIAN LETTER D
+	0x10286: "E",                                                                              // LYCIAN LETTER I
+	0x10287: "F",                                                                              // LYCIAN LETTER W
+	0x1028A: "l",                                                                              // LYCIAN LETTER J
+	0x1028D: "\u0245",                                                                         // LYCIAN LETTER L
+	0x10290: "X",                                                                              // LYCIAN LETTER MM
+	0x10292: "O",                                                                              // LYCIAN LETTER U
+	0x10294: "\u16dc",                                                                         // LYCIAN LETTER KK
+	0x10295: "P",                                                                              // LYCIAN LETTER R
+	0x10296: "S",                                                                              // LYCIAN LETTER S
+	0x10297: "T",                                                                              // LYCIAN LETTER T
+	0x1029B: "+",                                                                              // LYCIAN LETTER H
+	0x102A0: "A",                                                                              // CARIAN LETTER A
+	0x102A1: "B",                                                                              // CARIAN LETTER P2
+	0x102A2: "C",                                                                              // CARIAN LETTER D
+	0x102A3: "\u0394",                                                                         // CARIAN LETTER L
+	0x102A5: "F",                                                                              // CARIAN LETTER R
+	0x102AB: "O",                                                                              // CARIAN LETTER O
+	0x102AD: "\u03d8",                                                                         // CARIAN LETTER T
+	0x102B0: "M",                                                                              // CARIAN LETTER S
+	0x102B1: "T",                                                                              // CARIAN LETTER C-18
+	0x102B2: "Y",                                                                              // CARIAN LETTER U
+	0x102B3: "\u03a6",                                                                         // CARIAN LETTER NN
+	0x102B4: "X",                                                                              // CARIAN LETTER X
+	0x102B5: "\u03a8",                                                                         // CARIAN LETTER N
+	0x102B6: "\u03a9",                                                                         // CARIAN LETTER TT2
+	0x102B8: "\u2d40",                                                                         // CARIAN LETTER SS
+	0x102CF: "H",                                                                              // CARIAN LETTER E2
+	0x102E1: "\u062f",                                                                         // COPTIC EPACT DIGIT ONE
+	0x102E4: "\u0648",                                                                         // COPTIC EPACT DIGIT FOUR
+	0x102E8: "\u0637",                                                                         // COPTIC EPACT DIGIT EIGHT
+	0x102F2: "\u0635",                                                                         // COPTIC EPACT NUMBER NINETY
+	0x102F5: "Z",                                                                              // COPTIC EPACT NUMBER THREE HUNDRED
+	0x10301: "B",                                                                              // OLD ITALIC LETTER BE
+	0x10302: "C",                                                                              // OLD ITALIC LETTER KE
+	0x10309: "l",                                                                              // OLD ITALIC LETTER I
+	0x10311: "M",                                                                              // OLD ITALIC LETTER SHE
+	0x10312: "\u03d8",                                                                         // OLD ITALIC LETTER KU
+	0x10315: "T",                                                                              // OLD ITALIC LETTER TE
+	0x10317: "X",                                                                              // OLD ITALIC LETTER EKS
+	0x1031A: "8",                                                                              // OLD ITALIC LETTER EF
+	0x1031F: "*",                                                                              // OLD ITALIC LETTER ESS
+	0x10320: "l",                                                                              // OLD ITALIC NUMERAL ONE
+	0x10322: "X",                                                                              // OLD ITALIC NUMERAL TEN
+	0x103D1: "\U00010382",                                                                     // OLD PERSIAN NUMBER ONE
+	0x103D3: "\U00010393",                                                                     // OLD PERSIAN NUMBER TEN
+	0x10401: "\u0190",                                                                         // DESERET CAPITAL LETTER LONG E
+	0x10404: "O",                                                                              // DESERET CAPITAL LETTER LONG O
+	0x10411: "\ua4f6",                                                                         // DESERET CAPITAL LETTER PEE
+	0x10415: "C",                                                                              // DESERET CAPITAL LETTER CHEE
+	0x1041B: "L",                                                                              // DESERET CAPITAL LETTER ETH
+	0x1041F: "\u2c70",                                                                         // DESERET CAPITAL LETTER ESH
+	0x10420: "S",                                                                              // DESERET CAPITAL LETTER ZHEE
+	0x10423: "\u0186",                                                                         // DESERET CAPITAL LETTER EM
+	0x10425: "\u0418",                                                                         // DESERET CAPITAL LETTER ENG
+	0x10429: "\ua793",                                                                         // DESERET SMALL LETTER LONG E
+	0x1042A: "\u029a",                                                                         // DESERET SMALL LETTER LONG A
+	0x1042C: "o",                                                                              // DESERET SMALL LETTER LONG O
+	0x1043D: "c",                                                                              // DESERET SMALL LETTER CHEE
+	0x1043F: "\u0277",                                                                         // DESERET SMALL LETTER KAY
+	0x10442: "\u025e",                                                                         // DESERET SMALL LETTER VEE
+	0x10443: "\u029f",                                                                         // DESERET SMALL LETTER ETH
+	0x10448: "s",                                                                              // DESERET SMALL LETTER ZHEE
+	0x1044B: "\u0254",                                                                         // DESERET SMALL LETTER EM
+	0x1044D: "\u1d0e",                                                                         // DESERET SMALL LETTER ENG
+	0x104A0: "\U00010486",                                                                     // OSMANYA DIGIT ZERO
+	0x104B0: "\u0245",                                                                         // OSAGE CAPITAL LETTER A
+	0x104B4: "R",                                                                              // OSAGE CAPITAL LETTER BRA
+	0x104BC: "\u04c3",                                                                         // OSAGE CAPITAL LETTER KA
+	0x104C2: "O",                                                                              // OSAGE CAPITAL LETTER O
+	0x104C3: "\u0298",                                                                         // OSAGE CAPITAL LETTER OIN
+	0x104C4: "\u00de",                                                                         // OSAGE CAPITAL LETTER PA
+	0x104CD: "\u040b",                                                                         // OSAGE CAPITAL LETTER DHA
+	0x104CE: "U",                                                                              // OSAGE CAPITAL LETTER U
+	0x104D0: "\u16e6",                                                                         // OSAGE CAPITAL LETTER KHA
+	0x104D1: "\u03a8",                                                                         // OSAGE CAPITAL LETTER GHA
+	0x104D2: "7",                                                                              // OSAGE CAPITAL LETTER ZA
+	0x104D8: "\u028c",                                                                         // OSAGE SMALL LETTER A
+	0x104DB: "\u03bb",                                                                         // OSAGE SMALL LETTER AH
+	0x104EA: "o",                                                                              // OSAGE SMALL LETTER O
+	0x104EB: "\ua669",                                                                         // OSAGE SMALL LETTER OIN
+	0x104F6: "u",                                                                              // OSAGE SMALL LETTER U
+	0x104F9: "\u03c8",                                                                         // OSAGE SMALL LETTER GHA
+	0x10513: "N",                                                                              // ELBASAN LETTER NE
+	0x10516: "O",                                                                              // ELBASAN LETTER O
+	0x10518: "K",                                                                              // ELBASAN LETTER QE
+	0x1051C: "C",                                                                              // ELBASAN LETTER SHE
+	0x1051D: "V",                                                                              // ELBASAN LETTER TE
+	0x10525: "F",                                                                              // ELBASAN LETTER GHE
+	0x10526: "L",                                                                              // ELBASAN LETTER GHAMMA
+	0x10527: "X",                                                                              // ELBASAN LETTER KHE
+	0x10A3A: "\u0323",                                                                         // KHAROSHTHI SIGN DOT BELOW
+	0x10A50: ".",                                                                              // KHAROSHTHI PUNCTUATION DOT
+	0x10A57: "\U00010a56\U00010a56",                                                           // KHAROSHTHI PUNCTUATION DOUBLE DANDA
+	0x10CFA: "\U00010ca5",                                                                     // OLD HUNGARIAN NUMBER ONE
+	0x10CFC: "\U00010c82",                                                                     // OLD HUNGARIAN NUMBER TEN
+	0x110BB: "\u0970",                                                                         // KAITHI ABBREVIATION SIGN
+	0x111C7: "\u0970",                                                                         // SHARADA ABBREVIATION SIGN
+	0x111CA: "\u0323",                                                                         // SHARADA SIGN NUKTA
+	0x111CB: "\u093a",                                                                         // SHARADA VOWEL MODIFIER MARK
+	0x111DB: "\ua8fc",                                                                         // SHARADA SIGN SIDDHAM
+	0x111DC: "\ua8fb",                                                                         // SHARADA HEADSTROKE
+	0x111DE: "\u2248",                                                                         // SHARADA SECTION MARK-1
+	0x11300: "\u030a",                                                                         // GRANTHA SIGN COMBINING ANUSVARA ABOVE
+	0x11413: "\U00011434\U00011442\U00011412",                                                 // NEWA LETTER NGHA
+	0x11419: "\U00011434\U00011442\U00011418",                                                 // NEWA LETTER NYHA
+	0x11424: "\U00011434\U00011442\U00011423",                                                 // NEWA LETTER NHA
+	0x1142A: "\U00011434\U00011442\U00011429",                                                 // NEWA LETTER MHA
+	0x1142D: "\U00011434\U00011442\U0001142c",                                                 // NEWA LETTER RHA
+	0x1142F: "\U00011434\U00011442\U0001142e",                                                 // NEWA LETTER LHA
+	0x1144C: "\U0001144b\U0001144b",                                                           // NEWA DOUBLE DANDA
+	0x11492: "\u0998",                                                                         // TIRHUTA LETTER GHA
+	0x11494: "\u099a",                                                                         // TIRHUTA LETTER CA
+	0x11496: "\u099c",                                                                         // TIRHUTA LETTER JA
+	0x11498: "\u099e",                                                                         // TIRHUTA LETTER NYA
+	0x11499: "\u099f",                                                                         // TIRHUTA LETTER TTA
+	0x1149B: "\u09a1",                                                                         // TIRHUTA LETTER DDA
+	0x1149D: "\u09b2",                                                                         // TIRHUTA LETTER NNA
+	0x1149E: "\u09a4",                                                                         // TIRHUTA LETTER TA
+	0x1149F: "\u09a5",                                                                         // TIRHUTA LETTER THA
+	0x114A0: "\u09a6",                                                                         // TIRHUTA LETTER DA
+	0x114A1: "\u09a7",                                                                         // TIRHUTA LETTER DHA
+	0x114A2: "\u09a8",                                                                         // TIRHUTA LETTER NA
+	0x114A3: "\u09aa",                                                                         // TIRHUTA LETTER PA
+	0x114A7: "\u09ae",                                                                         // TIRHUTA LETTER MA
+	0x114A8: "\u09af",                                                                         // TIRHUTA LETTER YA
+	0x114A9: "\u09ac",                                                                         // TIRHUTA LETTER RA
+	0x114AA: "\u09a3",                                                                         // TIRHUTA LETTER LA
+	0x114AB: "\u09b0",                                                                         // TIRHUTA LETTER VA
+	0x114AD: "\u09b7",                                                                         // TIRHUTA LETTER SSA
+	0x114AE: "\u09b8",                                                                         // TIRHUTA LETTER SA
+	0x114B0: "\u09be",                                                                         // TIRHUTA VOWEL SIGN AA
+	0x114B1: "\u09bf",                                                                         // TIRHUTA VOWEL SIGN I
+	0x114B9: "\u09c7",                                                                         // TIRHUTA VOWEL SIGN E
+	0x114BC: "\u09cb",                                                                         // TIRHUTA VOWEL SIGN O
+	0x114BD: "\u09d7",                                                                         // TIRHUTA VOWEL SIGN SHORT O
+	0x114BE: "\u09cc",                                                                         // TIRHUTA VOWEL SIGN AU
+	0x114BF: "\u0306\u0307",                                                                   // TIRHUTA SIGN CANDRABINDU
+	0x114C1: "\u0983",                                                                         // TIRHUTA SIGN VISARGA
+	0x114C2: "\u09cd",                                                                         // TIRHUTA SIGN VIRAMA
+	0x114C3: "\u0323",                                                                         // TIRHUTA SIGN NUKTA
+	0x114C4: "\u09bd",                                                                         // TIRHUTA SIGN AVAGRAHA
+	0x114C5: "w\u0307",                                                                        // TIRHUTA GVANG
+	0x114D0: "O",                                                                              // TIRHUTA DIGIT ZERO
+	0x114D1: "\u09e7",                                                                         // TIRHUTA DIGIT ONE
+	0x114D2: "\u09e8",                                                                         // TIRHUTA DIGIT TWO
+	0x114D6: "\u09ec",                                                                         // TIRHUTA DIGIT SIX
+	0x115D8: "\U00011582",                                                                     // SIDDHAM LETTER THREE-CIRCLE ALTERNATE I
+	0x115D9: "\U00011582",                                                                     // SIDDHAM LETTER TWO-CIRCLE ALTERNATE I
+	0x115DA: "\U00011583",                                                                     // SIDDHAM LETTER TWO-CIRCLE ALTERNATE II
+	0x115DB: "\U00011584",                                                                     // SIDDHAM LETTER ALTERNATE U
+	0x115DC: "\U000115b2",                                                                     // SIDDHAM VOWEL SIGN ALTERNATE U
+	0x115DD: "\U000115b3",                                                                     // SIDDHAM VOWEL SIGN ALTERNATE UU
+	0x11642: "\U00011641\U00011641",                                                           // MODI DOUBLE DANDA
+	0x11700: "rn",                                                                             // AHOM LETTER KA
+	0x11706: "v",                                                                              // AHOM LETTER PA
+	0x1170A: "w",                                                                              // AHOM LETTER JA
+	0x1170E: "w",                                                                              // AHOM LETTER LA
+	0x1170F: "w",                                                                              // AHOM LETTER SA
+	0x118A0: "V",                                                                              // WARANG CITI CAPITAL LETTER NGAA
+	0x118A2: "F",                                                                              // WARANG CITI CAPITAL LETTER WI
+	0x118A3: "L",                                                                              // WARANG CITI CAPITAL LETTER YU
+	0x118A4: "Y",                                                                              // WARANG CITI CAPITAL LETTER YA
+	0x118A6: "E",                                                                              // WARANG CITI CAPITAL LETTER II
+	0x118A8: "\u2207",                                                                         // WARANG CITI CAPITAL LETTER E
+	0x118A9: "Z",                                                                              // WARANG CITI CAPITAL LETTER O
+	0x118AC: "9",                                                                              // WARANG CITI CAPITAL LETTER KO
+	0x118AE: "E",                                                                              // WARANG CITI CAPITAL LETTER YUJ
+	0x118AF: "4",                                                                              // WARANG CITI CAPITAL LETTER UC
+	0x118B2: "L",                                                                              // WARANG CITI CAPITAL LETTER TTE
+	0x118B5: "O",                                                                              // WARANG CITI CAPITAL LETTER AT
+	0x118B7: "\u16dc",                                                                         // WARANG CITI CAPITAL LETTER BU
+	0x118B8: "U",                                                                              // WARANG CITI CAPITAL LETTER PU
+	0x118BB: "5",                                                                              // WARANG CITI CAPITAL LETTER HORR
+	0x118BC: "T",                                                                              // WARANG CITI CAPITAL LETTER HAR
+	0x118C0: "v",                                                                              // WARANG CITI SMALL LETTER NGAA
+	0x118C1: "s",                                                                              // WARANG CITI SMALL LETTER A
+	0x118C2: "F",                                                                              // WARANG CITI SMALL LETTER WI
+	0x118C3: "i",                                                                              // WARANG CITI SMALL LETTER YU
+	0x118C4: "z",                                                                              // WARANG CITI SMALL LETTER YA
+	0x118C6: "7",                                                                              // WARANG CITI SMALL LETTER II
+	0x118C8: "o",                                                                              // WARANG CITI SMALL LETTER E
+	0x118CA: "3",                                                                              // WARANG CITI SMALL LETTER ANG
+	0x118CC: "9",                                                                              // WARANG CITI SMALL LETTER KO
+	0x118CE: "\ua793",                                                                         // WARANG CITI SMALL LETTER YUJ
+	0x118D5: "6",                                                                              // WARANG CITI SMALL LETTER AT
+	0x118D6: "9",                                                                              // WARANG CITI SMALL LETTER AM
+	0x118D7: "o",                                                                              // WARANG CITI SMALL LETTER BU
+	0x118D8: "u",                                                                              // WARANG CITI SMALL LETTER PU
+	0x118DC: "y",                                                                              // WARANG CITI SMALL LETTER HAR
+	0x118E0: "O",                                                                              // WARANG CITI DIGIT ZERO
+	0x118E3: "rn",                                                                             // WARANG CITI DIGIT THREE
+	0x118E4: "\u0669",                                                                         // WARANG CITI DIGIT FOUR
+	0x118E5: "Z",                                                                              // WARANG CITI DIGIT FIVE
+	0x118E6: "W",                                                                              // WARANG CITI DIGIT SIX
+	0x118E9: "C",                                                                              // WARANG CITI DIGIT NINE
+	0x118EC: "X",                                                                              // WARANG CITI NUMBER THIRTY
+	0x118EF: "W",                                                                              // WARANG CITI NUMBER SIXTY
+	0x118F2: "C",                                                                              // WARANG CITI NUMBER NINETY
+	0x11AE6: "\U00011ae5\U00011aef",                                                           // PAU CIN HAU RISING TONE
+	0x11AE7: "\U00011ae5\U00011af0",                                                           // PAU CIN HAU SANDHI GLOTTAL STOP
+	0x11AE8: "\U00011ae5\U00011ae5",                                                           // PAU CIN HAU RISING TONE LONG FINAL
+	0x11AE9: "\U00011ae5\U00011ae5\U00011aef",                                                 // PAU CIN HAU RISING TONE FINAL
+	0x11AEA: "\U00011ae5\U00011ae5\U00011af0",                                                 // PAU CIN HAU SANDHI GLOTTAL STOP FINAL
+	0x11AEC: "\U00011aeb\U00011aef",                                                           // PAU CIN HAU SANDHI TONE
+	0x11AED: "\U00011aeb\U00011aeb",                                                           // PAU CIN HAU SANDHI TONE LONG FINAL
+	0x11AEE: "\U00011aeb\U00011aeb\U00011aef",                                                 // PAU CIN HAU SANDHI TONE FINAL
+	0x11AF4: "\U00011af3\U00011aef",                                                           // PAU CIN HAU LOW-FALLING TONE
+	0x11AF5: "\U00011af3\U00011af0",                                                           // PAU CIN HAU GLOTTAL STOP
+	0x11AF6: "\U00011af3\U00011af3",                                                           // PAU CIN HAU LOW-FALLING TONE LONG FINAL
+	0x11AF7: "\U00011af3\U00011af3\U00011aef",                                                 // PAU CIN HAU LOW-FALLING TONE FINAL
+	0x11AF8: "\U00011af3\U00011af3\U00011af0",                                                 // PAU CIN HAU GLOTTAL STOP FINAL
+	0x11C42: "\U00011c41\U00011c41",                                                           // BHAIKSUKI DOUBLE DANDA
+	0x11CB2: "\U00011caa",                                                                     // MARCHEN VOWEL SIGN U
+	0x12038: "\U0001039a",                                                                     // CUNEIFORM SIGN ASH
+	0x132F9: "\U0001099e",                                                                     // EGYPTIAN HIEROGLYPH S034
+	0x16F07: "\u0393",                                                                         // MIAO LETTER FA
+	0x16F08: "V",                                                                              // MIAO LETTER VA
+	0x16F0A: "T",                                                                              // MIAO LETTER TA
+	0x16F16: "L",                                                                              // MIAO LETTER LA
+	0x16F1A: "\u0394",                                                                         // MIAO LETTER TLHA
+	0x16F1C: "\ua658",                                                                         // MIAO LETTER TLHYA
+	0x16F26: "\ua4f6",                                                                         // MIAO LETTER HA
+	0x16F28: "l",                                                                              // MIAO LETTER GHA
+	0x16F2D: "\u0190",                                                                         // MIAO LETTER NYHA
+	0x16F35: "R",                                                                              // MIAO LETTER ZHA
+	0x16F3A: "S",                                                                              // MIAO LETTER SA
+	0x16F3B: "3",                                                                              // MIAO LETTER ZA
+	0x16F3D: "\u0245",                                                                         // MIAO LETTER ZZA
+	0x16F3F: ">",                                                                              // MIAO LETTER ARCHAIC ZZA
+	0x16F40: "A",                                                                              // MIAO LETTER ZZYA
+	0x16F42: "U",                                                                              // MIAO LETTER WA
+	0x16F43: "Y",                                                                              // MIAO LETTER AH
+	0x16F51: "'",                                                                              // MIAO SIGN ASPIRATION
+	0x16F52: "'",                                                                              // MIAO SIGN REFORMED VOICING
+	0x1D114: "{",                                                                              // MUSICAL SYMBOL BRACE
+	0x1D16D: ".",                                                                              // MUSICAL SYMBOL COMBINING AUGMENTATION DOT
+	0x1D202: "\u04fe",                                                                         // GREEK VOCAL NOTATION SYMBOL-3
+	0x1D206: "3",                                                                              // GREEK VOCAL NOTATION SYMBOL-7
+	0x1D20B: "\u0418",                                                                         // GREEK VOCAL NOTATION SYMBOL-12
+	0x1D20D: "V",                                                                              // GREEK VOCAL NOTATION SYMBOL-14
+	0x1D20F: "\\",                                                                             // GREEK VOCAL NOTATION SYMBOL-16
+	0x1D212: "7",                                                                              // GREEK VOCAL NOTATION SYMBOL-19
+	0x1D213: "F",                                                                              // GREEK VOCAL NOTATION SYMBOL-20
+	0x1D214: "\U000102bc",                                                                     // GREEK VOCAL NOTATION SYMBOL-21
+	0x1D215: "\ua4f6",                                                                         // GREEK VOCAL NOTATION SYMBOL-22
+	0x1D216: "R",                                                                              // GREEK VOCAL NOTATION SYMBOL-23
+	0x1D217: "\u2c6f",                                                                         // GREEK VOCAL NOTATION SYMBOL-24
+	0x1D21A: "O\u0335",                                                                        // GREEK VOCAL NOTATION SYMBOL-52
+	0x1D21B: "\u2144",                                                                         // GREEK VOCAL NOTATION SYMBOL-53
+	0x1D21C: "\ua4d5",                                                                         // GREEK VOCAL NOTATION SYMBOL-54
+	0x1D221: "\u0190",                                                                         // GREEK INSTRUMENTAL NOTATION SYMBOL-7
+	0x1D222: "\u0460",                                                                         // GREEK INSTRUMENTAL NOTATION SYMBOL-8
+	0x1D22A: "L",                                                                              // GREEK INSTRUMENTAL NOTATION SYMBOL-23
+	0x1D22B: "\ua4f6",                                                                         // GREEK INSTRUMENTAL NOTATION SYMBOL-24
+	0x1D230: "\ua7fb",                                                                         // GREEK INSTRUMENTAL NOTATION SYMBOL-30
+	0x1D236: "<",                                                                              // GREEK INSTRUMENTAL NOTATION SYMBOL-40
+	0x1D237: ">",                                                                              // GREEK INSTRUMENTAL NOTATION SYMBOL-42
+	0x1D238: "\u228f",                                                                         // GREEK INSTRUMENTAL NOTATION SYMBOL-43
+	0x1D239: "\u2290",                                                                         // GREEK INSTRUMENTAL NOTATION SYMBOL-45
+	0x1D23A: "/",                                                                              // GREEK INSTRUMENTAL NOTATION SYMBOL-47
+	0x1D23B: "\\",                                                                             // GREEK INSTRUMENTAL NOTATION SYMBOL-48
+	0x1D23F: "\u16cb",                                                                         // GREEK INSTRUMENTAL NOTATION SYMBOL-52
+	0x1D245: "\u0548",                                                                         // GREEK MUSICAL LEIMMA
+	0x1D400: "A",                                                                              // MATHEMATICAL BOLD CAPITAL A
+	0x1D401: "B",                                                                              // MATHEMATICAL BOLD CAPITAL B
+	0x1D402: "C",                                                                              // MATHEMATICAL BOLD CAPITAL C
+	0x1D403: "D",                                                                              // MATHEMATICAL BOLD CAPITAL D
+	0x1D404: "E",                                                                              // MATHEMATICAL BOLD CAPITAL E
+	0x1D405: "F",                                                                              // MATHEMATICAL BOLD CAPITAL F
+	0x1D406: "G",                                                                              // MATHEMATICAL BOLD CAPITAL G
+	0x1D407: "H",                                                                              // MATHEMATICAL BOLD CAPITAL H
+	0x1D408: "l",                                                                              // MATHEMATICAL BOLD CAPITAL I
+	0x1D409: "J",                                                                              // MATHEMATICAL BOLD CAPITAL J
+	0x1D40A: "K",                                                                              // MATHEMATICAL BOLD CAPITAL K
+	0x1D40B: "L",                                                                              // MATHEMATICAL BOLD CAPITAL L
+	0x1D40C: "M",                                                                              // MATHEMATICAL BOLD CAPITAL M
+	0x1D40D: "N",                                                                              // MATHEMATICAL BOLD CAPITAL N
+	0x1D40E: "O",                                                                              // MATHEMATICAL BOLD CAPITAL O
+	0x1D40F: "P",                                                                              // MATHEMATICAL BOLD CAPITAL P
+	0x1D410: "Q",                                                                              // MATHEMATICAL BOLD CAPITAL Q
+	0x1D411: "R",                                                                              // MATHEMATICAL BOLD CAPITAL R
+	0x1D412: "S",                                                                              // MATHEMATICAL BOLD CAPITAL S
+	0x1D413: "T",                                                                              // MATHEMATICAL BOLD CAPITAL T
+	0x1D414: "U",                                                                              // MATHEMATICAL BOLD CAPITAL U
+	0x1D415: "V",                                                                              // MATHEMATICAL BOLD CAPITAL V
+	0x1D416: "W",                                                                              // MATHEMATICAL BOLD CAPITAL W
+	0x1D417: "X",                                                                              // MATHEMATICAL BOLD CAPITAL X
+	0x1D418: "Y",                                                                              // MATHEMATICAL BOLD CAPITAL Y
+	0x1D419: "Z",                                                                              // MATHEMATICAL BOLD CAPITAL Z
+	0x1D41A: "a",                                                                              // MATHEMATICAL BOLD SMALL A
+	0x1D41B: "b",                                                                              // MATHEMATICAL BOLD SMALL B
+	0x1D41C: "c",                                                                              // MATHEMATICAL BOLD SMALL C
+	0x1D41D: "d",                                                                              // MATHEMATICAL BOLD SMALL D
+	0x1D41E: "e",                                                                              // MATHEMATICAL BOLD SMALL E
+	0x1D41F: "f",                                                                              // MATHEMATICAL BOLD SMALL F
+	0x1D420: "g",                                                                              // MATHEMATICAL BOLD SMALL G
+	0x1D421: "h",                                                                              // MATHEMATICAL BOLD SMALL H
+	0x1D422: "i",                                                                              // MATHEMATICAL BOLD SMALL I
+	0x1D423: "j",                                                                              // MATHEMATICAL BOLD SMALL J
+	0x1D424: "k",                                                                              // MATHEMATICAL BOLD SMALL K
+	0x1D425: "l",                                                                              // MATHEMATICAL BOLD SMALL L
+	0x1D426: "rn",                                                                             // MATHEMATICAL BOLD SMALL M
+	0x1D427: "n",                                                                              // MATHEMATICAL BOLD SMALL N
+	0x1D428: "o",                                                                              // MATHEMATICAL BOLD SMALL O
+	0x1D429: "p",                                                                              // MATHEMATICAL BOLD SMALL P
+	0x1D42A: "q",                                                                              // MATHEMATICAL BOLD SMALL Q
+	0x1D42B: "r",                                                                              // MATHEMATICAL BOLD SMALL R
+	0x1D42C: "s",                                                                              // MATHEMATICAL BOLD SMALL S
+	0x1D42D: "t",                                                                              // MATHEMATICAL BOLD SMALL T
+	0x1D42E: "u",                                                                              // MATHEMATICAL BOLD SMALL U
+	0x1D42F: "v",                                                                              // MATHEMATICAL BOLD SMALL V
+	0x1D430: "w",                                                                              // MATHEMATICAL BOLD SMALL W
+	0x1D431: "x",                                                                              // MATHEMATICAL BOLD SMALL X
+	0x1D432: "y",                                                                              // MATHEMATICAL BOLD SMALL Y
+	0x1D433: "z",                                                                              // MATHEMATICAL BOLD SMALL Z
+	0x1D434: "A",                                                                              // MATHEMATICAL ITALIC CAPITAL A
+	0x1D435: "B",                                                                              // MATHEMATICAL ITALIC CAPITAL B
+	0x1D436: "C",                                                                              // MATHEMATICAL ITALIC CAPITAL C
+	0x1D437: "D",                                                                              // MATHEMATICAL ITALIC CAPITAL D
+	0x1D438: "E",                                                                              // MATHEMATICAL ITALIC CAPITAL E
+	0x1D439: "F",                                                                              // MATHEMATICAL ITALIC CAPITAL F
+	0x1D43A: "G",                                                                              // MATHEMATICAL ITALIC CAPITAL G
+	0x1D43B: "H",                                                                              // MATHEMATICAL ITALIC CAPITAL H
+	0x1D43C: "l",                                                                              // MATHEMATICAL ITALIC CAPITAL I
+	0x1D43D: "J",                                                                              // MATHEMATICAL ITALIC CAPITAL J
+	0x1D43E: "K",                                                                              // MATHEMATICAL ITALIC CAPITAL K
+	0x1D43F: "L",                                                                              // MATHEMATICAL ITALIC CAPITAL L
+	0x1D440: "M",                                                                              // MATHEMATICAL ITALIC CAPITAL M
+	0x1D441: "N",                                                                              // MATHEMATICAL ITALIC CAPITAL N
+	0x1D442: "O",                                                                              // MATHEMATICAL ITALIC CAPITAL O
+	0x1D443: "P",                                                                              // MATHEMATICAL ITALIC CAPITAL P
+	0x1D444: "Q",                                                                              // MATHEMATICAL ITALIC CAPITAL Q
+	0x1D445: "R",                                                                              // MATHEMATICAL ITALIC CAPITAL R
+	0x1D446: "S",                                                                              // MATHEMATICAL ITALIC CAPITAL S
+	0x1D447: "T",                                                                              // MATHEMATICAL ITALIC CAPITAL T
+	0x1D448: "U",                                                                              // MATHEMATICAL ITALIC CAPITAL U
+	0x1D449: "V",                                                                              // MATHEMATICAL ITALIC CAPITAL V
+	0x1D44A: "W",                                                                              // MATHEMATICAL ITALIC CAPITAL W
+	0x1D44B: "X",                                                                              // MATHEMATICAL ITALIC CAPITAL X
+	0x1D44C: "Y",                                                                              // MATHEMATICAL ITALIC CAPITAL Y
+	0x1D44D: "Z",                                                                              // MATHEMATICAL ITALIC CAPITAL Z
+	0x1D44E: "a",                                                                              // MATHEMATICAL ITALIC SMALL A
+	0x1D44F: "b",                                                                              // MATHEMATICAL ITALIC SMALL B
+	0x1D450: "c",                                                                              // MATHEMATICAL ITALIC SMALL C
+	0x1D451: "d",                                                                              // MATHEMATICAL ITALIC SMALL D
+	0x1D452: "e",                                                                              // MATHEMATICAL ITALIC SMALL E
+	0x1D453: "f",                                                                              // MATHEMATICAL ITALIC SMALL F
+	0x1D454: "g",                                                                              // MATHEMATICAL ITALIC SMALL G
+	0x1D456: "i",                                                                              // MATHEMATICAL ITALIC SMALL I
+	0x1D457: "j",                                                                              // MATHEMATICAL ITALIC SMALL J
+	0x1D458: "k",                                                                              // MATHEMATICAL ITALIC SMALL K
+	0x1D459: "l",                                                                              // MATHEMATICAL ITALIC SMALL L
+	0x1D45A: "rn",                                                                             // MATHEMATICAL ITALIC SMALL M
+	0x1D45B: "n",                                                                              // MATHEMATICAL ITALIC SMALL N
+	0x1D45C: "o",                                                                              // MATHEMATICAL ITALIC SMALL O
+	0x1D45D: "p",                                                                              // MATHEMATICAL ITALIC SMALL P
+	0x1D45E: "q",                                                                              // MATHEMATICAL ITALIC SMALL Q
+	0x1D45F: "r",                                                                              // MATHEMATICAL ITALIC SMALL R
+	0x1D460: "s",                                                                              // MATHEMATICAL ITALIC SMALL S
+	0x1D461: "t",                                                                              // MATHEMATICAL ITALIC SMALL T
+	0x1D462: "u",                                                                              // MATHEMATICAL ITALIC SMALL U
+	0x1D463: "v",                                                                              // MATHEMATICAL ITALIC SMALL V
+	0x1D464: "w",                                                                              // MATHEMATICAL ITALIC SMALL W
+	0x1D465: "x",                                                                              // MATHEMATICAL ITALIC SMALL X
+	0x1D466: "y",                                                                              // MATHEMATICAL ITALIC SMALL Y
+	0x1D467: "z",                                                                              // MATHEMATICAL ITALIC SMALL Z
+	0x1D468: "A",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL A
+	0x1D469: "B",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL B
+	0x1D46A: "C",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL C
+	0x1D46B: "D",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL D
+	0x1D46C: "E",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL E
+	0x1D46D: "F",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL F
+	0x1D46E: "G",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL G
+	0x1D46F: "H",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL H
+	0x1D470: "l",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL I
+	0x1D471: "J",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL J
+	0x1D472: "K",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL K
+	0x1D473: "L",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL L
+	0x1D474: "M",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL M
+	0x1D475: "N",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL N
+	0x1D476: "O",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL O
+	0x1D477: "P",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL P
+	0x1D478: "Q",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL Q
+	0x1D479: "R",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL R
+	0x1D47A: "S",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL S
+	0x1D47B: "T",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL T
+	0x1D47C: "U",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL U
+	0x1D47D: "V",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL V
+	0x1D47E: "W",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL W
+	0x1D47F: "X",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL X
+	0x1D480: "Y",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL Y
+	0x1D481: "Z",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL Z
+	0x1D482: "a",                                                                              // MATHEMATICAL BOLD ITALIC SMALL A
+	0x1D483: "b",                                                                              // MATHEMATICAL BOLD ITALIC SMALL B
+	0x1D484: "c",                                                                              // MATHEMATICAL BOLD ITALIC SMALL C
+	0x1D485: "d",                                                                              // MATHEMATICAL BOLD ITALIC SMALL D
+	0x1D486: "e",                                                                              // MATHEMATICAL BOLD ITALIC SMALL E
+	0x1D487: "f",                                                                              // MATHEMATICAL BOLD ITALIC SMALL F
+	0x1D488: "g",                                                                              // MATHEMATICAL BOLD ITALIC SMALL G
+	0x1D489: "h",                                                                              // MATHEMATICAL BOLD ITALIC SMALL H
+	0x1D48A: "i",                                                                              // MATHEMATICAL BOLD ITALIC SMALL I
+	0x1D48B: "j",                                                                              // MATHEMATICAL BOLD ITALIC SMALL J
+	0x1D48C: "k",                                                                              // MATHEMATICAL BOLD ITALIC SMALL K
+	0x1D48D: "l",                                                                              // MATHEMATICAL BOLD ITALIC SMALL L
+	0x1D48E: "rn",                                                                             // MATHEMATICAL BOLD ITALIC SMALL M
+	0x1D48F: "n",                                                                              // MATHEMATICAL BOLD ITALIC SMALL N
+	0x1D490: "o",                                                                              // MATHEMATICAL BOLD ITALIC SMALL O
+	0x1D491: "p",                                                                              // MATHEMATICAL BOLD ITALIC SMALL P
+	0x1D492: "q",                                                                              // MATHEMATICAL BOLD ITALIC SMALL Q
+	0x1D493: "r",                                                                              // MATHEMATICAL BOLD ITALIC SMALL R
+	0x1D494: "s",                                                                              // MATHEMATICAL BOLD ITALIC SMALL S
+	0x1D495: "t",                                                                              // MATHEMATICAL BOLD ITALIC SMALL T
+	0x1D496: "u",                                                                              // MATHEMATICAL BOLD ITALIC SMALL U
+	0x1D497: "v",                                                                              // MATHEMATICAL BOLD ITALIC SMALL V
+	0x1D498: "w",                                                                              // MATHEMATICAL BOLD ITALIC SMALL W
+	0x1D499: "x",                                                                              // MATHEMATICAL BOLD ITALIC SMALL X
+	0x1D49A: "y",                                                                              // MATHEMATICAL BOLD ITALIC SMALL Y
+	0x1D49B: "z",                                                                              // MATHEMATICAL BOLD ITALIC SMALL Z
+	0x1D49C: "A",                                                                              // MATHEMATICAL SCRIPT CAPITAL A
+	0x1D49E: "C",                                                                              // MATHEMATICAL SCRIPT CAPITAL C
+	0x1D49F: "D",                                                                              // MATHEMATICAL SCRIPT CAPITAL D
+	0x1D4A2: "G",                                                                              // MATHEMATICAL SCRIPT CAPITAL G
+	0x1D4A5: "J",                                                                              // MATHEMATICAL SCRIPT CAPITAL J
+	0x1D4A6: "K",                                                                              // MATHEMATICAL SCRIPT CAPITAL K
+	0x1D4A9: "N",                                                                              // MATHEMATICAL SCRIPT CAPITAL N
+	0x1D4AA: "O",                                                                              // MATHEMATICAL SCRIPT CAPITAL O
+	0x1D4AB: "P",                                                                              // MATHEMATICAL SCRIPT CAPITAL P
+	0x1D4AC: "Q",                                                                              // MATHEMATICAL SCRIPT CAPITAL Q
+	0x1D4AE: "S",                                                                              // MATHEMATICAL SCRIPT CAPITAL S
+	0x1D4AF: "T",                                                                              // MATHEMATICAL SCRIPT CAPITAL T
+	0x1D4B0: "U",                                                                              // MATHEMATICAL SCRIPT CAPITAL U
+	0x1D4B1: "V",                                                                              // MATHEMATICAL SCRIPT CAPITAL V
+	0x1D4B2: "W",                                                                              // MATHEMATICAL SCRIPT CAPITAL W
+	0x1D4B3: "X",                                                                              // MATHEMATICAL SCRIPT CAPITAL X
+	0x1D4B4: "Y",                                                                              // MATHEMATICAL SCRIPT CAPITAL Y
+	0x1D4B5: "Z",                                                                              // MATHEMATICAL SCRIPT CAPITAL Z
+	0x1D4B6: "a",                                                                              // MATHEMATICAL SCRIPT SMALL A
+	0x1D4B7: "b",                                                                              // MATHEMATICAL SCRIPT SMALL B
+	0x1D4B8: "c",                                                                              // MATHEMATICAL SCRIPT SMALL C
+	0x1D4B9: "d",                                                                              // MATHEMATICAL SCRIPT SMALL D
+	0x1D4BB: "f",                                                                              // MATHEMATICAL SCRIPT SMALL F
+	0x1D4BD: "h",                                                                              // MATHEMATICAL SCRIPT SMALL H
+	0x1D4BE: "i",                                                                              // MATHEMATICAL SCRIPT SMALL I
+	0x1D4BF: "j",                                                                              // MATHEMATICAL SCRIPT SMALL J
+	0x1D4C0: "k",                                                                              // MATHEMATICAL SCRIPT SMALL K
+	0x1D4C1: "l",                                                                              // MATHEMATICAL SCRIPT SMALL L
+	0x1D4C2: "rn",                                                                             // MATHEMATICAL SCRIPT SMALL M
+	0x1D4C3: "n",                                                                              // MATHEMATICAL SCRIPT SMALL N
+	0x1D4C5: "p",                                                                              // MATHEMATICAL SCRIPT SMALL P
+	0x1D4C6: "q",                                                                              // MATHEMATICAL SCRIPT SMALL Q
+	0x1D4C7: "r",                                                                              // MATHEMATICAL SCRIPT SMALL R
+	0x1D4C8: "s",                                                                              // MATHEMATICAL SCRIPT SMALL S
+	0x1D4C9: "t",                                                                              // MATHEMATICAL SCRIPT SMALL T
+	0x1D4CA: "u",                                                                              // MATHEMATICAL SCRIPT SMALL U
+	0x1D4CB: "v",                                                                              // MATHEMATICAL SCRIPT SMALL V
+	0x1D4CC: "w",                                                                              // MATHEMATICAL SCRIPT SMALL W
+	0x1D4CD: "x",                                                                              // MATHEMATICAL SCRIPT SMALL X
+	0x1D4CE: "y",                                                                              // MATHEMATICAL SCRIPT SMALL Y
+	0x1D4CF: "z",                                                                              // MATHEMATICAL SCRIPT SMALL Z
+	0x1D4D0: "A",                                                                              // MATHEMATICAL BOLD SCRIPT CAPITAL A
+	0x1D4D1: "B",                                                                              // MATHEMATICAL BOLD SCRIPT CAPITAL B
+	0x1D4D2: "C",                                                                              // MATHEMATICAL BOLD SCRIPT CAPITAL C
+	0x1D4D3: "D",                                                                              // MATHEMATICAL BOLD SCRIPT CAPITAL D
+	0x1D4D4: "E",                                                                              // MATHEMATICAL BOLD SCRIPT CAPITAL E
+	0x1D4D5: "F",                                                                              // MATHEMATICAL BOLD SCRIPT CAPITAL F
+	0x1D4D6: "G",                                                                              // MATHEMATICAL BOLD SCRIPT CAPITAL G
+	0x1D4D7: "H",                                                                              // MATHEMATICAL BOLD SCRIPT CAPITAL H
+	0x1D4D8: "l",                                                                              // MATHEMATICAL BOLD SCRIPT CAPITAL I
+	0x1D4D9: "J",                                                                              // MATHEMATICAL BOLD SCRIPT CAPITAL J
+	0x1D4DA: "K",                                                                              // MATHEMATICAL BOLD SCRIPT CAPITAL K
+	0x1D4DB: "L",                                                                              // MATHEMATICAL BOLD SCRIPT CAPITAL L
+	0x1D4DC: "M",                                                                              // MATHEMATICAL BOLD SCRIPT CAPITAL M
+	0x1D4DD: "N",                                                                              // MATHEMATICAL BOLD SCRIPT CAPITAL N
+	0x1D4DE: "O",                                                                              // MATHEMATICAL BOLD SCRIPT CAPITAL O
+	0x1D4DF: "P",                                                                              // MATHEMATICAL BOLD SCRIPT CAPITAL P
+	0x1D4E0: "Q",                                                                              // MATHEMATICAL BOLD SCRIPT CAPITAL Q
+	0x1D4E1: "R",                                                                              // MATHEMATICAL BOLD SCRIPT CAPITAL R
+	0x1D4E2: "S",                                                                              // MATHEMATICAL BOLD SCRIPT CAPITAL S
+	0x1D4E3: "T",                                                                              // MATHEMATICAL BOLD SCRIPT CAPITAL T
+	0x1D4E4: "U",                                                                              // MATHEMATICAL BOLD SCRIPT CAPITAL U
+	0x1D4E5: "V",                                                                              // MATHEMATICAL BOLD SCRIPT CAPITAL V
+	0x1D4E6: "W",                                                                              // MATHEMATICAL BOLD SCRIPT CAPITAL W
+	0x1D4E7: "X",                                                                              // MATHEMATICAL BOLD SCRIPT CAPITAL X
+	0x1D4E8: "Y",                                                                              // MATHEMATICAL BOLD SCRIPT CAPITAL Y
+	0x1D4E9: "Z",                                                                              // MATHEMATICAL BOLD SCRIPT CAPITAL Z
+	0x1D4EA: "a",                                                                              // MATHEMATICAL BOLD SCRIPT SMALL A
+	0x1D4EB: "b",                                                                              // MATHEMATICAL BOLD SCRIPT SMALL B
+	0x1D4EC: "c",                                                                              // MATHEMATICAL BOLD SCRIPT SMALL C
+	0x1D4ED: "d",                                                                              // MATHEMATICAL BOLD SCRIPT SMALL D
+	0x1D4EE: "e",                                                                              // MATHEMATICAL BOLD SCRIPT SMALL E
+	0x1D4EF: "f",                                                                              // MATHEMATICAL BOLD SCRIPT SMALL F
+	0x1D4F0: "g",                                                                              // MATHEMATICAL BOLD SCRIPT SMALL G
+	0x1D4F1: "h",                                                                              // MATHEMATICAL BOLD SCRIPT SMALL H
+	0x1D4F2: "i",                                                                              // MATHEMATICAL BOLD SCRIPT SMALL I
+	0x1D4F3: "j",                                                                              // MATHEMATICAL BOLD SCRIPT SMALL J
+	0x1D4F4: "k",                                                                              // MATHEMATICAL BOLD SCRIPT SMALL K
+	0x1D4F5: "l",                                                                              // MATHEMATICAL BOLD SCRIPT SMALL L
+	0x1D4F6: "rn",                                                                             // MATHEMATICAL BOLD SCRIPT SMALL M
+	0x1D4F7: "n",                                                                              // MATHEMATICAL BOLD SCRIPT SMALL N
+	0x1D4F8: "o",                                                                              // MATHEMATICAL BOLD SCRIPT SMALL O
+	0x1D4F9: "p",                                                                              // MATHEMATICAL BOLD SCRIPT SMALL P
+	0x1D4FA: "q",                                                                              // MATHEMATICAL BOLD SCRIPT SMALL Q
+	0x1D4FB: "r",                                                                              // MATHEMATICAL BOLD SCRIPT SMALL R
+	0x1D4FC: "s",                                                                              // MATHEMATICAL BOLD SCRIPT SMALL S
+	0x1D4FD: "t",                                                                              // MATHEMATICAL BOLD SCRIPT SMALL T
+	0x1D4FE: "u",                                                                              // MATHEMATICAL BOLD SCRIPT SMALL U
+	0x1D4FF: "v",                                                                              // MATHEMATICAL BOLD SCRIPT SMALL V
+	0x1D500: "w",                                                                              // MATHEMATICAL BOLD SCRIPT SMALL W
+	0x1D501: "x",                                                                              // MATHEMATICAL BOLD SCRIPT SMALL X
+	0x1D502: "y",                                                                              // MATHEMATICAL BOLD SCRIPT SMALL Y
+	0x1D503: "z",                                                                              // MATHEMATICAL BOLD SCRIPT SMALL Z
+	0x1D504: "A",                                                                              // MATHEMATICAL FRAKTUR CAPITAL A
+	0x1D505: "B",                                                                              // MATHEMATICAL FRAKTUR CAPITAL B
+	0x1D507: "D",                                                                              // MATHEMATICAL FRAKTUR CAPITAL D
+	0x1D508: "E",                                                                              // MATHEMATICAL FRAKTUR CAPITAL E
+	0x1D509: "F",                                                                              // MATHEMATICAL FRAKTUR CAPITAL F
+	0x1D50A: "G",                                                                              // MATHEMATICAL FRAKTUR CAPITAL G
+	0x1D50D: "J",                                                                              // MATHEMATICAL FRAKTUR CAPITAL J
+	0x1D50E: "K",                                                                              // MATHEMATICAL FRAKTUR CAPITAL K
+	0x1D50F: "L",                                                                              // MATHEMATICAL FRAKTUR CAPITAL L
+	0x1D510: "M",                                                                              // MATHEMATICAL FRAKTUR CAPITAL M
+	0x1D511: "N",                                                                              // MATHEMATICAL FRAKTUR CAPITAL N
+	0x1D512: "O",                                                                              // MATHEMATICAL FRAKTUR CAPITAL O
+	0x1D513: "P",                                                                              // MATHEMATICAL FRAKTUR CAPITAL P
+	0x1D514: "Q",                                                                              // MATHEMATICAL FRAKTUR CAPITAL Q
+	0x1D516: "S",                                                                              // MATHEMATICAL FRAKTUR CAPITAL S
+	0x1D517: "T",                                                                              // MATHEMATICAL FRAKTUR CAPITAL T
+	0x1D518: "U",                                                                              // MATHEMATICAL FRAKTUR CAPITAL U
+	0x1D519: "V",                                                                              // MATHEMATICAL FRAKTUR CAPITAL V
+	0x1D51A: "W",                                                                              // MATHEMATICAL FRAKTUR CAPITAL W
+	0x1D51B: "X",                                                                              // MATHEMATICAL FRAKTUR CAPITAL X
+	0x1D51C: "Y",                                                                              // MATHEMATICAL FRAKTUR CAPITAL Y
+	0x1D51E: "a",                                                                              // MATHEMATICAL FRAKTUR SMALL A
+	0x1D51F: "b",                                                                              // MATHEMATICAL FRAKTUR SMALL B
+	0x1D520: "c",                                                                              // MATHEMATICAL FRAKTUR SMALL C
+	0x1D521: "d",                                                                              // MATHEMATICAL FRAKTUR SMALL D
+	0x1D522: "e",                                                                              // MATHEMATICAL FRAKTUR SMALL E
+	0x1D523: "f",                                                                              // MATHEMATICAL FRAKTUR SMALL F
+	0x1D524: "g",                                                                              // MATHEMATICAL FRAKTUR SMALL G
+	0x1D525: "h",                                                                              // MATHEMATICAL FRAKTUR SMALL H
+	0x1D526: "i",                                                                              // MATHEMATICAL FRAKTUR SMALL I
+	0x1D527: "j",                                                                              // MATHEMATICAL FRAKTUR SMALL J
+	0x1D528: "k",                                                                              // MATHEMATICAL FRAKTUR SMALL K
+	0x1D529: "l",                                                                              // MATHEMATICAL FRAKTUR SMALL L
+	0x1D52A: "rn",                                                                             // MATHEMATICAL FRAKTUR SMALL M
+	0x1D52B: "n",                                                                              // MATHEMATICAL FRAKTUR SMALL N
+	0x1D52C: "o",                                                                              // MATHEMATICAL FRAKTUR SMALL O
+	0x1D52D: "p",                                                                              // MATHEMATICAL FRAKTUR SMALL P
+	0x1D52E: "q",                                                                              // MATHEMATICAL FRAKTUR SMALL Q
+	0x1D52F: "r",                                                                              // MATHEMATICAL FRAKTUR SMALL R
+	0x1D530: "s",                                                                              // MATHEMATICAL FRAKTUR SMALL S
+	0x1D531: "t",                                                                              // MATHEMATICAL FRAKTUR SMALL T
+	0x1D532: "u",                                                                              // MATHEMATICAL FRAKTUR SMALL U
+	0x1D533: "v",                                                                              // MATHEMATICAL FRAKTUR SMALL V
+	0x1D534: "w",                                                                              // MATHEMATICAL FRAKTUR SMALL W
+	0x1D535: "x",                                                                              // MATHEMATICAL FRAKTUR SMALL X
+	0x1D536: "y",                                                                              // MATHEMATICAL FRAKTUR SMALL Y
+	0x1D537: "z",                                                                              // MATHEMATICAL FRAKTUR SMALL Z
+	0x1D538: "A",                                                                              // MATHEMATICAL DOUBLE-STRUCK CAPITAL A
+	0x1D539: "B",                                                                              // MATHEMATICAL DOUBLE-STRUCK CAPITAL B
+	0x1D53B: "D",                                                                              // MATHEMATICAL DOUBLE-STRUCK CAPITAL D
+	0x1D53C: "E",                                                                              // MATHEMATICAL DOUBLE-STRUCK CAPITAL E
+	0x1D53D: "F",                                                                              // MATHEMATICAL DOUBLE-STRUCK CAPITAL F
+	0x1D53E: "G",                                                                              // MATHEMATICAL DOUBLE-STRUCK CAPITAL G
+	0x1D540: "l",                                                                              // MATHEMATICAL DOUBLE-STRUCK CAPITAL I
+	0x1D541: "J",                                                                              // MATHEMATICAL DOUBLE-STRUCK CAPITAL J
+	0x1D542: "K",                                                                              // MATHEMATICAL DOUBLE-STRUCK CAPITAL K
+	0x1D543: "L",                                                                              // MATHEMATICAL DOUBLE-STRUCK CAPITAL L
+	0x1D544: "M",                                                                              // MATHEMATICAL DOUBLE-STRUCK CAPITAL M
+	0x1D546: "O",                                                                              // MATHEMATICAL DOUBLE-STRUCK CAPITAL O
+	0x1D54A: "S",                                                                              // MATHEMATICAL DOUBLE-STRUCK CAPITAL S
+	0x1D54B: "T",                                                                              // MATHEMATICAL DOUBLE-STRUCK CAPITAL T
+	0x1D54C: "U",                                                                              // MATHEMATICAL DOUBLE-STRUCK CAPITAL U
+	0x1D54D: "V",                                                                              // MATHEMATICAL DOUBLE-STRUCK CAPITAL V
+	0x1D54E: "W",                                                                              // MATHEMATICAL DOUBLE-STRUCK CAPITAL W
+	0x1D54F: "X",                                                                              // MATHEMATICAL DOUBLE-STRUCK CAPITAL X
+	0x1D550: "Y",                                                                              // MATHEMATICAL DOUBLE-STRUCK CAPITAL Y
+	0x1D552: "a",                                                                              // MATHEMATICAL DOUBLE-STRUCK SMALL A
+	0x1D553: "b",                                                                              // MATHEMATICAL DOUBLE-STRUCK SMALL B
+	0x1D554: "c",                                                                              // MATHEMATICAL DOUBLE-STRUCK SMALL C
+	0x1D555: "d",                                                                              // MATHEMATICAL DOUBLE-STRUCK SMALL D
+	0x1D556: "e",                                                                              // MATHEMATICAL DOUBLE-STRUCK SMALL E
+	0x1D557: "f",                                                                              // MATHEMATICAL DOUBLE-STRUCK SMALL F
+	0x1D558: "g",                                                                              // MATHEMATICAL DOUBLE-STRUCK SMALL G
+	0x1D559: "h",                                                                              // MATHEMATICAL DOUBLE-STRUCK SMALL H
+	0x1D55A: "i",                                                                              // MATHEMATICAL DOUBLE-STRUCK SMALL I
+	0x1D55B: "j",                                                                              // MATHEMATICAL DOUBLE-STRUCK SMALL J
+	0x1D55C: "k",                                                                              // MATHEMATICAL DOUBLE-STRUCK SMALL K
+	0x1D55D: "l",                                                                              // MATHEMATICAL DOUBLE-STRUCK SMALL L
+	0x1D55E: "rn",                                                                             // MATHEMATICAL DOUBLE-STRUCK SMALL M
+	0x1D55F: "n",                                                                              // MATHEMATICAL DOUBLE-STRUCK SMALL N
+	0x1D560: "o",                                                                              // MATHEMATICAL DOUBLE-STRUCK SMALL O
+	0x1D561: "p",                                                                              // MATHEMATICAL DOUBLE-STRUCK SMALL P
+	0x1D562: "q",                                                                              // MATHEMATICAL DOUBLE-STRUCK SMALL Q
+	0x1D563: "r",                                                                              // MATHEMATICAL DOUBLE-STRUCK SMALL R
+	0x1D564: "s",                                                                              // MATHEMATICAL DOUBLE-STRUCK SMALL S
+	0x1D565: "t",                                                                              // MATHEMATICAL DOUBLE-STRUCK SMALL T
+	0x1D566: "u",                                                                              // MATHEMATICAL DOUBLE-STRUCK SMALL U
+	0x1D567: "v",                                                                              // MATHEMATICAL DOUBLE-STRUCK SMALL V
+	0x1D568: "w",                                                                              // MATHEMATICAL DOUBLE-STRUCK SMALL W
+	0x1D569: "x",                                                                              // MATHEMATICAL DOUBLE-STRUCK SMALL X
+	0x1D56A: "y",                                                                              // MATHEMATICAL DOUBLE-STRUCK SMALL Y
+	0x1D56B: "z",                                                                              // MATHEMATICAL DOUBLE-STRUCK SMALL Z
+	0x1D56C: "A",                                                                              // MATHEMATICAL BOLD FRAKTUR CAPITAL A
+	0x1D56D: "B",                                                                              // MATHEMATICAL BOLD FRAKTUR CAPITAL B
+	0x1D56E: "C",                                                                              // MATHEMATICAL BOLD FRAKTUR CAPITAL C
+	0x1D56F: "D",                                                                              // MATHEMATICAL BOLD FRAKTUR CAPITAL D
+	0x1D570: "E",                                                                              // MATHEMATICAL BOLD FRAKTUR CAPITAL E
+	0x1D571: "F",                                                                              // MATHEMATICAL BOLD FRAKTUR CAPITAL F
+	0x1D572: "G",                                                                              // MATHEMATICAL BOLD FRAKTUR CAPITAL G
+	0x1D573: "H",                                                                              // MATHEMATICAL BOLD FRAKTUR CAPITAL H
+	0x1D574: "l",                                                                              // MATHEMATICAL BOLD FRAKTUR CAPITAL I
+	0x1D575: "J",                                                                              // MATHEMATICAL BOLD FRAKTUR CAPITAL J
+	0x1D576: "K",                                                                              // MATHEMATICAL BOLD FRAKTUR CAPITAL K
+	0x1D577: "L",                                                                              // MATHEMATICAL BOLD FRAKTUR CAPITAL L
+	0x1D578: "M",                                                                              // MATHEMATICAL BOLD FRAKTUR CAPITAL M
+	0x1D579: "N",                                                                              // MATHEMATICAL BOLD FRAKTUR CAPITAL N
+	0x1D57A: "O",                                                                              // MATHEMATICAL BOLD FRAKTUR CAPITAL O
+	0x1D57B: "P",                                                                              // MATHEMATICAL BOLD FRAKTUR CAPITAL P
+	0x1D57C: "Q",                                                                              // MATHEMATICAL BOLD FRAKTUR CAPITAL Q
+	0x1D57D: "R",                                                                              // MATHEMATICAL BOLD FRAKTUR CAPITAL R
+	0x1D57E: "S",                                                                              // MATHEMATICAL BOLD FRAKTUR CAPITAL S
+	0x1D57F: "T",                                                                              // MATHEMATICAL BOLD FRAKTUR CAPITAL T
+	0x1D580: "U",                                                                              // MATHEMATICAL BOLD FRAKTUR CAPITAL U
+	0x1D581: "V",                                                                              // MATHEMATICAL BOLD FRAKTUR CAPITAL V
+	0x1D582: "W",                                                                              // MATHEMATICAL BOLD FRAKTUR CAPITAL W
+	0x1D583: "X",                                                                              // MATHEMATICAL BOLD FRAKTUR CAPITAL X
+	0x1D584: "Y",                                                                              // MATHEMATICAL BOLD FRAKTUR CAPITAL Y
+	0x1D585: "Z",                                                                              // MATHEMATICAL BOLD FRAKTUR CAPITAL Z
+	0x1D586: "a",                                                                              // MATHEMATICAL BOLD FRAKTUR SMALL A
+	0x1D587: "b",                                                                              // MATHEMATICAL BOLD FRAKTUR SMALL B
+	0x1D588: "c",                                                                              // MATHEMATICAL BOLD FRAKTUR SMALL C
+	0x1D589: "d",                                                                              // MATHEMATICAL BOLD FRAKTUR SMALL D
+	0x1D58A: "e",                                                                              // MATHEMATICAL BOLD FRAKTUR SMALL E
+	0x1D58B: "f",                                                                              // MATHEMATICAL BOLD FRAKTUR SMALL F
+	0x1D58C: "g",                                                                              // MATHEMATICAL BOLD FRAKTUR SMALL G
+	0x1D58D: "h",                                                                              // MATHEMATICAL BOLD FRAKTUR SMALL H
+	0x1D58E: "i",                                                                              // MATHEMATICAL BOLD FRAKTUR SMALL I
+	0x1D58F: "j",                                                                              // MATHEMATICAL BOLD FRAKTUR SMALL J
+	0x1D590: "k",                                                                              // MATHEMATICAL BOLD FRAKTUR SMALL K
+	0x1D591: "l",                                                                              // MATHEMATICAL BOLD FRAKTUR SMALL L
+	0x1D592: "rn",                                                                             // MATHEMATICAL BOLD FRAKTUR SMALL M
+	0x1D593: "n",                                                                              // MATHEMATICAL BOLD FRAKTUR SMALL N
+	0x1D594: "o",                                                                              // MATHEMATICAL BOLD FRAKTUR SMALL O
+	0x1D595: "p",                                                                              // MATHEMATICAL BOLD FRAKTUR SMALL P
+	0x1D596: "q",                                                                              // MATHEMATICAL BOLD FRAKTUR SMALL Q
+	0x1D597: "r",                                                                              // MATHEMATICAL BOLD FRAKTUR SMALL R
+	0x1D598: "s",                                                                              // MATHEMATICAL BOLD FRAKTUR SMALL S
+	0x1D599: "t",                                                                              // MATHEMATICAL BOLD FRAKTUR SMALL T
+	0x1D59A: "u",                                                                              // MATHEMATICAL BOLD FRAKTUR SMALL U
+	0x1D59B: "v",                                                                              // MATHEMATICAL BOLD FRAKTUR SMALL V
+	0x1D59C: "w",                                                                              // MATHEMATICAL BOLD FRAKTUR SMALL W
+	0x1D59D: "x",                                                                              // MATHEMATICAL BOLD FRAKTUR SMALL X
+	0x1D59E: "y",                                                                              // MATHEMATICAL BOLD FRAKTUR SMALL Y
+	0x1D59F: "z",                                                                              // MATHEMATICAL BOLD FRAKTUR SMALL Z
+	0x1D5A0: "A",                                                                              // MATHEMATICAL SANS-SERIF CAPITAL A
+	0x1D5A1: "B",                                                                              // MATHEMATICAL SANS-SERIF CAPITAL B
+	0x1D5A2: "C",                                                                              // MATHEMATICAL SANS-SERIF CAPITAL C
+	0x1D5A3: "D",                                                                              // MATHEMATICAL SANS-SERIF CAPITAL D
+	0x1D5A4: "E",                                                                              // MATHEMATICAL SANS-SERIF CAPITAL E
+	0x1D5A5: "F",                                                                              // MATHEMATICAL SANS-SERIF CAPITAL F
+	0x1D5A6: "G",                                                                              // MATHEMATICAL SANS-SERIF CAPITAL G
+	0x1D5A7: "H",                                                                              // MATHEMATICAL SANS-SERIF CAPITAL H
+	0x1D5A8: "l",                                                                              // MATHEMATICAL SANS-SERIF CAPITAL I
+	0x1D5A9: "J",                                                                              // MATHEMATICAL SANS-SERIF CAPITAL J
+	0x1D5AA: "K",                                                                              // MATHEMATICAL SANS-SERIF CAPITAL K
+	0x1D5AB: "L",                                                                              // MATHEMATICAL SANS-SERIF CAPITAL L
+	0x1D5AC: "M",                                                                              // MATHEMATICAL SANS-SERIF CAPITAL M
+	0x1D5AD: "N",                                                                              // MATHEMATICAL SANS-SERIF CAPITAL N
+	0x1D5AE: "O",                                                                              // MATHEMATICAL SANS-SERIF CAPITAL O
+	0x1D5AF: "P",                                                                              // MATHEMATICAL SANS-SERIF CAPITAL P
+	0x1D5B0: "Q",                                                                              // MATHEMATICAL SANS-SERIF CAPITAL Q
+	0x1D5B1: "R",                                                                              // MATHEMATICAL SANS-SERIF CAPITAL R
+	0x1D5B2: "S",                                                                              // MATHEMATICAL SANS-SERIF CAPITAL S
+	0x1D5B3: "T",                                                                              // MATHEMATICAL SANS-SERIF CAPITAL T
+	0x1D5B4: "U",                                                                              // MATHEMATICAL SANS-SERIF CAPITAL U
+	0x1D5B5: "V",                                                                              // MATHEMATICAL SANS-SERIF CAPITAL V
+	0x1D5B6: "W",                                                                              // MATHEMATICAL SANS-SERIF CAPITAL W
+	0x1D5B7: "X",                                                                              // MATHEMATICAL SANS-SERIF CAPITAL X
+	0x1D5B8: "Y",                                                                              // MATHEMATICAL SANS-SERIF CAPITAL Y
+	0x1D5B9: "Z",                                                                              // MATHEMATICAL SANS-SERIF CAPITAL Z
+	0x1D5BA: "a",                                                                              // MATHEMATICAL SANS-SERIF SMALL A
+	0x1D5BB: "b",                                                                              // MATHEMATICAL SANS-SERIF SMALL B
+	0x1D5BC: "c",                                                                              // MATHEMATICAL SANS-SERIF SMALL C
+	0x1D5BD: "d",                                                                              // MATHEMATICAL SANS-SERIF SMALL D
+	0x1D5BE: "e",                                                                              // MATHEMATICAL SANS-SERIF SMALL E
+	0x1D5BF: "f",                                                                              // MATHEMATICAL SANS-SERIF SMALL F
+	0x1D5C0: "g",                                                                              // MATHEMATICAL SANS-SERIF SMALL G
+	0x1D5C1: "h",                                                                              // MATHEMATICAL SANS-SERIF SMALL H
+	0x1D5C2: "i",                                                                              // MATHEMATICAL SANS-SERIF SMALL I
+	0x1D5C3: "j",                                                                              // MATHEMATICAL SANS-SERIF SMALL J
+	0x1D5C4: "k",                                                                              // MATHEMATICAL SANS-SERIF SMALL K
+	0x1D5C5: "l",                                                                              // MATHEMATICAL SANS-SERIF SMALL L
+	0x1D5C6: "rn",                                                                             // MATHEMATICAL SANS-SERIF SMALL M
+	0x1D5C7: "n",                                                                              // MATHEMATICAL SANS-SERIF SMALL N
+	0x1D5C8: "o",                                                                              // MATHEMATICAL SANS-SERIF SMALL O
+	0x1D5C9: "p",                                                                              // MATHEMATICAL SANS-SERIF SMALL P
+	0x1D5CA: "q",                                                                              // MATHEMATICAL SANS-SERIF SMALL Q
+	0x1D5CB: "r",                                                                              // MATHEMATICAL SANS-SERIF SMALL R
+	0x1D5CC: "s",                                                                              // MATHEMATICAL SANS-SERIF SMALL S
+	0x1D5CD: "t",                                                                              // MATHEMATICAL SANS-SERIF SMALL T
+	0x1D5CE: "u",                                                                              // MATHEMATICAL SANS-SERIF SMALL U
+	0x1D5CF: "v",                                                                              // MATHEMATICAL SANS-SERIF SMALL V
+	0x1D5D0: "w",                                                                              // MATHEMATICAL SANS-SERIF SMALL W
+	0x1D5D1: "x",                                                                              // MATHEMATICAL SANS-SERIF SMALL X
+	0x1D5D2: "y",                                                                              // MATHEMATICAL SANS-SERIF SMALL Y
+	0x1D5D3: "z",                                                                              // MATHEMATICAL SANS-SERIF SMALL Z
+	0x1D5D4: "A",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL A
+	0x1D5D5: "B",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL B
+	0x1D5D6: "C",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL C
+	0x1D5D7: "D",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL D
+	0x1D5D8: "E",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL E
+	0x1D5D9: "F",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL F
+	0x1D5DA: "G",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL G
+	0x1D5DB: "H",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL H
+	0x1D5DC: "l",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL I
+	0x1D5DD: "J",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL J
+	0x1D5DE: "K",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL K
+	0x1D5DF: "L",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL L
+	0x1D5E0: "M",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL M
+	0x1D5E1: "N",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL N
+	0x1D5E2: "O",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL O
+	0x1D5E3: "P",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL P
+	0x1D5E4: "Q",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL Q
+	0x1D5E5: "R",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL R
+	0x1D5E6: "S",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL S
+	0x1D5E7: "T",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL T
+	0x1D5E8: "U",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL U
+	0x1D5E9: "V",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL V
+	0x1D5EA: "W",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL W
+	0x1D5EB: "X",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL X
+	0x1D5EC: "Y",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL Y
+	0x1D5ED: "Z",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL Z
+	0x1D5EE: "a",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL A
+	0x1D5EF: "b",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL B
+	0x1D5F0: "c",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL C
+	0x1D5F1: "d",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL D
+	0x1D5F2: "e",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL E
+	0x1D5F3: "f",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL F
+	0x1D5F4: "g",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL G
+	0x1D5F5: "h",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL H
+	0x1D5F6: "i",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL I
+	0x1D5F7: "j",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL J
+	0x1D5F8: "k",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL K
+	0x1D5F9: "l",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL L
+	0x1D5FA: "rn",                                                                             // MATHEMATICAL SANS-SERIF BOLD SMALL M
+	0x1D5FB: "n",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL N
+	0x1D5FC: "o",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL O
+	0x1D5FD: "p",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL P
+	0x1D5FE: "q",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL Q
+	0x1D5FF: "r",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL R
+	0x1D600: "s",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL S
+	0x1D601: "t",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL T
+	0x1D602: "u",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL U
+	0x1D603: "v",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL V
+	0x1D604: "w",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL W
+	0x1D605: "x",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL X
+	0x1D606: "y",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL Y
+	0x1D607: "z",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL Z
+	0x1D608: "A",                                                                              // MATHEMATICAL SANS-SERIF ITALIC CAPITAL A
+	0x1D609: "B",                                                                              // MATHEMATICAL SANS-SERIF ITALIC CAPITAL B
+	0x1D60A: "C",                                                                              // MATHEMATICAL SANS-SERIF ITALIC CAPITAL C
+	0x1D60B: "D",                                                                              // MATHEMATICAL SANS-SERIF ITALIC CAPITAL D
+	0x1D60C: "E",                                                                              // MATHEMATICAL SANS-SERIF ITALIC CAPITAL E
+	0x1D60D: "F",                                                                              // MATHEMATICAL SANS-SERIF ITALIC CAPITAL F
+	0x1D60E: "G",                                                                              // MATHEMATICAL SANS-SERIF ITALIC CAPITAL G
+	0x1D60F: "H",                                                                              // MATHEMATICAL SANS-SERIF ITALIC CAPITAL H
+	0x1D610: "l",                                                                              // MATHEMATICAL SANS-SERIF ITALIC CAPITAL I
+	0x1D611: "J",                                                                              // MATHEMATICAL SANS-SERIF ITALIC CAPITAL J
+	0x1D612: "K",                                                                              // MATHEMATICAL SANS-SERIF ITALIC CAPITAL K
+	0x1D613: "L",                                                                              // MATHEMATICAL SANS-SERIF ITALIC CAPITAL L
+	0x1D614: "M",                                                                              // MATHEMATICAL SANS-SERIF ITALIC CAPITAL M
+	0x1D615: "N",                                                                              // MATHEMATICAL SANS-SERIF ITALIC CAPITAL N
+	0x1D616: "O",                                                                              // MATHEMATICAL SANS-SERIF ITALIC CAPITAL O
+	0x1D617: "P",                                                                              // MATHEMATICAL SANS-SERIF ITALIC CAPITAL P
+	0x1D618: "Q",                                                                              // MATHEMATICAL SANS-SERIF ITALIC CAPITAL Q
+	0x1D619: "R",                                                                              // MATHEMATICAL SANS-SERIF ITALIC CAPITAL R
+	0x1D61A: "S",                                                                              // MATHEMATICAL SANS-SERIF ITALIC CAPITAL S
+	0x1D61B: "T",                                                                              // MATHEMATICAL SANS-SERIF ITALIC CAPITAL T
+	0x1D61C: "U",                                                                              // MATHEMATICAL SANS-SERIF ITALIC CAPITAL U
+	0x1D61D: "V",                                                                              // MATHEMATICAL SANS-SERIF ITALIC CAPITAL V
+	0x1D61E: "W",                                                                              // MATHEMATICAL SANS-SERIF ITALIC CAPITAL W
+	0x1D61F: "X",                                                                              // MATHEMATICAL SANS-SERIF ITALIC CAPITAL X
+	0x1D620: "Y",                                                                              // MATHEMATICAL SANS-SERIF ITALIC CAPITAL Y
+	0x1D621: "Z",                                                                              // MATHEMATICAL SANS-SERIF ITALIC CAPITAL Z
+	0x1D622: "a",                                                                              // MATHEMATICAL SANS-SERIF ITALIC SMALL A
+	0x1D623: "b",                                                                              // MATHEMATICAL SANS-SERIF ITALIC SMALL B
+	0x1D624: "c",                                                                              // MATHEMATICAL SANS-SERIF ITALIC SMALL C
+	0x1D625: "d",                                                                              // MATHEMATICAL SANS-SERIF ITALIC SMALL D
+	0x1D626: "e",                                                                              // MATHEMATICAL SANS-SERIF ITALIC SMALL E
+	0x1D627: "f",                                                                              // MATHEMATICAL SANS-SERIF ITALIC SMALL F
+	0x1D628: "g",                                                                              // MATHEMATICAL SANS-SERIF ITALIC SMALL G
+	0x1D629: "h",                                                                              // MATHEMATICAL SANS-SERIF ITALIC SMALL H
+	0x1D62A: "i",                                                                              // MATHEMATICAL SANS-SERIF ITALIC SMALL I
+	0x1D62B: "j",                                                                              // MATHEMATICAL SANS-SERIF ITALIC SMALL J
+	0x1D62C: "k",                                                                              // MATHEMATICAL SANS-SERIF ITALIC SMALL K
+	0x1D62D: "l",                                                                              // MATHEMATICAL SANS-SERIF ITALIC SMALL L
+	0x1D62E: "rn",                                                                             // MATHEMATICAL SANS-SERIF ITALIC SMALL M
+	0x1D62F: "n",                                                                              // MATHEMATICAL SANS-SERIF ITALIC SMALL N
+	0x1D630: "o",                                                                              // MATHEMATICAL SANS-SERIF ITALIC SMALL O
+	0x1D631: "p",                                                                              // MATHEMATICAL SANS-SERIF ITALIC SMALL P
+	0x1D632: "q",                                                                              // MATHEMATICAL SANS-SERIF ITALIC SMALL Q
+	0x1D633: "r",                                                                              // MATHEMATICAL SANS-SERIF ITALIC SMALL R
+	0x1D634: "s",                                                                              // MATHEMATICAL SANS-SERIF ITALIC SMALL S
+	0x1D635: "t",                                                                              // MATHEMATICAL SANS-SERIF ITALIC SMALL T
+	0x1D636: "u",                                                                              // MATHEMATICAL SANS-SERIF ITALIC SMALL U
+	0x1D637: "v",                                                                              // MATHEMATICAL SANS-SERIF ITALIC SMALL V
+	0x1D638: "w",                                                                              // MATHEMATICAL SANS-SERIF ITALIC SMALL W
+	0x1D639: "x",                                                                              // MATHEMATICAL SANS-SERIF ITALIC SMALL X
+	0x1D63A: "y",                                                                              // MATHEMATICAL SANS-SERIF ITALIC SMALL Y
+	0x1D63B: "z",                                                                              // MATHEMATICAL SANS-SERIF ITALIC SMALL Z
+	0x1D63C: "A",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL A
+	0x1D63D: "B",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL B
+	0x1D63E: "C",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL C
+	0x1D63F: "D",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL D
+	0x1D640: "E",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL E
+	0x1D641: "F",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL F
+	0x1D642: "G",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL G
+	0x1D643: "H",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL H
+	0x1D644: "l",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL I
+	0x1D645: "J",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL J
+	0x1D646: "K",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL K
+	0x1D647: "L",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL L
+	0x1D648: "M",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL M
+	0x1D649: "N",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL N
+	0x1D64A: "O",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL O
+	0x1D64B: "P",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL P
+	0x1D64C: "Q",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL Q
+	0x1D64D: "R",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL R
+	0x1D64E: "S",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL S
+	0x1D64F: "T",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL T
+	0x1D650: "U",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL U
+	0x1D651: "V",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL V
+	0x1D652: "W",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL W
+	0x1D653: "X",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL X
+	0x1D654: "Y",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL Y
+	0x1D655: "Z",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL Z
+	0x1D656: "a",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL A
+	0x1D657: "b",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL B
+	0x1D658: "c",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL C
+	0x1D659: "d",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL D
+	0x1D65A: "e",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL E
+	0x1D65B: "f",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL F
+	0x1D65C: "g",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL G
+	0x1D65D: "h",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL H
+	0x1D65E: "i",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL I
+	0x1D65F: "j",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL J
+	0x1D660: "k",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL K
+	0x1D661: "l",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL L
+	0x1D662: "rn",                                                                             // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL M
+	0x1D663: "n",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL N
+	0x1D664: "o",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL O
+	0x1D665: "p",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL P
+	0x1D666: "q",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL Q
+	0x1D667: "r",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL R
+	0x1D668: "s",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL S
+	0x1D669: "t",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL T
+	0x1D66A: "u",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL U
+	0x1D66B: "v",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL V
+	0x1D66C: "w",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL W
+	0x1D66D: "x",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL X
+	0x1D66E: "y",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL Y
+	0x1D66F: "z",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL Z
+	0x1D670: "A",                                                                              // MATHEMATICAL MONOSPACE CAPITAL A
+	0x1D671: "B",                                                                              // MATHEMATICAL MONOSPACE CAPITAL B
+	0x1D672: "C",                                                                              // MATHEMATICAL MONOSPACE CAPITAL C
+	0x1D673: "D",                                                                              // MATHEMATICAL MONOSPACE CAPITAL D
+	0x1D674: "E",                                                                              // MATHEMATICAL MONOSPACE CAPITAL E
+	0x1D675: "F",                                                                              // MATHEMATICAL MONOSPACE CAPITAL F
+	0x1D676: "G",                                                                              // MATHEMATICAL MONOSPACE CAPITAL G
+	0x1D677: "H",                                                                              // MATHEMATICAL MONOSPACE CAPITAL H
+	0x1D678: "l",                                                                              // MATHEMATICAL MONOSPACE CAPITAL I
+	0x1D679: "J",                                                                              // MATHEMATICAL MONOSPACE CAPITAL J
+	0x1D67A: "K",                                                                              // MATHEMATICAL MONOSPACE CAPITAL K
+	0x1D67B: "L",                                                                              // MATHEMATICAL MONOSPACE CAPITAL L
+	0x1D67C: "M",                                                                              // MATHEMATICAL MONOSPACE CAPITAL M
+	0x1D67D: "N",                                                                              // MATHEMATICAL MONOSPACE CAPITAL N
+	0x1D67E: "O",                                                                              // MATHEMATICAL MONOSPACE CAPITAL O
+	0x1D67F: "P",                                                                              // MATHEMATICAL MONOSPACE CAPITAL P
+	0x1D680: "Q",                                                                              // MATHEMATICAL MONOSPACE CAPITAL Q
+	0x1D681: "R",                                                                              // MATHEMATICAL MONOSPACE CAPITAL R
+	0x1D682: "S",                                                                              // MATHEMATICAL MONOSPACE CAPITAL S
+	0x1D683: "T",                                                                              // MATHEMATICAL MONOSPACE CAPITAL T
+	0x1D684: "U",                                                                              // MATHEMATICAL MONOSPACE CAPITAL U
+	0x1D685: "V",                                                                              // MATHEMATICAL MONOSPACE CAPITAL V
+	0x1D686: "W",                                                                              // MATHEMATICAL MONOSPACE CAPITAL W
+	0x1D687: "X",                                                                              // MATHEMATICAL MONOSPACE CAPITAL X
+	0x1D688: "Y",                                                                              // MATHEMATICAL MONOSPACE CAPITAL Y
+	0x1D689: "Z",                                                                              // MATHEMATICAL MONOSPACE CAPITAL Z
+	0x1D68A: "a",                                                                              // MATHEMATICAL MONOSPACE SMALL A
+	0x1D68B: "b",                                                                              // MATHEMATICAL MONOSPACE SMALL B
+	0x1D68C: "c",                                                                              // MATHEMATICAL MONOSPACE SMALL C
+	0x1D68D: "d",                                                                              // MATHEMATICAL MONOSPACE SMALL D
+	0x1D68E: "e",                                                                              // MATHEMATICAL MONOSPACE SMALL E
+	0x1D68F: "f",                                                                              // MATHEMATICAL MONOSPACE SMALL F
+	0x1D690: "g",                                                                              // MATHEMATICAL MONOSPACE SMALL G
+	0x1D691: "h",                                                                              // MATHEMATICAL MONOSPACE SMALL H
+	0x1D692: "i",                                                                              // MATHEMATICAL MONOSPACE SMALL I
+	0x1D693: "j",                                                                              // MATHEMATICAL MONOSPACE SMALL J
+	0x1D694: "k",                                                                              // MATHEMATICAL MONOSPACE SMALL K
+	0x1D695: "l",                                                                              // MATHEMATICAL MONOSPACE SMALL L
+	0x1D696: "rn",                                                                             // MATHEMATICAL MONOSPACE SMALL M
+	0x1D697: "n",                                                                              // MATHEMATICAL MONOSPACE SMALL N
+	0x1D698: "o",                                                                              // MATHEMATICAL MONOSPACE SMALL O
+	0x1D699: "p",                                                                              // MATHEMATICAL MONOSPACE SMALL P
+	0x1D69A: "q",                                                                              // MATHEMATICAL MONOSPACE SMALL Q
+	0x1D69B: "r",                                                                              // MATHEMATICAL MONOSPACE SMALL R
+	0x1D69C: "s",                                                                              // MATHEMATICAL MONOSPACE SMALL S
+	0x1D69D: "t",                                                                              // MATHEMATICAL MONOSPACE SMALL T
+	0x1D69E: "u",                                                                              // MATHEMATICAL MONOSPACE SMALL U
+	0x1D69F: "v",                                                                              // MATHEMATICAL MONOSPACE SMALL V
+	0x1D6A0: "w",                                                                              // MATHEMATICAL MONOSPACE SMALL W
+	0x1D6A1: "x",                                                                              // MATHEMATICAL MONOSPACE SMALL X
+	0x1D6A2: "y",                                                                              // MATHEMATICAL MONOSPACE SMALL Y
+	0x1D6A3: "z",                                                                              // MATHEMATICAL MONOSPACE SMALL Z
+	0x1D6A4: "i",                                                                              // MATHEMATICAL ITALIC SMALL DOTLESS I
+	0x1D6A5: "\u0237",                                                                         // MATHEMATICAL ITALIC SMALL DOTLESS J
+	0x1D6A8: "A",                                                                              // MATHEMATICAL BOLD CAPITAL ALPHA
+	0x1D6A9: "B",                                                                              // MATHEMATICAL BOLD CAPITAL BETA
+	0x1D6AA: "\u0393",                                                                         // MATHEMATICAL BOLD CAPITAL GAMMA
+	0x1D6AB: "\u0394",                                                                         // MATHEMATICAL BOLD CAPITAL DELTA
+	0x1D6AC: "E",                                                                              // MATHEMATICAL BOLD CAPITAL EPSILON
+	0x1D6AD: "Z",                                                                              // MATHEMATICAL BOLD CAPITAL ZETA
+	0x1D6AE: "H",                                                                              // MATHEMATICAL BOLD CAPITAL ETA
+	0x1D6AF: "O\u0335",                                                                        // MATHEMATICAL BOLD CAPITAL THETA
+	0x1D6B0: "l",                                                                              // MATHEMATICAL BOLD CAPITAL IOTA
+	0x1D6B1: "K",                                                                              // MATHEMATICAL BOLD CAPITAL KAPPA
+	0x1D6B2: "\u0245",                                                                         // MATHEMATICAL BOLD CAPITAL LAMDA
+	0x1D6B3: "M",                                                                              // MATHEMATICAL BOLD CAPITAL MU
+	0x1D6B4: "N",                                                                              // MATHEMATICAL BOLD CAPITAL NU
+	0x1D6B5: "\u039e",                                                                         // MATHEMATICAL BOLD CAPITAL XI
+	0x1D6B6: "O",                                                                              // MATHEMATICAL BOLD CAPITAL OMICRON
+	0x1D6B7: "\u03a0",                                                                         // MATHEMATICAL BOLD CAPITAL PI
+	0x1D6B8: "P",                                                                              // MATHEMATICAL BOLD CAPITAL RHO
+	0x1D6B9: "O\u0335",                                                                        // MATHEMATICAL BOLD CAPITAL THETA SYMBOL
+	0x1D6BA: "\u01a9",                                                                         // MATHEMATICAL BOLD CAPITAL SIGMA
+	0x1D6BB: "T",                                                                              // MATHEMATICAL BOLD CAPITAL TAU
+	0x1D6BC: "Y",                                                                              // MATHEMATICAL BOLD CAPITAL UPSILON
+	0x1D6BD: "\u03a6",                                                                         // MATHEMATICAL BOLD CAPITAL PHI
+	0x1D6BE: "X",                                                                              // MATHEMATICAL BOLD CAPITAL CHI
+	0x1D6BF: "\u03a8",                                                                         // MATHEMATICAL BOLD CAPITAL PSI
+	0x1D6C0: "\u03a9",                                                                         // MATHEMATICAL BOLD CAPITAL OMEGA
+	0x1D6C1: "\u2207",                                                                         // MATHEMATICAL BOLD NABLA
+	0x1D6C2: "a",                                                                              // MATHEMATICAL BOLD SMALL ALPHA
+	0x1D6C3: "\u00df",                                                                         // MATHEMATICAL BOLD SMALL BETA
+	0x1D6C4: "y",                                                                              // MATHEMATICAL BOLD SMALL GAMMA
+	0x1D6C5: "\u1e9f",                                                                         // MATHEMATICAL BOLD SMALL DELTA
+	0x1D6C6: "\ua793",                                                                         // MATHEMATICAL BOLD SMALL EPSILON
+	0x1D6C7: "\u03b6",                                                                         // MATHEMATICAL BOLD SMALL ZETA
+	0x1D6C8: "n\u0329",                                                                        // MATHEMATICAL BOLD SMALL ETA
+	0x1D6C9: "O\u0335",                                                                        // MATHEMATICAL BOLD SMALL THETA
+	0x1D6CA: "i",                                                                              // MATHEMATICAL BOLD SMALL IOTA
+	0x1D6CB: "\u0138",                                                                         // MATHEMATICAL BOLD SMALL KAPPA
+	0x1D6CC: "\u03bb",                                                                         // MATHEMATICAL BOLD SMALL LAMDA
+	0x1D6CD: "\u03bc",                                                                         // MATHEMATICAL BOLD SMALL MU
+	0x1D6CE: "v",                                                                              // MATHEMATICAL BOLD SMALL NU
+	0x1D6CF: "\u03be",                                                                         // MATHEMATICAL BOLD SMALL XI
+	0x1D6D0: "o",                                                                              // MATHEMATICAL BOLD SMALL OMICRON
+	0x1D6D1: "\u03c0",                                                                         // MATHEMATICAL BOLD SMALL PI
+	0x1D6D2: "p",                                                                              // MATHEMATICAL BOLD SMALL RHO
+	0x1D6D3: "\u03c2",                                                                         // MATHEMATICAL BOLD SMALL FINAL SIGMA
+	0x1D6D4: "o",                                                                              // MATHEMATICAL BOLD SMALL SIGMA
+	0x1D6D5: "\u1d1b",                                                                         // MATHEMATICAL BOLD SMALL TAU
+	0x1D6D6: "u",                                                                              // MATHEMATICAL BOLD SMALL UPSILON
+	0x1D6D7: "\u0278",                                                                         // MATHEMATICAL BOLD SMALL PHI
+	0x1D6D8: "\u03c7",                                                                         // MATHEMATICAL BOLD SMALL CHI
+	0x1D6D9: "\u03c8",                                                                         // MATHEMATICAL BOLD SMALL PSI
+	0x1D6DA: "\u03c9",                                                                         // MATHEMATICAL BOLD SMALL OMEGA
+	0x1D6DB: "\u2202",                                                                         // MATHEMATICAL BOLD PARTIAL DIFFERENTIAL
+	0x1D6DC: "\ua793",                                                                         // MATHEMATICAL BOLD EPSILON SYMBOL
+	0x1D6DD: "O\u0335",                                                                        // MATHEMATICAL BOLD THETA SYMBOL
+	0x1D6DE: "\u0138",                                                                         // MATHEMATICAL BOLD KAPPA SYMBOL
+	0x1D6DF: "\u0278",                                                                         // MATHEMATICAL BOLD PHI SYMBOL
+	0x1D6E0: "p",                                                                              // MATHEMATICAL BOLD RHO SYMBOL
+	0x1D6E1: "\u03c0",                                                                         // MATHEMATICAL BOLD PI SYMBOL
+	0x1D6E2: "A",                                                                              // MATHEMATICAL ITALIC CAPITAL ALPHA
+	0x1D6E3: "B",                                                                              // MATHEMATICAL ITALIC CAPITAL BETA
+	0x1D6E4: "\u0393",                                                                         // MATHEMATICAL ITALIC CAPITAL GAMMA
+	0x1D6E5: "\u0394",                                                                         // MATHEMATICAL ITALIC CAPITAL DELTA
+	0x1D6E6: "E",                                                                              // MATHEMATICAL ITALIC CAPITAL EPSILON
+	0x1D6E7: "Z",                                                                              // MATHEMATICAL ITALIC CAPITAL ZETA
+	0x1D6E8: "H",                                                                              // MATHEMATICAL ITALIC CAPITAL ETA
+	0x1D6E9: "O\u0335",                                                                        // MATHEMATICAL ITALIC CAPITAL THETA
+	0x1D6EA: "l",                                                                              // MATHEMATICAL ITALIC CAPITAL IOTA
+	0x1D6EB: "K",                                                                              // MATHEMATICAL ITALIC CAPITAL KAPPA
+	0x1D6EC: "\u0245",                                                                         // MATHEMATICAL ITALIC CAPITAL LAMDA
+	0x1D6ED: "M",                                                                              // MATHEMATICAL ITALIC CAPITAL MU
+	0x1D6EE: "N",                                                                              // MATHEMATICAL ITALIC CAPITAL NU
+	0x1D6EF: "\u039e",                                                                         // MATHEMATICAL ITALIC CAPITAL XI
+	0x1D6F0: "O",                                                                              // MATHEMATICAL ITALIC CAPITAL OMICRON
+	0x1D6F1: "\u03a0",                                                                         // MATHEMATICAL ITALIC CAPITAL PI
+	0x1D6F2: "P",                                                                              // MATHEMATICAL ITALIC CAPITAL RHO
+	0x1D6F3: "O\u0335",                                                                        // MATHEMATICAL ITALIC CAPITAL THETA SYMBOL
+	0x1D6F4: "\u01a9",                                                                         // MATHEMATICAL ITALIC CAPITAL SIGMA
+	0x1D6F5: "T",                                                                              // MATHEMATICAL ITALIC CAPITAL TAU
+	0x1D6F6: "Y",                                                                              // MATHEMATICAL ITALIC CAPITAL UPSILON
+	0x1D6F7: "\u03a6",                                                                         // MATHEMATICAL ITALIC CAPITAL PHI
+	0x1D6F8: "X",                                                                              // MATHEMATICAL ITALIC CAPITAL CHI
+	0x1D6F9: "\u03a8",                                                                         // MATHEMATICAL ITALIC CAPITAL PSI
+	0x1D6FA: "\u03a9",                                                                         // MATHEMATICAL ITALIC CAPITAL OMEGA
+	0x1D6FB: "\u2207",                                                                         // MATHEMATICAL ITALIC NABLA
+	0x1D6FC: "a",                                                                              // MATHEMATICAL ITALIC SMALL ALPHA
+	0x1D6FD: "\u00df",                                                                         // MATHEMATICAL ITALIC SMALL BETA
+	0x1D6FE: "y",                                                                              // MATHEMATICAL ITALIC SMALL GAMMA
+	0x1D6FF: "\u1e9f",                                                                         // MATHEMATICAL ITALIC SMALL DELTA
+	0x1D700: "\ua793",                                                                         // MATHEMATICAL ITALIC SMALL EPSILON
+	0x1D701: "\u03b6",                                                                         // MATHEMATICAL ITALIC SMALL ZETA
+	0x1D702: "n\u0329",                                                                        // MATHEMATICAL ITALIC SMALL ETA
+	0x1D703: "O\u0335",                                                                        // MATHEMATICAL ITALIC SMALL THETA
+	0x1D704: "i",                                                                              // MATHEMATICAL ITALIC SMALL IOTA
+	0x1D705: "\u0138",                                                                         // MATHEMATICAL ITALIC SMALL KAPPA
+	0x1D706: "\u03bb",                                                                         // MATHEMATICAL ITALIC SMALL LAMDA
+	0x1D707: "\u03bc",                                                                         // MATHEMATICAL ITALIC SMALL MU
+	0x1D708: "v",                                                                              // MATHEMATICAL ITALIC SMALL NU
+	0x1D709: "\u03be",                                                                         // MATHEMATICAL ITALIC SMALL XI
+	0x1D70A: "o",                                                                              // MATHEMATICAL ITALIC SMALL OMICRON
+	0x1D70B: "\u03c0",                                                                         // MATHEMATICAL ITALIC SMALL PI
+	0x1D70C: "p",                                                                              // MATHEMATICAL ITALIC SMALL RHO
+	0x1D70D: "\u03c2",                                                                         // MATHEMATICAL ITALIC SMALL FINAL SIGMA
+	0x1D70E: "o",                                                                              // MATHEMATICAL ITALIC SMALL SIGMA
+	0x1D70F: "\u1d1b",                                                                         // MATHEMATICAL ITALIC SMALL TAU
+	0x1D710: "u",                                                                              // MATHEMATICAL ITALIC SMALL UPSILON
+	0x1D711: "\u0278",                                                                         // MATHEMATICAL ITALIC SMALL PHI
+	0x1D712: "\u03c7",                                                                         // MATHEMATICAL ITALIC SMALL CHI
+	0x1D713: "\u03c8",                                                                         // MATHEMATICAL ITALIC SMALL PSI
+	0x1D714: "\u03c9",                                                                         // MATHEMATICAL ITALIC SMALL OMEGA
+	0x1D715: "\u2202",                                                                         // MATHEMATICAL ITALIC PARTIAL DIFFERENTIAL
+	0x1D716: "\ua793",                                                                         // MATHEMATICAL ITALIC EPSILON SYMBOL
+	0x1D717: "O\u0335",                                                                        // MATHEMATICAL ITALIC THETA SYMBOL
+	0x1D718: "\u0138",                                                                         // MATHEMATICAL ITALIC KAPPA SYMBOL
+	0x1D719: "\u0278",                                                                         // MATHEMATICAL ITALIC PHI SYMBOL
+	0x1D71A: "p",                                                                              // MATHEMATICAL ITALIC RHO SYMBOL
+	0x1D71B: "\u03c0",                                                                         // MATHEMATICAL ITALIC PI SYMBOL
+	0x1D71C: "A",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL ALPHA
+	0x1D71D: "B",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL BETA
+	0x1D71E: "\u0393",                                                                         // MATHEMATICAL BOLD ITALIC CAPITAL GAMMA
+	0x1D71F: "\u0394",                                                                         // MATHEMATICAL BOLD ITALIC CAPITAL DELTA
+	0x1D720: "E",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL EPSILON
+	0x1D721: "Z",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL ZETA
+	0x1D722: "H",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL ETA
+	0x1D723: "O\u0335",                                                                        // MATHEMATICAL BOLD ITALIC CAPITAL THETA
+	0x1D724: "l",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL IOTA
+	0x1D725: "K",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL KAPPA
+	0x1D726: "\u0245",                                                                         // MATHEMATICAL BOLD ITALIC CAPITAL LAMDA
+	0x1D727: "M",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL MU
+	0x1D728: "N",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL NU
+	0x1D729: "\u039e",                                                                         // MATHEMATICAL BOLD ITALIC CAPITAL XI
+	0x1D72A: "O",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL OMICRON
+	0x1D72B: "\u03a0",                                                                         // MATHEMATICAL BOLD ITALIC CAPITAL PI
+	0x1D72C: "P",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL RHO
+	0x1D72D: "O\u0335",                                                                        // MATHEMATICAL BOLD ITALIC CAPITAL THETA SYMBOL
+	0x1D72E: "\u01a9",                                                                         // MATHEMATICAL BOLD ITALIC CAPITAL SIGMA
+	0x1D72F: "T",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL TAU
+	0x1D730: "Y",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL UPSILON
+	0x1D731: "\u03a6",                                                                         // MATHEMATICAL BOLD ITALIC CAPITAL PHI
+	0x1D732: "X",                                                                              // MATHEMATICAL BOLD ITALIC CAPITAL CHI
+	0x1D733: "\u03a8",                                                                         // MATHEMATICAL BOLD ITALIC CAPITAL PSI
+	0x1D734: "\u03a9",                                                                         // MATHEMATICAL BOLD ITALIC CAPITAL OMEGA
+	0x1D735: "\u2207",                                                                         // MATHEMATICAL BOLD ITALIC NABLA
+	0x1D736: "a",                                                                              // MATHEMATICAL BOLD ITALIC SMALL ALPHA
+	0x1D737: "\u00df",                                                                         // MATHEMATICAL BOLD ITALIC SMALL BETA
+	0x1D738: "y",                                                                              // MATHEMATICAL BOLD ITALIC SMALL GAMMA
+	0x1D739: "\u1e9f",                                                                         // MATHEMATICAL BOLD ITALIC SMALL DELTA
+	0x1D73A: "\ua793",                                                                         // MATHEMATICAL BOLD ITALIC SMALL EPSILON
+	0x1D73B: "\u03b6",                                                                         // MATHEMATICAL BOLD ITALIC SMALL ZETA
+	0x1D73C: "n\u0329",                                                                        // MATHEMATICAL BOLD ITALIC SMALL ETA
+	0x1D73D: "O\u0335",                                                                        // MATHEMATICAL BOLD ITALIC SMALL THETA
+	0x1D73E: "i",                                                                              // MATHEMATICAL BOLD ITALIC SMALL IOTA
+	0x1D73F: "\u0138",                                                                         // MATHEMATICAL BOLD ITALIC SMALL KAPPA
+	0x1D740: "\u03bb",                                                                         // MATHEMATICAL BOLD ITALIC SMALL LAMDA
+	0x1D741: "\u03bc",                                                                         // MATHEMATICAL BOLD ITALIC SMALL MU
+	0x1D742: "v",                                                                              // MATHEMATICAL BOLD ITALIC SMALL NU
+	0x1D743: "\u03be",                                                                         // MATHEMATICAL BOLD ITALIC SMALL XI
+	0x1D744: "o",                                                                              // MATHEMATICAL BOLD ITALIC SMALL OMICRON
+	0x1D745: "\u03c0",                                                                         // MATHEMATICAL BOLD ITALIC SMALL PI
+	0x1D746: "p",                                                                              // MATHEMATICAL BOLD ITALIC SMALL RHO
+	0x1D747: "\u03c2",                                                                         // MATHEMATICAL BOLD ITALIC SMALL FINAL SIGMA
+	0x1D748: "o",                                                                              // MATHEMATICAL BOLD ITALIC SMALL SIGMA
+	0x1D749: "\u1d1b",                                                                         // MATHEMATICAL BOLD ITALIC SMALL TAU
+	0x1D74A: "u",                                                                              // MATHEMATICAL BOLD ITALIC SMALL UPSILON
+	0x1D74B: "\u0278",                                                                         // MATHEMATICAL BOLD ITALIC SMALL PHI
+	0x1D74C: "\u03c7",                                                                         // MATHEMATICAL BOLD ITALIC SMALL CHI
+	0x1D74D: "\u03c8",                                                                         // MATHEMATICAL BOLD ITALIC SMALL PSI
+	0x1D74E: "\u03c9",                                                                         // MATHEMATICAL BOLD ITALIC SMALL OMEGA
+	0x1D74F: "\u2202",                                                                         // MATHEMATICAL BOLD ITALIC PARTIAL DIFFERENTIAL
+	0x1D750: "\ua793",                                                                         // MATHEMATICAL BOLD ITALIC EPSILON SYMBOL
+	0x1D751: "O\u0335",                                                                        // MATHEMATICAL BOLD ITALIC THETA SYMBOL
+	0x1D752: "\u0138",                                                                         // MATHEMATICAL BOLD ITALIC KAPPA SYMBOL
+	0x1D753: "\u0278",                                                                         // MATHEMATICAL BOLD ITALIC PHI SYMBOL
+	0x1D754: "p",                                                                              // MATHEMATICAL BOLD ITALIC RHO SYMBOL
+	0x1D755: "\u03c0",                                                                         // MATHEMATICAL BOLD ITALIC PI SYMBOL
+	0x1D756: "A",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL ALPHA
+	0x1D757: "B",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL BETA
+	0x1D758: "\u0393",                                                                         // MATHEMATICAL SANS-SERIF BOLD CAPITAL GAMMA
+	0x1D759: "\u0394",                                                                         // MATHEMATICAL SANS-SERIF BOLD CAPITAL DELTA
+	0x1D75A: "E",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL EPSILON
+	0x1D75B: "Z",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL ZETA
+	0x1D75C: "H",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL ETA
+	0x1D75D: "O\u0335",                                                                        // MATHEMATICAL SANS-SERIF BOLD CAPITAL THETA
+	0x1D75E: "l",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL IOTA
+	0x1D75F: "K",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL KAPPA
+	0x1D760: "\u0245",                                                                         // MATHEMATICAL SANS-SERIF BOLD CAPITAL LAMDA
+	0x1D761: "M",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL MU
+	0x1D762: "N",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL NU
+	0x1D763: "\u039e",                                                                         // MATHEMATICAL SANS-SERIF BOLD CAPITAL XI
+	0x1D764: "O",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL OMICRON
+	0x1D765: "\u03a0",                                                                         // MATHEMATICAL SANS-SERIF BOLD CAPITAL PI
+	0x1D766: "P",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL RHO
+	0x1D767: "O\u0335",                                                                        // MATHEMATICAL SANS-SERIF BOLD CAPITAL THETA SYMBOL
+	0x1D768: "\u01a9",                                                                         // MATHEMATICAL SANS-SERIF BOLD CAPITAL SIGMA
+	0x1D769: "T",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL TAU
+	0x1D76A: "Y",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL UPSILON
+	0x1D76B: "\u03a6",                                                                         // MATHEMATICAL SANS-SERIF BOLD CAPITAL PHI
+	0x1D76C: "X",                                                                              // MATHEMATICAL SANS-SERIF BOLD CAPITAL CHI
+	0x1D76D: "\u03a8",                                                                         // MATHEMATICAL SANS-SERIF BOLD CAPITAL PSI
+	0x1D76E: "\u03a9",                                                                         // MATHEMATICAL SANS-SERIF BOLD CAPITAL OMEGA
+	0x1D76F: "\u2207",                                                                         // MATHEMATICAL SANS-SERIF BOLD NABLA
+	0x1D770: "a",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL ALPHA
+	0x1D771: "\u00df",                                                                         // MATHEMATICAL SANS-SERIF BOLD SMALL BETA
+	0x1D772: "y",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL GAMMA
+	0x1D773: "\u1e9f",                                                                         // MATHEMATICAL SANS-SERIF BOLD SMALL DELTA
+	0x1D774: "\ua793",                                                                         // MATHEMATICAL SANS-SERIF BOLD SMALL EPSILON
+	0x1D775: "\u03b6",                                                                         // MATHEMATICAL SANS-SERIF BOLD SMALL ZETA
+	0x1D776: "n\u0329",                                                                        // MATHEMATICAL SANS-SERIF BOLD SMALL ETA
+	0x1D777: "O\u0335",                                                                        // MATHEMATICAL SANS-SERIF BOLD SMALL THETA
+	0x1D778: "i",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL IOTA
+	0x1D779: "\u0138",                                                                         // MATHEMATICAL SANS-SERIF BOLD SMALL KAPPA
+	0x1D77A: "\u03bb",                                                                         // MATHEMATICAL SANS-SERIF BOLD SMALL LAMDA
+	0x1D77B: "\u03bc",                                                                         // MATHEMATICAL SANS-SERIF BOLD SMALL MU
+	0x1D77C: "v",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL NU
+	0x1D77D: "\u03be",                                                                         // MATHEMATICAL SANS-SERIF BOLD SMALL XI
+	0x1D77E: "o",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL OMICRON
+	0x1D77F: "\u03c0",                                                                         // MATHEMATICAL SANS-SERIF BOLD SMALL PI
+	0x1D780: "p",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL RHO
+	0x1D781: "\u03c2",                                                                         // MATHEMATICAL SANS-SERIF BOLD SMALL FINAL SIGMA
+	0x1D782: "o",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL SIGMA
+	0x1D783: "\u1d1b",                                                                         // MATHEMATICAL SANS-SERIF BOLD SMALL TAU
+	0x1D784: "u",                                                                              // MATHEMATICAL SANS-SERIF BOLD SMALL UPSILON
+	0x1D785: "\u0278",                                                                         // MATHEMATICAL SANS-SERIF BOLD SMALL PHI
+	0x1D786: "\u03c7",                                                                         // MATHEMATICAL SANS-SERIF BOLD SMALL CHI
+	0x1D787: "\u03c8",                                                                         // MATHEMATICAL SANS-SERIF BOLD SMALL PSI
+	0x1D788: "\u03c9",                                                                         // MATHEMATICAL SANS-SERIF BOLD SMALL OMEGA
+	0x1D789: "\u2202",                                                                         // MATHEMATICAL SANS-SERIF BOLD PARTIAL DIFFERENTIAL
+	0x1D78A: "\ua793",                                                                         // MATHEMATICAL SANS-SERIF BOLD EPSILON SYMBOL
+	0x1D78B: "O\u0335",                                                                        // MATHEMATICAL SANS-SERIF BOLD THETA SYMBOL
+	0x1D78C: "\u0138",                                                                         // MATHEMATICAL SANS-SERIF BOLD KAPPA SYMBOL
+	0x1D78D: "\u0278",                                                                         // MATHEMATICAL SANS-SERIF BOLD PHI SYMBOL
+	0x1D78E: "p",                                                                              // MATHEMATICAL SANS-SERIF BOLD RHO SYMBOL
+	0x1D78F: "\u03c0",                                                                         // MATHEMATICAL SANS-SERIF BOLD PI SYMBOL
+	0x1D790: "A",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL ALPHA
+	0x1D791: "B",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL BETA
+	0x1D792: "\u0393",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL GAMMA
+	0x1D793: "\u0394",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL DELTA
+	0x1D794: "E",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL EPSILON
+	0x1D795: "Z",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL ZETA
+	0x1D796: "H",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL ETA
+	0x1D797: "O\u0335",                                                                        // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL THETA
+	0x1D798: "l",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL IOTA
+	0x1D799: "K",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL KAPPA
+	0x1D79A: "\u0245",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL LAMDA
+	0x1D79B: "M",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL MU
+	0x1D79C: "N",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL NU
+	0x1D79D: "\u039e",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL XI
+	0x1D79E: "O",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL OMICRON
+	0x1D79F: "\u03a0",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL PI
+	0x1D7A0: "P",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL RHO
+	0x1D7A1: "O\u0335",                                                                        // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL THETA SYMBOL
+	0x1D7A2: "\u01a9",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL SIGMA
+	0x1D7A3: "T",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL TAU
+	0x1D7A4: "Y",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL UPSILON
+	0x1D7A5: "\u03a6",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL PHI
+	0x1D7A6: "X",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL CHI
+	0x1D7A7: "\u03a8",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL PSI
+	0x1D7A8: "\u03a9",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL OMEGA
+	0x1D7A9: "\u2207",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC NABLA
+	0x1D7AA: "a",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL ALPHA
+	0x1D7AB: "\u00df",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL BETA
+	0x1D7AC: "y",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL GAMMA
+	0x1D7AD: "\u1e9f",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL DELTA
+	0x1D7AE: "\ua793",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL EPSILON
+	0x1D7AF: "\u03b6",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL ZETA
+	0x1D7B0: "n\u0329",                                                                        // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL ETA
+	0x1D7B1: "O\u0335",                                                                        // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL THETA
+	0x1D7B2: "i",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL IOTA
+	0x1D7B3: "\u0138",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL KAPPA
+	0x1D7B4: "\u03bb",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL LAMDA
+	0x1D7B5: "\u03bc",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL MU
+	0x1D7B6: "v",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL NU
+	0x1D7B7: "\u03be",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL XI
+	0x1D7B8: "o",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL OMICRON
+	0x1D7B9: "\u03c0",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL PI
+	0x1D7BA: "p",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL RHO
+	0x1D7BB: "\u03c2",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL FINAL SIGMA
+	0x1D7BC: "o",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL SIGMA
+	0x1D7BD: "\u1d1b",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL TAU
+	0x1D7BE: "u",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL UPSILON
+	0x1D7BF: "\u0278",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL PHI
+	0x1D7C0: "\u03c7",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL CHI
+	0x1D7C1: "\u03c8",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL PSI
+	0x1D7C2: "\u03c9",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL OMEGA
+	0x1D7C3: "\u2202",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC PARTIAL DIFFERENTIAL
+	0x1D7C4: "\ua793",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC EPSILON SYMBOL
+	0x1D7C5: "O\u0335",                                                                        // MATHEMATICAL SANS-SERIF BOLD ITALIC THETA SYMBOL
+	0x1D7C6: "\u0138",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC KAPPA SYMBOL
+	0x1D7C7: "\u0278",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC PHI SYMBOL
+	0x1D7C8: "p",                                                                              // MATHEMATICAL SANS-SERIF BOLD ITALIC RHO SYMBOL
+	0x1D7C9: "\u03c0",                                                                         // MATHEMATICAL SANS-SERIF BOLD ITALIC PI SYMBOL
+	0x1D7CA: "F",                                                                              // MATHEMATICAL BOLD CAPITAL DIGAMMA
+	0x1D7CB: "\u03dd",                                                                         // MATHEMATICAL BOLD SMALL DIGAMMA
+	0x1D7CE: "O",                                                                              // MATHEMATICAL BOLD DIGIT ZERO
+	0x1D7CF: "l",                                                                              // MATHEMATICAL BOLD DIGIT ONE
+	0x1D7D0: "2",                                                                              // MATHEMATICAL BOLD DIGIT TWO
+	0x1D7D1: "3",                                                                              // MATHEMATICAL BOLD DIGIT THREE
+	0x1D7D2: "4",                                                                              // MATHEMATICAL BOLD DIGIT FOUR
+	0x1D7D3: "5",                                                                              // MATHEMATICAL BOLD DIGIT FIVE
+	0x1D7D4: "6",                                                                              // MATHEMATICAL BOLD DIGIT SIX
+	0x1D7D5: "7",                                                                              // MATHEMATICAL BOLD DIGIT SEVEN
+	0x1D7D6: "8",                                                                              // MATHEMATICAL BOLD DIGIT EIGHT
+	0x1D7D7: "9",                                                                              // MATHEMATICAL BOLD DIGIT NINE
+	0x1D7D8: "O",                                                                              // MATHEMATICAL DOUBLE-STRUCK DIGIT ZERO
+	0x1D7D9: "l",                                                                              // MATHEMATICAL DOUBLE-STRUCK DIGIT ONE
+	0x1D7DA: "2",                                                                              // MATHEMATICAL DOUBLE-STRUCK DIGIT TWO
+	0x1D7DB: "3",                                                                              // MATHEMATICAL DOUBLE-STRUCK DIGIT THREE
+	0x1D7DC: "4",                                                                              // MATHEMATICAL DOUBLE-STRUCK DIGIT FOUR
+	0x1D7DD: "5",                                                                              // MATHEMATICAL DOUBLE-STRUCK DIGIT FIVE
+	0x1D7DE: "6",                                                                              // MATHEMATICAL DOUBLE-STRUCK DIGIT SIX
+	0x1D7DF: "7",                                                                              // MATHEMATICAL DOUBLE-STRUCK DIGIT SEVEN
+	0x1D7E0: "8",                                                                              // MATHEMATICAL DOUBLE-STRUCK DIGIT EIGHT
+	0x1D7E1: "9",                                                                              // MATHEMATICAL DOUBLE-STRUCK DIGIT NINE
+	0x1D7E2: "O",                                                                              // MATHEMATICAL SANS-SERIF DIGIT ZERO
+	0x1D7E3: "l",                                                                              // MATHEMATICAL SANS-SERIF DIGIT ONE
+	0x1D7E4: "2",                                                                              // MATHEMATICAL SANS-SERIF DIGIT TWO
+	0x1D7E5: "3",                                                                              // MATHEMATICAL SANS-SERIF DIGIT THREE
+	0x1D7E6: "4",                                                                              // MATHEMATICAL SANS-SERIF DIGIT FOUR
+	0x1D7E7: "5",                                                                              // MATHEMATICAL SANS-SERIF DIGIT FIVE
+	0x1D7E8: "6",                                                                              // MATHEMATICAL SANS-SERIF DIGIT SIX
+	0x1D7E9: "7",                                                                              // MATHEMATICAL SANS-SERIF DIGIT SEVEN
+	0x1D7EA: "8",                                                                              // MATHEMATICAL SANS-SERIF DIGIT EIGHT
+	0x1D7EB: "9",                                                                              // MATHEMATICAL SANS-SERIF DIGIT NINE
+	0x1D7EC: "O",                                                                              // MATHEMATICAL SANS-SERIF BOLD DIGIT ZERO
+	0x1D7ED: "l",                                                                              // MATHEMATICAL SANS-SERIF BOLD DIGIT ONE
+	0x1D7EE: "2",                                                                              // MATHEMATICAL SANS-SERIF BOLD DIGIT TWO
+	0x1D7EF: "3",                                                                              // MATHEMATICAL SANS-SERIF BOLD DIGIT THREE
+	0x1D7F0: "4",                                                                              // MATHEMATICAL SANS-SERIF BOLD DIGIT FOUR
+	0x1D7F1: "5",                                                                              // MATHEMATICAL SANS-SERIF BOLD DIGIT FIVE
+	0x1D7F2: "6",                                                                              // MATHEMATICAL SANS-SERIF BOLD DIGIT SIX
+	0x1D7F3: "7",                                                                              // MATHEMATICAL SANS-SERIF BOLD DIGIT SEVEN
+	0x1D7F4: "8",                                                                              // MATHEMATICAL SANS-SERIF BOLD DIGIT EIGHT
+	0x1D7F5: "9",                                                                              // MATHEMATICAL SANS-SERIF BOLD DIGIT NINE
+	0x1D7F6: "O",                                                                              // MATHEMATICAL MONOSPACE DIGIT ZERO
+	0x1D7F7: "l",                                                                              // MATHEMATICAL MONOSPACE DIGIT ONE
+	0x1D7F8: "2",                                                                              // MATHEMATICAL MONOSPACE DIGIT TWO
+	0x1D7F9: "3",                                                                              // MATHEMATICAL MONOSPACE DIGIT THREE
+	0x1D7FA: "4",                                                                              // MATHEMATICAL MONOSPACE DIGIT FOUR
+	0x1D7FB: "5",                                                                              // MATHEMATICAL MONOSPACE DIGIT FIVE
+	0x1D7FC: "6",                                                                              // MATHEMATICAL MONOSPACE DIGIT SIX
+	0x1D7FD: "7",                                                                              // MATHEMATICAL MONOSPACE DIGIT SEVEN
+	0x1D7FE: "8",                                                                              // MATHEMATICAL MONOSPACE DIGIT EIGHT
+	0x1D7FF: "9",                                                                              // MATHEMATICAL MONOSPACE DIGIT NINE
+	0x1E8C7: "l",                                                                              // MENDE KIKAKUI DIGIT ONE
+	0x1E8C8: "\u2220",                                                                         // MENDE KIKAKUI DIGIT TWO
+	0x1E8C9: "\u0663",                                                                         // MENDE KIKAKUI DIGIT THREE
+	0x1E8CB: "8",                                                                              // MENDE KIKAKUI DIGIT FIVE
+	0x1E8CC: "\u2202",                                                                         // MENDE KIKAKUI DIGIT SIX
+	0x1E8CD: "\u2202\u0335",                                                                   // MENDE KIKAKUI DIGIT SEVEN
+	0x1EE00: "l",                                                                              // ARABIC MATHEMATICAL ALEF
+	0x1EE01: "\u0628",                                                                         // ARABIC MATHEMATICAL BEH
+	0x1EE02: "\u062c",                                                                         // ARABIC MATHEMATICAL JEEM
+	0x1EE03: "\u062f",                                                                         // ARABIC MATHEMATICAL DAL
+	0x1EE05: "\u0648",                                                                         // ARABIC MATHEMATICAL WAW
+	0x1EE06: "\u0632",                                                                         // ARABIC MATHEMATICAL ZAIN
+	0x1EE07: "\u062d",                                                                         // ARABIC MATHEMATICAL HAH
+	0x1EE08: "\u0637",                                                                         // ARABIC MATHEMATICAL TAH
+	0x1EE09: "\u0649",                                                                         // ARABIC MATHEMATICAL YEH
+	0x1EE0A: "\u0643",                                                                         // ARABIC MATHEMATICAL KAF
+	0x1EE0B: "\u0644",                                                                         // ARABIC MATHEMATICAL LAM
+	0x1EE0C: "\u0645",                                                                         // ARABIC MATHEMATICAL MEEM
+	0x1EE0D: "\u0646",                                                                         // ARABIC MATHEMATICAL NOON
+	0x1EE0E: "\u0633",                                                                         // ARABIC MATHEMATICAL SEEN
+	0x1EE0F: "\u0639",                                                                         // ARABIC MATHEMATICAL AIN
+	0x1EE10: "\u0641",                                                                         // ARABIC MATHEMATICAL FEH
+	0x1EE11: "\u0635",                                                                         // ARABIC MATHEMATICAL SAD
+	0x1EE12: "\u0642",                                                                         // ARABIC MATHEMATICAL QAF
+	0x1EE13: "\u0631",                                                                         // ARABIC MATHEMATICAL REH
+	0x1EE14: "\u0633\u06db",                                                                   // ARABIC MATHEMATICAL SHEEN
+	0x1EE15: "\u062a",                                                                         // ARABIC MATHEMATICAL TEH
+	0x1EE16: "\u0649\u06db",                                                                   // ARABIC MATHEMATICAL THEH
+	0x1EE17: "\u062e",                                                                         // ARABIC MATHEMATICAL KHAH
+	0x1EE18: "\u0630",                                                                         // ARABIC MATHEMATICAL THAL
+	0x1EE19: "\u0636",                                                                         // ARABIC MATHEMATICAL DAD
+	0x1EE1A: "\u0638",                                                                         // ARABIC MATHEMATICAL ZAH
+	0x1EE1B: "\u063a",                                                                         // ARABIC MATHEMATICAL GHAIN
+	0x1EE1C: "\u0649",                                                                         // ARABIC MATHEMATICAL DOTLESS BEH
+	0x1EE1D: "\u0649",                                                                         // ARABIC MATHEMATICAL DOTLESS NOON
+	0x1EE1E: "\u06a1",                                                                         // ARABIC MATHEMATICAL DOTLESS FEH
+	0x1EE1F: "\u06a1",                                                                         // ARABIC MATHEMATICAL DOTLESS QAF
+	0x1EE21: "\u0628",                                                                         // ARABIC MATHEMATICAL INITIAL BEH
+	0x1EE22: "\u062c",                                                                         // ARABIC MATHEMATICAL INITIAL JEEM
+	0x1EE24: "o",                                                                              // ARABIC MATHEMATICAL INITIAL HEH
+	0x1EE27: "\u062d",                                                                         // ARABIC MATHEMATICAL INITIAL HAH
+	0x1EE29: "\u0649",                                                                         // ARABIC MATHEMATICAL INITIAL YEH
+	0x1EE2A: "\u0643",                                                                         // ARABIC MATHEMATICAL INITIAL KAF
+	0x1EE2B: "\u0644",                                                                         // ARABIC MATHEMATICAL INITIAL LAM
+	0x1EE2C: "\u0645",                                                                         // ARABIC MATHEMATICAL INITIAL MEEM
+	0x1EE2D: "\u0646",                                                                         // ARABIC MATHEMATICAL INITIAL NOON
+	0x1EE2E: "\u0633",                                                                         // ARABIC MATHEMATICAL INITIAL SEEN
+	0x1EE2F: "\u0639",                                                                         // ARABIC MATHEMATICAL INITIAL AIN
+	0x1EE30: "\u0641",                                                                         // ARABIC MATHEMATICAL INITIAL FEH
+	0x1EE31: "\u0635",                                                                         // ARABIC MATHEMATICAL INITIAL SAD
+	0x1EE32: "\u0642",                                                                         // ARABIC MATHEMATICAL INITIAL QAF
+	0x1EE34: "\u0633\u06db",                                                                   // ARABIC MATHEMATICAL INITIAL SHEEN
+	0x1EE35: "\u062a",                                                                         // ARABIC MATHEMATICAL INITIAL TEH
+	0x1EE36: "\u0649\u06db",                                                                   // ARABIC MATHEMATICAL INITIAL THEH
+	0x1EE37: "\u062e",                                                                         // ARABIC MATHEMATICAL INITIAL KHAH
+	0x1EE39: "\u0636",                                                                         // ARABIC MATHEMATICAL INITIAL DAD
+	0x1EE3B: "\u063a",                                                                         // ARABIC MATHEMATICAL INITIAL GHAIN
+	0x1EE42: "\u062c",                                                                         // ARABIC MATHEMATICAL TAILED JEEM
+	0x1EE47: "\u062d",                                                                         // ARABIC MATHEMATICAL TAILED HAH
+	0x1EE49: "\u0649",                                                                         // ARABIC MATHEMATICAL TAILED YEH
+	0x1EE4B: "\u0644",                                                                         // ARABIC MATHEMATICAL TAILED LAM
+	0x1EE4D: "\u0646",                                                                         // ARABIC MATHEMATICAL TAILED NOON
+	0x1EE4E: "\u0633",                                                                         // ARABIC MATHEMATICAL TAILED SEEN
+	0x1EE4F: "\u0639",                                                                         // ARABIC MATHEMATICAL TAILED AIN
+	0x1EE51: "\u0635",                                                                         // ARABIC MATHEMATICAL TAILED SAD
+	0x1EE52: "\u0642",                                                                         // ARABIC MATHEMATICAL TAILED QAF
+	0x1EE54: "\u0633\u06db",                                                                   // ARABIC MATHEMATICAL TAILED SHEEN
+	0x1EE57: "\u062e",                                                                         // ARABIC MATHEMATICAL TAILED KHAH
+	0x1EE59: "\u0636",                                                                         // ARABIC MATHEMATICAL TAILED DAD
+	0x1EE5B: "\u063a",                                                                         // ARABIC MATHEMATICAL TAILED GHAIN
+	0x1EE5D: "\u0649",                                                                         // ARABIC MATHEMATICAL TAILED DOTLESS NOON
+	0x1EE5F: "\u06a1",                                                                         // ARABIC MATHEMATICAL TAILED DOTLESS QAF
+	0x1EE61: "\u0628",                                                                         // ARABIC MATHEMATICAL STRETCHED BEH
+	0x1EE62: "\u062c",                                                                         // ARABIC MATHEMATICAL STRETCHED JEEM
+	0x1EE64: "o",                                                                              // ARABIC MATHEMATICAL STRETCHED HEH
+	0x1EE67: "\u062d",                                                                         // ARABIC MATHEMATICAL STRETCHED HAH
+	0x1EE68: "\u0637",                                                                         // ARABIC MATHEMATICAL STRETCHED TAH
+	0x1EE69: "\u0649",                                                                         // ARABIC MATHEMATICAL STRETCHED YEH
+	0x1EE6A: "\u0643",                                                                         // ARABIC MATHEMATICAL STRETCHED KAF
+	0x1EE6C: "\u0645",                                                                         // ARABIC MATHEMATICAL STRETCHED MEEM
+	0x1EE6D: "\u0646",                                                                         // ARABIC MATHEMATICAL STRETCHED NOON
+	0x1EE6E: "\u0633",                                                                         // ARABIC MATHEMATICAL STRETCHED SEEN
+	0x1EE6F: "\u0639",                                                                         // ARABIC MATHEMATICAL STRETCHED AIN
+	0x1EE70: "\u0641",                                                                         // ARABIC MATHEMATICAL STRETCHED FEH
+	0x1EE71: "\u0635",                                                                         // ARABIC MATHEMATICAL STRETCHED SAD
+	0x1EE72: "\u0642",                                                                         // ARABIC MATHEMATICAL STRETCHED QAF
+	0x1EE74: "\u0633\u06db",                                                                   // ARABIC MATHEMATICAL STRETCHED SHEEN
+	0x1EE75: "\u062a",                                                                         // ARABIC MATHEMATICAL STRETCHED TEH
+	0x1EE76: "\u0649\u06db",                                                                   // ARABIC MATHEMATICAL STRETCHED THEH
+	0x1EE77: "\u062e",                                                                         // ARABIC MATHEMATICAL STRETCHED KHAH
+	0x1EE79: "\u0636",                                                                         // ARABIC MATHEMATICAL STRETCHED DAD
+	0x1EE7A: "\u0638",                                                                         // ARABIC MATHEMATICAL STRETCHED ZAH
+	0x1EE7B: "\u063a",                                                                         // ARABIC MATHEMATICAL STRETCHED GHAIN
+	0x1EE7C: "\u0649",                                                                         // ARABIC MATHEMATICAL STRETCHED DOTLESS BEH
+	0x1EE7E: "\u06a1",                                                                         // ARABIC MATHEMATICAL STRETCHED DOTLESS FEH
+	0x1EE80: "l",                                                                              // ARABIC MATHEMATICAL LOOPED ALEF
+	0x1EE81: "\u0628",                                                                         // ARABIC MATHEMATICAL LOOPED BEH
+	0x1EE82: "\u062c",                                                                         // ARABIC MATHEMATICAL LOOPED JEEM
+	0x1EE83: "\u062f",                                                                         // ARABIC MATHEMATICAL LOOPED DAL
+	0x1EE84: "o",                                                                              // ARABIC MATHEMATICAL LOOPED HEH
+	0x1EE85: "\u0648",                                                                         // ARABIC MATHEMATICAL LOOPED WAW
+	0x1EE86: "\u0632",                                                                         // ARABIC MATHEMATICAL LOOPED ZAIN
+	0x1EE87: "\u062d",                                                                         // ARABIC MATHEMATICAL LOOPED HAH
+	0x1EE88: "\u0637",                                                                         // ARABIC MATHEMATICAL LOOPED TAH
+	0x1EE89: "\u0649",                                                                         // ARABIC MATHEMATICAL LOOPED YEH
+	0x1EE8B: "\u0644",                                                                         // ARABIC MATHEMATICAL LOOPED LAM
+	0x1EE8C: "\u0645",                                                                         // ARABIC MATHEMATICAL LOOPED MEEM
+	0x1EE8D: "\u0646",                                                                         // ARABIC MATHEMATICAL LOOPED NOON
+	0x1EE8E: "\u0633",                                                                         // ARABIC MATHEMATICAL LOOPED SEEN
+	0x1EE8F: "\u0639",                                                                         // ARABIC MATHEMATICAL LOOPED AIN
+	0x1EE90: "\u0641",                                                                         // ARABIC MATHEMATICAL LOOPED FEH
+	0x1EE91: "\u0635",                                                                         // ARABIC MATHEMATICAL LOOPED SAD
+	0x1EE92: "\u0642",                                                                         // ARABIC MATHEMATICAL LOOPED QAF
+	0x1EE93: "\u0631",                                                                         // ARABIC MATHEMATICAL LOOPED REH
+	0x1EE94: "\u0633\u06db",                                                                   // ARABIC MATHEMATICAL LOOPED SHEEN
+	0x1EE95: "\u062a",                                                                         // ARABIC MATHEMATICAL LOOPED TEH
+	0x1EE96: "\u0649\u06db",                                                                   // ARABIC MATHEMATICAL LOOPED THEH
+	0x1EE97: "\u062e",                                                                         // ARABIC MATHEMATICAL LOOPED KHAH
+	0x1EE98: "\u0630",                                                                         // ARABIC MATHEMATICAL LOOPED THAL
+	0x1EE99: "\u0636",                                                                         // ARABIC MATHEMATICAL LOOPED DAD
+	0x1EE9A: "\u0638",                                                                         // ARABIC MATHEMATICAL LOOPED ZAH
+	0x1EE9B: "\u063a",                                                                         // ARABIC MATHEMATICAL LOOPED GHAIN
+	0x1EEA1: "\u0628",                                                                         // ARABIC MATHEMATICAL DOUBLE-STRUCK BEH
+	0x1EEA2: "\u062c",                                                                         // ARABIC MATHEMATICAL DOUBLE-STRUCK JEEM
+	0x1EEA3: "\u062f",                                                                         // ARABIC MATHEMATICAL DOUBLE-STRUCK DAL
+	0x1EEA5: "\u0648",                                                                         // ARABIC MATHEMATICAL DOUBLE-STRUCK WAW
+	0x1EEA6: "\u0632",                                                                         // ARABIC MATHEMATICAL DOUBLE-STRUCK ZAIN
+	0x1EEA7: "\u062d",                                                                         // ARABIC MATHEMATICAL DOUBLE-STRUCK HAH
+	0x1EEA8: "\u0637",                                                                         // ARABIC MATHEMATICAL DOUBLE-STRUCK TAH
+	0x1EEA9: "\u0649",                                                                         // ARABIC MATHEMATICAL DOUBLE-STRUCK YEH
+	0x1EEAB: "\u0644",                                                                         // ARABIC MATHEMATICAL DOUBLE-STRUCK LAM
+	0x1EEAC: "\u0645",                                                                         // ARABIC MATHEMATICAL DOUBLE-STRUCK MEEM
+	0x1EEAD: "\u0646",                                                                         // ARABIC MATHEMATICAL DOUBLE-STRUCK NOON
+	0x1EEAE: "\u0633",                                                                         // ARABIC MATHEMATICAL DOUBLE-STRUCK SEEN
+	0x1EEAF: "\u0639",                                                                         // ARABIC MATHEMATICAL DOUBLE-STRUCK AIN
+	0x1EEB0: "\u0641",                                                                         // ARABIC MATHEMATICAL DOUBLE-STRUCK FEH
+	0x1EEB1: "\u0635",                                                                         // ARABIC MATHEMATICAL DOUBLE-STRUCK SAD
+	0x1EEB2: "\u0642",                                                                         // ARABIC MATHEMATICAL DOUBLE-STRUCK QAF
+	0x1EEB3: "\u0631",                                                                         // ARABIC MATHEMATICAL DOUBLE-STRUCK REH
+	0x1EEB4: "\u0633\u06db",                                                                   // ARABIC MATHEMATICAL DOUBLE-STRUCK SHEEN
+	0x1EEB5: "\u062a",                                                                         // ARABIC MATHEMATICAL DOUBLE-STRUCK TEH
+	0x1EEB6: "\u0649\u06db",                                                                   // ARABIC MATHEMATICAL DOUBLE-STRUCK THEH
+	0x1EEB7: "\u062e",                                                                         // ARABIC MATHEMATICAL DOUBLE-STRUCK KHAH
+	0x1EEB8: "\u0630",                                                                         // ARABIC MATHEMATICAL DOUBLE-STRUCK THAL
+	0x1EEB9: "\u0636",                                                                         // ARABIC MATHEMATICAL DOUBLE-STRUCK DAD
+	0x1EEBA: "\u0638",                                                                         // ARABIC MATHEMATICAL DOUBLE-STRUCK ZAH
+	0x1EEBB: "\u063a",                                                                         // ARABIC MATHEMATICAL DOUBLE-STRUCK GHAIN
+	0x1F100: "O.",                                                                             // DIGIT ZERO FULL STOP
+	0x1F101: "O,",                                                                             // DIGIT ZERO COMMA
+	0x1F102: "l,",                                                                             // DIGIT ONE COMMA
+	0x1F103: "2,",                                                                             // DIGIT TWO COMMA
+	0x1F104: "3,",                                                                             // DIGIT THREE COMMA
+	0x1F105: "4,",                                                                             // DIGIT FOUR COMMA
+	0x1F106: "5,",                                                                             // DIGIT FIVE COMMA
+	0x1F107: "6,",                                                                             // DIGIT SIX COMMA
+	0x1F108: "7,",                                                                             // DIGIT SEVEN COMMA
+	0x1F109: "8,",                                                                             // DIGIT EIGHT COMMA
+	0x1F10A: "9,",                                                                             // DIGIT NINE COMMA
+	0x1F10F: "$\u20e0",                                                                        // CIRCLED DOLLAR SIGN WITH OVERLAID BACKSLASH
+	0x1F110: "(A)",                                                                            // PARENTHESIZED LATIN CAPITAL LETTER A
+	0x1F111: "(B)",                                                                            // PARENTHESIZED LATIN CAPITAL LETTER B
+	0x1F112: "(C)",                                                                            // PARENTHESIZED LATIN CAPITAL LETTER C
+	0x1F113: "(D)",                                                                            // PARENTHESIZED LATIN CAPITAL LETTER D
+	0x1F114: "(E)",                                                                            // PARENTHESIZED LATIN CAPITAL LETTER E
+	0x1F115: "(F)",                                                                            // PARENTHESIZED LATIN CAPITAL LETTER F
+	0x1F116: "(G)",                                                                            // PARENTHESIZED LATIN CAPITAL LETTER G
+	0x1F117: "(H)",                                                                            // PARENTHESIZED LATIN CAPITAL LETTER H
+	0x1F118: "(l)",                                                                            // PARENTHESIZED LATIN CAPITAL LETTER I
+	0x1F119: "(J)",                                                                            // PARENTHESIZED LATIN CAPITAL LETTER J
+	0x1F11A: "(K)",                                                                            // PARENTHESIZED LATIN CAPITAL LETTER K
+	0x1F11B: "(L)",                                                                            // PARENTHESIZED LATIN CAPITAL LETTER L
+	0x1F11C: "(M)",                                                                            // PARENTHESIZED LATIN CAPITAL LETTER M
+	0x1F11D: "(N)",                                                                            // PARENTHESIZED LATIN CAPITAL LETTER N
+	0x1F11E: "(O)",                                                                            // PARENTHESIZED LATIN CAPITAL LETTER O
+	0x1F11F: "(P)",                                                                            // PARENTHESIZED LATIN CAPITAL LETTER P
+	0x1F120: "(Q)",                                                                            // PARENTHESIZED LATIN CAPITAL LETTER Q
+	0x1F121: "(R)",                                                                            // PARENTHESIZED LATIN CAPITAL LETTER R
+	0x1F122: "(S)",                                                                            // PARENTHESIZED LATIN CAPITAL LETTER S
+	0x1F123: "(T)",                                                                            // PARENTHESIZED LATIN CAPITAL LETTER T
+	0x1F124: "(U)",                                                                            // PARENTHESIZED LATIN CAPITAL LETTER U
+	0x1F125: "(V)",                                                                            // PARENTHESIZED LATIN CAPITAL LETTER V
+	0x1F126: "(W)",                                                                            // PARENTHESIZED LATIN CAPITAL LETTER W
+	0x1F127: "(X)",                                                                            // PARENTHESIZED LATIN CAPITAL LETTER X
+	0x1F128: "(Y)",                                                                            // PARENTHESIZED LATIN CAPITAL LETTER Y
+	0x1F129: "(Z)",                                                                            // PARENTHESIZED LATIN CAPITAL LETTER Z
+	0x1F12A: "(S)",                                                                            // TORTOISE SHELL BRACKETED LATIN CAPITAL LETTER S
+	0x1F16D: "\u33c4\t\u20dd",                                                                 // CIRCLED CC
+	0x1F16E: "C\u20e0",                                                                        // CIRCLED C WITH OVERLAID BACKSLASH
+	0x1F240: "(\u672c)",                                                                       // TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-672C
+	0x1F241: "(\u4e09)",                                                                       // TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-4E09
+	0x1F242: "(\u4e8c)",                                                                       // TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-4E8C
+	0x1F243: "(\u5b89)",                                                                       // TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-5B89
+	0x1F244: "(\u70b9)",                                                                       // TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-70B9
+	0x1F245: "(\u6253)",                                                                       // TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-6253
+	0x1F246: "(\u76d7)",                                                                       // TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-76D7
+	0x1F247: "(\u52dd)",                                                                       // TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-52DD
+	0x1F248: "(\u6557)",                                                                       // TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-6557
+	0x1F312: "\u263d",                                                                         // WAXING CRESCENT MOON SYMBOL
+	0x1F318: "\u263e",                                                                         // WANING CRESCENT MOON SYMBOL
+	0x1F319: "\u263d",                                                                         // CRESCENT MOON
+	0x1F700: "QE",                                                                             // ALCHEMICAL SYMBOL FOR QUINTESSENCE
+	0x1F701: "\ua658",                                                                         // ALCHEMICAL SYMBOL FOR AIR
+	0x1F702: "\u0394",                                                                         // ALCHEMICAL SYMBOL FOR FIRE
+	0x1F704: "\U000102bc",                                                                     // ALCHEMICAL SYMBOL FOR WATER
+	0x1F707: "AR",                                                                             // ALCHEMICAL SYMBOL FOR AQUA REGIA-2
+	0x1F708: "V\u1de4",                                                                        // ALCHEMICAL SYMBOL FOR AQUA VITAE
+	0x1F70A: "\u2629",                                                                         // ALCHEMICAL SYMBOL FOR VINEGAR
+	0x1F714: "O\u0335",                                                                        // ALCHEMICAL SYMBOL FOR SALT
+	0x1F728: "\U000102a8",                                                                     // ALCHEMICAL SYMBOL FOR VERDIGRIS
+	0x1F73A: "\u29df",                                                                         // ALCHEMICAL SYMBOL FOR ARSENIC
+	0x1F74C: "C",                                                                              // ALCHEMICAL SYMBOL FOR CALX
+	0x1F754: "\u16dc",                                                                         // ALCHEMICAL SYMBOL FOR SOAP
+	0x1F755: "\u22a1",                                                                         // ALCHEMICAL SYMBOL FOR URINE
+	0x1F75C: "sss",                                                                            // ALCHEMICAL SYMBOL FOR STRATUM SUPER STRATUM
+	0x1F75E: "\u224f",                                                                         // ALCHEMICAL SYMBOL FOR SUBLIMATION
+	0x1F768: "T",                                                                              // ALCHEMICAL SYMBOL FOR CRUCIBLE-4
+	0x1F76B: "MB",                                                                             // ALCHEMICAL SYMBOL FOR BATH OF MARY
+	0x1F76C: "VB",                                                                             // ALCHEMICAL SYMBOL FOR BATH OF VAPOURS
+	0x1F771: "\u22a0",                                                                         // ALCHEMICAL SYMBOL FOR MONTH
+	0x1FBF0: "O",                                                                              // SEGMENTED DIGIT ZERO
+	0x1FBF1: "l",                                                                              // SEGMENTED DIGIT ONE
+	0x1FBF2: "2",                                                                              // SEGMENTED DIGIT TWO
+	0x1FBF3: "3",                                                                              // SEGMENTED DIGIT THREE
+	0x1FBF4: "4",                                                                              // SEGMENTED DIGIT FOUR
+	0x1FBF5: "5",                                                                              // SEGMENTED DIGIT FIVE
+	0x1FBF6: "6",                                                                              // SEGMENTED DIGIT SIX
+	0x1FBF7: "7",                                                                              // SEGMENTED DIGIT SEVEN
+	0x1FBF8: "8",                                                                              // SEGMENTED DIGIT EIGHT
+	0x1FBF9: "9",                                                                              // SEGMENTED DIGIT NINE
+	0x21FE8: "\u276c",                                                                         // <CJK Ideograph Extension B>
+	0x2F800: "\u4e3d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F800
+	0x2F801: "\u4e38",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F801
+	0x2F802: "\u4e41",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F802
+	0x2F803: "\U00020122",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F803
+	0x2F804: "\u4f60",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F804
+	0x2F805: "\u4fae",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F805
+	0x2F806: "\u4fbb",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F806
+	0x2F807: "\u4f75",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F807
+	0x2F808: "\u507a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F808
+	0x2F809: "\u5099",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F809
+	0x2F80A: "\u50e7",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F80A
+	0x2F80B: "\u50cf",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F80B
+	0x2F80C: "\u349e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F80C
+	0x2F80D: "\U0002063a",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F80D
+	0x2F80E: "\u514d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F80E
+	0x2F80F: "\u5154",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F80F
+	0x2F810: "\u5164",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F810
+	0x2F811: "\u5177",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F811
+	0x2F812: "\U0002051c",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F812
+	0x2F813: "\u34b9",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F813
+	0x2F814: "\u5167",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F814
+	0x2F815: "\u518d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F815
+	0x2F816: "\U0002054b",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F816
+	0x2F817: "\u5197",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F817
+	0x2F818: "\u51a4",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F818
+	0x2F819: "\u4ecc",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F819
+	0x2F81A: "\u51ac",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F81A
+	0x2F81B: "\u51b5",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F81B
+	0x2F81C: "\U000291df",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F81C
+	0x2F81D: "\u51f5",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F81D
+	0x2F81E: "\u5203",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F81E
+	0x2F81F: "\u34df",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F81F
+	0x2F820: "\u523b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F820
+	0x2F821: "\u5246",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F821
+	0x2F822: "\u5272",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F822
+	0x2F823: "\u5277",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F823
+	0x2F824: "\u3515",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F824
+	0x2F825: "\u52c7",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F825
+	0x2F826: "\u52c9",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F826
+	0x2F827: "\u52e4",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F827
+	0x2F828: "\u52fa",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F828
+	0x2F829: "\u5305",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F829
+	0x2F82A: "\u5306",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F82A
+	0x2F82B: "\u5317",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F82B
+	0x2F82C: "\u5349",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F82C
+	0x2F82D: "\u5351",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F82D
+	0x2F82E: "\u535a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F82E
+	0x2F82F: "\u5373",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F82F
+	0x2F830: "\u537d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F830
+	0x2F831: "\u537f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F831
+	0x2F832: "\u537f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F832
+	0x2F833: "\u537f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F833
+	0x2F834: "\U00020a2c",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F834
+	0x2F835: "\u7070",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F835
+	0x2F836: "\u53ca",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F836
+	0x2F837: "\u53df",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F837
+	0x2F838: "\U00020b63",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F838
+	0x2F839: "\u53eb",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F839
+	0x2F83A: "\u53f1",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F83A
+	0x2F83B: "\u5406",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F83B
+	0x2F83C: "\u549e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F83C
+	0x2F83D: "\u5438",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F83D
+	0x2F83E: "\u5448",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F83E
+	0x2F83F: "\u5468",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F83F
+	0x2F840: "\u54a2",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F840
+	0x2F841: "\u54f6",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F841
+	0x2F842: "\u5510",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F842
+	0x2F843: "\u5553",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F843
+	0x2F844: "\u5563",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F844
+	0x2F845: "\u5584",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F845
+	0x2F846: "\u5584",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F846
+	0x2F847: "\u5599",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F847
+	0x2F848: "\u55ab",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F848
+	0x2F849: "\u55b3",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F849
+	0x2F84A: "\u55c2",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F84A
+	0x2F84B: "\u5716",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F84B
+	0x2F84C: "\u5606",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F84C
+	0x2F84D: "\u5717",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F84D
+	0x2F84E: "\u5651",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F84E
+	0x2F84F: "\u5674",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F84F
+	0x2F850: "\u5207",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F850
+	0x2F851: "\u58ee",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F851
+	0x2F852: "\u57ce",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F852
+	0x2F853: "\u57f4",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F853
+	0x2F854: "\u580d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F854
+	0x2F855: "\u578b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F855
+	0x2F856: "\u5832",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F856
+	0x2F857: "\u5831",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F857
+	0x2F858: "\u58ac",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F858
+	0x2F859: "\U000214e4",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F859
+	0x2F85A: "\u58f2",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F85A
+	0x2F85B: "\u58f7",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F85B
+	0x2F85C: "\u5906",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F85C
+	0x2F85D: "\u591a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F85D
+	0x2F85E: "\u5922",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F85E
+	0x2F85F: "\u5962",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F85F
+	0x2F860: "\U000216a8",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F860
+	0x2F861: "\U000216ea",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F861
+	0x2F862: "\u59ec",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F862
+	0x2F863: "\u5a1b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F863
+	0x2F864: "\u5a27",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F864
+	0x2F865: "\u59d8",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F865
+	0x2F866: "\u5a66",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F866
+	0x2F867: "\u36ee",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F867
+	0x2F868: "\u36fc",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F868
+	0x2F869: "\u5b08",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F869
+	0x2F86A: "\u5b3e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F86A
+	0x2F86B: "\u5b3e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F86B
+	0x2F86C: "\U000219c8",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F86C
+	0x2F86D: "\u5bc3",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F86D
+	0x2F86E: "\u5bd8",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F86E
+	0x2F86F: "\u5be7",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F86F
+	0x2F870: "\u5bf3",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F870
+	0x2F871: "\U00021b18",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F871
+	0x2F872: "\u5bff",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F872
+	0x2F873: "\u5c06",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F873
+	0x2F874: "\u5f53",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F874
+	0x2F875: "\u5c22",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F875
+	0x2F876: "\u3781",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F876
+	0x2F877: "\u5c60",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F877
+	0x2F878: "\u5c6e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F878
+	0x2F879: "\u5cc0",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F879
+	0x2F87A: "\u5c8d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F87A
+	0x2F87B: "\U00021de4",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F87B
+	0x2F87C: "\u5d43",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F87C
+	0x2F87D: "\U00021de6",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F87D
+	0x2F87E: "\u5d6e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F87E
+	0x2F87F: "\u5d6b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F87F
+	0x2F880: "\u5d7c",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F880
+	0x2F881: "\u5de1",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F881
+	0x2F882: "\u5de2",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F882
+	0x2F883: "\u382f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F883
+	0x2F884: "\u5dfd",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F884
+	0x2F885: "\u5e28",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F885
+	0x2F886: "\u5e3d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F886
+	0x2F887: "\u5e69",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F887
+	0x2F888: "\u3862",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F888
+	0x2F889: "\U00022183",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F889
+	0x2F88A: "\u387c",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F88A
+	0x2F88B: "\u5eb0",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F88B
+	0x2F88C: "\u5eb3",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F88C
+	0x2F88D: "\u5eb6",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F88D
+	0x2F88E: "\u5eca",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F88E
+	0x2F88F: "\U0002a392",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F88F
+	0x2F890: "\u5efe",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F890
+	0x2F891: "\U00022331",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F891
+	0x2F892: "\U00022331",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F892
+	0x2F893: "\u8201",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F893
+	0x2F894: "\u5f22",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F894
+	0x2F895: "\u5f22",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F895
+	0x2F896: "\u38c7",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F896
+	0x2F897: "\U000232b8",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F897
+	0x2F898: "\U000261da",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F898
+	0x2F899: "\u5f62",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F899
+	0x2F89A: "\u5f6b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F89A
+	0x2F89B: "\u38e3",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F89B
+	0x2F89C: "\u5f9a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F89C
+	0x2F89D: "\u5fcd",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F89D
+	0x2F89E: "\u5fd7",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F89E
+	0x2F89F: "\u5ff9",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F89F
+	0x2F8A0: "\u6081",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8A0
+	0x2F8A1: "\u393a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8A1
+	0x2F8A2: "\u391c",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8A2
+	0x2F8A3: "\u6094",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8A3
+	0x2F8A4: "\U000226d4",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F8A4
+	0x2F8A5: "\u60c7",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8A5
+	0x2F8A6: "\u6148",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8A6
+	0x2F8A7: "\u614c",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8A7
+	0x2F8A8: "\u614e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8A8
+	0x2F8A9: "\u614c",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8A9
+	0x2F8AA: "\u617a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8AA
+	0x2F8AB: "\u618e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8AB
+	0x2F8AC: "\u61b2",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8AC
+	0x2F8AD: "\u61a4",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8AD
+	0x2F8AE: "\u61af",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8AE
+	0x2F8AF: "\u61de",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8AF
+	0x2F8B0: "\u61f2",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8B0
+	0x2F8B1: "\u61f6",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8B1
+	0x2F8B2: "\u6210",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8B2
+	0x2F8B3: "\u621b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8B3
+	0x2F8B4: "\u625d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8B4
+	0x2F8B5: "\u62b1",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8B5
+	0x2F8B6: "\u62d4",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8B6
+	0x2F8B7: "\u6350",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8B7
+	0x2F8B8: "\U00022b0c",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F8B8
+	0x2F8B9: "\u633d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8B9
+	0x2F8BA: "\u62fc",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8BA
+	0x2F8BB: "\u6368",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8BB
+	0x2F8BC: "\u6383",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8BC
+	0x2F8BD: "\u63e4",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8BD
+	0x2F8BE: "\U00022bf1",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F8BE
+	0x2F8BF: "\u6422",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8BF
+	0x2F8C0: "\u63c5",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8C0
+	0x2F8C1: "\u63a9",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8C1
+	0x2F8C2: "\u3a2e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8C2
+	0x2F8C3: "\u6469",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8C3
+	0x2F8C4: "\u647e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8C4
+	0x2F8C5: "\u649d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8C5
+	0x2F8C6: "\u6477",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8C6
+	0x2F8C7: "\u3a6c",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8C7
+	0x2F8C8: "\u654f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8C8
+	0x2F8C9: "\u656c",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8C9
+	0x2F8CA: "\U0002300a",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F8CA
+	0x2F8CB: "\u65e3",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8CB
+	0x2F8CC: "\u66f8",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8CC
+	0x2F8CD: "\u6649",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8CD
+	0x2F8CE: "\u3b19",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8CE
+	0x2F8CF: "\u6691",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8CF
+	0x2F8D0: "\u3b08",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8D0
+	0x2F8D1: "\u3ae4",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8D1
+	0x2F8D2: "\u5192",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8D2
+	0x2F8D3: "\u5195",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8D3
+	0x2F8D4: "\u6700",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8D4
+	0x2F8D5: "\u669c",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8D5
+	0x2F8D6: "\u80ad",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8D6
+	0x2F8D7: "\u43d9",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8D7
+	0x2F8D8: "\u6717",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8D8
+	0x2F8D9: "\u671b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8D9
+	0x2F8DA: "\u6721",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8DA
+	0x2F8DB: "\u675e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8DB
+	0x2F8DC: "\u6753",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8DC
+	0x2F8DD: "\U000233c3",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F8DD
+	0x2F8DE: "\u3b49",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8DE
+	0x2F8DF: "\u67fa",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8DF
+	0x2F8E0: "\u6785",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8E0
+	0x2F8E1: "\u6852",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8E1
+	0x2F8E2: "\u6885",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8E2
+	0x2F8E3: "\U0002346d",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F8E3
+	0x2F8E4: "\u688e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8E4
+	0x2F8E5: "\u681f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8E5
+	0x2F8E6: "\u6914",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8E6
+	0x2F8E7: "\u3b9d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8E7
+	0x2F8E8: "\u6942",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8E8
+	0x2F8E9: "\u69a3",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8E9
+	0x2F8EA: "\u69ea",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8EA
+	0x2F8EB: "\u6aa8",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8EB
+	0x2F8EC: "\U000236a3",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F8EC
+	0x2F8ED: "\u6adb",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8ED
+	0x2F8EE: "\u3c18",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8EE
+	0x2F8EF: "\u6b21",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8EF
+	0x2F8F0: "\U000238a7",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F8F0
+	0x2F8F1: "\u6b54",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8F1
+	0x2F8F2: "\u3c4e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8F2
+	0x2F8F3: "\u6b72",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8F3
+	0x2F8F4: "\u6b9f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8F4
+	0x2F8F5: "\u6bba",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8F5
+	0x2F8F6: "\u6bbb",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8F6
+	0x2F8F7: "\U00023a8d",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F8F7
+	0x2F8F8: "\U00021d0b",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F8F8
+	0x2F8F9: "\U00023afa",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F8F9
+	0x2F8FA: "\u6c4e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8FA
+	0x2F8FB: "\U00023cbc",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F8FB
+	0x2F8FC: "\u6cbf",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8FC
+	0x2F8FD: "\u6ccd",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8FD
+	0x2F8FE: "\u6c67",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8FE
+	0x2F8FF: "\u6d16",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F8FF
+	0x2F900: "\u6d3e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F900
+	0x2F901: "\u6d77",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F901
+	0x2F902: "\u6d41",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F902
+	0x2F903: "\u6d69",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F903
+	0x2F904: "\u6d78",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F904
+	0x2F905: "\u6d85",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F905
+	0x2F906: "\U00023d1e",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F906
+	0x2F907: "\u6d34",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F907
+	0x2F908: "\u6e2f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F908
+	0x2F909: "\u6e6e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F909
+	0x2F90A: "\u3d33",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F90A
+	0x2F90B: "\u6ecb",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F90B
+	0x2F90C: "\u6ec7",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F90C
+	0x2F90D: "\U00023ed1",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F90D
+	0x2F90E: "\u6df9",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F90E
+	0x2F90F: "\u6f6e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F90F
+	0x2F910: "\U00023f5e",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F910
+	0x2F911: "\U00023f8e",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F911
+	0x2F912: "\u6fc6",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F912
+	0x2F913: "\u7039",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F913
+	0x2F914: "\u701e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F914
+	0x2F915: "\u701b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F915
+	0x2F916: "\u3d96",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F916
+	0x2F917: "\u704a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F917
+	0x2F918: "\u707d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F918
+	0x2F919: "\u7077",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F919
+	0x2F91A: "\u70ad",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F91A
+	0x2F91B: "\U00020525",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F91B
+	0x2F91C: "\u7145",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F91C
+	0x2F91D: "\U00024263",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F91D
+	0x2F91E: "\u719c",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F91E
+	0x2F91F: "\U000243ab",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F91F
+	0x2F920: "\u7228",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F920
+	0x2F921: "\u7235",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F921
+	0x2F922: "\u7250",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F922
+	0x2F923: "\U00024608",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F923
+	0x2F924: "\u7280",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F924
+	0x2F925: "\u7295",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F925
+	0x2F926: "\U00024735",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F926
+	0x2F927: "\U00024814",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F927
+	0x2F928: "\u737a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F928
+	0x2F929: "\u738b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F929
+	0x2F92A: "\u3eac",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F92A
+	0x2F92B: "\u73a5",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F92B
+	0x2F92C: "\u3eb8",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F92C
+	0x2F92D: "\u3eb8",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F92D
+	0x2F92E: "\u7447",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F92E
+	0x2F92F: "\u745c",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F92F
+	0x2F930: "\u7471",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F930
+	0x2F931: "\u7485",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F931
+	0x2F932: "\u74ca",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F932
+	0x2F933: "\u3f1b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F933
+	0x2F934: "\u7524",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F934
+	0x2F935: "\U00024c36",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F935
+	0x2F936: "\u753e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F936
+	0x2F937: "\U00024c92",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F937
+	0x2F938: "\u7570",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F938
+	0x2F939: "\U0002219f",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F939
+	0x2F93A: "\u7610",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F93A
+	0x2F93B: "\U00024fa1",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F93B
+	0x2F93C: "\U00024fb8",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F93C
+	0x2F93D: "\U00025044",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F93D
+	0x2F93E: "\u3ffc",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F93E
+	0x2F93F: "\u4008",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F93F
+	0x2F940: "\u76f4",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F940
+	0x2F941: "\U000250f3",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F941
+	0x2F942: "\U000250f2",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F942
+	0x2F943: "\U00025119",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F943
+	0x2F944: "\U00025133",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F944
+	0x2F945: "\u771e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F945
+	0x2F946: "\u771f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F946
+	0x2F947: "\u771f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F947
+	0x2F948: "\u774a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F948
+	0x2F949: "\u4039",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F949
+	0x2F94A: "\u778b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F94A
+	0x2F94B: "\u4046",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F94B
+	0x2F94C: "\u4096",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F94C
+	0x2F94D: "\U0002541d",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F94D
+	0x2F94E: "\u784e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F94E
+	0x2F94F: "\u788c",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F94F
+	0x2F950: "\u78cc",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F950
+	0x2F951: "\u40e3",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F951
+	0x2F952: "\U00025626",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F952
+	0x2F953: "\u7956",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F953
+	0x2F954: "\U0002569a",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F954
+	0x2F955: "\U000256c5",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F955
+	0x2F956: "\u798f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F956
+	0x2F957: "\u79eb",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F957
+	0x2F958: "\u412f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F958
+	0x2F959: "\u7a40",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F959
+	0x2F95A: "\u7a4a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F95A
+	0x2F95B: "\u7a4f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F95B
+	0x2F95C: "\U0002597c",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F95C
+	0x2F95D: "\U00025aa7",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F95D
+	0x2F95E: "\U00025aa7",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F95E
+	0x2F95F: "\u7aee",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F95F
+	0x2F960: "\u4202",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F960
+	0x2F961: "\U00025bab",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F961
+	0x2F962: "\u7bc6",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F962
+	0x2F963: "\u7bc9",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F963
+	0x2F964: "\u4227",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F964
+	0x2F965: "\U00025c80",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F965
+	0x2F966: "\u7cd2",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F966
+	0x2F967: "\u42a0",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F967
+	0x2F968: "\u7ce8",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F968
+	0x2F969: "\u7ce3",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F969
+	0x2F96A: "\u7d00",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F96A
+	0x2F96B: "\U00025f86",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F96B
+	0x2F96C: "\u7d63",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F96C
+	0x2F96D: "\u4301",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F96D
+	0x2F96E: "\u7dc7",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F96E
+	0x2F96F: "\u7e02",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F96F
+	0x2F970: "\u7e45",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F970
+	0x2F971: "\u4334",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F971
+	0x2F972: "\U00026228",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F972
+	0x2F973: "\U00026247",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F973
+	0x2F974: "\u4359",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F974
+	0x2F975: "\U000262d9",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F975
+	0x2F976: "\u7f7a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F976
+	0x2F977: "\U0002633e",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F977
+	0x2F978: "\u7f95",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F978
+	0x2F979: "\u7ffa",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F979
+	0x2F97A: "\u8005",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F97A
+	0x2F97B: "\U000264da",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F97B
+	0x2F97C: "\U00026523",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F97C
+	0x2F97D: "\u8060",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F97D
+	0x2F97E: "\U000265a8",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F97E
+	0x2F97F: "\u8070",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F97F
+	0x2F980: "\U0002335f",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F980
+	0x2F981: "\u43d5",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F981
+	0x2F982: "\u80b2",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F982
+	0x2F983: "\u8103",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F983
+	0x2F984: "\u440b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F984
+	0x2F985: "\u813e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F985
+	0x2F986: "\u5ab5",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F986
+	0x2F987: "\U000267a7",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F987
+	0x2F988: "\U000267b5",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F988
+	0x2F989: "\U00023393",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F989
+	0x2F98A: "\U0002339c",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F98A
+	0x2F98B: "\u8201",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F98B
+	0x2F98C: "\u8204",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F98C
+	0x2F98D: "\u8f9e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F98D
+	0x2F98E: "\u446b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F98E
+	0x2F98F: "\u8291",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F98F
+	0x2F990: "\u828b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F990
+	0x2F991: "\u829d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F991
+	0x2F992: "\u52b3",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F992
+	0x2F993: "\u82b1",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F993
+	0x2F994: "\u82b3",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F994
+	0x2F995: "\u82bd",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F995
+	0x2F996: "\u82e6",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F996
+	0x2F997: "\U00026b3c",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F997
+	0x2F998: "\u82e5",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F998
+	0x2F999: "\u831d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F999
+	0x2F99A: "\u8363",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F99A
+	0x2F99B: "\u83ad",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F99B
+	0x2F99C: "\u8323",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F99C
+	0x2F99D: "\u83bd",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F99D
+	0x2F99E: "\u83e7",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F99E
+	0x2F99F: "\u8457",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F99F
+	0x2F9A0: "\u8353",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9A0
+	0x2F9A1: "\u83ca",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9A1
+	0x2F9A2: "\u83cc",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9A2
+	0x2F9A3: "\u83dc",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9A3
+	0x2F9A4: "\U00026c36",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F9A4
+	0x2F9A5: "\U00026d6b",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F9A5
+	0x2F9A6: "\U00026cd5",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F9A6
+	0x2F9A7: "\u452b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9A7
+	0x2F9A8: "\u84f1",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9A8
+	0x2F9A9: "\u84f3",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9A9
+	0x2F9AA: "\u8516",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9AA
+	0x2F9AB: "\U000273ca",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F9AB
+	0x2F9AC: "\u8564",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9AC
+	0x2F9AD: "\U00026f2c",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F9AD
+	0x2F9AE: "\u455d",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9AE
+	0x2F9AF: "\u4561",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9AF
+	0x2F9B0: "\U00026fb1",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F9B0
+	0x2F9B1: "\U000270d2",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F9B1
+	0x2F9B2: "\u456b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9B2
+	0x2F9B3: "\u8650",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9B3
+	0x2F9B4: "\u865c",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9B4
+	0x2F9B5: "\u8667",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9B5
+	0x2F9B6: "\u8669",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9B6
+	0x2F9B7: "\u86a9",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9B7
+	0x2F9B8: "\u8688",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9B8
+	0x2F9B9: "\u870e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9B9
+	0x2F9BA: "\u86e2",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9BA
+	0x2F9BB: "\u8779",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9BB
+	0x2F9BC: "\u8728",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9BC
+	0x2F9BD: "\u876b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9BD
+	0x2F9BE: "\u8786",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9BE
+	0x2F9BF: "\u45d7",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9BF
+	0x2F9C0: "\u87e1",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9C0
+	0x2F9C1: "\u8801",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9C1
+	0x2F9C2: "\u45f9",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9C2
+	0x2F9C3: "\u8860",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9C3
+	0x2F9C4: "\u8863",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9C4
+	0x2F9C5: "\U00027667",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F9C5
+	0x2F9C6: "\u88d7",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9C6
+	0x2F9C7: "\u88de",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9C7
+	0x2F9C8: "\u4635",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9C8
+	0x2F9C9: "\u88fa",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9C9
+	0x2F9CA: "\u34bb",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9CA
+	0x2F9CB: "\U000278ae",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F9CB
+	0x2F9CC: "\U00027966",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F9CC
+	0x2F9CD: "\u46be",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9CD
+	0x2F9CE: "\u46c7",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9CE
+	0x2F9CF: "\u8aa0",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9CF
+	0x2F9D0: "\u8aed",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9D0
+	0x2F9D1: "\u8b8a",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9D1
+	0x2F9D2: "\u8c55",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9D2
+	0x2F9D3: "\U00027ca8",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F9D3
+	0x2F9D4: "\u8cab",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9D4
+	0x2F9D5: "\u8cc1",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9D5
+	0x2F9D6: "\u8d1b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9D6
+	0x2F9D7: "\u8d77",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9D7
+	0x2F9D8: "\U00027f2f",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F9D8
+	0x2F9D9: "\U00020804",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F9D9
+	0x2F9DA: "\u8dcb",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9DA
+	0x2F9DB: "\u8dbc",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9DB
+	0x2F9DC: "\u8df0",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9DC
+	0x2F9DD: "\U000208de",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F9DD
+	0x2F9DE: "\u8ed4",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9DE
+	0x2F9DF: "\u8f38",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9DF
+	0x2F9E0: "\U000285d2",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F9E0
+	0x2F9E1: "\U000285ed",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F9E1
+	0x2F9E2: "\u9094",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9E2
+	0x2F9E3: "\u90f1",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9E3
+	0x2F9E4: "\u9111",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9E4
+	0x2F9E5: "\U0002872e",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F9E5
+	0x2F9E6: "\u911b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9E6
+	0x2F9E7: "\u9238",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9E7
+	0x2F9E8: "\u92d7",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9E8
+	0x2F9E9: "\u92d8",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9E9
+	0x2F9EA: "\u927c",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9EA
+	0x2F9EB: "\u93f9",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9EB
+	0x2F9EC: "\u9415",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9EC
+	0x2F9ED: "\U00028bfa",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F9ED
+	0x2F9EE: "\u958b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9EE
+	0x2F9EF: "\u4995",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9EF
+	0x2F9F0: "\u95b7",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9F0
+	0x2F9F1: "\U00028d77",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F9F1
+	0x2F9F2: "\u49e6",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9F2
+	0x2F9F3: "\u96c3",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9F3
+	0x2F9F4: "\u5db2",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9F4
+	0x2F9F5: "\u9723",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9F5
+	0x2F9F6: "\U00029145",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F9F6
+	0x2F9F7: "\U0002921a",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F9F7
+	0x2F9F8: "\u4a6e",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9F8
+	0x2F9F9: "\u4a76",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9F9
+	0x2F9FA: "\u97e0",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9FA
+	0x2F9FB: "\U0002940a",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F9FB
+	0x2F9FC: "\u4ab2",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9FC
+	0x2F9FD: "\U00029496",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2F9FD
+	0x2F9FE: "\u980b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9FE
+	0x2F9FF: "\u980b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2F9FF
+	0x2FA00: "\u9829",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2FA00
+	0x2FA01: "\U000295b6",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2FA01
+	0x2FA02: "\u98e2",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2FA02
+	0x2FA03: "\u4b33",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2FA03
+	0x2FA04: "\u9929",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2FA04
+	0x2FA05: "\u99a7",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2FA05
+	0x2FA06: "\u99c2",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2FA06
+	0x2FA07: "\u99fe",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2FA07
+	0x2FA08: "\u4bce",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2FA08
+	0x2FA09: "\U00029b30",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2FA09
+	0x2FA0A: "\u9b12",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2FA0A
+	0x2FA0B: "\u9c40",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2FA0B
+	0x2FA0C: "\u9cfd",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2FA0C
+	0x2FA0D: "\u4cce",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2FA0D
+	0x2FA0E: "\u4ced",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2FA0E
+	0x2FA0F: "\u9d67",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2FA0F
+	0x2FA10: "\U0002a0ce",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2FA10
+	0x2FA11: "\u4cf8",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2FA11
+	0x2FA12: "\U0002a105",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2FA12
+	0x2FA13: "\U0002a20e",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2FA13
+	0x2FA14: "\U0002a291",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2FA14
+	0x2FA15: "\u9ebb",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2FA15
+	0x2FA16: "\u4d56",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2FA16
+	0x2FA17: "\u9ef9",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2FA17
+	0x2FA18: "\u9efe",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2FA18
+	0x2FA19: "\u9f05",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2FA19
+	0x2FA1A: "\u9f0f",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2FA1A
+	0x2FA1B: "\u9f16",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2FA1B
+	0x2FA1C: "\u9f3b",                                                                         // CJK COMPATIBILITY IDEOGRAPH-2FA1C
+	0x2FA1D: "\U0002a600",                                                                     // CJK COMPATIBILITY IDEOGRAPH-2FA1D
+}
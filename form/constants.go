@@ -2,12 +2,34 @@ package form
 
 // Common validation error messages
 const (
-	ErrFieldRequired      = "This field is required"
-	ErrMustBeNumber       = "Must be a number"
-	ErrInvalidEmail       = "Invalid email format"
-	ErrInvalidURL         = "Invalid URL format"
-	ErrMustBeAlpha        = "Must contain only letters"
-	ErrMustBeAlphanumeric = "Must contain only letters and numbers"
+	ErrFieldRequired        = "This field is required"
+	ErrMustBeNumber         = "Must be a number"
+	ErrInvalidEmail         = "Invalid email format"
+	ErrInvalidURL           = "Invalid URL format"
+	ErrMustBeAlpha          = "Must contain only letters"
+	ErrMustBeAlphanumeric   = "Must contain only letters and numbers"
+	ErrInvalidUTF8          = "Must be valid UTF-8 text"
+	ErrMustBeUnique         = "Must not contain duplicate values"
+	ErrMustBeBool           = "Must be true or false"
+	ErrMustBeDuration       = "Must be a duration such as 30s or 5m"
+	ErrMixedScripts         = "Must not mix characters from different scripts"
+	ErrConfusable           = "Must not contain characters that imitate other letters"
+	ErrInvalidDateTime      = "Must be a valid date and time"
+	ErrMustBeFuture         = "Must be in the future"
+	ErrMustBePast           = "Must be in the past"
+	ErrInvalidWeekday       = "Must fall on an allowed day of the week"
+	ErrOutsideBusinessHours = "Must be during business hours"
+	ErrInvalidPostalCode    = "Invalid postal code"
+	ErrInvalidPhone         = "Invalid phone number"
+	ErrInvalidNationalID    = "Invalid national ID number"
+	ErrUnsupportedCountry   = "Country is not supported"
+	ErrBodyTooLarge         = "Request body too large"
+	ErrTooManyFields        = "Too many fields"
+	ErrNestedTooDeeply      = "Input is nested too deeply"
+	ErrUnsupportedMediaType = "Unsupported content type"
+	ErrUnknownField         = "Unknown field"
+	ErrDuplicateField       = "Must be submitted only once"
+	ErrWizardExpired        = "This form has expired, please start again"
 )
 
 // Common test values
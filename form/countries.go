@@ -0,0 +1,230 @@
+package form
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+//go:generate go run ./internal/countrygen -input internal/countrygen/countries.txt -output countries_table.go
+
+// countryFormat holds the formats of one country. Patterns match whole values; nil means
+// the country has no such format.
+type countryFormat struct {
+	postalCode  *regexp.Regexp
+	callingCode string
+	trunkPrefix string
+	// phone matches the national significant number: the digits after the calling code,
+	// without the trunk prefix
+	phone      *regexp.Regexp
+	nationalID *regexp.Regexp
+	// idChecksum names the nationalIDChecksums entry applied after the pattern
+	idChecksum string
+}
+
+// nationalIDChecksums verify the check digits of national IDs, given without separators
+var nationalIDChecksums = map[string]func(id string) bool{
+	"br_cpf": validCPF,
+	"es_nif": validNIF,
+	"nl_bsn": validBSN,
+	"us_ssn": validSSN,
+}
+
+// isCountryCode reports whether a rule parameter is an ISO 3166-1 alpha-2 code rather
+// than the name of the field holding one
+func isCountryCode(param string) bool {
+	return len(param) == 2 && param[0] >= 'A' && param[0] <= 'Z' && param[1] >= 'A' && param[1] <= 'Z'
+}
+
+// countryFor returns the country a rule checks against: the parameter itself, or the
+// value of the field it names
+func countryFor(param string, context ValidationContext) string {
+	if param == "" || isCountryCode(param) {
+		return param
+	}
+	return strings.ToUpper(strings.TrimSpace(context.Get(param)))
+}
+
+// formatFor returns the formats a rule checks against. Values are skipped when the
+// field naming the country is empty, so that only the country is reported, and rejected
+// when the country isn't in the table.
+func formatFor(param string, context ValidationContext) (format countryFormat, skip bool, message string) {
+	country := countryFor(param, context)
+	if param != "" && country == "" {
+		return countryFormat{}, true, ""
+	}
+	format, ok := countryFormats[country]
+	if !ok {
+		return countryFormat{}, true, ErrUnsupportedCountry
+	}
+	return format, false, ""
+}
+
+// builtinCountryValidators contains the rules checking values against a country's
+// formats. The parameter is a country code such as "DE" or the name of a field holding
+// one.
+var builtinCountryValidators = map[string]ContextValidator{
+	"postal_code": func(value, param string, context ValidationContext) string {
+		if value == "" {
+			return ""
+		}
+		format, skip, message := formatFor(param, context)
+		if skip || format.postalCode == nil {
+			return message
+		}
+		if !format.postalCode.MatchString(strings.ToUpper(strings.TrimSpace(value))) {
+			return ErrInvalidPostalCode
+		}
+		return ""
+	},
+	"phone": func(value, param string, context ValidationContext) string {
+		// phone without a parameter accepts international numbers of any country
+		if value == "" {
+			return ""
+		}
+		country := countryFor(param, context)
+		if param != "" {
+			if _, skip, message := formatFor(param, context); skip {
+				return message
+			}
+		}
+		digits, international, ok := phoneDigits(value)
+		if !ok || !validPhone(digits, international, country) {
+			return ErrInvalidPhone
+		}
+		return ""
+	},
+	"national_id": func(value, param string, context ValidationContext) string {
+		if value == "" {
+			return ""
+		}
+		format, skip, message := formatFor(param, context)
+		if skip || format.nationalID == nil {
+			return message
+		}
+		id := strings.ToUpper(strings.TrimSpace(value))
+		if !format.nationalID.MatchString(id) {
+			return ErrInvalidNationalID
+		}
+		if check, ok := nationalIDChecksums[format.idChecksum]; ok && !check(strings.NewReplacer(" ", "", "-", "", ".", "").Replace(id)) {
+			return ErrInvalidNationalID
+		}
+		return ""
+	},
+}
+
+// phoneDigits reduces a phone number to its digits, dropping common separators. Numbers
+// starting with "+" or "00" are international and start with the calling code.
+func phoneDigits(value string) (digits string, international bool, ok bool) {
+	value = strings.TrimSpace(value)
+	if rest, found := strings.CutPrefix(value, "+"); found {
+		value, international = rest, true
+	}
+	digits = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "", "/", "").Replace(value)
+	if !international {
+		digits, international = strings.CutPrefix(digits, "00")
+	}
+	if digits == "" {
+		return "", false, false
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return "", false, false
+		}
+	}
+	return digits, international, true
+}
+
+// validPhone checks a number against the country's format. Without one, international
+// numbers are checked against the countries sharing their calling code, or else only
+// for length, and national numbers only for length.
+func validPhone(digits string, international bool, country string) bool {
+	if format, ok := countryFormats[country]; ok && format.phone != nil {
+		return format.validPhone(digits, international)
+	}
+	if !international {
+		return country != "" && len(digits) >= 4 && len(digits) <= 15
+	}
+	knownCode := false
+	for _, format := range countryFormats {
+		if format.phone != nil && strings.HasPrefix(digits, format.callingCode) {
+			if format.validPhone(digits, true) {
+				return true
+			}
+			knownCode = true
+		}
+	}
+	return !knownCode && len(digits) >= 8 && len(digits) <= 15
+}
+
+// validPhone checks a number written with or without this country's calling code
+func (f countryFormat) validPhone(digits string, international bool) bool {
+	number := digits
+	if international {
+		var ok bool
+		if number, ok = strings.CutPrefix(digits, f.callingCode); !ok {
+			return false
+		}
+	}
+	if f.phone.MatchString(number) {
+		return true
+	}
+	// The trunk prefix is dialed within the country and often kept after the calling
+	// code too, as in +49 (0)30 1234567
+	national, ok := strings.CutPrefix(number, f.trunkPrefix)
+	return f.trunkPrefix != "" && ok && f.phone.MatchString(national)
+}
+
+// validNIF checks the control letter of a Spanish NIF, or of an NIE starting with X, Y or Z
+func validNIF(id string) bool {
+	if len(id) != 9 {
+		return false
+	}
+	digits := strings.NewReplacer("X", "0", "Y", "1", "Z", "2").Replace(id[:1]) + id[1:8]
+	n, err := strconv.Atoi(digits)
+	if err != nil {
+		return false
+	}
+	return "TRWAGMYFPDXBNJZSQVHLCKE"[n%23] == id[8]
+}
+
+// validCPF checks the two mod 11 check digits of a Brazilian CPF
+func validCPF(id string) bool {
+	if len(id) != 11 || strings.Count(id, id[:1]) == len(id) {
+		return false
+	}
+	for length := 9; length <= 10; length++ {
+		sum := 0
+		for i := 0; i < length; i++ {
+			sum += int(id[i]-'0') * (length + 1 - i)
+		}
+		if check := sum * 10 % 11 % 10; check != int(id[length]-'0') {
+			return false
+		}
+	}
+	return true
+}
+
+// validBSN applies the eleven test to a Dutch BSN; 8-digit numbers have a leading zero dropped
+func validBSN(id string) bool {
+	if len(id) == 8 {
+		id = "0" + id
+	}
+	if len(id) != 9 {
+		return false
+	}
+	sum := -int(id[8] - '0')
+	for i := 0; i < 8; i++ {
+		sum += int(id[i]-'0') * (9 - i)
+	}
+	return sum != 0 && sum%11 == 0
+}
+
+// validSSN rejects US Social Security numbers with areas, groups or serials never issued
+func validSSN(id string) bool {
+	if len(id) != 9 {
+		return false
+	}
+	area, group, serial := id[:3], id[3:5], id[5:]
+	return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
+}
@@ -0,0 +1,173 @@
+// Code generated by countrygen from countries.txt. DO NOT EDIT.
+
+package form
+
+import "regexp"
+
+// countryFormats holds the postal code, phone number and national ID formats of each
+// country, by ISO 3166-1 alpha-2 code.
+var countryFormats = map[string]countryFormat{
+	"AT": {
+		postalCode:  regexp.MustCompile(`^(?:\d{4})$`),
+		callingCode: "43",
+		trunkPrefix: "0",
+		phone:       regexp.MustCompile(`^(?:[1-9]\d{3,12})$`),
+	},
+	"AU": {
+		postalCode:  regexp.MustCompile(`^(?:\d{4})$`),
+		callingCode: "61",
+		trunkPrefix: "0",
+		phone:       regexp.MustCompile(`^(?:[2-478]\d{8})$`),
+	},
+	"BE": {
+		postalCode:  regexp.MustCompile(`^(?:\d{4})$`),
+		callingCode: "32",
+		trunkPrefix: "0",
+		phone:       regexp.MustCompile(`^(?:[1-9]\d{7,8})$`),
+	},
+	"BR": {
+		postalCode:  regexp.MustCompile(`^(?:\d{5}-?\d{3})$`),
+		callingCode: "55",
+		trunkPrefix: "0",
+		phone:       regexp.MustCompile(`^(?:[1-9]{2}(?:9\d{8}|[2-5]\d{7}))$`),
+		nationalID:  regexp.MustCompile(`^(?:\d{3}\.?\d{3}\.?\d{3}-?\d{2})$`),
+		idChecksum:  "br_cpf",
+	},
+	"CA": {
+		postalCode:  regexp.MustCompile(`^(?:[ABCEGHJ-NPRSTVXY]\d[ABCEGHJ-NPRSTV-Z] ?\d[ABCEGHJ-NPRSTV-Z]\d)$`),
+		callingCode: "1",
+		trunkPrefix: "1",
+		phone:       regexp.MustCompile(`^(?:[2-9]\d{2}[2-9]\d{6})$`),
+	},
+	"CH": {
+		postalCode:  regexp.MustCompile(`^(?:\d{4})$`),
+		callingCode: "41",
+		trunkPrefix: "0",
+		phone:       regexp.MustCompile(`^(?:[1-9]\d{8})$`),
+	},
+	"CN": {
+		postalCode:  regexp.MustCompile(`^(?:\d{6})$`),
+		callingCode: "86",
+		trunkPrefix: "0",
+		phone:       regexp.MustCompile(`^(?:1[3-9]\d{9}|[2-9]\d{8,10})$`),
+	},
+	"DE": {
+		postalCode:  regexp.MustCompile(`^(?:\d{5})$`),
+		callingCode: "49",
+		trunkPrefix: "0",
+		phone:       regexp.MustCompile(`^(?:[1-9]\d{4,14})$`),
+	},
+	"DK": {
+		postalCode:  regexp.MustCompile(`^(?:\d{4})$`),
+		callingCode: "45",
+		phone:       regexp.MustCompile(`^(?:[2-9]\d{7})$`),
+	},
+	"ES": {
+		postalCode:  regexp.MustCompile(`^(?:(?:0[1-9]|[1-4]\d|5[0-2])\d{3})$`),
+		callingCode: "34",
+		phone:       regexp.MustCompile(`^(?:[5-9]\d{8})$`),
+		nationalID:  regexp.MustCompile(`^(?:[0-9XYZ]\d{7}-?[A-Z])$`),
+		idChecksum:  "es_nif",
+	},
+	"FI": {
+		postalCode:  regexp.MustCompile(`^(?:\d{5})$`),
+		callingCode: "358",
+		trunkPrefix: "0",
+		phone:       regexp.MustCompile(`^(?:[1-9]\d{4,11})$`),
+	},
+	"FR": {
+		postalCode:  regexp.MustCompile(`^(?:\d{2} ?\d{3})$`),
+		callingCode: "33",
+		trunkPrefix: "0",
+		phone:       regexp.MustCompile(`^(?:[1-9]\d{8})$`),
+	},
+	"GB": {
+		postalCode:  regexp.MustCompile(`^(?:GIR ?0AA|[A-Z]{1,2}\d[A-Z\d]? ?\d[A-Z]{2})$`),
+		callingCode: "44",
+		trunkPrefix: "0",
+		phone:       regexp.MustCompile(`^(?:[1-9]\d{8,9})$`),
+		nationalID:  regexp.MustCompile(`^(?:[A-CEGHJ-PR-TW-Z]{2} ?\d{2} ?\d{2} ?\d{2} ?[A-D])$`),
+	},
+	"IE": {
+		postalCode:  regexp.MustCompile(`^(?:(?:[AC-FHKNPRTV-Y]\d{2}|D6W) ?[\dAC-FHKNPRTV-Y]{4})$`),
+		callingCode: "353",
+		trunkPrefix: "0",
+		phone:       regexp.MustCompile(`^(?:[1-9]\d{6,9})$`),
+	},
+	"IN": {
+		postalCode:  regexp.MustCompile(`^(?:[1-9]\d{5})$`),
+		callingCode: "91",
+		trunkPrefix: "0",
+		phone:       regexp.MustCompile(`^(?:[1-9]\d{9})$`),
+	},
+	"IT": {
+		postalCode:  regexp.MustCompile(`^(?:\d{5})$`),
+		callingCode: "39",
+		phone:       regexp.MustCompile(`^(?:0\d{5,10}|3\d{8,9})$`),
+	},
+	"JP": {
+		postalCode:  regexp.MustCompile(`^(?:\d{3}-?\d{4})$`),
+		callingCode: "81",
+		trunkPrefix: "0",
+		phone:       regexp.MustCompile(`^(?:[1-9]\d{8,9})$`),
+	},
+	"KR": {
+		postalCode:  regexp.MustCompile(`^(?:\d{5})$`),
+		callingCode: "82",
+		trunkPrefix: "0",
+		phone:       regexp.MustCompile(`^(?:[1-9]\d{7,9})$`),
+	},
+	"MX": {
+		postalCode:  regexp.MustCompile(`^(?:\d{5})$`),
+		callingCode: "52",
+		phone:       regexp.MustCompile(`^(?:[1-9]\d{9})$`),
+	},
+	"NL": {
+		postalCode:  regexp.MustCompile(`^(?:[1-9]\d{3} ?[A-Z]{2})$`),
+		callingCode: "31",
+		trunkPrefix: "0",
+		phone:       regexp.MustCompile(`^(?:[1-9]\d{8})$`),
+		nationalID:  regexp.MustCompile(`^(?:\d{8,9})$`),
+		idChecksum:  "nl_bsn",
+	},
+	"NO": {
+		postalCode:  regexp.MustCompile(`^(?:\d{4})$`),
+		callingCode: "47",
+		phone:       regexp.MustCompile(`^(?:[2-9]\d{7})$`),
+	},
+	"NZ": {
+		postalCode:  regexp.MustCompile(`^(?:\d{4})$`),
+		callingCode: "64",
+		trunkPrefix: "0",
+		phone:       regexp.MustCompile(`^(?:[2-9]\d{7,9})$`),
+	},
+	"PL": {
+		postalCode:  regexp.MustCompile(`^(?:\d{2}-\d{3})$`),
+		callingCode: "48",
+		phone:       regexp.MustCompile(`^(?:[1-9]\d{8})$`),
+	},
+	"PT": {
+		postalCode:  regexp.MustCompile(`^(?:\d{4}-\d{3})$`),
+		callingCode: "351",
+		phone:       regexp.MustCompile(`^(?:[2-9]\d{8})$`),
+	},
+	"SE": {
+		postalCode:  regexp.MustCompile(`^(?:\d{3} ?\d{2})$`),
+		callingCode: "46",
+		trunkPrefix: "0",
+		phone:       regexp.MustCompile(`^(?:[1-9]\d{6,9})$`),
+	},
+	"SG": {
+		postalCode:  regexp.MustCompile(`^(?:\d{6})$`),
+		callingCode: "65",
+		phone:       regexp.MustCompile(`^(?:[689]\d{7})$`),
+	},
+	"US": {
+		postalCode:  regexp.MustCompile(`^(?:\d{5}(?:[ -]\d{4})?)$`),
+		callingCode: "1",
+		trunkPrefix: "1",
+		phone:       regexp.MustCompile(`^(?:[2-9]\d{2}[2-9]\d{6})$`),
+		nationalID:  regexp.MustCompile(`^(?:\d{3}-?\d{2}-?\d{4})$`),
+		idChecksum:  "us_ssn",
+	},
+}
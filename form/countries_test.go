@@ -0,0 +1,147 @@
+package form
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestCountryRules(t *testing.T) {
+	tests := []struct {
+		value string
+		rule  string
+		valid bool
+	}{
+		{"10115", "postal_code=DE", true},
+		{"1011", "postal_code=DE", false},
+		{"sw1a 1aa", "postal_code=GB", true},
+		{"SW1A", "postal_code=GB", false},
+		{"K1A 0B1", "postal_code=CA", true},
+		{"D1A 0B1", "postal_code=CA", false},
+		{"94105-1234", "postal_code=US", true},
+		{"1012 AB", "postal_code=NL", true},
+		{"53001", "postal_code=ES", false},
+		{"anything", "postal_code=ZZ", false},
+		{"10115", "postal_code", false},
+		{"", "postal_code=DE", true},
+
+		{"030 123456", "phone=DE", true},
+		{"+49 (0)30 123456", "phone=DE", true},
+		{"0049 30 123456", "phone=DE", true},
+		{"+33 1 23 45 67 89", "phone=DE", false},
+		{"(415) 555-2671", "phone=US", true},
+		{"+1 415 555 2671", "phone=US", true},
+		{"555-2671", "phone=US", false},
+		{"+55 11 91234-5678", "phone=BR", true},
+		{"+34 612 345 678", "phone=ES", true},
+		{"012 345 678", "phone=ES", false},
+		{"call me", "phone=ES", false},
+		{"+44 20 7946 0958", "phone", true},
+		{"+44 20 79", "phone", false},
+		{"+999 1234 5678", "phone", true},
+		{"020 7946 0958", "phone", false},
+		{"12345", "phone=ZZ", false},
+
+		{"12345678Z", "national_id=ES", true},
+		{"12345678-Z", "national_id=ES", true},
+		{"12345678A", "national_id=ES", false},
+		{"X1234567L", "national_id=ES", true},
+		{"529.982.247-25", "national_id=BR", true},
+		{"52998224725", "national_id=BR", true},
+		{"529.982.247-26", "national_id=BR", false},
+		{"111.111.111-11", "national_id=BR", false},
+		{"123-45-6789", "national_id=US", true},
+		{"666-45-6789", "national_id=US", false},
+		{"123-00-6789", "national_id=US", false},
+		{"12-345-6789", "national_id=US", false},
+		{"111222333", "national_id=NL", true},
+		{"111222334", "national_id=NL", false},
+		{"AB 12 34 56 C", "national_id=GB", true},
+		{"AB123456E", "national_id=GB", false},
+		{"anything", "national_id=DE", true},
+		{"anything", "national_id=ZZ", false},
+		{"12345678Z", "national_id", false},
+	}
+
+	for _, tt := range tests {
+		errs := validateFieldWithContext(tt.value, tt.rule, ValidationContext{})
+		if (len(errs) == 0) != tt.valid {
+			t.Errorf("%q with %q: expected valid=%v, got %v", tt.value, tt.rule, tt.valid, errs)
+		}
+	}
+}
+
+func TestCountryChecksumsAreImplemented(t *testing.T) {
+	for code, format := range countryFormats {
+		if _, ok := nationalIDChecksums[format.idChecksum]; format.idChecksum != "" && !ok {
+			t.Errorf("%s: unknown national ID checksum %q", code, format.idChecksum)
+		}
+	}
+}
+
+type shippingForm struct {
+	Country string `form:"country" validate:"required"`
+	Postal  string `form:"postal" validate:"required,postal_code=country"`
+	Phone   string `form:"phone" validate:"phone=country"`
+	TaxID   string `form:"tax_id" validate:"omitempty,national_id=country"`
+	Billing struct {
+		Country string `form:"country"`
+		Postal  string `form:"postal" validate:"postal_code=.country"`
+	} `form:"billing"`
+}
+
+func TestCountryFromField(t *testing.T) {
+	valid := shippingForm{Country: "es", Postal: "28013", Phone: "612 345 678", TaxID: "12345678Z"}
+	valid.Billing.Country, valid.Billing.Postal = "GB", "EC1A 1BB"
+	if errs := ValidateStruct(context.Background(), &valid); len(errs) != 0 {
+		t.Errorf("Expected no errors, got %v", errs)
+	}
+
+	invalid := shippingForm{Country: "US", Postal: "28013-12", Phone: "612 345 678", TaxID: "12345678Z"}
+	invalid.Billing.Country, invalid.Billing.Postal = "ES", "EC1A 1BB"
+	errs := ValidateStruct(context.Background(), &invalid)
+	for key, expected := range map[string]string{
+		"postal":         ErrInvalidPostalCode,
+		"phone":          ErrInvalidPhone,
+		"tax_id":         ErrInvalidNationalID,
+		"billing.postal": ErrInvalidPostalCode,
+	} {
+		if len(errs[key]) != 1 || errs[key][0] != expected {
+			t.Errorf("Expected %q for %s, got %v", expected, key, errs)
+		}
+	}
+
+	// Countries outside the table are rejected rather than accepted unchecked
+	errs = ValidateStruct(context.Background(), &shippingForm{Country: "XY", Postal: "28013", Phone: "612 345 678", TaxID: "12345678Z"})
+	for _, key := range []string{"postal", "phone", "tax_id"} {
+		if len(errs[key]) != 1 || errs[key][0] != ErrUnsupportedCountry {
+			t.Errorf("Expected %q for %s, got %v", ErrUnsupportedCountry, key, errs)
+		}
+	}
+
+	// Without a country, only the country field is reported
+	errs = ValidateStruct(context.Background(), &shippingForm{Postal: "28013", Phone: "612 345 678"})
+	if len(errs) != 1 || len(errs["country"]) == 0 {
+		t.Errorf("Expected only a country error, got %v", errs)
+	}
+}
+
+func TestCountryRuleReferences(t *testing.T) {
+	type Address struct {
+		Country string `form:"country"`
+		Postal  string `form:"postal" validate:"postal_code=contry"`
+		Phone   string `form:"phone" validate:"phone=US"`
+		Mobile  string `form:"mobile" validate:"phone"`
+		Fax     string `form:"fax" validate:"phone=ZZ"`
+		TaxID   string `form:"tax_id" validate:"national_id"`
+	}
+
+	expected := []string{
+		`postal: postal_code refers to unknown field "contry"`,
+		`fax: phone refers to unknown country "ZZ"`,
+		`tax_id: national_id needs a country code or field`,
+	}
+	if errs := ValidateStruct(context.Background(), &Address{}); !reflect.DeepEqual(errs["_struct"], expected) {
+		t.Errorf("Expected %v, got %v", expected, errs)
+	}
+}
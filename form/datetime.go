@@ -0,0 +1,435 @@
+package form
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// now returns the current time for relative bounds; tests replace it for determinism
+var now = time.Now
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// namedLayouts are the layout names accepted by datetime=<layout>.
+// Any other parameter is used as a Go reference-time layout such as "02/01/2006".
+var namedLayouts = map[string]string{
+	"RFC3339":     time.RFC3339,
+	"RFC3339Nano": time.RFC3339Nano,
+	"RFC1123":     time.RFC1123,
+	"RFC1123Z":    time.RFC1123Z,
+	"RFC822":      time.RFC822,
+	"RFC822Z":     time.RFC822Z,
+	"Kitchen":     time.Kitchen,
+	"DateTime":    time.DateTime,
+	"DateOnly":    time.DateOnly,
+	"TimeOnly":    time.TimeOnly,
+	"date":        time.DateOnly,
+	"time":        "15:04",
+	"local":       "2006-01-02T15:04", // HTML datetime-local inputs
+}
+
+// defaultLayouts are tried in order when a field has no datetime rule
+var defaultLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	time.DateTime,
+	time.DateOnly,
+	time.TimeOnly,
+	"15:04",
+}
+
+// locations caches time zones loaded for tz= rules
+var locations sync.Map
+
+// loadLocation loads a time zone by IANA name, caching the result
+func loadLocation(name string) (*time.Location, bool) {
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), true
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, false
+	}
+	locations.Store(name, loc)
+	return loc, true
+}
+
+// timeSettings holds the layout and time zone declared by a field's datetime= and tz= rules
+type timeSettings struct {
+	layout   string
+	location *time.Location
+}
+
+// timeSettingsFor reads the datetime= and tz= rules from a validate tag.
+// Without them, values are parsed with the default layouts and zone-less values are UTC.
+// Unknown zones are left at UTC here; checkReferences reports them.
+func timeSettingsFor(validateTag string) timeSettings {
+	settings := timeSettings{location: time.UTC}
+	if !strings.Contains(validateTag, "datetime=") && !strings.Contains(validateTag, "tz=") {
+		return settings
+	}
+	for _, rule := range strings.Split(validateTag, ",") {
+		name, param, _ := strings.Cut(strings.TrimSpace(rule), "=")
+		switch name {
+		case "datetime":
+			if layout, ok := namedLayouts[param]; ok {
+				settings.layout = layout
+			} else {
+				settings.layout = param
+			}
+		case "tz":
+			if loc, ok := loadLocation(param); ok {
+				settings.location = loc
+			}
+		}
+	}
+	return settings
+}
+
+// times returns the time settings of the field being validated, defaulting to UTC
+func (c ValidationContext) times() timeSettings {
+	if c.time.location == nil {
+		c.time.location = time.UTC
+	}
+	return c.time
+}
+
+// parse parses a value with the field's layout, or with the default layouts.
+// Values without a UTC offset are interpreted in the field's time zone.
+func (s timeSettings) parse(value string) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, false
+	}
+	if s.layout != "" {
+		t, err := time.ParseInLocation(s.layout, value, s.location)
+		return t, err == nil
+	}
+	for _, layout := range defaultLayouts {
+		if t, err := time.ParseInLocation(layout, value, s.location); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// format formats a time with the field's layout, or RFC 3339. The zero time formats as "".
+func (s timeSettings) format(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	if s.layout != "" {
+		return t.In(s.location).Format(s.layout)
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+// ParseTime parses a time value using the datetime= layout and tz= zone declared in a
+// validate tag. It is used by code generated by `gokit form gen` for time.Time fields.
+func ParseTime(value, validateTag string) (time.Time, bool) {
+	return timeSettingsFor(validateTag).parse(value)
+}
+
+// FormatTime formats a time value using the datetime= layout and tz= zone declared in a
+// validate tag, or RFC 3339. It is used by code generated by `gokit form gen`.
+func FormatTime(t time.Time, validateTag string) string {
+	return timeSettingsFor(validateTag).format(t)
+}
+
+// setTimeValue binds a value to a time.Time field, leaving it unchanged if it doesn't parse
+func setTimeValue(field reflect.Value, value, validateTag string) {
+	if t, ok := ParseTime(value, validateTag); ok {
+		field.Set(reflect.ValueOf(t))
+	}
+}
+
+// resolveBound resolves the bound of an after= or before= rule. Bounds are "now" or
+// "today" with an optional offset such as "now+30d" or "today-1y", an absolute time in
+// the field's layout or a default layout, or the name of another field.
+func resolveBound(param string, context ValidationContext) (time.Time, bool) {
+	if t, ok := literalBound(param, context.times()); ok {
+		return t, true
+	}
+	// The other field may use a different layout, so fall back to the default layouts
+	other := context.Get(param)
+	if t, ok := context.times().parse(other); ok {
+		return t, true
+	}
+	return timeSettings{location: context.times().location}.parse(other)
+}
+
+// literalBound resolves a relative or absolute bound, reporting false for field names
+func literalBound(param string, settings timeSettings) (time.Time, bool) {
+	for _, base := range []string{"now", "today"} {
+		offset, ok := strings.CutPrefix(param, base)
+		if !ok {
+			continue
+		}
+		t := now().In(settings.location)
+		if base == "today" {
+			y, m, d := t.Date()
+			t = time.Date(y, m, d, 0, 0, 0, 0, settings.location)
+		}
+		if t, ok := applyOffset(t, offset); ok {
+			return t, true
+		}
+	}
+
+	if t, ok := settings.parse(param); ok {
+		return t, true
+	}
+	return timeSettings{location: settings.location}.parse(param)
+}
+
+// applyOffset adds an offset such as "+30d", "-1y6mo" or "+2h30m" to t.
+// Calendar units are y, mo, w and d; clock units are those of time.ParseDuration.
+func applyOffset(t time.Time, offset string) (time.Time, bool) {
+	if offset == "" {
+		return t, true
+	}
+	sign := 1
+	switch offset[0] {
+	case '+':
+	case '-':
+		sign = -1
+	default:
+		return time.Time{}, false
+	}
+	rest := offset[1:]
+	if rest == "" {
+		return time.Time{}, false
+	}
+
+	for rest != "" {
+		i := 0
+		for i < len(rest) && rest[i] >= '0' && rest[i] <= '9' {
+			i++
+		}
+		if i == 0 {
+			return time.Time{}, false
+		}
+		n, err := strconv.Atoi(rest[:i])
+		if err != nil {
+			return time.Time{}, false
+		}
+		n *= sign
+		rest = rest[i:]
+
+		j := 0
+		for j < len(rest) && (rest[j] < '0' || rest[j] > '9') {
+			j++
+		}
+		unit := rest[:j]
+		rest = rest[j:]
+
+		switch unit {
+		case "y":
+			t = t.AddDate(n, 0, 0)
+		case "mo":
+			t = t.AddDate(0, n, 0)
+		case "w":
+			t = t.AddDate(0, 0, 7*n)
+		case "d":
+			t = t.AddDate(0, 0, n)
+		default:
+			d, err := time.ParseDuration(strconv.Itoa(n) + unit)
+			if err != nil {
+				return time.Time{}, false
+			}
+			t = t.Add(d)
+		}
+	}
+	return t, true
+}
+
+// describeBound returns the bound as shown in error messages: field names are quoted
+// as the field comparison rules do, other bounds are shown as written
+func describeBound(param string, context ValidationContext) string {
+	if _, ok := literalBound(param, context.times()); ok {
+		return param
+	}
+	return strconv.Quote(param)
+}
+
+// ageOn returns the age in whole years on the given day of someone born on birth.
+// Both are compared as calendar dates, so a birth date parsed as UTC midnight isn't
+// shifted to the previous day in zones west of UTC.
+func ageOn(birth, day time.Time) int {
+	age := day.Year() - birth.Year()
+	if day.Month() < birth.Month() || (day.Month() == birth.Month() && day.Day() < birth.Day()) {
+		age--
+	}
+	return age
+}
+
+// weekdayNames maps the weekday= names to weekdays
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// parseWeekdays parses a weekday list such as "mon-fri" or "sat:sun"
+func parseWeekdays(param string) (map[time.Weekday]bool, bool) {
+	days := make(map[time.Weekday]bool)
+	for _, part := range SplitParam(param, -1) {
+		from, to, isRange := strings.Cut(strings.ToLower(strings.TrimSpace(part)), "-")
+		start, ok := weekdayNames[from]
+		if !ok {
+			return nil, false
+		}
+		if !isRange {
+			days[start] = true
+			continue
+		}
+		end, ok := weekdayNames[to]
+		if !ok {
+			return nil, false
+		}
+		for d := start; ; d = (d + 1) % 7 {
+			days[d] = true
+			if d == end {
+				break
+			}
+		}
+	}
+	return days, len(days) > 0
+}
+
+// parseClockRange parses a time-of-day range such as "09:00-17:30" into minutes since midnight
+func parseClockRange(param string) (int, int, bool) {
+	from, to, ok := strings.Cut(param, "-")
+	if !ok {
+		return 0, 0, false
+	}
+	start, err1 := time.Parse("15:04", strings.TrimSpace(from))
+	end, err2 := time.Parse("15:04", strings.TrimSpace(to))
+	if err1 != nil || err2 != nil {
+		return 0, 0, false
+	}
+	return start.Hour()*60 + start.Minute(), end.Hour()*60 + end.Minute(), true
+}
+
+// withinClockRange reports whether t's time of day falls in [start, end), in minutes.
+// Ranges that wrap past midnight, such as 22:00-06:00, are supported.
+func withinClockRange(t time.Time, start, end int) bool {
+	minutes := t.Hour()*60 + t.Minute()
+	if start <= end {
+		return minutes >= start && minutes < end
+	}
+	return minutes >= start || minutes < end
+}
+
+// timeRule wraps a time rule so that it only runs on values that parse; values that
+// don't are reported once per field with ErrInvalidDateTime
+func timeRule(check func(t time.Time, param string, context ValidationContext) string) ContextValidator {
+	return func(value, param string, context ValidationContext) string {
+		if value == "" {
+			return ""
+		}
+		t, ok := context.times().parse(value)
+		if !ok {
+			return ErrInvalidDateTime
+		}
+		return check(t.In(context.times().location), param, context)
+	}
+}
+
+// builtinTimeValidators contains the date and time rules. Values are parsed with the
+// field's datetime= layout (or the default layouts) in its tz= zone (or UTC).
+var builtinTimeValidators = map[string]ContextValidator{
+	"datetime": func(value, param string, context ValidationContext) string {
+		// datetime=<layout> only checks that the value parses; the layout is read by every time rule
+		if value == "" {
+			return ""
+		}
+		if _, ok := context.times().parse(value); !ok {
+			return ErrInvalidDateTime
+		}
+		return ""
+	},
+	"tz": func(value, param string, context ValidationContext) string {
+		// tz=<zone> only sets the zone read by the other time rules
+		return ""
+	},
+	"after": timeRule(func(t time.Time, param string, context ValidationContext) string {
+		bound, ok := resolveBound(param, context)
+		if !ok || t.After(bound) {
+			return ""
+		}
+		if param == "now" {
+			return ErrMustBeFuture
+		}
+		return fmt.Sprintf("Must be after %s", describeBound(param, context))
+	}),
+	"before": timeRule(func(t time.Time, param string, context ValidationContext) string {
+		bound, ok := resolveBound(param, context)
+		if !ok || t.Before(bound) {
+			return ""
+		}
+		if param == "now" {
+			return ErrMustBePast
+		}
+		return fmt.Sprintf("Must be before %s", describeBound(param, context))
+	}),
+	"min_age": timeRule(func(t time.Time, param string, context ValidationContext) string {
+		years, err := strconv.Atoi(param)
+		if err != nil {
+			return ""
+		}
+		if ageOn(t, now().In(context.times().location)) < years {
+			return fmt.Sprintf("Must be at least %d years old", years)
+		}
+		return ""
+	}),
+	"max_age": timeRule(func(t time.Time, param string, context ValidationContext) string {
+		years, err := strconv.Atoi(param)
+		if err != nil {
+			return ""
+		}
+		if ageOn(t, now().In(context.times().location)) > years {
+			return fmt.Sprintf("Must be no more than %d years old", years)
+		}
+		return ""
+	}),
+	"weekday": timeRule(func(t time.Time, param string, context ValidationContext) string {
+		// weekday=mon-fri or weekday=sat:sun
+		days, ok := parseWeekdays(param)
+		if !ok || days[t.Weekday()] {
+			return ""
+		}
+		return ErrInvalidWeekday
+	}),
+	"time_between": timeRule(func(t time.Time, param string, context ValidationContext) string {
+		// time_between=09:00-17:00 constrains the time of day
+		start, end, ok := parseClockRange(param)
+		if !ok || withinClockRange(t, start, end) {
+			return ""
+		}
+		from, to, _ := strings.Cut(param, "-")
+		return fmt.Sprintf("Must be between %s and %s", strings.TrimSpace(from), strings.TrimSpace(to))
+	}),
+	"business_hours": timeRule(func(t time.Time, param string, context ValidationContext) string {
+		// business_hours is Monday to Friday, 09:00-17:00; business_hours=08:30-18:00 changes the hours
+		if param == "" {
+			param = "09:00-17:00"
+		}
+		start, end, ok := parseClockRange(param)
+		if !ok {
+			return ""
+		}
+		if t.Weekday() == time.Saturday || t.Weekday() == time.Sunday || !withinClockRange(t, start, end) {
+			return ErrOutsideBusinessHours
+		}
+		return ""
+	}),
+}
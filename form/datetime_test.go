@@ -0,0 +1,242 @@
+package form
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// fixNow pins the clock used by relative bounds for the duration of a test
+func fixNow(t *testing.T, at time.Time) {
+	t.Helper()
+	previous := now
+	now = func() time.Time { return at }
+	t.Cleanup(func() { now = previous })
+}
+
+func TestDateTimeLayouts(t *testing.T) {
+	tests := []struct {
+		value string
+		rule  string
+		valid bool
+	}{
+		{"2026-03-04T10:00:00Z", "datetime=RFC3339", true},
+		{"2026-03-04T10:00:00+02:00", "datetime", true},
+		{"2026-03-04", "datetime=RFC3339", false},
+		{"2026-03-04", "datetime=date", true},
+		{"2026-02-30", "datetime=date", false},
+		{"04/03/2026", "datetime=02/01/2006", true},
+		{"2026-03-04T10:00", "datetime=local", true},
+		{"10:15", "datetime=time", true},
+		{"25:00", "datetime=time", false},
+		{"tomorrow", "datetime", false},
+		{"", "datetime=date", true},
+	}
+
+	for _, tt := range tests {
+		errs := validateFieldWithContext(tt.value, tt.rule, ValidationContext{})
+		if (len(errs) == 0) != tt.valid {
+			t.Errorf("%q with %q: expected valid=%v, got %v", tt.value, tt.rule, tt.valid, errs)
+		}
+	}
+}
+
+func TestRelativeBounds(t *testing.T) {
+	fixNow(t, time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		value    string
+		rule     string
+		expected []string
+	}{
+		{"2026-03-04T13:00:00Z", "after=now", nil},
+		{"2026-03-04T11:00:00Z", "after=now", []string{ErrMustBeFuture}},
+		{"2026-03-04T11:00:00Z", "before=now", nil},
+		{"2026-03-05", "before=now", []string{ErrMustBePast}},
+		{"2026-04-02", "after=now,before=now+30d", nil},
+		{"2026-04-04", "after=now,before=now+30d", []string{"Must be before now+30d"}},
+		{"2026-03-04", "after=today-1w", nil},
+		{"2026-02-20", "after=today-1w", []string{"Must be after today-1w"}},
+		{"2026-03-04T14:29:00Z", "before=now+2h30m", nil},
+		{"2027-03-05", "before=now+1y", []string{"Must be before now+1y"}},
+		{"2026-09-03", "before=today+6mo", nil},
+		{"2026-01-01", "after=2026-01-01", []string{"Must be after 2026-01-01"}},
+		{"2026-01-02", "after=2026-01-01", nil},
+	}
+
+	for _, tt := range tests {
+		errs := validateFieldWithContext(tt.value, tt.rule, ValidationContext{})
+		if !reflect.DeepEqual(errs, tt.expected) {
+			t.Errorf("%q with %q: expected %v, got %v", tt.value, tt.rule, tt.expected, errs)
+		}
+	}
+}
+
+func TestFieldBounds(t *testing.T) {
+	context := ValidationContext{values: map[string]string{
+		"check_in": "2026-03-04T15:00:00+01:00",
+	}}
+
+	if errs := validateFieldWithContext("2026-03-04T14:30:00Z", "after=check_in", context); len(errs) > 0 {
+		t.Errorf("Expected times in different zones to compare as instants, got %v", errs)
+	}
+	expected := []string{`Must be after "check_in"`}
+	if errs := validateFieldWithContext("2026-03-04T13:30:00Z", "after=check_in", context); !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected %v, got %v", expected, errs)
+	}
+	if errs := validateFieldWithContext("2026-03-05", "datetime=date,after=check_in", context); len(errs) > 0 {
+		t.Errorf("Expected a bound field in another layout to be parsed, got %v", errs)
+	}
+	if errs := validateFieldWithContext("2026-03-04", "after=missing", context); len(errs) > 0 {
+		t.Errorf("Expected an empty field bound to be ignored, got %v", errs)
+	}
+}
+
+func TestAgeRules(t *testing.T) {
+	fixNow(t, time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		value    string
+		rule     string
+		expected []string
+	}{
+		{"2008-03-04", "min_age=18", nil},
+		{"2008-03-05", "min_age=18", []string{"Must be at least 18 years old"}},
+		{"1956-03-05", "max_age=69", nil},
+		{"1956-03-04", "max_age=69", []string{"Must be no more than 69 years old"}},
+		{"not a date", "min_age=18", []string{ErrInvalidDateTime}},
+	}
+
+	for _, tt := range tests {
+		errs := validateFieldWithContext(tt.value, tt.rule, ValidationContext{})
+		if !reflect.DeepEqual(errs, tt.expected) {
+			t.Errorf("%q with %q: expected %v, got %v", tt.value, tt.rule, tt.expected, errs)
+		}
+	}
+}
+
+func TestTimeOfDayRules(t *testing.T) {
+	tests := []struct {
+		value    string
+		rule     string
+		expected []string
+	}{
+		// 2026-03-04 is a Wednesday and 2026-03-07 a Saturday
+		{"2026-03-04T09:00", "business_hours", nil},
+		{"2026-03-04T17:00", "business_hours", []string{ErrOutsideBusinessHours}},
+		{"2026-03-07T10:00", "business_hours", []string{ErrOutsideBusinessHours}},
+		{"2026-03-04T08:15", "business_hours=08:00-18:00", nil},
+		{"2026-03-04T12:00", "time_between=09:00-17:00", nil},
+		{"2026-03-04T18:00", "time_between=09:00-17:00", []string{"Must be between 09:00 and 17:00"}},
+		{"23:30", "time_between=22:00-06:00", nil},
+		{"07:00", "time_between=22:00-06:00", []string{"Must be between 22:00 and 06:00"}},
+		{"2026-03-07", "weekday=sat:sun", nil},
+		{"2026-03-04", "weekday=mon-fri", nil},
+		{"2026-03-07", "weekday=mon-fri", []string{ErrInvalidWeekday}},
+		{"2026-03-08", "weekday=fri-mon", nil},
+	}
+
+	for _, tt := range tests {
+		errs := validateFieldWithContext(tt.value, tt.rule, ValidationContext{})
+		if !reflect.DeepEqual(errs, tt.expected) {
+			t.Errorf("%q with %q: expected %v, got %v", tt.value, tt.rule, tt.expected, errs)
+		}
+	}
+}
+
+func TestTimeZones(t *testing.T) {
+	fixNow(t, time.Date(2026, 3, 4, 14, 0, 0, 0, time.UTC))
+
+	// 09:30 in New York is 14:30 UTC, after now and within business hours there
+	if errs := validateFieldWithContext("2026-03-04T09:30", "datetime=local,tz=America/New_York,after=now,business_hours", ValidationContext{}); len(errs) > 0 {
+		t.Errorf("Expected no errors, got %v", errs)
+	}
+	// An explicit offset wins over the field's zone, and business hours are checked in the zone
+	expected := []string{ErrOutsideBusinessHours}
+	if errs := validateFieldWithContext("2026-03-04T15:00:00Z", "tz=America/New_York,business_hours=11:00-17:00", ValidationContext{}); !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected %v, got %v", expected, errs)
+	}
+}
+
+func TestInvalidDateTimeReportedOnce(t *testing.T) {
+	expected := []string{ErrInvalidDateTime}
+	errs := validateFieldWithContext("soon", "datetime=date,after=now,weekday=mon-fri", ValidationContext{})
+	if !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected %v, got %v", expected, errs)
+	}
+}
+
+func TestApplyOffset(t *testing.T) {
+	base := time.Date(2026, 1, 31, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		offset   string
+		expected time.Time
+		ok       bool
+	}{
+		{"", base, true},
+		{"+1d", base.AddDate(0, 0, 1), true},
+		{"-2w", base.AddDate(0, 0, -14), true},
+		{"+1y6mo", base.AddDate(1, 6, 0), true},
+		{"+90m", base.Add(90 * time.Minute), true},
+		{"-1d12h", base.AddDate(0, 0, -1).Add(-12 * time.Hour), true},
+		{"+", time.Time{}, false},
+		{"+3x", time.Time{}, false},
+		{"30d", time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := applyOffset(base, tt.offset)
+		if ok != tt.ok || !got.Equal(tt.expected) {
+			t.Errorf("applyOffset(%q) = %v, %v; expected %v, %v", tt.offset, got, ok, tt.expected, tt.ok)
+		}
+	}
+}
+
+func TestBindTimeFields(t *testing.T) {
+	type Appointment struct {
+		Start    time.Time `form:"start" validate:"required,datetime=local,tz=Europe/Paris"`
+		Day      time.Time `form:"day" validate:"datetime=02/01/2006"`
+		Reminder time.Time `form:"reminder"`
+	}
+
+	req := createRequest(map[string]string{
+		"start":    "2026-03-04T10:30",
+		"day":      "05/03/2026",
+		"reminder": "2026-03-04T09:00:00+01:00",
+	})
+	var a Appointment
+	if errs := DecodeAndValidate(req, &a); len(errs) > 0 {
+		t.Fatalf("Unexpected errors: %v", errs)
+	}
+
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip("time zone data unavailable")
+	}
+	if !a.Start.Equal(time.Date(2026, 3, 4, 10, 30, 0, 0, paris)) || a.Start.Location().String() != "Europe/Paris" {
+		t.Errorf("Unexpected start: %v", a.Start)
+	}
+	if !a.Day.Equal(time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected day: %v", a.Day)
+	}
+	if !a.Reminder.Equal(time.Date(2026, 3, 4, 8, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected reminder: %v", a.Reminder)
+	}
+
+	// Values that don't parse leave the field unset and report the error
+	req = createRequest(map[string]string{"start": "next tuesday"})
+	a = Appointment{}
+	errs := DecodeAndValidate(req, &a)
+	if !reflect.DeepEqual(errs["start"], []string{ErrInvalidDateTime}) || !a.Start.IsZero() {
+		t.Errorf("Expected an invalid start to be reported and left unset, got %v and %v", errs, a.Start)
+	}
+
+	// ValidateStruct formats time fields with their layout
+	a = Appointment{Start: time.Date(2026, 3, 4, 10, 30, 0, 0, paris), Day: time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)}
+	if errs := ValidateStruct(t.Context(), &a); len(errs) > 0 {
+		t.Errorf("Unexpected errors: %v", errs)
+	}
+	if errs := ValidateStruct(t.Context(), &Appointment{}); !reflect.DeepEqual(errs["start"], []string{ErrFieldRequired}) {
+		t.Errorf("Expected a zero start time to be reported as required, got %v", errs)
+	}
+}
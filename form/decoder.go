@@ -0,0 +1,394 @@
+package form
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Default request limits used when DecoderOptions leaves them unset
+const (
+	DefaultMaxBodyBytes = 100 << 20
+	DefaultMaxMemory    = 32 << 20
+)
+
+// errBodyTooLarge is returned by maxBytesReader when the body exceeds its limit
+var errBodyTooLarge = errors.New("http: request body too large")
+
+// DecoderOptions configures a Decoder. The zero value gives the behavior of the
+// package-level functions.
+type DecoderOptions struct {
+	// MaxBodyBytes caps the request body. Larger bodies fail with ErrBodyTooLarge, and
+	// requests whose Content-Length declares a larger body fail before it is read.
+	// It defaults to DefaultMaxBodyBytes.
+	MaxBodyBytes int64
+	// MaxMemory is the part of a multipart body kept in memory; the rest of the files
+	// are stored in temporary files. It defaults to DefaultMaxMemory.
+	MaxMemory int64
+	// MaxFields limits the number of distinct input keys, counted after JSON bodies are
+	// flattened. Zero means no limit.
+	MaxFields int
+	// MaxValueLength limits the length of every input value in bytes. Zero means no limit.
+	MaxValueLength int
+	// MaxValues limits the number of submitted values: name=value pairs of the query and
+	// urlencoded bodies, values and files of multipart bodies, and object members and
+	// array elements of JSON bodies. Urlencoded and JSON bodies are counted as they are
+	// read, so a flood of values is rejected before it is decoded. Zero means no limit.
+	MaxValues int
+	// MaxDepth limits the nesting of input: objects and arrays of JSON bodies, checked as
+	// they are read, and the segments of keys such as "lines[0].sku". Zero means no limit.
+	MaxDepth int
+	// ContentTypes lists the media types accepted for request bodies, such as
+	// "application/x-www-form-urlencoded" or "multipart/*". Bodies of other types, or
+	// without a Content-Type, fail with ErrUnsupportedMediaType before they are read.
+	// Empty accepts any type.
+	ContentTypes []string
+
+	// FormTag names the tag that holds form keys; it defaults to "form". Keys are read up
+	// to the first comma, so FormTag: "json" reuses existing json tags.
+	FormTag string
+	// JSONTag names the tag that holds keys for JSON bodies; it defaults to FormTag.
+	JSONTag string
+	// ValidateTag names the tag that holds validation rules; it defaults to "validate".
+	ValidateTag string
+	// SanitizeTag names the tag that holds sanitizers; it defaults to "sanitize".
+	SanitizeTag string
+
+	// Dialect selects the syntax of validate tags. With DialectPlayground, tags written for
+	// go-playground/validator are translated, and rules without an equivalent are skipped;
+	// list them with UnsupportedRules.
+	Dialect Dialect
+
+	// Strict reports input keys that don't belong to any field, and repeated values for
+	// scalar fields, instead of ignoring them.
+	Strict bool
+
+	// Localize reads dates, and numbers of fields tagged locale:"number" or
+	// locale:"currency", in the formats of the request's locale, using the
+	// i18n.Translator that i18n.LocaleDetector stores in the context, so "1.234,56" and
+	// "31.12.2025" decode as 1234.56 and 2025-12-31 for a German user. Input is rewritten
+	// before binding, so min, max and date rules compare the parsed values. A locale tag
+	// of "date" forces a date and "-" turns localization off. Untagged numeric fields are
+	// read as usual, as <input type="number"> always submits "1234.56". Without a
+	// Translator in the context, input is read as usual.
+	Localize bool
+}
+
+// Decoder decodes and validates requests with configurable limits, tag names and
+// strictness. A Decoder is safe for concurrent use. The package-level functions use a
+// Decoder with default options.
+//
+// Example:
+//
+//	decoder := form.NewDecoder(form.DecoderOptions{
+//	    MaxBodyBytes: 1 << 20,
+//	    MaxFields:    50,
+//	    Strict:       true,
+//	})
+//	errs := decoder.DecodeAndValidate(r.Context(), r, &f)
+type Decoder struct {
+	opts     DecoderOptions
+	tags     tagNames
+	jsonTags tagNames
+}
+
+// defaultDecoder backs the package-level functions
+var defaultDecoder = NewDecoder(DecoderOptions{})
+
+// NewDecoder returns a Decoder with the given options, filling in defaults for unset ones.
+func NewDecoder(opts DecoderOptions) *Decoder {
+	if opts.MaxBodyBytes <= 0 {
+		opts.MaxBodyBytes = DefaultMaxBodyBytes
+	}
+	if opts.MaxMemory <= 0 {
+		opts.MaxMemory = DefaultMaxMemory
+	}
+	if opts.FormTag == "" {
+		opts.FormTag = defaultTags.form
+	}
+	if opts.JSONTag == "" {
+		opts.JSONTag = opts.FormTag
+	}
+	if opts.ValidateTag == "" {
+		opts.ValidateTag = defaultTags.validate
+	}
+	if opts.SanitizeTag == "" {
+		opts.SanitizeTag = defaultTags.sanitize
+	}
+
+	d := &Decoder{opts: opts}
+	d.tags = tagNames{form: opts.FormTag, validate: opts.ValidateTag, sanitize: opts.SanitizeTag, dialect: opts.Dialect}
+	d.jsonTags = tagNames{form: opts.JSONTag, validate: opts.ValidateTag, sanitize: opts.SanitizeTag, dialect: opts.Dialect}
+	return d
+}
+
+// DecodeAndValidate decodes a urlencoded or multipart request into v and validates it,
+// like the package-level DecodeAndValidateWithContext but with the decoder's options.
+func (d *Decoder) DecodeAndValidate(ctx context.Context, r *http.Request, v interface{}) ValidationErrors {
+	start := time.Now()
+	formName := ""
+	if v != nil {
+		formName = reflect.TypeOf(v).Elem().Name()
+	}
+	if obs := getObserver(); obs != nil {
+		obs.OnDecodeStart(ctx, formName)
+	}
+	// Reject what the headers alone rule out, before anything reads the body
+	if errors := d.screen(r); errors != nil {
+		if obs := getObserver(); obs != nil {
+			obs.OnDecodeEnd(ctx, formName, nil)
+		}
+		return errors
+	}
+	errors := make(ValidationErrors)
+
+	// Cap the body before anything reads it
+	if r.Body != nil && r.Form == nil {
+		r.Body = &maxBytesReader{r: r.Body, n: d.opts.MaxBodyBytes}
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		errors[FormErrorKey] = []string{parseFailure(err, "Failed to parse form data")}
+		if obs := getObserver(); obs != nil {
+			obs.OnDecodeEnd(ctx, formName, err)
+		}
+		return errors
+	}
+
+	// Parse multipart form if needed
+	contentType := r.Header.Get("Content-Type")
+	if r.MultipartForm == nil && strings.Contains(contentType, "multipart/form-data") {
+		// #nosec G120 -- request body capped by maxBytesReader wrapper above
+		if err := r.ParseMultipartForm(d.opts.MaxMemory); err != nil {
+			errors[FormErrorKey] = []string{parseFailure(err, "Failed to parse multipart form data")}
+			if obs := getObserver(); obs != nil {
+				obs.OnDecodeEnd(ctx, formName, err)
+			}
+			return errors
+		}
+	}
+	if d.opts.MaxValues > 0 && countValues(r) > d.opts.MaxValues {
+		if obs := getObserver(); obs != nil {
+			obs.OnDecodeEnd(ctx, formName, nil)
+		}
+		return ValidationErrors{FormErrorKey: {ErrTooManyFields}}
+	}
+
+	if structErrors := validateStructPointer(ctx, v, formName); structErrors != nil {
+		return structErrors
+	}
+	val := reflect.ValueOf(v).Elem()
+
+	// Convert request data to form-like structure
+	formData := make(map[string][]string)
+	if r.MultipartForm != nil {
+		for key, values := range r.MultipartForm.Value {
+			formData[key] = values
+		}
+	} else {
+		for key, values := range r.Form {
+			formData[key] = values
+		}
+	}
+
+	ctx = trackRules(ctx)
+	validationErrors := d.decodeAndValidateFields(ctx, v, val, formData, formName, d.tags)
+
+	handleFormObservability(ctx, formName, validationErrors, start)
+
+	return validationErrors
+}
+
+// DecodeAndValidateJSON decodes a JSON body into v and validates it, like the
+// package-level DecodeAndValidateJSON but with the decoder's options. Keys are read
+// from the JSONTag tags.
+func (d *Decoder) DecodeAndValidateJSON(ctx context.Context, reader io.Reader, v interface{}) ValidationErrors {
+	start := time.Now()
+	formName := ""
+	if v != nil {
+		formName = reflect.TypeOf(v).Elem().Name()
+	}
+
+	if obs := getObserver(); obs != nil {
+		obs.OnDecodeStart(ctx, formName)
+	}
+
+	formData, errors, err := d.readJSON(reader)
+	if errors != nil {
+		if obs := getObserver(); obs != nil {
+			obs.OnDecodeEnd(ctx, formName, err)
+		}
+		return errors
+	}
+
+	if structErrors := validateStructPointer(ctx, v, formName); structErrors != nil {
+		return structErrors
+	}
+	val := reflect.ValueOf(v).Elem()
+
+	ctx = trackRules(ctx)
+	errors = d.decodeAndValidateFields(ctx, v, val, formData, formName, d.jsonTags)
+
+	handleFormObservability(ctx, formName, errors, start)
+
+	return errors
+}
+
+// readJSON decodes a JSON object body, capped at MaxBodyBytes and screened for MaxDepth
+// and MaxValues, and flattens it into form data. If the body can't be decoded, it
+// returns the errors to report and the cause.
+func (d *Decoder) readJSON(reader io.Reader) (map[string][]string, ValidationErrors, error) {
+	var jsonData map[string]interface{}
+	body := &maxBytesReader{r: io.NopCloser(reader), n: d.opts.MaxBodyBytes}
+	var input io.Reader = body
+	if d.opts.MaxDepth > 0 || d.opts.MaxValues > 0 {
+		input = &jsonScreen{r: body, maxDepth: d.opts.MaxDepth, maxValues: d.opts.MaxValues}
+	}
+	if err := json.NewDecoder(input).Decode(&jsonData); err != nil {
+		switch {
+		case body.err == errBodyTooLarge:
+			return nil, ValidationErrors{FormErrorKey: {ErrBodyTooLarge}}, err
+		case errors.Is(err, errNestedTooDeeply):
+			return nil, ValidationErrors{FormErrorKey: {ErrNestedTooDeeply}}, err
+		case errors.Is(err, errTooManyValues):
+			return nil, ValidationErrors{FormErrorKey: {ErrTooManyFields}}, err
+		}
+		return nil, ValidationErrors{"_json": {"Failed to decode JSON: " + err.Error()}}, err
+	}
+
+	// Convert map to form-like structure
+	formData := make(map[string][]string)
+	for key, value := range jsonData {
+		addFormValue(formData, key, value)
+	}
+	return formData, nil, nil
+}
+
+// ValidationMiddleware returns middleware that decodes and validates each request into
+// a new instance of formStruct with the decoder's options, like the package-level
+// ValidationMiddlewareWithContext. Requests with a JSON content type are decoded as JSON.
+// Requests are screened before their body is read, and rejected ones reach errorHandler
+// with errors that StatusCode maps to 413 or 415.
+func (d *Decoder) ValidationMiddleware(formStruct interface{}, errorHandler ValidationErrorHandler) func(http.Handler) http.Handler {
+	tags := func(r *http.Request) tagNames {
+		if isJSONRequest(r) {
+			return d.jsonTags
+		}
+		return d.tags
+	}
+	return validationMiddleware(formStruct, errorHandler, tags, func(r *http.Request, v interface{}) ValidationErrors {
+		if isJSONRequest(r) {
+			if errors := d.screen(r); errors != nil {
+				return errors
+			}
+			return d.DecodeAndValidateJSON(r.Context(), r.Body, v)
+		}
+		return d.DecodeAndValidate(r.Context(), r, v)
+	})
+}
+
+// isJSONRequest reports whether r has a JSON content type
+func isJSONRequest(r *http.Request) bool {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return mediaType == "application/json"
+}
+
+// parseFailure returns the message for a body that failed to parse
+func parseFailure(err error, message string) string {
+	switch {
+	case errors.Is(err, errBodyTooLarge):
+		return ErrBodyTooLarge
+	case errors.Is(err, errTooManyValues):
+		return ErrTooManyFields
+	}
+	return message
+}
+
+// checkInput enforces the field and depth limits and, in strict mode, rejects unknown keys and
+// repeated values for scalar fields. It returns nil when the input is acceptable.
+func (d *Decoder) checkInput(plan *structPlan, formData map[string][]string) ValidationErrors {
+	if d.opts.MaxFields > 0 && len(formData) > d.opts.MaxFields {
+		return ValidationErrors{FormErrorKey: {ErrTooManyFields}}
+	}
+
+	if d.opts.MaxDepth > 0 {
+		for key := range formData {
+			if keyDepth(key) > d.opts.MaxDepth {
+				return ValidationErrors{FormErrorKey: {ErrNestedTooDeeply}}
+			}
+		}
+	}
+
+	errors := make(ValidationErrors)
+	for key, values := range formData {
+		if d.opts.MaxValueLength > 0 {
+			for _, value := range values {
+				if len(value) > d.opts.MaxValueLength {
+					errors[key] = []string{fmt.Sprintf("Must be no more than %d bytes", d.opts.MaxValueLength)}
+					break
+				}
+			}
+		}
+		if !d.opts.Strict || len(errors[key]) > 0 {
+			continue
+		}
+		f, ok := matchInputKey(plan, key)
+		switch {
+		case !ok:
+			errors[key] = []string{ErrUnknownField}
+		case len(values) > 1 && !f.collection && f.nested == nil:
+			errors[key] = []string{ErrDuplicateField}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+// matchInputKey finds the field an input key is decoded into. It accepts the key forms
+// the decoder reads: "key", "key[]", "key[0]" and "key[name]" for collections,
+// "key.name" for maps and nested structs, and "key" alone for JSON objects and arrays,
+// which are also submitted whole.
+func matchInputKey(plan *structPlan, key string) (fieldPlan, bool) {
+	for _, f := range plan.fields {
+		if key == f.key {
+			return f, true
+		}
+		rest := strings.TrimPrefix(key, f.key)
+		if len(rest) == len(key) {
+			continue
+		}
+		switch {
+		case f.nested != nil:
+			if strings.HasPrefix(rest, ".") {
+				if leaf, ok := matchInputKey(plan.nested(f), rest[1:]); ok {
+					return leaf, true
+				}
+			}
+		case f.isMap:
+			if strings.HasPrefix(rest, ".") || strings.HasPrefix(rest, "[") && strings.HasSuffix(rest, "]") {
+				return f, true
+			}
+		case f.collection:
+			if rest == "[]" {
+				return f, true
+			}
+			if strings.HasPrefix(rest, "[") && strings.HasSuffix(rest, "]") {
+				if index, err := strconv.Atoi(rest[1 : len(rest)-1]); err == nil && index >= 0 {
+					return f, true
+				}
+			}
+		}
+	}
+	return fieldPlan{}, false
+}
@@ -0,0 +1,180 @@
+package form
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type decoderForm struct {
+	Name    string            `form:"name" json:"full_name,omitempty" binding:"required" validate:"required,min=2"`
+	Tags    []string          `form:"tags" json:"tags"`
+	Attrs   map[string]string `form:"attrs" json:"attrs"`
+	Address decoderAddress    `form:"address" json:"address"`
+	Secret  string            `form:"-" json:"-"`
+}
+
+type decoderAddress struct {
+	Country  string `form:"country" json:"country" validate:"required" binding:"required"`
+	PostCode string `form:"post_code" json:"post_code" validate:"required" binding:"required"`
+}
+
+func TestDecoderBodyLimit(t *testing.T) {
+	d := NewDecoder(DecoderOptions{MaxBodyBytes: 16})
+
+	// A body of exactly the limit is accepted
+	body := "name=" + strings.Repeat("a", 11)
+	var f decoderForm
+	errs := d.DecodeAndValidate(context.Background(), newBodyRequest(body, "application/x-www-form-urlencoded"), &f)
+	if _, failed := errs[FormErrorKey]; failed || f.Name != strings.Repeat("a", 11) {
+		t.Errorf("Expected a body at the limit to decode, got %v", errs)
+	}
+
+	expected := ValidationErrors{FormErrorKey: {ErrBodyTooLarge}}
+	errs = d.DecodeAndValidate(context.Background(), newBodyRequest(body+"a", "application/x-www-form-urlencoded"), &f)
+	if !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected %v for a urlencoded body, got %v", expected, errs)
+	}
+
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+	writer.WriteField("name", strings.Repeat("a", 64))
+	writer.Close()
+	errs = d.DecodeAndValidate(context.Background(), newBodyRequest(buf.String(), writer.FormDataContentType()), &f)
+	if !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected %v for a multipart body, got %v", expected, errs)
+	}
+
+	errs = d.DecodeAndValidateJSON(context.Background(), strings.NewReader(`{"name":"`+strings.Repeat("a", 32)+`"}`), &f)
+	if !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected %v for a JSON body, got %v", expected, errs)
+	}
+}
+
+func TestDecoderFieldLimits(t *testing.T) {
+	d := NewDecoder(DecoderOptions{MaxFields: 2, MaxValueLength: 5})
+
+	var f decoderForm
+	errs := d.DecodeAndValidate(context.Background(), newValuesRequest(url.Values{"a": {"1"}, "b": {"2"}, "c": {"3"}}), &f)
+	if !reflect.DeepEqual(errs, ValidationErrors{FormErrorKey: {ErrTooManyFields}}) {
+		t.Errorf("Expected too many fields, got %v", errs)
+	}
+
+	errs = d.DecodeAndValidate(context.Background(), newValuesRequest(url.Values{"name": {"abcdef"}, "tags": {"ok", "toolong"}}), &f)
+	expected := ValidationErrors{
+		"name": {"Must be no more than 5 bytes"},
+		"tags": {"Must be no more than 5 bytes"},
+	}
+	if !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected %v, got %v", expected, errs)
+	}
+	if f.Name != "" {
+		t.Errorf("Expected nothing to be bound when limits are exceeded, got %+v", f)
+	}
+}
+
+func TestDecoderStrict(t *testing.T) {
+	d := NewDecoder(DecoderOptions{Strict: true})
+
+	values := url.Values{
+		"name":              {"Ada"},
+		"tags":              {"a", "b"},
+		"tags[2]":           {"c"},
+		"attrs[color]":      {"red"},
+		"address.country":   {"NL"},
+		"address.post_code": {"1011", "1012"},
+		"address.city":      {"Amsterdam"},
+		"tags[x]":           {"d"},
+		"admin":             {"true"},
+		"secret":            {"x"},
+	}
+	var f decoderForm
+	errs := d.DecodeAndValidate(context.Background(), newValuesRequest(values), &f)
+
+	expected := ValidationErrors{
+		"address.post_code": {ErrDuplicateField},
+		"address.city":      {ErrUnknownField},
+		"tags[x]":           {ErrUnknownField},
+		"admin":             {ErrUnknownField},
+		"secret":            {ErrUnknownField},
+	}
+	if !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected %v, got %v", expected, errs)
+	}
+
+	// Without strict mode the extra input is ignored
+	errs = DecodeAndValidate(newValuesRequest(url.Values{"name": {"Ada", "Bob"}, "admin": {"true"}, "address.country": {"NL"}, "address.post_code": {"1011"}}), &f)
+	if len(errs) > 0 || f.Name != "Ada" {
+		t.Errorf("Expected lenient decoding, got %v and %+v", errs, f)
+	}
+
+	// JSON objects and arrays are also submitted whole under their own key
+	body := `{"name":"Ada","tags":["a"],"attrs":{"k":"v"},"address":{"country":"NL","post_code":"1011"}}`
+	if errs := d.DecodeAndValidateJSON(context.Background(), strings.NewReader(body), &f); len(errs) > 0 {
+		t.Errorf("Expected strict JSON decoding to accept nested input, got %v", errs)
+	}
+}
+
+func TestDecoderTagNames(t *testing.T) {
+	d := NewDecoder(DecoderOptions{FormTag: "json", ValidateTag: "binding"})
+
+	var f decoderForm
+	errs := d.DecodeAndValidate(context.Background(), newValuesRequest(url.Values{"full_name": {"A"}, "address.country": {"NL"}}), &f)
+	expected := ValidationErrors{
+		"address.post_code": {ErrFieldRequired},
+	}
+	if !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected binding rules with json keys, got %v", errs)
+	}
+	if f.Name != "A" || f.Address.Country != "NL" {
+		t.Errorf("Expected fields to be bound by json keys, got %+v", f)
+	}
+
+	// JSONTag only changes the keys of JSON bodies
+	d = NewDecoder(DecoderOptions{JSONTag: "json"})
+	f = decoderForm{}
+	errs = d.DecodeAndValidateJSON(context.Background(), strings.NewReader(`{"full_name":"Ada","address":{"country":"NL","post_code":"1011"}}`), &f)
+	if len(errs) > 0 || f.Name != "Ada" {
+		t.Errorf("Expected JSON keys from json tags, got %v and %+v", errs, f)
+	}
+	f = decoderForm{}
+	d.DecodeAndValidate(context.Background(), newValuesRequest(url.Values{"name": {"Ada"}}), &f)
+	if f.Name != "Ada" {
+		t.Errorf("Expected form keys from form tags, got %+v", f)
+	}
+}
+
+func TestDecoderValidationMiddleware(t *testing.T) {
+	d := NewDecoder(DecoderOptions{Strict: true})
+	handler := d.ValidationMiddleware(decoderForm{}, JSONValidationErrorHandler)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f := ValidatedFormFromContext(r.Context()).(*decoderForm)
+		io.WriteString(w, f.Name)
+	}))
+
+	req := newBodyRequest(`{"name":"Ada","address":{"country":"NL","post_code":"1011"}}`, "application/json; charset=utf-8")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	if w.Code != http.StatusOK || w.Body.String() != "Ada" {
+		t.Errorf("Expected JSON body to be decoded, got %d %s", w.Code, w.Body)
+	}
+
+	req = newValuesRequest(url.Values{"name": {"Ada"}, "admin": {"1"}})
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	if w.Code != http.StatusUnprocessableEntity || !strings.Contains(w.Body.String(), `"field":"admin","error":"Unknown field"`) {
+		t.Errorf("Expected strict mode to reject unknown keys, got %d %s", w.Code, w.Body)
+	}
+}
+
+func newBodyRequest(body, contentType string) *http.Request {
+	req := httptest.NewRequest("POST", "/", strings.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	return req
+}
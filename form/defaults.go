@@ -0,0 +1,131 @@
+package form
+
+import (
+	"crypto/rand"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultProvider returns a dynamic default value for a `default:"@name"` tag.
+type DefaultProvider func() string
+
+// defaultProviders holds the providers available to default tags, by name without the "@"
+var defaultProviders = map[string]DefaultProvider{
+	"now": func() string {
+		return now().UTC().Format(time.RFC3339Nano)
+	},
+	"today": func() string {
+		return now().UTC().Format(time.DateOnly)
+	},
+	"uuid": newUUID,
+}
+
+// RegisterDefaultProvider registers a provider for dynamic defaults. Fields refer to it
+// with an "@" before its name, as in `default:"@tenant"`. Providers run once per
+// decoded field whose key is absent from the input. Register providers at startup:
+// forms naming a provider that isn't registered fail with a "_struct" error.
+//
+// Example:
+//
+//	form.RegisterDefaultProvider("year", func() string {
+//	    return strconv.Itoa(time.Now().Year())
+//	})
+func RegisterDefaultProvider(name string, provider DefaultProvider) {
+	defaultProviders[name] = provider
+}
+
+// resolveDefault returns the value of a default tag. "@name" calls the named provider
+// and "@@" escapes a literal leading "@". When the field declares a datetime= layout or
+// tz= zone, a default that parses as a time is formatted in that layout, so that
+// `default:"@now"` works for fields of any layout.
+func resolveDefault(defaultTag, validateTag string) string {
+	value := defaultTag
+	switch {
+	case strings.HasPrefix(defaultTag, "@@"):
+		value = defaultTag[1:]
+	case strings.HasPrefix(defaultTag, "@"):
+		provider, exists := defaultProviders[defaultTag[1:]]
+		if !exists {
+			return ""
+		}
+		value = provider()
+	}
+
+	if settings := timeSettingsFor(validateTag); settings.layout != "" {
+		if t, ok := (timeSettings{location: settings.location}).parse(value); ok {
+			value = settings.format(t)
+		}
+	}
+	return value
+}
+
+// splitDefault splits the default of a slice field into its comma-separated elements
+func splitDefault(value string) []string {
+	if value == "" {
+		return nil
+	}
+	elements := strings.Split(value, ",")
+	for i, element := range elements {
+		elements[i] = strings.TrimSpace(element)
+	}
+	return elements
+}
+
+// hasCollectionInput reports whether formData holds any element of the collection at key
+func hasCollectionInput(formData map[string][]string, key string) bool {
+	if _, ok := formData[key]; ok {
+		return true
+	}
+	return hasInputWithPrefix(formData, key+"[")
+}
+
+// newUUID returns a random RFC 4122 version 4 UUID
+func newUUID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return ""
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// hasOmitEmpty reports whether a list of rules contains omitempty
+func hasOmitEmpty(rules []string) bool {
+	for _, rule := range rules {
+		if strings.TrimSpace(rule) == "omitempty" {
+			return true
+		}
+	}
+	return false
+}
+
+// hasRequired reports whether rules contain required
+func hasRequired(rules []string) bool {
+	for _, rule := range rules {
+		if strings.TrimSpace(rule) == "required" {
+			return true
+		}
+	}
+	return false
+}
+
+// isEmptyValue reports whether a field value counts as empty for omitempty: blank input,
+// or the zero value of a numeric or boolean field
+func isEmptyValue(value string, kind reflect.Kind) bool {
+	if value == "" {
+		return true
+	}
+	switch {
+	case isNumericType(kind):
+		n, err := strconv.ParseFloat(value, 64)
+		return err == nil && n == 0
+	case kind == reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		return err == nil && !b
+	}
+	return false
+}
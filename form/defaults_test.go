@@ -0,0 +1,134 @@
+package form
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+)
+
+type defaultsForm struct {
+	Role     string    `form:"role" default:"member" validate:"required,alpha"`
+	Page     int       `form:"page" default:"1" validate:"min=1"`
+	Tags     []string  `form:"tags" default:"news, sport"`
+	Token    string    `form:"token" default:"@uuid"`
+	Day      string    `form:"day" default:"@now" validate:"datetime=date"`
+	Created  time.Time `form:"created" default:"@now"`
+	Handle   string    `form:"handle" default:"@@home"`
+	Email    string    `form:"email" validate:"omitempty,email"`
+	Nickname string    `form:"nickname" validate:"omitempty,min=3"`
+	Limit    int       `form:"limit" validate:"omitempty,min=10"`
+	Labels   []string  `form:"labels" validate:"omitempty,min=2"`
+}
+
+func TestDefaultsForAbsentKeys(t *testing.T) {
+	at := time.Date(2026, 3, 14, 9, 26, 53, 0, time.UTC)
+	fixNow(t, at)
+
+	var f defaultsForm
+	if errs := DecodeAndValidate(newValuesRequest(url.Values{}), &f); len(errs) > 0 {
+		t.Fatalf("Expected no validation errors, got: %v", errs)
+	}
+
+	if f.Role != "member" || f.Page != 1 || f.Handle != "@home" {
+		t.Errorf("Expected literal defaults, got %+v", f)
+	}
+	if !reflect.DeepEqual(f.Tags, []string{"news", "sport"}) {
+		t.Errorf("Expected slice default, got %v", f.Tags)
+	}
+	if !regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`).MatchString(f.Token) {
+		t.Errorf("Expected a version 4 UUID, got %q", f.Token)
+	}
+	if f.Day != "2026-03-14" {
+		t.Errorf("Expected @now in the field's layout, got %q", f.Day)
+	}
+	if !f.Created.Equal(at) {
+		t.Errorf("Expected @now for a time field, got %v", f.Created)
+	}
+}
+
+func TestDefaultsKeepPresentInput(t *testing.T) {
+	values := url.Values{
+		"role":    {""},
+		"page":    {"4"},
+		"tags[0]": {"tech"},
+		"token":   {"abc"},
+	}
+
+	var f defaultsForm
+	errs := DecodeAndValidate(newValuesRequest(values), &f)
+
+	// A submitted blank value is not absent, so the default doesn't apply
+	if !reflect.DeepEqual(errs, ValidationErrors{"role": {ErrFieldRequired}}) {
+		t.Errorf("Expected only role to fail, got %v", errs)
+	}
+	if f.Page != 4 || f.Token != "abc" || !reflect.DeepEqual(f.Tags, []string{"tech"}) {
+		t.Errorf("Expected input to win over defaults, got %+v", f)
+	}
+}
+
+func TestRegisterDefaultProvider(t *testing.T) {
+	calls := 0
+	RegisterDefaultProvider("tenant", func() string {
+		calls++
+		return "acme"
+	})
+	defer delete(defaultProviders, "tenant")
+
+	type tenantForm struct {
+		Tenant string `form:"tenant" default:"@tenant"`
+	}
+
+	var f tenantForm
+	DecodeAndValidate(newValuesRequest(url.Values{}), &f)
+	if f.Tenant != "acme" {
+		t.Errorf("Expected provider default, got %+v", f)
+	}
+
+	DecodeAndValidate(newValuesRequest(url.Values{"tenant": {"other"}}), &f)
+	if calls != 1 {
+		t.Errorf("Expected the provider to run only for absent keys, ran %d times", calls)
+	}
+}
+
+func TestUnknownDefaultProvider(t *testing.T) {
+	type typoForm struct {
+		Created string `form:"created" default:"@nwo"`
+		Handle  string `form:"handle" default:"@@nwo"`
+	}
+
+	var f typoForm
+	errs := DecodeAndValidate(newValuesRequest(url.Values{}), &f)
+	expected := ValidationErrors{"_struct": {`created: default refers to unknown provider "@nwo"`}}
+	if !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected %v, got %v", expected, errs)
+	}
+}
+
+func TestOmitEmpty(t *testing.T) {
+	values := url.Values{"role": {"admin"}, "email": {""}, "nickname": {""}, "limit": {"0"}}
+
+	var f defaultsForm
+	if errs := DecodeAndValidate(newValuesRequest(values), &f); len(errs) > 0 {
+		t.Errorf("Expected empty optional fields to skip their rules, got: %v", errs)
+	}
+
+	values = url.Values{"email": {"nope"}, "nickname": {"ab"}, "limit": {"5"}, "labels": {"a"}}
+	expected := ValidationErrors{
+		"email":    {ErrInvalidEmail},
+		"nickname": {"Must be at least 3 characters long"},
+		"limit":    {"Must be at least 10"},
+		"labels":   {"Must contain at least 2 items"},
+	}
+	if errs := DecodeAndValidate(newValuesRequest(values), &f); !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected %v, got %v", expected, errs)
+	}
+
+	// ValidateStruct treats zero numbers as empty too
+	s := defaultsForm{Role: "member", Page: 1}
+	if errs := ValidateStruct(context.Background(), &s); len(errs) > 0 {
+		t.Errorf("Expected zero values to skip omitempty rules, got: %v", errs)
+	}
+}
@@ -0,0 +1,220 @@
+package form
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Dialect selects the syntax of validate tags read by a Decoder.
+type Dialect int
+
+const (
+	// DialectGokit reads validate tags in this package's syntax. It is the default.
+	DialectGokit Dialect = iota
+	// DialectPlayground reads validate tags written for github.com/go-playground/validator,
+	// such as `validate:"required,gte=1,oneof=red green"`, and maps them onto this
+	// package's rules. Rules without an equivalent are skipped, and listed by
+	// Decoder.UnsupportedRules along with required rules on numbers and bools, which
+	// accept a submitted 0 or false here.
+	DialectPlayground
+)
+
+// playgroundRenames maps go-playground rule names to rules of this package with the
+// same meaning and parameter
+var playgroundRenames = map[string]string{
+	"alphanum":     "alphanumeric",
+	"alphaunicode": "alpha",
+	"number":       "numeric",
+	"gte":          "min",
+	"lte":          "max",
+	"eqcsfield":    "eqfield",
+	"necsfield":    "nefield",
+	"gtcsfield":    "gtfield",
+	"gtecsfield":   "gtefield",
+	"ltcsfield":    "ltfield",
+	"ltecsfield":   "ltefield",
+
+	"postcode_iso3166_alpha2":       "postal_code",
+	"postcode_iso3166_alpha2_field": "postal_code",
+}
+
+// translatePlayground rewrites a go-playground validate tag for a field of type typ into
+// this package's syntax. It returns the rewritten tag and the rules it had to drop or
+// change the meaning of.
+func translatePlayground(tag string, typ reflect.Type) (string, []string) {
+	if tag == "-" {
+		return "", nil
+	}
+
+	// Rules before dive apply to a collection's length, later ones to its elements
+	kind := typ.Kind()
+	countRules := isCollectionType(typ)
+	if countRules {
+		kind = typ.Elem().Kind()
+	}
+
+	var rules, unsupported []string
+	for _, rule := range strings.Split(tag, ",") {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+		name, param, _ := strings.Cut(rule, "=")
+		translated := ""
+		switch renamed, ok := playgroundRenames[name]; {
+		case ok && (param != "" || name != "gte" && name != "lte"):
+			// gte and lte without a parameter compare times with now, which has no equivalent
+			translated = joinRule(renamed, param)
+		case ok:
+		case strings.Contains(rule, "|"):
+			// Alternatives such as "rgb|rgba" have no equivalent
+		case name == "dive":
+			countRules = false
+			translated = rule
+		case name == "keys" || name == "endkeys":
+			translated = rule
+		case name == "len" && param != "":
+			translated = "min=" + param + ",max=" + param
+		case name == "gt" || name == "lt":
+			// Exclusive bounds become inclusive ones for whole-number comparisons:
+			// integer values, string lengths and collection sizes
+			n, err := strconv.Atoi(param)
+			if err != nil || !countRules && !isIntegerKind(kind) && kind != reflect.String {
+				break
+			}
+			if name == "gt" {
+				translated = "min=" + strconv.Itoa(n+1)
+			} else {
+				translated = "max=" + strconv.Itoa(n-1)
+			}
+		case name == "oneof" && param != "":
+			translated = "oneof=" + playgroundList(param)
+		case name == "required_with" || name == "required_without":
+			if param != "" {
+				translated = name + "=" + playgroundList(param)
+			}
+		case name == "required_if" || name == "required_unless":
+			// Only a single field and value pair has an equivalent
+			if args := strings.Fields(param); len(args) == 2 {
+				translated = name + "=" + args[0] + ":" + args[1]
+			}
+		case isKnownRule(name):
+			translated = rule
+		}
+
+		if translated == "" {
+			unsupported = append(unsupported, rule)
+			continue
+		}
+		if strings.HasPrefix(name, "required") && !countRules && (isNumericType(kind) || kind == reflect.Bool) {
+			// go-playground requires numbers and bools to be non-zero; here submitted
+			// zeros are present. The rule is kept, but reported as changed.
+			unsupported = append(unsupported, rule+" (0 and false count as present)")
+		}
+		rules = append(rules, translated)
+	}
+	return strings.Join(rules, ","), unsupported
+}
+
+// joinRule formats a rule with an optional parameter
+func joinRule(name, param string) string {
+	if param == "" {
+		return name
+	}
+	return name + "=" + param
+}
+
+// playgroundList converts a space-separated go-playground list, in which values may be
+// single-quoted to contain spaces, into a colon-separated parameter for SplitParam
+func playgroundList(param string) string {
+	var items []string
+	for param = strings.TrimSpace(param); param != ""; param = strings.TrimSpace(param) {
+		var item string
+		if param[0] == '\'' {
+			if end := strings.IndexByte(param[1:], '\''); end >= 0 {
+				item, param = param[1:end+1], param[end+2:]
+			} else {
+				item, param = param[1:], ""
+			}
+		} else if end := strings.IndexByte(param, ' '); end >= 0 {
+			item, param = param[:end], param[end:]
+		} else {
+			item, param = param, ""
+		}
+		items = append(items, strings.NewReplacer(`\`, `\\`, ":", `\:`).Replace(item))
+	}
+	return strings.Join(items, ":")
+}
+
+// isIntegerKind reports whether kind is a signed or unsigned integer kind
+func isIntegerKind(kind reflect.Kind) bool {
+	return kind >= reflect.Int && kind <= reflect.Uint64
+}
+
+// isKnownRule reports whether name is a built-in or registered rule of this package
+func isKnownRule(name string) bool {
+	if name == "omitempty" || name == "unique" {
+		return true
+	}
+	if _, ok := registry.contextValidators[name]; ok {
+		return true
+	}
+	if _, ok := registry.validators[name]; ok {
+		return true
+	}
+	_, ok := builtinValidators[name]
+	return ok || builtinContextRules[name]
+}
+
+// builtinContextRules holds the names of builtinContextValidators. Plans can't read that
+// map directly because its validators resolve fields through plans.
+var builtinContextRules = make(map[string]bool)
+
+func init() {
+	for name := range builtinContextValidators {
+		builtinContextRules[name] = true
+	}
+}
+
+// UnsupportedRules lists the rules in the validate tags of v, a struct or pointer to one,
+// that the decoder's dialect can't express, as "key: rule" in field order. Those rules are
+// skipped when validating, so check the list during migration, for example in a test.
+// Rules enforced with a different meaning are listed with a note, as in
+// "age: required (0 and false count as present)".
+// It is always empty for DialectGokit.
+//
+// Example:
+//
+//	decoder := form.NewDecoder(form.DecoderOptions{Dialect: form.DialectPlayground})
+//	for _, problem := range decoder.UnsupportedRules(SignUpForm{}) {
+//	    log.Printf("not enforced: %s", problem)
+//	}
+func (d *Decoder) UnsupportedRules(v interface{}) []string {
+	typ := reflect.TypeOf(v)
+	for typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var problems []string
+	var walk func(plan *structPlan, prefix string, onPath map[reflect.Type]bool)
+	walk = func(plan *structPlan, prefix string, onPath map[reflect.Type]bool) {
+		for _, f := range plan.fields {
+			key := prefix + f.key
+			for _, rule := range f.unsupported {
+				problems = append(problems, fmt.Sprintf("%s: %s", key, rule))
+			}
+			if f.nested != nil && !onPath[f.nested] {
+				onPath[f.nested] = true
+				walk(plan.nested(f), key+".", onPath)
+				delete(onPath, f.nested)
+			}
+		}
+	}
+	walk(planWithTags(typ, d.tags), "", map[reflect.Type]bool{typ: true})
+	return problems
+}
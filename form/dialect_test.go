@@ -0,0 +1,143 @@
+package form
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTranslatePlayground(t *testing.T) {
+	testCases := []struct {
+		name        string
+		tag         string
+		typ         reflect.Type
+		expected    string
+		unsupported []string
+	}{
+		{"renames", "required,alphanum,gte=3,lte=20", reflect.TypeOf(""), "required,alphanumeric,min=3,max=20", nil},
+		{"exclusive int bounds", "gt=0,lt=100", reflect.TypeOf(0), "min=1,max=99", nil},
+		{"exclusive string bounds", "gt=2", reflect.TypeOf(""), "min=3", nil},
+		{"exclusive float bounds", "gt=0", reflect.TypeOf(0.0), "", []string{"gt=0"}},
+		{"len", "len=5", reflect.TypeOf(""), "min=5,max=5", nil},
+		{"oneof", "oneof=red green 'dark blue'", reflect.TypeOf(""), "oneof=red:green:dark blue", nil},
+		{"oneof colon", "oneof=a:b c", reflect.TypeOf(""), `oneof=a\:b:c`, nil},
+		{"omitempty", "omitempty,email", reflect.TypeOf(""), "omitempty,email", nil},
+		{"required_with", "required_with=Street City", reflect.TypeOf(""), "required_with=Street:City", nil},
+		{"required_if", "required_if=Kind business", reflect.TypeOf(""), "required_if=Kind:business", nil},
+		{"required_if pairs", "required_if=Kind business Size large", reflect.TypeOf(""), "", []string{"required_if=Kind business Size large"}},
+		{"cross-struct fields", "eqcsfield=Password", reflect.TypeOf(""), "eqfield=Password", nil},
+		{"postal codes", "postcode_iso3166_alpha2=DE,postcode_iso3166_alpha2_field=Country", reflect.TypeOf(""), "postal_code=DE,postal_code=Country", nil},
+		{"dive", "gt=0,lt=4,dive,gte=1", reflect.TypeOf([]int{}), "min=1,max=3,dive,min=1", nil},
+		{"dive strings", "dive,gt=1", reflect.TypeOf([]float64{}), "dive", []string{"gt=1"}},
+		{"map keys", "dive,keys,alpha,endkeys,required", reflect.TypeOf(map[string]string{}), "dive,keys,alpha,endkeys,required", nil},
+		{"time comparisons", "gte", reflect.TypeOf(""), "", []string{"gte"}},
+		{"alternatives", "required,hexcolor|rgb", reflect.TypeOf(""), "required", []string{"hexcolor|rgb"}},
+		{"unknown", "required,uuid4,e164", reflect.TypeOf(""), "required", []string{"uuid4", "e164"}},
+		{"skip", "-", reflect.TypeOf(""), "", nil},
+		{"required number", "required,gte=1", reflect.TypeOf(0), "required,min=1", []string{"required (0 and false count as present)"}},
+		{"required bool", "required", reflect.TypeOf(false), "required", []string{"required (0 and false count as present)"}},
+		{"required elements", "required,dive,required_with=Kind", reflect.TypeOf([]float64{}), "required,dive,required_with=Kind", []string{"required_with=Kind (0 and false count as present)"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			translated, unsupported := translatePlayground(tc.tag, tc.typ)
+			if translated != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, translated)
+			}
+			if !reflect.DeepEqual(unsupported, tc.unsupported) {
+				t.Errorf("Expected unsupported %q, got %q", tc.unsupported, unsupported)
+			}
+		})
+	}
+}
+
+type playgroundForm struct {
+	Name    string            `form:"name" validate:"required,gte=2,lte=10"`
+	Age     int               `form:"age" validate:"gt=17,lt=130"`
+	Color   string            `form:"color" validate:"omitempty,oneof=red green 'dark blue'"`
+	Tags    []string          `form:"tags" validate:"lte=2,dive,alphanum"`
+	Street  string            `form:"street"`
+	Zip     string            `form:"zip" validate:"required_with=Street,uuid4"`
+	Address playgroundAddress `form:"address"`
+}
+
+type playgroundAddress struct {
+	Phone string `form:"phone" validate:"required,e164"`
+}
+
+func TestDecoderPlaygroundDialect(t *testing.T) {
+	d := NewDecoder(DecoderOptions{Dialect: DialectPlayground})
+	decode := func(values url.Values) ValidationErrors {
+		var f playgroundForm
+		req := newBodyRequest(values.Encode(), "application/x-www-form-urlencoded")
+		return d.DecodeAndValidate(context.Background(), req, &f)
+	}
+
+	errs := decode(url.Values{
+		"name":          {"Ada"},
+		"age":           {"18"},
+		"color":         {"dark blue"},
+		"tags":          {"go", "forms"},
+		"address.phone": {"+15550100"},
+	})
+	if len(errs) != 0 {
+		t.Errorf("Expected no errors, got %v", errs)
+	}
+
+	errs = decode(url.Values{
+		"name":   {"A"},
+		"age":    {"17"},
+		"color":  {"purple"},
+		"tags":   {"go", "forms", "web!"},
+		"street": {"Main St"},
+	})
+	expected := ValidationErrors{
+		"name":          {"Must be at least 2 characters long"},
+		"age":           {"Must be at least 18"},
+		"color":         {"Must be one of: red, green, dark blue"},
+		"tags":          {"Must contain no more than 2 items"},
+		"tags[2]":       {"Must contain only letters and numbers"},
+		"zip":           {ErrFieldRequired},
+		"address.phone": {ErrFieldRequired},
+	}
+	if !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected %v, got %v", expected, errs)
+	}
+
+	// The default dialect doesn't know gte, so it doesn't enforce it
+	var f playgroundForm
+	req := newBodyRequest("name=A&age=18", "application/x-www-form-urlencoded")
+	if errs := DecodeAndValidate(req, &f); len(errs["name"]) != 0 {
+		t.Errorf("Expected the default dialect to ignore gte, got %v", errs)
+	}
+}
+
+func TestUnsupportedRules(t *testing.T) {
+	d := NewDecoder(DecoderOptions{Dialect: DialectPlayground})
+	expected := []string{"zip: uuid4", "address.phone: e164"}
+	if problems := d.UnsupportedRules(&playgroundForm{}); !reflect.DeepEqual(problems, expected) {
+		t.Errorf("Expected %q, got %q", expected, problems)
+	}
+
+	if problems := defaultDecoder.UnsupportedRules(playgroundForm{}); len(problems) != 0 {
+		t.Errorf("Expected no problems for the default dialect, got %q", problems)
+	}
+	if problems := d.UnsupportedRules("not a struct"); problems != nil {
+		t.Errorf("Expected nil for a non-struct, got %q", problems)
+	}
+
+	// Rules registered later are known to plans compiled afterwards
+	RegisterValidator("playground_e164", func(value string) string {
+		if value != "" && !strings.HasPrefix(value, "+") {
+			return "Must start with +"
+		}
+		return ""
+	})
+	translated, unsupported := translatePlayground("playground_e164", reflect.TypeOf(""))
+	if translated != "playground_e164" || unsupported != nil {
+		t.Errorf("Expected a registered rule to pass through, got %q and %q", translated, unsupported)
+	}
+}
@@ -0,0 +1,175 @@
+package form
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Encode encodes a struct into form values, the inverse of DecodeAndValidate. Keys come
+// from form tags, nested structs use dotted keys such as "billing.country", slices
+// repeat their key, maps use bracketed keys such as "attrs[color]", and time.Time fields
+// use the layout of their datetime= rule. v must be a struct or a non-nil pointer to one.
+//
+// Every exported field is encoded, including zero values, so that defaults don't replace
+// them when the values are decoded again. Nil pointers to structs and empty collections
+// are left out, and so are fields of types the decoder can't bind.
+//
+// Example:
+//
+//	values, err := form.Encode(&SearchForm{Query: "gopher", Page: 2})
+//	if err != nil {
+//	    return err
+//	}
+//	http.Redirect(w, r, "/search?"+values.Encode(), http.StatusSeeOther)
+func Encode(v interface{}) (url.Values, error) {
+	val := reflect.ValueOf(v)
+	for val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return nil, fmt.Errorf("form: cannot encode a nil pointer")
+		}
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("form: cannot encode %T, expected a struct", v)
+	}
+
+	values := make(url.Values)
+	encodeStructFields(val, planFor(val.Type()), "", values, map[reflect.Type]bool{val.Type(): true})
+	return values, nil
+}
+
+// encodeStructFields encodes the fields of one struct level, recursing into nested
+// structs. onPath guards against pointer cycles.
+func encodeStructFields(val reflect.Value, plan *structPlan, prefix string, values url.Values, onPath map[reflect.Type]bool) {
+	for _, f := range plan.fields {
+		if !f.exported {
+			continue
+		}
+		field := val.Field(f.index)
+		key := prefix + f.key
+
+		switch {
+		case f.nested != nil:
+			if f.pointer {
+				if field.IsNil() {
+					continue
+				}
+				field = field.Elem()
+			}
+			if onPath[f.nested] {
+				continue
+			}
+			onPath[f.nested] = true
+			encodeStructFields(field, plan.nested(f), key+".", values, onPath)
+			delete(onPath, f.nested)
+
+		case f.isMap:
+			c := collectionOf(field)
+			for i, k := range c.keys {
+				values.Set(key+"["+k+"]", c.values[i])
+			}
+
+		case f.collection:
+			for _, value := range collectionOf(field).values {
+				values.Add(key, value)
+			}
+
+		case f.isTime:
+			values.Set(key, FormatTime(field.Interface().(time.Time), f.validate))
+
+		case isScalarKind(field.Kind()):
+			values.Set(key, formatScalar(field))
+		}
+	}
+}
+
+// File is a file part for NewMultipartRequest.
+type File struct {
+	// Field is the form key of the file input
+	Field string
+	// Filename is the name reported for the file
+	Filename string
+	// ContentType defaults to application/octet-stream
+	ContentType string
+	// Content is read when the request body is built
+	Content io.Reader
+}
+
+// NewMultipartRequest builds a multipart/form-data request from a struct encoded with
+// Encode and a list of files. The body is built in memory, and fields are written in
+// key order so that the same input always produces the same body apart from the
+// boundary.
+//
+// Example:
+//
+//	req, err := form.NewMultipartRequest("POST", "/profile", &ProfileForm{Name: "Ada"},
+//	    form.File{Field: "avatar", Filename: "ada.png", ContentType: "image/png", Content: bytes.NewReader(png)})
+func NewMultipartRequest(method, target string, v interface{}, files ...File) (*http.Request, error) {
+	values, err := Encode(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, value := range values[key] {
+			if err := writer.WriteField(key, value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, file := range files {
+		contentType := file.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
+			escapeQuotes(file.Field), escapeQuotes(file.Filename)))
+		header.Set("Content-Type", contentType)
+		part, err := writer.CreatePart(header)
+		if err != nil {
+			return nil, err
+		}
+		if file.Content != nil {
+			if _, err := io.Copy(part, file.Content); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(method, target, &body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req, nil
+}
+
+// quoteEscaper escapes quoted Content-Disposition parameters as mime/multipart does
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// escapeQuotes escapes a Content-Disposition parameter value
+func escapeQuotes(s string) string {
+	return quoteEscaper.Replace(s)
+}
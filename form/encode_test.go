@@ -0,0 +1,140 @@
+package form
+
+import (
+	"io"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type encodeItem struct {
+	SKU      string `form:"sku" validate:"required"`
+	Quantity uint8  `form:"qty"`
+}
+
+type encodeForm struct {
+	Name     string             `form:"name" validate:"required"`
+	Page     int                `form:"page" default:"1"`
+	Ratio    float32            `form:"ratio"`
+	Active   bool               `form:"active"`
+	Level    encodeLevel        `form:"level"`
+	Tags     []string           `form:"tags"`
+	Scores   []int              `form:"scores"`
+	Limits   map[string]float64 `form:"limits"`
+	Day      time.Time          `form:"day" validate:"datetime=date,tz=Europe/Amsterdam"`
+	Item     encodeItem         `form:"item"`
+	Gift     *encodeItem        `form:"gift"`
+	Skipped  *encodeItem        `form:"skipped"`
+	Callback func()             `form:"callback"`
+	internal string
+}
+
+// encodeLevel is a named integer type
+type encodeLevel int8
+
+func TestEncode(t *testing.T) {
+	amsterdam, err := time.LoadLocation("Europe/Amsterdam")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	in := encodeForm{
+		Name:     "Ada & co",
+		Ratio:    0.1,
+		Active:   true,
+		Level:    -3,
+		Tags:     []string{"a b", ""},
+		Scores:   []int{3, 1},
+		Limits:   map[string]float64{"cpu": 1.5, "mem": 512},
+		Day:      time.Date(2026, 5, 10, 0, 0, 0, 0, amsterdam),
+		Item:     encodeItem{SKU: "X-1", Quantity: 2},
+		Gift:     &encodeItem{SKU: "G-7"},
+		internal: "secret",
+	}
+
+	values, err := Encode(&in)
+	if err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+	expected := url.Values{
+		"name":        {"Ada & co"},
+		"page":        {"0"},
+		"ratio":       {"0.1"},
+		"active":      {"true"},
+		"level":       {"-3"},
+		"tags":        {"a b", ""},
+		"scores":      {"3", "1"},
+		"limits[cpu]": {"1.5"},
+		"limits[mem]": {"512"},
+		"day":         {"2026-05-10"},
+		"item.sku":    {"X-1"},
+		"item.qty":    {"2"},
+		"gift.sku":    {"G-7"},
+		"gift.qty":    {"0"},
+	}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("Expected %v, got %v", expected, values)
+	}
+
+	// Decoding the encoded values gives back the original struct
+	var out encodeForm
+	if errs := DecodeAndValidate(newValuesRequest(values), &out); len(errs) > 0 {
+		t.Fatalf("Expected no validation errors, got: %v", errs)
+	}
+	in.internal = ""
+	if !out.Day.Equal(in.Day) {
+		t.Errorf("Expected day %v, got %v", in.Day, out.Day)
+	}
+	out.Day = in.Day
+	if !reflect.DeepEqual(out, in) {
+		t.Errorf("Round trip differs:\nin:  %+v\nout: %+v", in, out)
+	}
+}
+
+func TestEncodeRejectsNonStructs(t *testing.T) {
+	var nilForm *encodeForm
+	for _, v := range []interface{}{nil, "text", nilForm, []encodeForm{}} {
+		if _, err := Encode(v); err == nil {
+			t.Errorf("Expected an error encoding %#v", v)
+		}
+	}
+}
+
+func TestNewMultipartRequest(t *testing.T) {
+	in := encodeForm{Name: "Ada", Tags: []string{"x", "y"}, Item: encodeItem{SKU: "X-1"}}
+	req, err := NewMultipartRequest("POST", "/upload", in,
+		File{Field: "avatar", Filename: `ada "1".png`, ContentType: "image/png", Content: strings.NewReader("png-bytes")},
+		File{Field: "notes", Filename: "notes.bin"},
+	)
+	if err != nil {
+		t.Fatalf("NewMultipartRequest failed: %v", err)
+	}
+	if req.Method != "POST" || req.URL.Path != "/upload" {
+		t.Errorf("Expected POST /upload, got %s %s", req.Method, req.URL)
+	}
+
+	var out encodeForm
+	if errs := DecodeAndValidate(req, &out); len(errs) > 0 {
+		t.Fatalf("Expected no validation errors, got: %v", errs)
+	}
+	if out.Name != "Ada" || !reflect.DeepEqual(out.Tags, []string{"x", "y"}) || out.Item.SKU != "X-1" {
+		t.Errorf("Expected fields to be decoded from the multipart body, got %+v", out)
+	}
+
+	avatar := req.MultipartForm.File["avatar"][0]
+	if avatar.Filename != `ada "1".png` || avatar.Header.Get("Content-Type") != "image/png" {
+		t.Errorf("Unexpected avatar header: %v %v", avatar.Filename, avatar.Header)
+	}
+	f, err := avatar.Open()
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer f.Close()
+	if content, _ := io.ReadAll(f); string(content) != "png-bytes" {
+		t.Errorf("Expected file content to be preserved, got %q", content)
+	}
+	if notes := req.MultipartForm.File["notes"][0]; notes.Header.Get("Content-Type") != "application/octet-stream" || notes.Size != 0 {
+		t.Errorf("Expected an empty octet-stream part, got %v", notes.Header)
+	}
+}
@@ -30,19 +30,18 @@
 package form
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"html"
 	"io"
+	"math/big"
 	"net/http"
 	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
-	"time"
 	"unicode"
-
-	"github.com/kdsmith18542/gokit/observability"
 )
 
 // Pre-compiled regular expressions for validators and sanitizers
@@ -96,18 +95,35 @@ type ContextValidator func(value, param string, context ValidationContext) strin
 
 // Sanitizer is a function that sanitizes a value and returns the sanitized version.
 // Sanitizers are applied before validation and can transform the input.
+// Use ParamSanitizer for sanitizers that take a tag parameter or can fail.
 type Sanitizer func(value string) string
 
 // ValidationContext provides access to all form field values for cross-field validation.
 // Use this in custom validators that need to compare or reference other fields.
 type ValidationContext struct {
 	values map[string]string
+	// scopes locates the field being validated in the struct tree, from the root down
+	scopes []scope
+	// time holds the datetime= layout and tz= zone of the field being validated
+	time timeSettings
+	// failures records the rules that fail for metrics, under the key in field
+	failures *ruleFailures
+	field    string
 }
 
 // Get returns the value of a field by name.
 // Returns an empty string if the field is not found.
 // This method handles both form tags and field names by trying multiple variations.
+//
+// In nested structs, names are resolved from the struct holding the field being validated:
+// ".Password" is a field of the same struct, "../StartDate" a field of the enclosing struct
+// and "Billing.Country" a path from the root struct.
 func (c ValidationContext) Get(fieldName string) string {
+	if key, ok := resolveReference(c.scopes, fieldName); ok {
+		return c.values[key]
+	}
+	fieldName = strings.TrimPrefix(fieldName, ".")
+
 	// Try exact match first
 	if value, exists := c.values[fieldName]; exists {
 		return value
@@ -141,6 +157,11 @@ type Registry struct {
 	validators        map[string]Validator
 	contextValidators map[string]ContextValidator
 	sanitizers        map[string]Sanitizer
+	paramSanitizers   map[string]ParamSanitizer
+	pipelines         map[string]string
+	structValidators  map[reflect.Type][]StructValidatorFunc
+	masks             map[string]Mask
+	lengthMode        LengthMode
 }
 
 // Global registry instance
@@ -148,6 +169,10 @@ var registry = &Registry{
 	validators:        make(map[string]Validator),
 	contextValidators: make(map[string]ContextValidator),
 	sanitizers:        make(map[string]Sanitizer),
+	paramSanitizers:   make(map[string]ParamSanitizer),
+	pipelines:         make(map[string]string),
+	structValidators:  make(map[reflect.Type][]StructValidatorFunc),
+	masks:             make(map[string]Mask),
 }
 
 // RegisterValidator registers a custom validator function.
@@ -208,6 +233,9 @@ func RegisterSanitizer(name string, sanitizer Sanitizer) {
 //   - `validate:"rule1,rule2"` - specifies validation rules
 //   - `sanitize:"sanitizer1,sanitizer2"` - specifies sanitization rules
 //
+// After the tag rules, struct-level validators run: the struct's Validate method if it
+// implements StructValidator, then any registered with RegisterStructValidator.
+//
 // Returns a ValidationErrors map. If the map is empty, validation passed.
 func DecodeAndValidate(r *http.Request, v interface{}) ValidationErrors {
 	return DecodeAndValidateWithContext(context.Background(), r, v)
@@ -235,95 +263,7 @@ func DecodeAndValidate(r *http.Request, v interface{}) ValidationErrors {
 //	    // Use validated form
 //	}
 func DecodeAndValidateWithContext(ctx context.Context, r *http.Request, v interface{}) ValidationErrors {
-	start := time.Now()
-	formName := ""
-	if v != nil {
-		formName = reflect.TypeOf(v).Elem().Name()
-	}
-	if obs := getObserver(); obs != nil {
-		obs.OnDecodeStart(ctx, formName)
-	}
-	errors := make(ValidationErrors)
-
-	// Parse form data
-	if err := r.ParseForm(); err != nil {
-		errors["_form"] = []string{"Failed to parse form data"}
-		if obs := getObserver(); obs != nil {
-			obs.OnDecodeEnd(ctx, formName, err)
-		}
-		return errors
-	}
-
-	// Parse multipart form if needed
-	contentType := r.Header.Get("Content-Type")
-	if r.MultipartForm == nil && strings.Contains(contentType, "multipart/form-data") {
-		r.Body = &maxBytesReader{r: r.Body, n: 100 << 20}
-		// #nosec G120 -- request body capped by maxBytesReader wrapper above
-		if err := r.ParseMultipartForm(32 << 20); err != nil {
-			errors["_form"] = []string{"Failed to parse multipart form data"}
-			if obs := getObserver(); obs != nil {
-				obs.OnDecodeEnd(ctx, formName, err)
-			}
-			return errors
-		}
-	}
-
-	val := reflect.ValueOf(v)
-	if val.Kind() != reflect.Ptr || val.IsNil() {
-		errors["_struct"] = []string{"Target must be a non-nil pointer to struct"}
-		if obs := getObserver(); obs != nil {
-			obs.OnDecodeEnd(ctx, formName, nil)
-		}
-		return errors
-	}
-
-	val = val.Elem()
-	if val.Kind() != reflect.Struct {
-		errors["_struct"] = []string{"Target must be a pointer to struct"}
-		if obs := getObserver(); obs != nil {
-			obs.OnDecodeEnd(ctx, formName, nil)
-		}
-		return errors
-	}
-
-	// Convert request data to form-like structure
-	formData := make(map[string][]string)
-	if r.MultipartForm != nil {
-		for key, values := range r.MultipartForm.Value {
-			formData[key] = values
-		}
-	} else {
-		for key, values := range r.Form {
-			formData[key] = values
-		}
-	}
-
-	// First pass: collect all field values and apply sanitizers
-	fieldValues := processFormFields(val, formData)
-
-	if obs := getObserver(); obs != nil {
-		obs.OnDecodeEnd(ctx, formName, nil)
-		obs.OnValidationStart(ctx, formName)
-	}
-
-	// Second pass: validate fields
-	validationErrors := validateFormFields(val, fieldValues)
-
-	handleFormObservability(ctx, formName, validationErrors, start)
-
-	return validationErrors
-}
-
-// applySanitizers applies a chain of sanitizers to a value
-func applySanitizers(value, sanitizeTag string) string {
-	sanitizers := strings.Split(sanitizeTag, ",")
-	for _, sanitizerName := range sanitizers {
-		sanitizerName = strings.TrimSpace(sanitizerName)
-		if sanitizer, exists := registry.sanitizers[sanitizerName]; exists {
-			value = sanitizer(value)
-		}
-	}
-	return value
+	return defaultDecoder.DecodeAndValidate(ctx, r, v)
 }
 
 // validateFieldWithContext validates a field value against validation rules with context
@@ -334,6 +274,12 @@ func validateFieldWithContext(value, validateTag string, context ValidationConte
 	if len(kind) > 0 {
 		fieldKind = kind[0]
 	}
+	context.time = timeSettingsFor(validateTag)
+
+	// omitempty skips every other rule for an empty value
+	if hasOmitEmpty(validators) && isEmptyValue(value, fieldKind) {
+		return nil
+	}
 
 	for _, validatorRule := range validators {
 		validatorRule = strings.TrimSpace(validatorRule)
@@ -347,25 +293,30 @@ func validateFieldWithContext(value, validateTag string, context ValidationConte
 		// Check context validators first (for cross-field validation)
 		if contextValidator, exists := registry.contextValidators[validatorName]; exists {
 			if errorMsg := contextValidator(value, param, context); errorMsg != "" {
-				errors = append(errors, errorMsg)
+				errors = appendUnique(errors, errorMsg)
+				context.failures.add(context.field, validatorName)
 			}
 		} else if validator, exists := registry.validators[validatorName]; exists {
 			if errorMsg := validator(value); errorMsg != "" {
-				errors = append(errors, errorMsg)
+				errors = appendUnique(errors, errorMsg)
+				context.failures.add(context.field, validatorName)
 			}
 		} else if builtinValidator, exists := builtinValidators[validatorName]; exists {
 			if validatorName == "min" || validatorName == "max" {
 				if errorMsg := builtinValidatorWithKind(value, param, fieldKind, validatorName); errorMsg != "" {
-					errors = append(errors, errorMsg)
+					errors = appendUnique(errors, errorMsg)
+					context.failures.add(context.field, validatorName)
 				}
 			} else {
 				if errorMsg := builtinValidator(value, param); errorMsg != "" {
-					errors = append(errors, errorMsg)
+					errors = appendUnique(errors, errorMsg)
+					context.failures.add(context.field, validatorName)
 				}
 			}
 		} else if builtinContextValidator, exists := builtinContextValidators[validatorName]; exists {
 			if errorMsg := builtinContextValidator(value, param, context); errorMsg != "" {
-				errors = append(errors, errorMsg)
+				errors = appendUnique(errors, errorMsg)
+				context.failures.add(context.field, validatorName)
 			}
 		}
 	}
@@ -373,6 +324,17 @@ func validateFieldWithContext(value, validateTag string, context ValidationConte
 	return errors
 }
 
+// appendUnique appends a message unless the field already has it, so that several
+// rules failing for the same reason (such as an unparseable date) report it once
+func appendUnique(errors []string, msg string) []string {
+	for _, existing := range errors {
+		if existing == msg {
+			return errors
+		}
+	}
+	return append(errors, msg)
+}
+
 // isNumericType checks if a reflect.Kind represents a numeric type
 func isNumericType(kind reflect.Kind) bool {
 	return kind == reflect.Int || kind == reflect.Int8 || kind == reflect.Int16 ||
@@ -403,18 +365,18 @@ func validateMin(value, param string, kind reflect.Kind) string {
 	}
 
 	if isNumericType(kind) {
-		num, err := strconv.ParseFloat(value, 64)
-		if err != nil {
+		order, ok := compareNumber(value, param)
+		if !ok {
 			return ErrMustBeNumber
 		}
-		if num < minVal {
+		if order < 0 {
 			return fmt.Sprintf("Must be at least %v", param)
 		}
 		return ""
 	}
 
 	// fallback to string length
-	if len(value) < int(minVal) {
+	if stringLength(value) < int(minVal) {
 		return fmt.Sprintf("Must be at least %d characters long", int(minVal))
 	}
 	return ""
@@ -428,23 +390,44 @@ func validateMax(value, param string, kind reflect.Kind) string {
 	}
 
 	if isNumericType(kind) {
-		num, err := strconv.ParseFloat(value, 64)
-		if err != nil {
+		order, ok := compareNumber(value, param)
+		if !ok {
 			return ErrMustBeNumber
 		}
-		if num > maxVal {
+		if order > 0 {
 			return fmt.Sprintf("Must be no more than %v", param)
 		}
 		return ""
 	}
 
 	// fallback to string length
-	if len(value) > int(maxVal) {
+	if stringLength(value) > int(maxVal) {
 		return fmt.Sprintf("Must be no more than %d characters long", int(maxVal))
 	}
 	return ""
 }
 
+// compareNumber compares a numeric value with a bound, returning -1, 0 or +1.
+// Both are compared exactly where possible, so large integers don't lose precision to float64.
+// It returns false if either is not a number.
+func compareNumber(value, bound string) (int, bool) {
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, false
+	}
+	b, err := strconv.ParseFloat(bound, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	if exactV, ok := new(big.Rat).SetString(value); ok {
+		if exactB, ok := new(big.Rat).SetString(bound); ok {
+			return exactV.Cmp(exactB), true
+		}
+	}
+	return cmp.Compare(v, b), true
+}
+
 // setFieldValue sets a field value based on its type
 func setFieldValue(field reflect.Value, value string) {
 	switch field.Kind() {
@@ -502,7 +485,7 @@ var builtinValidators = map[string]func(value, param string) string{
 		if err != nil {
 			return ""
 		}
-		if len(value) < minVal {
+		if stringLength(value) < minVal {
 			return fmt.Sprintf("Must be at least %d characters long", minVal)
 		}
 		return ""
@@ -515,7 +498,7 @@ var builtinValidators = map[string]func(value, param string) string{
 		if err != nil {
 			return ""
 		}
-		if len(value) > maxVal {
+		if stringLength(value) > maxVal {
 			return fmt.Sprintf("Must be no more than %d characters long", maxVal)
 		}
 		return ""
@@ -549,6 +532,7 @@ var builtinValidators = map[string]func(value, param string) string{
 		}
 		return ""
 	},
+	"no_confusables": noConfusables,
 	"alphanumeric": func(value, param string) string {
 		if value == "" {
 			return ""
@@ -560,6 +544,19 @@ var builtinValidators = map[string]func(value, param string) string{
 		}
 		return ""
 	},
+	"oneof": func(value, param string) string {
+		// oneof=a:b:c requires one of the listed values
+		if value == "" {
+			return ""
+		}
+		options := SplitParam(param, 0)
+		for _, option := range options {
+			if value == option {
+				return ""
+			}
+		}
+		return fmt.Sprintf("Must be one of: %s", strings.Join(options, ", "))
+	},
 }
 
 // builtinContextValidators contains all built-in context-aware validation functions
@@ -612,6 +609,30 @@ var builtinContextValidators = map[string]ContextValidator{
 		}
 		return ""
 	},
+	"required_with": func(value, param string, context ValidationContext) string {
+		// required_with=a:b means this field is required if any of the listed fields is not empty
+		if strings.TrimSpace(value) != "" {
+			return ""
+		}
+		for _, field := range SplitParam(param, 0) {
+			if strings.TrimSpace(context.Get(field)) != "" {
+				return ErrFieldRequired
+			}
+		}
+		return ""
+	},
+	"required_without": func(value, param string, context ValidationContext) string {
+		// required_without=a:b means this field is required if any of the listed fields is empty
+		if strings.TrimSpace(value) != "" {
+			return ""
+		}
+		for _, field := range SplitParam(param, 0) {
+			if strings.TrimSpace(context.Get(field)) == "" {
+				return ErrFieldRequired
+			}
+		}
+		return ""
+	},
 	"eqfield": func(value, param string, context ValidationContext) string {
 		// eqfield=fieldname means this field must equal the specified field
 		if param == "" {
@@ -833,13 +854,13 @@ var builtinSanitizers = map[string]Sanitizer{
 		return htmlTagRegex.ReplaceAllString(value, "")
 	},
 	"normalize_unicode": func(value string) string {
-		// Normalize unicode characters (NFD form)
-		return strings.ToValidUTF8(value, "")
+		// Drop invalid UTF-8 and normalize to NFC; use normalize_unicode=NFKC etc. for other forms
+		return normalizeUnicode(value, "NFC")
 	},
 }
 
 // maxBytesReader is like http.MaxBytesReader but without needing a ResponseWriter.
-// It returns an error when the read limit is exceeded.
+// It returns errBodyTooLarge once the body is longer than n bytes.
 type maxBytesReader struct {
 	r   io.ReadCloser
 	n   int64
@@ -850,18 +871,23 @@ func (l *maxBytesReader) Read(p []byte) (int, error) {
 	if l.err != nil {
 		return 0, l.err
 	}
-	if int64(len(p)) > l.n {
-		p = p[:l.n]
+	if len(p) == 0 {
+		return 0, nil
 	}
-	n, err := l.r.Read(p)
-	l.n -= int64(n)
-	if l.n <= 0 && err == nil {
-		err = fmt.Errorf("http: request body too large")
+	// Read one byte more than allowed to tell a body of exactly n bytes from a longer one
+	if int64(len(p))-1 > l.n {
+		p = p[:l.n+1]
 	}
-	if err != nil {
+	n, err := l.r.Read(p)
+	if int64(n) <= l.n {
+		l.n -= int64(n)
 		l.err = err
+		return n, err
 	}
-	return n, err
+	n = int(l.n)
+	l.n = 0
+	l.err = errBodyTooLarge
+	return n, l.err
 }
 
 func (l *maxBytesReader) Close() error {
@@ -954,6 +980,15 @@ func init() {
 	for name, sanitizer := range builtinSanitizers {
 		RegisterSanitizer(name, sanitizer)
 	}
+	for name, sanitizer := range builtinParamSanitizers {
+		RegisterParamSanitizer(name, sanitizer)
+	}
+	for name, validator := range builtinTimeValidators {
+		RegisterContextValidator(name, validator)
+	}
+	for name, validator := range builtinCountryValidators {
+		RegisterContextValidator(name, validator)
+	}
 
 	RegisterValidator("is_uppercase", func(value string) string {
 		if value == "" {
@@ -975,9 +1010,4 @@ func init() {
 		}
 		return ""
 	})
-
-	// Initialize the default observer to nil, users can set their own
-	// through observability.SetObserver. This avoids a global default
-	// that might not be desired.
-	observability.SetObserver(nil)
 }
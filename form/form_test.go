@@ -380,6 +380,9 @@ func TestBuiltinValidators(t *testing.T) {
 		{"alpha_valid", "abc", "alpha", "", ""},
 		{"alphanumeric_invalid", "abc@123", "alphanumeric", "", "Must contain only letters and numbers"},
 		{"alphanumeric_valid", "abc123", "alphanumeric", "", ""},
+		{"oneof_invalid", "purple", "oneof", "red:green:dark blue", "Must be one of: red, green, dark blue"},
+		{"oneof_valid", "dark blue", "oneof", "red:green:dark blue", ""},
+		{"oneof_empty", "", "oneof", "red:green", ""},
 	}
 
 	for _, tc := range testCases {
@@ -438,7 +441,10 @@ func TestChainedSanitizers(t *testing.T) {
 	input := "  Hello   World@123  "
 	expected := "hello-world-123"
 
-	result := applySanitizers(input, "trim,to_lower,kebab_case")
+	result, err := applySanitizers(input, "trim,to_lower,kebab_case")
+	if err != nil {
+		t.Fatalf("Unexpected sanitizer error: %v", err)
+	}
 	if result != expected {
 		t.Errorf("Expected '%s', got '%s'", expected, result)
 	}
@@ -641,6 +647,61 @@ func TestAdvancedConditionalValidation(t *testing.T) {
 			}),
 			expected: ValidationErrors{},
 		},
+		{
+			name: "required_with validation",
+			form: &struct {
+				Street string `form:"street"`
+				City   string `form:"city"`
+				Zip    string `form:"zip" validate:"required_with=street:city"`
+			}{},
+			request: createRequest(map[string]string{
+				"street": "",
+				"city":   "Springfield",
+				"zip":    "",
+			}),
+			expected: ValidationErrors{
+				"zip": []string{"This field is required"},
+			},
+		},
+		{
+			name: "required_with validation - none present",
+			form: &struct {
+				Street string `form:"street"`
+				Zip    string `form:"zip" validate:"required_with=street"`
+			}{},
+			request: createRequest(map[string]string{
+				"street": "",
+				"zip":    "",
+			}),
+			expected: ValidationErrors{},
+		},
+		{
+			name: "required_without validation",
+			form: &struct {
+				Email string `form:"email" validate:"required_without=phone"`
+				Phone string `form:"phone" validate:"required_without=email"`
+			}{},
+			request: createRequest(map[string]string{
+				"email": "",
+				"phone": "",
+			}),
+			expected: ValidationErrors{
+				"email": []string{"This field is required"},
+				"phone": []string{"This field is required"},
+			},
+		},
+		{
+			name: "required_without validation - one present",
+			form: &struct {
+				Email string `form:"email" validate:"required_without=phone"`
+				Phone string `form:"phone" validate:"required_without=email"`
+			}{},
+			request: createRequest(map[string]string{
+				"email": "",
+				"phone": "555-0100",
+			}),
+			expected: ValidationErrors{},
+		},
 		{
 			name: "eqfield validation - matching",
 			form: &struct {
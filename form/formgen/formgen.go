@@ -0,0 +1,683 @@
+// Package formgen generates reflection-free decoders and validators for structs that
+// use the form package's form, validate and sanitize tags. It backs the
+// `gokit form gen` command.
+//
+// For every named struct type with at least one tagged field, the generator emits a
+// DecodeForm(url.Values) method and a Validate() method, with DecodeFormContext and
+// ValidateContext variants taking a context, which together implement form.GeneratedForm. The form package uses them automatically in place of reflection.
+// The generated code calls the same sanitizers and rules as the reflective decoder, so
+// both paths produce identical results.
+//
+// Example:
+//
+//	//go:generate gokit form gen .
+//	type SignUpForm struct {
+//	    Email string `form:"email" sanitize:"trim,to_lower" validate:"required,email"`
+//	    Age   int    `form:"age" validate:"min=18"`
+//	}
+package formgen
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// OutputFile is the name of the file the generator writes into each package directory.
+const OutputFile = "form_gen.go"
+
+// formImportPath is the import path of the runtime package used by generated code
+const formImportPath = "github.com/kdsmith18542/gokit/form"
+
+// header marks generated files so that tools and reviewers can recognise them
+const header = "// Code generated by gokit form gen. DO NOT EDIT.\n\n"
+
+// GenerateDir generates code for the Go package in dir and writes it to OutputFile.
+// A stale OutputFile is removed if the package no longer has tagged structs.
+// It returns the names of the structs code was generated for.
+func GenerateDir(dir string) ([]string, error) {
+	src, names, err := generate(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(dir, OutputFile)
+	if src == nil {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	// #nosec G306 -- generated Go source is meant to be readable like any other source file
+	if err := os.WriteFile(path, src, 0644); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// Generate returns the generated source for the Go package in dir without writing it.
+// It returns nil if the package has no tagged structs.
+func Generate(dir string) ([]byte, error) {
+	src, _, err := generate(dir)
+	return src, err
+}
+
+// generate loads the package in dir and renders code for its tagged structs
+func generate(dir string) ([]byte, []string, error) {
+	pkg, err := loadPackage(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	g := &generator{pkg: pkg, imports: newImportSet(pkg)}
+	var structs []*structInfo
+	for _, name := range pkg.Scope().Names() {
+		s, err := g.inspect(pkg.Scope().Lookup(name))
+		if err != nil {
+			return nil, nil, err
+		}
+		if s != nil {
+			structs = append(structs, s)
+		}
+	}
+	if len(structs) == 0 {
+		return nil, nil, nil
+	}
+
+	var body bytes.Buffer
+	names := make([]string, len(structs))
+	for i, s := range structs {
+		g.render(&body, s)
+		names[i] = s.name
+	}
+
+	var out bytes.Buffer
+	out.WriteString(header)
+	fmt.Fprintf(&out, "package %s\n\n", pkg.Name())
+	g.imports.render(&out)
+	out.Write(body.Bytes())
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, nil, fmt.Errorf("formatting generated code for %s: %w", dir, err)
+	}
+	return src, names, nil
+}
+
+// loadPackage parses and type-checks the non-test Go files in dir, skipping the
+// generator's own output so that stale generated code can't get in the way
+func loadPackage(dir string) (*types.Package, error) {
+	bp, err := build.ImportDir(dir, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, name := range bp.GoFiles {
+		if name == OutputFile {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+
+	var firstErr error
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error: func(err error) {
+			if firstErr == nil {
+				firstErr = err
+			}
+		},
+	}
+	pkg, _ := conf.Check(bp.ImportPath, fset, files, nil)
+	if pkg == nil {
+		return nil, fmt.Errorf("type-checking %s: %w", dir, firstErr)
+	}
+	return pkg, nil
+}
+
+// scalarKind classifies the field types that the form package binds from a string
+type scalarKind int
+
+const (
+	notScalar scalarKind = iota
+	stringScalar
+	intScalar
+	uintScalar
+	floatScalar
+	boolScalar
+)
+
+// bindKind describes how a field is bound
+type bindKind int
+
+const (
+	bindNone bindKind = iota
+	bindScalar
+	bindSlice
+	bindMap
+	bindTime
+)
+
+// fieldInfo describes one struct field
+type fieldInfo struct {
+	name     string
+	key      string
+	sanitize string
+	validate string
+	def      string
+	bind     bindKind
+	// kind is the reflect.Kind passed to validation: the element kind for collections
+	kind string
+	// typ is the field type; for collections, elem and mapKey are its element and key types
+	typ    types.Type
+	elem   types.Type
+	mapKey types.Type
+}
+
+// structInfo describes one struct type to generate code for
+type structInfo struct {
+	name   string
+	fields []fieldInfo
+}
+
+// generator renders code for one package
+type generator struct {
+	pkg     *types.Package
+	imports *importSet
+}
+
+// inspect returns the struct description for a package-level object, or nil if the
+// object is not a tagged, non-generic struct type without hand-written methods.
+// Structs with nested struct fields or path references, such as eqfield=../Password,
+// are left to the reflective decoder, which resolves references across the struct tree.
+func (g *generator) inspect(obj types.Object) (*structInfo, error) {
+	typeName, ok := obj.(*types.TypeName)
+	if !ok || typeName.IsAlias() {
+		return nil, nil
+	}
+	named, ok := typeName.Type().(*types.Named)
+	if !ok || named.TypeParams().Len() > 0 {
+		return nil, nil
+	}
+	st, ok := named.Underlying().(*types.Struct)
+	if !ok || !hasFormTags(st) || hasNesting(st) {
+		return nil, nil
+	}
+	for i := 0; i < named.NumMethods(); i++ {
+		switch named.Method(i).Name() {
+		case "DecodeForm", "Validate", "DecodeFormContext", "ValidateContext":
+			// The generated methods would clash, as with a StructValidator's Validate
+			return nil, nil
+		}
+	}
+
+	s := &structInfo{name: typeName.Name()}
+	for i := 0; i < st.NumFields(); i++ {
+		field := st.Field(i)
+		tag := reflect.StructTag(st.Tag(i))
+		if basic, ok := field.Type().Underlying().(*types.Basic); ok && basic.Kind() == types.Invalid {
+			return nil, fmt.Errorf("%s.%s: cannot resolve field type", s.name, field.Name())
+		}
+
+		// Like the reflective decoder, read keys up to the first comma and skip "-"
+		key, _, _ := strings.Cut(tag.Get("form"), ",")
+		if key == "-" {
+			continue
+		}
+		info := fieldInfo{
+			name:     field.Name(),
+			key:      key,
+			sanitize: tag.Get("sanitize"),
+			validate: tag.Get("validate"),
+			def:      tag.Get("default"),
+			typ:      field.Type(),
+			kind:     reflectKind(field.Type()),
+		}
+		if info.key == "" {
+			info.key = strings.ToLower(field.Name())
+		}
+
+		// Mirror the reflective decoder: collections are detected for any field,
+		// but only exported fields are assigned
+		switch u := field.Type().Underlying().(type) {
+		case *types.Slice:
+			if kind := scalarOf(u.Elem()); kind != notScalar && !isByte(u.Elem()) {
+				info.bind, info.elem, info.kind = bindSlice, u.Elem(), reflectKind(u.Elem())
+			}
+		case *types.Map:
+			if scalarOf(u.Key()) == stringScalar && scalarOf(u.Elem()) != notScalar {
+				info.bind, info.elem, info.mapKey, info.kind = bindMap, u.Elem(), u.Key(), reflectKind(u.Elem())
+			}
+		default:
+			if isTime(field.Type()) {
+				info.bind = bindTime
+			} else if scalarOf(field.Type()) != notScalar {
+				info.bind = bindScalar
+			}
+		}
+		if !field.Exported() && (info.bind == bindScalar || info.bind == bindTime) {
+			info.bind = bindNone
+		}
+
+		s.fields = append(s.fields, info)
+	}
+	return s, nil
+}
+
+// fieldRefRules lists the rules whose parameter names another field, as in the form package
+var fieldRefRules = map[string]bool{
+	"eqfield": true, "nefield": true, "gtfield": true, "gtefield": true, "ltfield": true, "ltefield": true,
+	"required_if": true, "required_unless": true, "required_with": true, "required_without": true,
+	"date_after": true, "date_before": true, "after": true, "before": true,
+	"postal_code": true, "phone": true, "national_id": true,
+}
+
+// hasNesting reports whether st has exported nested struct fields, or rules that refer to
+// fields by path rather than by sibling name
+func hasNesting(st *types.Struct) bool {
+	for i := 0; i < st.NumFields(); i++ {
+		field := st.Field(i)
+		t := field.Type()
+		if ptr, ok := t.Underlying().(*types.Pointer); ok {
+			t = ptr.Elem()
+		}
+		if _, ok := t.Underlying().(*types.Struct); ok && field.Exported() && !isTime(t) {
+			return true
+		}
+
+		for _, rule := range strings.Split(reflect.StructTag(st.Tag(i)).Get("validate"), ",") {
+			name, param, _ := strings.Cut(strings.TrimSpace(rule), "=")
+			if !fieldRefRules[name] {
+				continue
+			}
+			for _, ref := range strings.Split(param, ":") {
+				if strings.Contains(strings.TrimPrefix(ref, "."), ".") {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// hasFormTags reports whether any field of st has a form, validate, sanitize or default tag
+func hasFormTags(st *types.Struct) bool {
+	for i := 0; i < st.NumFields(); i++ {
+		tag := reflect.StructTag(st.Tag(i))
+		for _, key := range []string{"form", "validate", "sanitize", "default"} {
+			if _, ok := tag.Lookup(key); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isTime reports whether t is time.Time, which is bound with the field's datetime= layout
+func isTime(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+	return named.Obj().Pkg().Path() == "time" && named.Obj().Name() == "Time"
+}
+
+// scalarOf classifies a type by the way setFieldValue binds it
+func scalarOf(t types.Type) scalarKind {
+	basic, ok := t.Underlying().(*types.Basic)
+	if !ok {
+		return notScalar
+	}
+	switch basic.Kind() {
+	case types.String:
+		return stringScalar
+	case types.Int, types.Int8, types.Int16, types.Int32, types.Int64:
+		return intScalar
+	case types.Uint, types.Uint8, types.Uint16, types.Uint32, types.Uint64:
+		return uintScalar
+	case types.Float32, types.Float64:
+		return floatScalar
+	case types.Bool:
+		return boolScalar
+	}
+	return notScalar
+}
+
+// isByte reports whether t is a byte, which the form package doesn't bind as a collection element
+func isByte(t types.Type) bool {
+	basic, ok := t.Underlying().(*types.Basic)
+	return ok && basic.Kind() == types.Uint8
+}
+
+// bitSize returns 32 for float32 and 64 otherwise
+func bitSize(t types.Type) int {
+	if basic, ok := t.Underlying().(*types.Basic); ok && basic.Kind() == types.Float32 {
+		return 32
+	}
+	return 64
+}
+
+// basicKinds maps basic types to the name of the matching reflect.Kind constant
+var basicKinds = map[types.BasicKind]string{
+	types.Bool:          "Bool",
+	types.Int:           "Int",
+	types.Int8:          "Int8",
+	types.Int16:         "Int16",
+	types.Int32:         "Int32",
+	types.Int64:         "Int64",
+	types.Uint:          "Uint",
+	types.Uint8:         "Uint8",
+	types.Uint16:        "Uint16",
+	types.Uint32:        "Uint32",
+	types.Uint64:        "Uint64",
+	types.Uintptr:       "Uintptr",
+	types.Float32:       "Float32",
+	types.Float64:       "Float64",
+	types.Complex64:     "Complex64",
+	types.Complex128:    "Complex128",
+	types.String:        "String",
+	types.UnsafePointer: "UnsafePointer",
+}
+
+// reflectKind returns the name of the reflect.Kind constant for t, without the package qualifier
+func reflectKind(t types.Type) string {
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		if kind, ok := basicKinds[u.Kind()]; ok {
+			return kind
+		}
+	case *types.Struct:
+		return "Struct"
+	case *types.Pointer:
+		return "Pointer"
+	case *types.Slice:
+		return "Slice"
+	case *types.Array:
+		return "Array"
+	case *types.Map:
+		return "Map"
+	case *types.Chan:
+		return "Chan"
+	case *types.Signature:
+		return "Func"
+	case *types.Interface:
+		return "Interface"
+	}
+	return "Invalid"
+}
+
+// typeExpr returns the source expression for t, importing its package if needed
+func (g *generator) typeExpr(t types.Type) string {
+	return types.TypeString(t, func(p *types.Package) string {
+		if p == g.pkg {
+			return ""
+		}
+		return g.imports.add(p.Path(), p.Name())
+	})
+}
+
+// render writes the generated methods for s
+func (g *generator) render(w *bytes.Buffer, s *structInfo) {
+	form := g.imports.add(formImportPath, "form")
+	url := g.imports.add("net/url", "url")
+	reflectPkg := g.imports.add("reflect", "reflect")
+	ctxPkg := g.imports.add("context", "context")
+
+	fmt.Fprintf(w, "var _ %s.GeneratedForm = (*%s)(nil)\n\n", form, s.name)
+
+	fmt.Fprintf(w, "// DecodeForm decodes and validates values into %s without reflection.\n", s.name)
+	fmt.Fprintf(w, "func (f *%s) DecodeForm(values %s.Values) %s.ValidationErrors {\n", s.name, url, form)
+	fmt.Fprintf(w, "return f.DecodeFormContext(%s.Background(), values)\n", ctxPkg)
+	w.WriteString("}\n\n")
+
+	fmt.Fprintf(w, "// DecodeFormContext is DecodeForm, recording failed rules in the validation of ctx.\n")
+	fmt.Fprintf(w, "func (f *%s) DecodeFormContext(ctx %s.Context, values %s.Values) %s.ValidationErrors {\n", s.name, ctxPkg, url, form)
+	fmt.Fprintf(w, "d := %s.NewGenDecoderContext(ctx, values)\n", form)
+	for _, field := range s.fields {
+		target := "f." + field.name
+		if field.def != "" && field.bind != bindMap {
+			fmt.Fprintf(w, "d.Default(%q, %q, %q, %t)\n", field.key, field.def, field.validate, field.bind == bindSlice)
+		}
+		switch field.bind {
+		case bindScalar:
+			fmt.Fprintf(w, "if v, ok := d.Scalar(%q, %q, %q); ok {\n", field.key, field.name, field.sanitize)
+			g.parse(w, target, field.typ, "v")
+			w.WriteString("}\n")
+		case bindTime:
+			fmt.Fprintf(w, "if v, ok := d.Scalar(%q, %q, %q); ok {\n", field.key, field.name, field.sanitize)
+			fmt.Fprintf(w, "if t, ok := %s.ParseTime(v, %q); ok {\n", form, field.validate)
+			fmt.Fprintf(w, "%s = t\n", target)
+			w.WriteString("}\n}\n")
+		case bindSlice:
+			fmt.Fprintf(w, "if vs, ok := d.Slice(%q, %q, %q); ok {\n", field.key, field.name, field.sanitize)
+			if !ast.IsExported(field.name) {
+				w.WriteString("_ = vs\n")
+			} else if types.Identical(field.typ, types.NewSlice(types.Typ[types.String])) {
+				fmt.Fprintf(w, "%s = vs\n", target)
+			} else {
+				fmt.Fprintf(w, "s := make(%s, len(vs))\n", g.typeExpr(field.typ))
+				w.WriteString("for i, v := range vs {\n")
+				g.parse(w, "s[i]", field.elem, "v")
+				w.WriteString("}\n")
+				fmt.Fprintf(w, "%s = s\n", target)
+			}
+			w.WriteString("}\n")
+		case bindMap:
+			fmt.Fprintf(w, "if keys, vs, ok := d.Map(%q, %q, %q); ok {\n", field.key, field.name, field.sanitize)
+			if ast.IsExported(field.name) {
+				fmt.Fprintf(w, "m := make(%s, len(keys))\n", g.typeExpr(field.typ))
+				w.WriteString("for i, k := range keys {\n")
+				key := g.convert(field.mapKey, types.String, "k")
+				if scalarOf(field.elem) == stringScalar {
+					fmt.Fprintf(w, "m[%s] = %s\n", key, g.convert(field.elem, types.String, "vs[i]"))
+				} else {
+					fmt.Fprintf(w, "var e %s\n", g.typeExpr(field.elem))
+					g.parse(w, "e", field.elem, "vs[i]")
+					fmt.Fprintf(w, "m[%s] = e\n", key)
+				}
+				w.WriteString("}\n")
+				fmt.Fprintf(w, "%s = m\n", target)
+			} else {
+				w.WriteString("_, _ = keys, vs\n")
+			}
+			w.WriteString("}\n")
+		default:
+			fmt.Fprintf(w, "d.Scalar(%q, %q, %q)\n", field.key, field.name, field.sanitize)
+		}
+	}
+	g.validate(w, s, reflectPkg)
+	w.WriteString("}\n\n")
+
+	fmt.Fprintf(w, "// Validate validates the current field values of %s without reflection.\n", s.name)
+	fmt.Fprintf(w, "func (f *%s) Validate() %s.ValidationErrors {\n", s.name, form)
+	fmt.Fprintf(w, "return f.ValidateContext(%s.Background())\n", ctxPkg)
+	w.WriteString("}\n\n")
+
+	fmt.Fprintf(w, "// ValidateContext is Validate, recording failed rules in the validation of ctx.\n")
+	fmt.Fprintf(w, "func (f *%s) ValidateContext(ctx %s.Context) %s.ValidationErrors {\n", s.name, ctxPkg, form)
+	fmt.Fprintf(w, "d := %s.NewGenValidator(ctx)\n", form)
+	for _, field := range s.fields {
+		source := "f." + field.name
+		switch {
+		case field.bind == bindScalar:
+			fmt.Fprintf(w, "d.SetScalar(%q, %q, %s)\n", field.key, field.name, g.format(field.typ, source))
+		case field.bind == bindTime:
+			fmt.Fprintf(w, "d.SetScalar(%q, %q, %s.FormatTime(%s, %q))\n", field.key, field.name, form, source, field.validate)
+		case field.bind == bindSlice && ast.IsExported(field.name) && types.Identical(field.typ, types.NewSlice(types.Typ[types.String])):
+			fmt.Fprintf(w, "d.SetSlice(%q, %q, %s)\n", field.key, field.name, source)
+		case field.bind == bindMap && ast.IsExported(field.name) && types.Identical(field.typ, types.NewMap(types.Typ[types.String], types.Typ[types.String])):
+			fmt.Fprintf(w, "d.SetMap(%q, %q, %s)\n", field.key, field.name, source)
+		case field.bind == bindSlice && ast.IsExported(field.name):
+			w.WriteString("{\n")
+			fmt.Fprintf(w, "s := make([]string, len(%s))\n", source)
+			fmt.Fprintf(w, "for i, e := range %s {\n", source)
+			fmt.Fprintf(w, "s[i] = %s\n", g.format(field.elem, "e"))
+			w.WriteString("}\n")
+			fmt.Fprintf(w, "d.SetSlice(%q, %q, s)\n", field.key, field.name)
+			w.WriteString("}\n")
+		case field.bind == bindMap && ast.IsExported(field.name):
+			w.WriteString("{\n")
+			fmt.Fprintf(w, "m := make(map[string]string, len(%s))\n", source)
+			fmt.Fprintf(w, "for k, e := range %s {\n", source)
+			fmt.Fprintf(w, "m[%s] = %s\n", widen(field.mapKey, types.String, "k"), g.format(field.elem, "e"))
+			w.WriteString("}\n")
+			fmt.Fprintf(w, "d.SetMap(%q, %q, m)\n", field.key, field.name)
+			w.WriteString("}\n")
+		case field.bind == bindSlice:
+			fmt.Fprintf(w, "d.SetSlice(%q, %q, nil)\n", field.key, field.name)
+		case field.bind == bindMap:
+			fmt.Fprintf(w, "d.SetMap(%q, %q, nil)\n", field.key, field.name)
+		default:
+			fmt.Fprintf(w, "d.SetScalar(%q, %q, \"\")\n", field.key, field.name)
+		}
+	}
+	g.validate(w, s, reflectPkg)
+	w.WriteString("}\n\n")
+}
+
+// validate writes the validation calls shared by DecodeFormContext and ValidateContext
+func (g *generator) validate(w *bytes.Buffer, s *structInfo, reflectPkg string) {
+	for _, field := range s.fields {
+		fmt.Fprintf(w, "d.Validate(%q, %q, %s.%s)\n", field.key, field.validate, reflectPkg, field.kind)
+	}
+	w.WriteString("return d.Errors()\n")
+}
+
+// convert returns the expression converting src, of the basic type named by from, to type t.
+// The conversion is omitted if src already has type t.
+func (g *generator) convert(t types.Type, from types.BasicKind, src string) string {
+	if basic, ok := t.(*types.Basic); ok && basic.Kind() == from {
+		return src
+	}
+	return fmt.Sprintf("%s(%s)", g.typeExpr(t), src)
+}
+
+// parse writes code assigning the string expression src, parsed as type t, to target.
+// Unparseable input leaves the target unchanged, as setFieldValue does.
+func (g *generator) parse(w *bytes.Buffer, target string, t types.Type, src string) {
+	kind := scalarOf(t)
+	if kind == stringScalar {
+		fmt.Fprintf(w, "%s = %s\n", target, g.convert(t, types.String, src))
+		return
+	}
+
+	strconvPkg := g.imports.add("strconv", "strconv")
+	switch kind {
+	case intScalar:
+		fmt.Fprintf(w, "if %s != \"\" {\nif n, err := %s.ParseInt(%s, 10, 64); err == nil {\n%s = %s\n}\n}\n", src, strconvPkg, src, target, g.convert(t, types.Int64, "n"))
+	case uintScalar:
+		fmt.Fprintf(w, "if %s != \"\" {\nif n, err := %s.ParseUint(%s, 10, 64); err == nil {\n%s = %s\n}\n}\n", src, strconvPkg, src, target, g.convert(t, types.Uint64, "n"))
+	case floatScalar:
+		fmt.Fprintf(w, "if %s != \"\" {\nif n, err := %s.ParseFloat(%s, 64); err == nil {\n%s = %s\n}\n}\n", src, strconvPkg, src, target, g.convert(t, types.Float64, "n"))
+	case boolScalar:
+		fmt.Fprintf(w, "if %s != \"\" {\nif b, err := %s.ParseBool(%s); err == nil {\n%s = %s\n}\n}\n", src, strconvPkg, src, target, g.convert(t, types.Bool, "b"))
+	}
+}
+
+// format returns the expression formatting src, of type t, as a string
+func (g *generator) format(t types.Type, src string) string {
+	kind := scalarOf(t)
+	if kind == stringScalar {
+		return widen(t, types.String, src)
+	}
+
+	strconvPkg := g.imports.add("strconv", "strconv")
+	switch kind {
+	case intScalar:
+		return fmt.Sprintf("%s.FormatInt(%s, 10)", strconvPkg, widen(t, types.Int64, src))
+	case uintScalar:
+		return fmt.Sprintf("%s.FormatUint(%s, 10)", strconvPkg, widen(t, types.Uint64, src))
+	case floatScalar:
+		return fmt.Sprintf("%s.FormatFloat(%s, 'f', -1, %d)", strconvPkg, widen(t, types.Float64, src), bitSize(t))
+	default:
+		return fmt.Sprintf("%s.FormatBool(%s)", strconvPkg, widen(t, types.Bool, src))
+	}
+}
+
+// widen returns src converted to the basic type named by kind, unless it already has that type
+func widen(t types.Type, kind types.BasicKind, src string) string {
+	if basic, ok := t.(*types.Basic); ok && basic.Kind() == kind {
+		return src
+	}
+	return fmt.Sprintf("%s(%s)", types.Typ[kind].Name(), src)
+}
+
+// importSet tracks the imports of the generated file, choosing names that don't
+// clash with the package's own declarations or with each other
+type importSet struct {
+	pkg   *types.Package
+	names map[string]string // import path -> name used in generated code
+	used  map[string]bool
+}
+
+// newImportSet returns an empty import set for code generated into pkg
+func newImportSet(pkg *types.Package) *importSet {
+	return &importSet{pkg: pkg, names: make(map[string]string), used: make(map[string]bool)}
+}
+
+// add imports path and returns the name to refer to it by
+func (s *importSet) add(path, name string) string {
+	if existing, ok := s.names[path]; ok {
+		return existing
+	}
+	candidate := name
+	for i := 2; s.used[candidate] || s.pkg.Scope().Lookup(candidate) != nil; i++ {
+		candidate = fmt.Sprintf("%s%d", name, i)
+	}
+	s.names[path] = candidate
+	s.used[candidate] = true
+	return candidate
+}
+
+// render writes the import block
+func (s *importSet) render(w *bytes.Buffer) {
+	paths := make([]string, 0, len(s.names))
+	for path := range s.names {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	// Standard library imports come first, separated from the rest as goimports does
+	sort.SliceStable(paths, func(i, j int) bool {
+		return isStdlib(paths[i]) && !isStdlib(paths[j])
+	})
+
+	w.WriteString("import (\n")
+	for i, path := range paths {
+		if i > 0 && isStdlib(paths[i-1]) && !isStdlib(path) {
+			w.WriteString("\n")
+		}
+		name := s.names[path]
+		if name == filepath.Base(path) {
+			fmt.Fprintf(w, "%q\n", path)
+		} else {
+			fmt.Fprintf(w, "%s %q\n", name, path)
+		}
+	}
+	w.WriteString(")\n\n")
+}
+
+// isStdlib reports whether an import path belongs to the standard library
+func isStdlib(path string) bool {
+	first, _, _ := strings.Cut(path, "/")
+	return !strings.Contains(first, ".")
+}
@@ -0,0 +1,144 @@
+package formgen
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestGeneratedFixtureIsCurrent fails if the committed fixture output is stale.
+// Regenerate it with `go generate ./form/internal/gentest`.
+func TestGeneratedFixtureIsCurrent(t *testing.T) {
+	dir := filepath.Join("..", "internal", "gentest")
+	src, err := Generate(dir)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	committed, err := os.ReadFile(filepath.Join(dir, OutputFile))
+	if err != nil {
+		t.Fatalf("Failed to read committed output: %v", err)
+	}
+	if !bytes.Equal(src, committed) {
+		t.Errorf("%s is out of date; run go generate ./form/internal/gentest", filepath.Join(dir, OutputFile))
+	}
+}
+
+func writePackage(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+	return dir
+}
+
+func TestGenerateSkipsUnsupportedStructs(t *testing.T) {
+	dir := writePackage(t, map[string]string{
+		"types.go": `package forms
+
+type Plain struct {
+	Name string
+}
+
+type Custom struct {
+	Name string ` + "`form:\"name\"`" + `
+}
+
+func (c *Custom) DecodeForm() {}
+
+type Checked struct {
+	Name string ` + "`form:\"name\"`" + `
+}
+
+func (c *Checked) Validate() error { return nil }
+
+type Generic[T any] struct {
+	Value T ` + "`form:\"value\"`" + `
+}
+
+type Address struct {
+	Country string ` + "`form:\"country\" validate:\"eqfield=../Country\"`" + `
+}
+
+type Order struct {
+	Country string  ` + "`form:\"country\"`" + `
+	Billing Address ` + "`form:\"billing\"`" + `
+}
+`,
+	})
+
+	src, err := Generate(dir)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	if src != nil {
+		t.Errorf("Expected no output, got:\n%s", src)
+	}
+}
+
+func TestGenerateDirWritesAndRemoves(t *testing.T) {
+	dir := writePackage(t, map[string]string{
+		"types.go": `package forms
+
+var url = "https://example.com"
+
+type Contact struct {
+	Email string ` + "`form:\"email\" validate:\"required,email\"`" + `
+}
+`,
+	})
+
+	names, err := GenerateDir(dir)
+	if err != nil {
+		t.Fatalf("GenerateDir failed: %v", err)
+	}
+	if len(names) != 1 || names[0] != "Contact" {
+		t.Errorf("Expected [Contact], got %v", names)
+	}
+
+	src, err := os.ReadFile(filepath.Join(dir, OutputFile))
+	if err != nil {
+		t.Fatalf("Expected %s to be written: %v", OutputFile, err)
+	}
+	for _, want := range []string{
+		"// Code generated by gokit form gen. DO NOT EDIT.",
+		`url2 "net/url"`,
+		"func (f *Contact) DecodeForm(values url2.Values) form.ValidationErrors",
+		`d.Validate("email", "required,email", reflect.String)`,
+	} {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("Expected generated code to contain %q, got:\n%s", want, src)
+		}
+	}
+
+	// Removing the tags makes the generated file stale, so it is deleted
+	if err := os.WriteFile(filepath.Join(dir, "types.go"), []byte("package forms\n\ntype Contact struct{ Email string }\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := GenerateDir(dir); err != nil {
+		t.Fatalf("GenerateDir failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, OutputFile)); !os.IsNotExist(err) {
+		t.Errorf("Expected stale %s to be removed, got %v", OutputFile, err)
+	}
+}
+
+func TestGenerateReportsTypeErrors(t *testing.T) {
+	dir := writePackage(t, map[string]string{
+		"types.go": `package forms
+
+type Broken struct {
+	When Missing ` + "`form:\"when\"`" + `
+}
+`,
+	})
+
+	if _, err := Generate(dir); err == nil {
+		t.Error("Expected an error for an unresolved field type")
+	}
+}
@@ -0,0 +1,166 @@
+package formtest
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/kdsmith18542/gokit/form"
+)
+
+// ruleMessagesMu guards ruleMessages, as parallel tests may register messages
+var ruleMessagesMu sync.RWMutex
+
+// ruleMessages lists, for each built-in rule, the messages or message prefixes it
+// reports. Prefixes end with a space; other entries must match exactly.
+var ruleMessages = map[string][]string{
+	"required":         {form.ErrFieldRequired},
+	"required_if":      {form.ErrFieldRequired, "This field is required when "},
+	"required_unless":  {form.ErrFieldRequired},
+	"required_with":    {form.ErrFieldRequired},
+	"required_without": {form.ErrFieldRequired},
+	"oneof":            {"Must be one of: "},
+	"email":            {form.ErrInvalidEmail},
+	"url":              {form.ErrInvalidURL},
+	"numeric":          {form.ErrMustBeNumber},
+	"alpha":            {form.ErrMustBeAlpha},
+	"alphanumeric":     {form.ErrMustBeAlphanumeric},
+	"unique":           {form.ErrMustBeUnique},
+	"no_confusables":   {form.ErrConfusable, form.ErrMixedScripts},
+	"datetime":         {form.ErrInvalidDateTime},
+	"weekday":          {form.ErrInvalidWeekday},
+	"business_hours":   {form.ErrOutsideBusinessHours},
+	"min":              {"Must be at least ", "Must contain at least "},
+	"max":              {"Must be no more than ", "Must contain no more than "},
+	"min_age":          {"Must be at least "},
+	"max_age":          {"Must be no more than "},
+	"eqfield":          {"Must match "},
+	"nefield":          {"Must not match "},
+	"gtfield":          {"Must be greater than "},
+	"gtefield":         {"Must be greater than or equal to "},
+	"ltfield":          {"Must be less than "},
+	"ltefield":         {"Must be less than or equal to "},
+	"after":            {form.ErrMustBeFuture, "Must be after "},
+	"before":           {form.ErrMustBePast, "Must be before "},
+	"date_after":       {"Must be after "},
+	"date_before":      {"Must be before "},
+	"time_between":     {"Must be between "},
+}
+
+// RegisterRuleMessage declares the message a custom rule reports, so that
+// AssertFieldError can refer to the rule by name. A message ending in a space is
+// matched as a prefix.
+func RegisterRuleMessage(rule, message string) {
+	ruleMessagesMu.Lock()
+	defer ruleMessagesMu.Unlock()
+	ruleMessages[rule] = append(ruleMessages[rule], message)
+}
+
+// matchesRule reports whether msg is the message expected. expected is either the
+// message itself or a rule such as "required" or "min=8". For a rule with a parameter,
+// templated messages must also mention the parameter, as a whole word or number.
+func matchesRule(msg, expected string) bool {
+	if msg == expected {
+		return true
+	}
+	name, param, _ := strings.Cut(expected, "=")
+	ruleMessagesMu.RLock()
+	candidates := ruleMessages[name]
+	ruleMessagesMu.RUnlock()
+	for _, candidate := range candidates {
+		if !strings.HasSuffix(candidate, " ") {
+			if msg == candidate {
+				return true
+			}
+			continue
+		}
+		if strings.HasPrefix(msg, candidate) && (param == "" || containsToken(msg[len(candidate):], param)) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsToken reports whether s contains token, not as part of a longer word or
+// number, so that "8" isn't found in "18"
+func containsToken(s, token string) bool {
+	for i := strings.Index(s, token); i >= 0; {
+		end := i + len(token)
+		before, _ := utf8.DecodeLastRuneInString(s[:i])
+		after, _ := utf8.DecodeRuneInString(s[end:])
+		if !isWordRune(before) && !isWordRune(after) {
+			return true
+		}
+		next := strings.Index(s[i+1:], token)
+		if next < 0 {
+			break
+		}
+		i += next + 1
+	}
+	return false
+}
+
+// isWordRune reports whether r continues a word or number
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// AssertFieldError fails the test unless field has an error matching expected, which is
+// either a message or a rule such as "required" or "min=8". Custom rules can be named
+// once their message is declared with RegisterRuleMessage.
+//
+// Example:
+//
+//	formtest.AssertFieldError(t, errs, "email", "required")
+//	formtest.AssertFieldError(t, errs, "password", "min=8")
+//	formtest.AssertFieldError(t, errs, "username", "Username already taken")
+func AssertFieldError(t testing.TB, errs form.ValidationErrors, field, expected string) {
+	t.Helper()
+	messages, exists := errs[field]
+	if !exists {
+		t.Errorf("Expected %s error on %q, but the field has no errors; errors: %s", expected, field, describe(errs))
+		return
+	}
+	for _, msg := range messages {
+		if matchesRule(msg, expected) {
+			return
+		}
+	}
+	t.Errorf("Expected %s error on %q, got %q", expected, field, messages)
+}
+
+// AssertNoFieldError fails the test if field has any errors.
+func AssertNoFieldError(t testing.TB, errs form.ValidationErrors, field string) {
+	t.Helper()
+	if messages, exists := errs[field]; exists {
+		t.Errorf("Expected no errors on %q, got %q", field, messages)
+	}
+}
+
+// AssertNoErrors fails the test if there are any validation errors.
+func AssertNoErrors(t testing.TB, errs form.ValidationErrors) {
+	t.Helper()
+	if len(errs) > 0 {
+		t.Errorf("Expected no validation errors, got %s", describe(errs))
+	}
+}
+
+// describe formats errors in key order for failure messages
+func describe(errs form.ValidationErrors) string {
+	if len(errs) == 0 {
+		return "none"
+	}
+	keys := make([]string, 0, len(errs))
+	for key := range errs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	parts := make([]string, len(keys))
+	for i, key := range keys {
+		parts[i] = key + ": " + strings.Join(errs[key], ", ")
+	}
+	return strings.Join(parts, "; ")
+}
@@ -0,0 +1,220 @@
+package formtest
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kdsmith18542/gokit/form"
+)
+
+type signUp struct {
+	Email    string   `form:"email" validate:"required,email"`
+	Password string   `form:"password" validate:"required,min=8"`
+	Tags     []string `form:"tags"`
+}
+
+// recorder captures failures instead of failing the test
+type recorder struct {
+	testing.TB
+	failures []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func TestURLEncodedRequest(t *testing.T) {
+	req := Post("/signup").
+		Field("email", "ada@example.com").
+		Field("tags", "a", "b").
+		Struct(struct {
+			Password string `form:"password"`
+		}{"correct horse"}).
+		Request()
+
+	if ct := req.Header.Get("Content-Type"); ct != "application/x-www-form-urlencoded" {
+		t.Errorf("Expected urlencoded content type, got %q", ct)
+	}
+	var f signUp
+	AssertNoErrors(t, form.DecodeAndValidate(req, &f))
+	if f.Email != "ada@example.com" || f.Password != "correct horse" || !reflect.DeepEqual(f.Tags, []string{"a", "b"}) {
+		t.Errorf("Unexpected decoded form: %+v", f)
+	}
+}
+
+func TestMultipartRequest(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n0000")
+	b := Post("/upload").
+		Values(url.Values{"email": {"ada@example.com"}, "password": {"correct horse"}}).
+		File("avatar", `a "b".png`, png).
+		FileWithType("doc", "notes.txt", "text/plain", []byte("hello"))
+
+	for i := 0; i < 2; i++ {
+		req := b.Request()
+		var f signUp
+		AssertNoErrors(t, form.DecodeAndValidate(req, &f))
+
+		avatar := req.MultipartForm.File["avatar"][0]
+		if avatar.Filename != `a "b".png` || avatar.Header.Get("Content-Type") != "image/png" {
+			t.Errorf("Unexpected avatar part: %q %v", avatar.Filename, avatar.Header)
+		}
+		doc, err := req.MultipartForm.File["doc"][0].Open()
+		if err != nil {
+			t.Fatalf("Open failed: %v", err)
+		}
+		if content, _ := io.ReadAll(doc); string(content) != "hello" {
+			t.Errorf("Expected file content to be preserved, got %q", content)
+		}
+		doc.Close()
+	}
+
+	if ct := Post("/").Multipart().Request().Header.Get("Content-Type"); !strings.HasPrefix(ct, "multipart/form-data; boundary=") {
+		t.Errorf("Expected multipart content type, got %q", ct)
+	}
+}
+
+func TestJSONRequest(t *testing.T) {
+	req := NewRequest("PUT", "/api").
+		JSON(map[string]interface{}{"email": "nope", "password": "short"}).
+		Header("X-Request-ID", "42").
+		Request()
+
+	if req.Method != "PUT" || req.Header.Get("Content-Type") != "application/json" || req.Header.Get("X-Request-ID") != "42" {
+		t.Errorf("Unexpected request: %s %v", req.Method, req.Header)
+	}
+	var f signUp
+	errs := form.DecodeAndValidateJSON(req.Context(), req.Body, &f)
+	AssertFieldError(t, errs, "email", "email")
+	AssertFieldError(t, errs, "password", "min=8")
+
+	raw := Post("/").JSON(`{"email":`).Request()
+	if body, _ := io.ReadAll(raw.Body); string(body) != `{"email":` {
+		t.Errorf("Expected raw JSON body to be sent as is, got %q", body)
+	}
+}
+
+func TestAssertions(t *testing.T) {
+	errs := form.ValidationErrors{
+		"email":    {form.ErrFieldRequired},
+		"password": {"Must be at least 8 characters long"},
+		"username": {"Username already taken"},
+		"pin":      {"Must be at least 18 characters long"},
+	}
+
+	passing := []struct{ field, expected string }{
+		{"pin", "min=18"},
+		{"email", "required"},
+		{"email", form.ErrFieldRequired},
+		{"password", "min"},
+		{"password", "min=8"},
+		{"username", "Username already taken"},
+	}
+	for _, tt := range passing {
+		r := &recorder{}
+		AssertFieldError(r, errs, tt.field, tt.expected)
+		if len(r.failures) > 0 {
+			t.Errorf("AssertFieldError(%q, %q) failed: %v", tt.field, tt.expected, r.failures)
+		}
+	}
+
+	failing := []struct{ field, expected string }{
+		{"email", "email"},
+		{"password", "min=12"},
+		{"pin", "min=8"},
+		{"password", "max"},
+		{"username", "username_available"},
+		{"missing", "required"},
+	}
+	for _, tt := range failing {
+		r := &recorder{}
+		AssertFieldError(r, errs, tt.field, tt.expected)
+		if len(r.failures) != 1 {
+			t.Errorf("Expected AssertFieldError(%q, %q) to fail once, got %v", tt.field, tt.expected, r.failures)
+		}
+	}
+
+	RegisterRuleMessage("username_available", "Username already taken")
+	defer func() {
+		ruleMessagesMu.Lock()
+		delete(ruleMessages, "username_available")
+		ruleMessagesMu.Unlock()
+	}()
+	r := &recorder{}
+	AssertFieldError(r, errs, "username", "username_available")
+	AssertNoFieldError(r, errs, "tags")
+	AssertNoErrors(r, nil)
+	if len(r.failures) > 0 {
+		t.Errorf("Expected assertions to pass, got %v", r.failures)
+	}
+
+	AssertNoFieldError(r, errs, "email")
+	AssertNoErrors(r, errs)
+	if len(r.failures) != 2 || !strings.Contains(r.failures[1], "email: This field is required; password:") {
+		t.Errorf("Expected two failures listing the errors in key order, got %v", r.failures)
+	}
+}
+
+func TestRuleTable(t *testing.T) {
+	form.RegisterValidator("formtest_even", func(value string) string {
+		if value != "" && (value[len(value)-1]-'0')%2 != 0 {
+			return "Must be even"
+		}
+		return ""
+	})
+
+	RuleTable{
+		{Rule: "formtest_even", Valid: []string{"", "2", "10"}, Invalid: []string{"3"}, Message: "Must be even"},
+		{Name: "numeric min", Rule: "min=18", Kind: reflect.Int, Valid: []string{"18", "99"}, Invalid: []string{"17"}, Message: "Must be at least 18"},
+		{Rule: "min=3,alphanumeric", Valid: []string{"abc"}, Invalid: []string{"ab", "ab!"}},
+		{
+			Rule:    "eqfield=ConfirmPassword",
+			Fields:  map[string]string{"confirm_password": "s3cret"},
+			Valid:   []string{"s3cret"},
+			Invalid: []string{"other"},
+		},
+	}.Run(t)
+}
+
+func TestRuleStruct(t *testing.T) {
+	tc := RuleCase{Rule: "eqfield=b", Fields: map[string]string{"c": "", "b": "", "a": ""}}
+	first, err := ruleStruct(tc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		if typ, _ := ruleStruct(tc); typ != first {
+			t.Fatalf("Expected the same type on every run, got %v and %v", first, typ)
+		}
+	}
+	if name := first.Field(1).Name; name != "A" {
+		t.Errorf("Expected fields in key order, got %v", first)
+	}
+
+	for _, fields := range []map[string]string{{"value": ""}, {"Value": ""}, {"start_date": "", "start-date": ""}} {
+		if _, err := ruleStruct(RuleCase{Rule: "required", Fields: fields}); err == nil {
+			t.Errorf("Expected the Go names of %v to collide", fields)
+		}
+	}
+	if _, err := ruleStruct(RuleCase{Rule: "required", Kind: reflect.Slice}); err == nil {
+		t.Error("Expected an error for an unsupported kind")
+	}
+}
+
+func TestGoName(t *testing.T) {
+	for key, expected := range map[string]string{
+		"password":         "Password",
+		"confirm_password": "ConfirmPassword",
+		"start-date":       "StartDate",
+		"2fa":              "F2fa",
+	} {
+		if got := goName(key); got != expected {
+			t.Errorf("goName(%q) = %q, expected %q", key, got, expected)
+		}
+	}
+}
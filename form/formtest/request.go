@@ -0,0 +1,206 @@
+// Package formtest provides helpers for testing handlers and rules built on the form
+// package: fluent request builders for urlencoded, JSON and multipart bodies, assertions
+// on ValidationErrors, and a table-driven tester for validation rules.
+//
+// Example:
+//
+//	req := formtest.Post("/signup").
+//	    Field("email", "ada@example.com").
+//	    File("avatar", "ada.png", png).
+//	    Request()
+//
+//	var f SignUpForm
+//	errs := form.DecodeAndValidate(req, &f)
+//	formtest.AssertFieldError(t, errs, "password", "required")
+package formtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"net/url"
+	"sort"
+
+	"github.com/kdsmith18542/gokit/form"
+)
+
+// bodyKind is the encoding a RequestBuilder uses for its body
+type bodyKind int
+
+const (
+	urlEncoded bodyKind = iota
+	multipartBody
+	jsonBody
+)
+
+// file is a file part added with RequestBuilder.File
+type file struct {
+	field, filename, contentType string
+	content                      []byte
+}
+
+// RequestBuilder builds a test request step by step. Like httptest.NewRequest, it
+// panics on errors in the test's own input, such as a value that can't be encoded.
+type RequestBuilder struct {
+	method  string
+	target  string
+	kind    bodyKind
+	values  url.Values
+	files   []file
+	json    []byte
+	headers http.Header
+}
+
+// NewRequest returns a builder for a request with the given method and target. Without
+// further calls it builds an empty urlencoded form.
+func NewRequest(method, target string) *RequestBuilder {
+	return &RequestBuilder{
+		method:  method,
+		target:  target,
+		values:  make(url.Values),
+		headers: make(http.Header),
+	}
+}
+
+// Post returns a builder for a POST request to target.
+func Post(target string) *RequestBuilder {
+	return NewRequest(http.MethodPost, target)
+}
+
+// Field adds values for a form key.
+func (b *RequestBuilder) Field(key string, values ...string) *RequestBuilder {
+	b.values[key] = append(b.values[key], values...)
+	return b
+}
+
+// Values adds every key of values.
+func (b *RequestBuilder) Values(values url.Values) *RequestBuilder {
+	for key, vs := range values {
+		b.Field(key, vs...)
+	}
+	return b
+}
+
+// Struct adds the fields of v, encoded with form.Encode.
+func (b *RequestBuilder) Struct(v interface{}) *RequestBuilder {
+	values, err := form.Encode(v)
+	if err != nil {
+		panic("formtest: " + err.Error())
+	}
+	return b.Values(values)
+}
+
+// File adds a file part and switches the body to multipart/form-data. The part's
+// content type is detected from content.
+func (b *RequestBuilder) File(field, filename string, content []byte) *RequestBuilder {
+	return b.FileWithType(field, filename, http.DetectContentType(content), content)
+}
+
+// FileWithType adds a file part with an explicit content type and switches the body to
+// multipart/form-data.
+func (b *RequestBuilder) FileWithType(field, filename, contentType string, content []byte) *RequestBuilder {
+	b.kind = multipartBody
+	b.files = append(b.files, file{field: field, filename: filename, contentType: contentType, content: content})
+	return b
+}
+
+// Multipart switches the body to multipart/form-data even without files.
+func (b *RequestBuilder) Multipart() *RequestBuilder {
+	b.kind = multipartBody
+	return b
+}
+
+// JSON sets a JSON body. Strings and byte slices are sent as they are, so malformed
+// bodies can be tested too; other values are marshaled. Form fields and files added to
+// the builder are ignored for JSON requests.
+func (b *RequestBuilder) JSON(v interface{}) *RequestBuilder {
+	b.kind = jsonBody
+	switch body := v.(type) {
+	case string:
+		b.json = []byte(body)
+	case []byte:
+		b.json = body
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			panic("formtest: " + err.Error())
+		}
+		b.json = data
+	}
+	return b
+}
+
+// Header sets a request header. A Content-Type set here replaces the one the builder
+// chooses for the body.
+func (b *RequestBuilder) Header(key, value string) *RequestBuilder {
+	b.headers.Set(key, value)
+	return b
+}
+
+// Request builds the request. It can be called more than once.
+func (b *RequestBuilder) Request() *http.Request {
+	var body io.Reader
+	var contentType string
+	switch b.kind {
+	case jsonBody:
+		body, contentType = bytes.NewReader(b.json), "application/json"
+	case multipartBody:
+		var buf bytes.Buffer
+		contentType = b.writeMultipart(&buf)
+		body = &buf
+	default:
+		body, contentType = bytes.NewBufferString(b.values.Encode()), "application/x-www-form-urlencoded"
+	}
+
+	req := httptest.NewRequest(b.method, b.target, body)
+	req.Header.Set("Content-Type", contentType)
+	for key, values := range b.headers {
+		req.Header[key] = append([]string(nil), values...)
+	}
+	return req
+}
+
+// writeMultipart writes the fields in key order and then the files, and returns the
+// body's content type
+func (b *RequestBuilder) writeMultipart(buf *bytes.Buffer) string {
+	writer := multipart.NewWriter(buf)
+
+	keys := make([]string, 0, len(b.values))
+	for key := range b.values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, value := range b.values[key] {
+			if err := writer.WriteField(key, value); err != nil {
+				panic("formtest: " + err.Error())
+			}
+		}
+	}
+
+	for _, f := range b.files {
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", mime.FormatMediaType("form-data", map[string]string{
+			"name":     f.field,
+			"filename": f.filename,
+		}))
+		header.Set("Content-Type", f.contentType)
+		part, err := writer.CreatePart(header)
+		if err == nil {
+			_, err = part.Write(f.content)
+		}
+		if err != nil {
+			panic(fmt.Sprintf("formtest: writing file %q: %v", f.filename, err))
+		}
+	}
+	if err := writer.Close(); err != nil {
+		panic("formtest: " + err.Error())
+	}
+	return writer.FormDataContentType()
+}
@@ -0,0 +1,169 @@
+package formtest
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/kdsmith18542/gokit/form"
+)
+
+// RuleCase checks a validate tag against values that must pass and values that must fail.
+type RuleCase struct {
+	// Name names the subtest; it defaults to Rule
+	Name string
+	// Rule is a validate tag such as "username_available" or "min=3,alphanumeric"
+	Rule string
+	// Kind is the kind of the field under test; it defaults to reflect.String
+	Kind reflect.Kind
+	// Fields holds other form values, for cross-field rules such as "eqfield=password"
+	Fields map[string]string
+	// Valid values must produce no errors
+	Valid []string
+	// Invalid values must produce at least one error
+	Invalid []string
+	// Message, if set, must be among the errors of every invalid value
+	Message string
+}
+
+// RuleTable is a table of rule cases.
+//
+// Example:
+//
+//	formtest.RuleTable{
+//	    {Rule: "username_available", Valid: []string{"newuser"}, Invalid: []string{"admin"}},
+//	    {Rule: "min=18", Kind: reflect.Int, Valid: []string{"18"}, Invalid: []string{"17"}, Message: "Must be at least 18"},
+//	    {Rule: "eqfield=password", Fields: map[string]string{"password": "s3cret"}, Valid: []string{"s3cret"}, Invalid: []string{"other"}},
+//	}.Run(t)
+type RuleTable []RuleCase
+
+// Run runs every case as a subtest. Each value is decoded into a struct whose "value"
+// field carries the rule, alongside string fields for Fields, so rules run through
+// form.DecodeAndValidate exactly as they do in handlers.
+func (table RuleTable) Run(t *testing.T) {
+	t.Helper()
+	for _, tc := range table {
+		name := tc.Name
+		if name == "" {
+			name = tc.Rule
+		}
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			typ, err := ruleStruct(tc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, value := range tc.Valid {
+				if errs := validateValue(typ, tc, value); len(errs) > 0 {
+					t.Errorf("Expected %q to pass %q, got %s", value, tc.Rule, describe(errs))
+				}
+			}
+			for _, value := range tc.Invalid {
+				messages := validateValue(typ, tc, value)["value"]
+				switch {
+				case len(messages) == 0:
+					t.Errorf("Expected %q to fail %q", value, tc.Rule)
+				case tc.Message != "" && !contains(messages, tc.Message):
+					t.Errorf("Expected %q to fail %q with %q, got %q", value, tc.Rule, tc.Message, messages)
+				}
+			}
+		})
+	}
+}
+
+// scalarTypes maps the supported field kinds to their types
+var scalarTypes = map[reflect.Kind]reflect.Type{
+	reflect.String:  reflect.TypeOf(""),
+	reflect.Bool:    reflect.TypeOf(false),
+	reflect.Int:     reflect.TypeOf(0),
+	reflect.Int64:   reflect.TypeOf(int64(0)),
+	reflect.Uint:    reflect.TypeOf(uint(0)),
+	reflect.Float64: reflect.TypeOf(0.0),
+}
+
+// ruleStruct builds the struct type a case decodes into. Fields are added in key order,
+// so that every run builds the same type.
+func ruleStruct(tc RuleCase) (reflect.Type, error) {
+	kind := tc.Kind
+	if kind == reflect.Invalid {
+		kind = reflect.String
+	}
+	typ, ok := scalarTypes[kind]
+	if !ok {
+		return nil, fmt.Errorf("formtest: unsupported kind %s", kind)
+	}
+
+	fields := []reflect.StructField{{
+		Name: "Value",
+		Type: typ,
+		Tag:  reflect.StructTag(`form:"value" validate:"` + strings.ReplaceAll(tc.Rule, `"`, `\"`) + `"`),
+	}}
+	keys := make([]string, 0, len(tc.Fields))
+	for key := range tc.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	names := map[string]string{"Value": ""}
+	for _, key := range keys {
+		name := goName(key)
+		if other, taken := names[name]; taken {
+			if name == "Value" {
+				return nil, fmt.Errorf("formtest: Fields key %q collides with the value under test; use another key", key)
+			}
+			return nil, fmt.Errorf("formtest: Fields keys %q and %q both become the Go name %s; use another key", other, key, name)
+		}
+		names[name] = key
+		fields = append(fields, reflect.StructField{
+			Name: name,
+			Type: scalarTypes[reflect.String],
+			Tag:  reflect.StructTag(`form:"` + key + `"`),
+		})
+	}
+	return reflect.StructOf(fields), nil
+}
+
+// validateValue decodes value and the case's fields into a new struct of type typ
+func validateValue(typ reflect.Type, tc RuleCase, value string) form.ValidationErrors {
+	values := url.Values{"value": {value}}
+	for key, v := range tc.Fields {
+		values.Set(key, v)
+	}
+	req := Post("/").Values(values).Request()
+	return form.DecodeAndValidate(req, reflect.New(typ).Interface())
+}
+
+// goName turns a form key such as "confirm_password" into an exported Go name such as
+// "ConfirmPassword", so that rules can refer to the field by either
+func goName(key string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range key {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	if b.Len() == 0 || !unicode.IsLetter([]rune(b.String())[0]) {
+		return "F" + b.String()
+	}
+	return b.String()
+}
+
+// contains reports whether messages contains msg
+func contains(messages []string, msg string) bool {
+	for _, m := range messages {
+		if m == msg {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,124 @@
+package form
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"sort"
+)
+
+// GeneratedForm is implemented by types with reflection-free decoders generated by
+// `gokit form gen`. The decode functions call DecodeFormContext instead of the
+// reflective decoder whenever the target implements this interface, and ValidateStruct
+// calls ValidateContext, so that failed rules are recorded and observed as they are for
+// reflective decoding.
+//
+// Types with their own DecodeForm or Validate method, such as a StructValidator, are
+// left to the reflective decoder, as the generated methods would clash with them.
+type GeneratedForm interface {
+	// DecodeForm sanitizes values, binds them to the struct and validates the tag rules.
+	DecodeForm(values url.Values) ValidationErrors
+	// Validate validates the tag rules against the struct's current field values.
+	Validate() ValidationErrors
+	// DecodeFormContext is DecodeForm with the context of the validation.
+	DecodeFormContext(ctx context.Context, values url.Values) ValidationErrors
+	// ValidateContext is Validate with the context of the validation.
+	ValidateContext(ctx context.Context) ValidationErrors
+}
+
+// GenDecoder holds decoding state for code generated by `gokit form gen`.
+// It runs the same sanitizers and rules as the reflective decoder, so generated and
+// reflective decoding produce identical results. It is not meant to be used by hand.
+type GenDecoder struct {
+	formData map[string][]string
+	decoded  *decodedFields
+	errors   ValidationErrors
+}
+
+// NewGenDecoder returns a GenDecoder reading from values, which may be nil when the
+// generated code only validates existing field values.
+func NewGenDecoder(values url.Values) *GenDecoder {
+	return NewGenDecoderContext(context.Background(), values)
+}
+
+// NewGenValidator returns a GenDecoder validating the current field values of a struct,
+// recording failed rules like NewGenDecoderContext. As with ValidateStruct, required
+// fails for numbers that are zero and bools that are false.
+func NewGenValidator(ctx context.Context) *GenDecoder {
+	d := NewGenDecoderContext(ctx, nil)
+	d.decoded.existing = true
+	return d
+}
+
+// NewGenDecoderContext returns a GenDecoder reading from values that records the rules
+// failing in the validation ctx belongs to, like the reflective decoder.
+func NewGenDecoderContext(ctx context.Context, values url.Values) *GenDecoder {
+	decoded := newDecodedFields()
+	decoded.failures = rulesFrom(ctx)
+	return &GenDecoder{
+		formData: values,
+		decoded:  decoded,
+		errors:   make(ValidationErrors),
+	}
+}
+
+// Default records the default tag of a field, used by the following Scalar or Slice
+// call when the field's key is absent from the input. Pass collection for slice fields.
+func (d *GenDecoder) Default(key, defaultTag, validateTag string, collection bool) {
+	d.decoded.setDefault(d.formData, key, defaultTag, validateTag, collection)
+}
+
+// Scalar returns the sanitized input for a scalar field. It returns false if
+// sanitization failed and the field should be left unset.
+func (d *GenDecoder) Scalar(key, fieldName, sanitizeTag string) (string, bool) {
+	return d.decoded.decodeScalar(d.formData, key, fieldName, sanitizeTag)
+}
+
+// Slice returns the sanitized elements for a slice field. It returns false if
+// sanitization failed and the field should be left unset.
+func (d *GenDecoder) Slice(key, fieldName, sanitizeTag string) ([]string, bool) {
+	c, ok := d.decoded.decodeCollection(d.formData, key, fieldName, sanitizeTag, false)
+	return c.values, ok
+}
+
+// Map returns the sorted keys and sanitized values for a map field. It returns false
+// if sanitization failed and the field should be left unset.
+func (d *GenDecoder) Map(key, fieldName, sanitizeTag string) ([]string, []string, bool) {
+	c, ok := d.decoded.decodeCollection(d.formData, key, fieldName, sanitizeTag, true)
+	return c.keys, c.values, ok
+}
+
+// SetScalar records the current value of a scalar field for validation.
+func (d *GenDecoder) SetScalar(key, fieldName, value string) {
+	d.decoded.setScalar(key, fieldName, value)
+}
+
+// SetSlice records the current elements of a slice field for validation.
+func (d *GenDecoder) SetSlice(key, fieldName string, values []string) {
+	d.decoded.setCollection(key, fieldName, &collection{values: values})
+}
+
+// SetMap records the current entries of a map field for validation.
+func (d *GenDecoder) SetMap(key, fieldName string, entries map[string]string) {
+	c := &collection{keys: make([]string, 0, len(entries))}
+	for k := range entries {
+		c.keys = append(c.keys, k)
+	}
+	sort.Strings(c.keys)
+	for _, k := range c.keys {
+		c.values = append(c.values, entries[k])
+	}
+	d.decoded.setCollection(key, fieldName, c)
+}
+
+// Validate validates one field against its validate tag. Call it after every field has
+// been decoded or set so that cross-field rules see the whole form. For slice and map
+// fields, kind is the element kind.
+func (d *GenDecoder) Validate(key, validateTag string, kind reflect.Kind) {
+	d.decoded.validateField(d.errors, key, validateTag, kind, ValidationContext{values: d.decoded.values, failures: d.decoded.failures})
+}
+
+// Errors returns the validation errors collected so far.
+func (d *GenDecoder) Errors() ValidationErrors {
+	return d.errors
+}
@@ -0,0 +1,427 @@
+package form
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrSubmissionInProgress is returned by IdempotencyStore.Reserve when another request
+// holds the key.
+var ErrSubmissionInProgress = errors.New("form: submission in progress")
+
+// Defaults for IdempotencyOptions
+const (
+	DefaultIdempotencyHeader = "Idempotency-Key"
+	DefaultIdempotencyField  = "_idempotency_key"
+	DefaultIdempotencyTTL    = 24 * time.Hour
+	DefaultIdempotencyLock   = time.Minute
+	DefaultMaxReplayBytes    = 1 << 20
+)
+
+// Codes of the JSON errors written by IdempotencyGuard
+const (
+	idempotencyCodeInProgress   = "idempotency_in_progress"
+	idempotencyCodeKeyRequired  = "idempotency_key_required"
+	idempotencyCodeUnavailable  = "idempotency_unavailable"
+	idempotencyCodeInvalidInput = "idempotency_invalid_input"
+	idempotencyCodeKeyReused    = "idempotency_key_reused"
+)
+
+// idempotencyReplayedHeader marks replayed responses
+const idempotencyReplayedHeader = "Idempotent-Replayed"
+
+// StoredResponse is a response recorded for a completed submission.
+type StoredResponse struct {
+	Status int
+	Header http.Header
+	Body   []byte
+	// Fingerprint is a hash of the submission the response answers, so that a key
+	// reused for a different submission is rejected rather than replayed
+	Fingerprint string
+}
+
+// IdempotencyStore records submissions for IdempotencyGuard. Implementations backed by a
+// shared store such as Redis let several instances recognize replays; Reserve must be
+// atomic, for example SET NX.
+type IdempotencyStore interface {
+	// Reserve claims key for a new submission for at most lock. It returns the stored
+	// response if the submission completed already, ErrSubmissionInProgress if another
+	// request holds the key, and nil, nil once the key is claimed.
+	Reserve(ctx context.Context, key string, lock time.Duration) (*StoredResponse, error)
+	// Complete stores the response of a claimed submission for ttl.
+	Complete(ctx context.Context, key string, response *StoredResponse, ttl time.Duration) error
+	// Release gives up a claim without storing a response, so the submission can be retried.
+	Release(ctx context.Context, key string) error
+}
+
+// IdempotencyOptions configures an IdempotencyGuard.
+type IdempotencyOptions struct {
+	// Header names the request header holding the key; it defaults to
+	// DefaultIdempotencyHeader. It takes precedence over the form field.
+	Header string
+	// Field names the form field holding the key; it defaults to DefaultIdempotencyField.
+	Field string
+	// RequireKey rejects submissions without a key instead of processing them unprotected.
+	RequireKey bool
+	// TTL is how long completed responses are replayed; it defaults to DefaultIdempotencyTTL.
+	TTL time.Duration
+	// LockTimeout bounds how long a submission holds its key while the handler runs, in
+	// case the process dies; it defaults to DefaultIdempotencyLock.
+	LockTimeout time.Duration
+	// MaxReplayBytes is the largest response body stored for replay; larger responses
+	// aren't stored, so a replay runs the handler again. It defaults to DefaultMaxReplayBytes.
+	MaxReplayBytes int
+	// Scope returns the namespace of the request's key, so that equal keys sent to
+	// different endpoints or by different users don't collide. It defaults to the
+	// method and path; include the user's ID when keys come from clients.
+	Scope func(r *http.Request) string
+	// Store records submissions; it defaults to an in-memory MemoryIdempotencyStore.
+	Store IdempotencyStore
+	// MaxBodyBytes caps the request body read to find the form field; it defaults to
+	// DefaultMaxBodyBytes.
+	MaxBodyBytes int64
+}
+
+// IdempotencyGuard makes form and JSON submissions idempotent, so that a double-clicked
+// "Pay" button or a retried request runs the handler once. Each submission carries a
+// one-time key in a hidden form field or an Idempotency-Key header. The first request
+// with a key runs the handler; replays get the recorded response, marked with an
+// "Idempotent-Replayed: true" header, and requests arriving while the first still runs
+// get 409 Conflict. A key sent again with a different form or body gets 422
+// Unprocessable Entity, as replaying the first response would hide the change.
+//
+// Only successful responses, with a status below 400, are recorded. Failed submissions,
+// such as those with validation errors, release their key so the user can correct the
+// form and submit it again.
+//
+// Example:
+//
+//	guard := form.NewIdempotencyGuard(form.IdempotencyOptions{RequireKey: true})
+//	mux.Handle("/pay", guard.Middleware(form.ValidationMiddlewareWithContext(PaymentForm{}, nil)(payHandler)))
+//
+//	// In the template of the form
+//	<form method="post" action="/pay">{{ .IdempotencyField }} ... </form>
+type IdempotencyGuard struct {
+	opts IdempotencyOptions
+}
+
+// NewIdempotencyGuard returns an IdempotencyGuard with the given options, filling in
+// defaults for unset ones.
+func NewIdempotencyGuard(opts IdempotencyOptions) *IdempotencyGuard {
+	if opts.Header == "" {
+		opts.Header = DefaultIdempotencyHeader
+	}
+	if opts.Field == "" {
+		opts.Field = DefaultIdempotencyField
+	}
+	if opts.TTL <= 0 {
+		opts.TTL = DefaultIdempotencyTTL
+	}
+	if opts.LockTimeout <= 0 {
+		opts.LockTimeout = DefaultIdempotencyLock
+	}
+	if opts.MaxReplayBytes <= 0 {
+		opts.MaxReplayBytes = DefaultMaxReplayBytes
+	}
+	if opts.Scope == nil {
+		opts.Scope = func(r *http.Request) string { return r.Method + " " + r.URL.Path }
+	}
+	if opts.Store == nil {
+		opts.Store = NewMemoryIdempotencyStore()
+	}
+	if opts.MaxBodyBytes <= 0 {
+		opts.MaxBodyBytes = DefaultMaxBodyBytes
+	}
+	return &IdempotencyGuard{opts: opts}
+}
+
+// Token returns a new random submission key.
+func (g *IdempotencyGuard) Token() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic("form: generating idempotency key: " + err.Error())
+	}
+	return base64.RawURLEncoding.EncodeToString(b[:])
+}
+
+// Field returns a hidden input holding a new submission key, to be placed inside the
+// form each time it is rendered.
+func (g *IdempotencyGuard) Field() template.HTML {
+	return template.HTML(`<input type="hidden" name="` + template.HTMLEscapeString(g.opts.Field) + `" value="` + g.Token() + `">`) // #nosec G203 -- the name is escaped and the key is base64
+}
+
+// Middleware returns middleware that runs each submission once per key. Requests with
+// safe methods such as GET pass through. The key's form field is removed from the
+// parsed form, so it never reaches strict decoders; JSON bodies are left untouched and
+// carry their key in the header.
+func (g *IdempotencyGuard) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if err := parseRequestForm(r, g.opts.MaxBodyBytes); err != nil {
+			idempotencyError(w, http.StatusBadRequest, parseFailure(err, "Failed to parse form data"), idempotencyCodeInvalidInput)
+			return
+		}
+		key := takeFormValue(r, g.opts.Field)
+		if header := r.Header.Get(g.opts.Header); header != "" {
+			key = header
+		}
+		if key == "" {
+			if g.opts.RequireKey {
+				idempotencyError(w, http.StatusBadRequest, "Missing idempotency key", idempotencyCodeKeyRequired)
+				return
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		sum, err := fingerprint(r)
+		if err != nil {
+			idempotencyError(w, http.StatusBadRequest, parseFailure(err, "Failed to read request body"), idempotencyCodeInvalidInput)
+			return
+		}
+
+		key = g.opts.Scope(r) + "\x00" + key
+		start := time.Now()
+		stored, err := g.opts.Store.Reserve(r.Context(), key, g.opts.LockTimeout)
+		if errors.Is(err, ErrSubmissionInProgress) {
+			observeStore(r.Context(), "reserve", "idempotency", start, nil)
+		} else {
+			observeStore(r.Context(), "reserve", "idempotency", start, err)
+		}
+		switch {
+		case errors.Is(err, ErrSubmissionInProgress):
+			idempotencyError(w, http.StatusConflict, "Submission already in progress", idempotencyCodeInProgress)
+			return
+		case err != nil:
+			// Processing the submission without protection could charge twice
+			idempotencyError(w, http.StatusServiceUnavailable, "Submission could not be checked", idempotencyCodeUnavailable)
+			return
+		case stored != nil && stored.Fingerprint != "" && stored.Fingerprint != sum:
+			idempotencyError(w, http.StatusUnprocessableEntity, "Idempotency key was used for a different submission", idempotencyCodeKeyReused)
+			return
+		case stored != nil:
+			replay(w, stored)
+			return
+		}
+
+		g.run(next, w, r, key, sum)
+	})
+}
+
+// run calls the handler for a claimed key and records or releases the key afterwards.
+// The key is released if the handler panics.
+func (g *IdempotencyGuard) run(next http.Handler, w http.ResponseWriter, r *http.Request, key, sum string) {
+	// Record and release with a context that outlives a cancelled request
+	ctx := context.WithoutCancel(r.Context())
+	rec := &recordingWriter{ResponseWriter: w, limit: g.opts.MaxReplayBytes}
+	completed := false
+	defer func() {
+		if !completed {
+			// A failed release keeps the key locked until LockTimeout, so retries get 409
+			start := time.Now()
+			observeStore(ctx, "release", "idempotency", start, g.opts.Store.Release(ctx, key))
+		}
+	}()
+
+	next.ServeHTTP(rec, r)
+
+	status := rec.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	if status >= http.StatusBadRequest || rec.overflow {
+		return
+	}
+	response := &StoredResponse{Status: status, Header: rec.Header().Clone(), Body: rec.body.Bytes(), Fingerprint: sum}
+	start := time.Now()
+	err := g.opts.Store.Complete(ctx, key, response, g.opts.TTL)
+	observeStore(ctx, "complete", "idempotency", start, err)
+	completed = err == nil
+}
+
+// fingerprint hashes the submission of r: its parsed form values and the contents of
+// uploaded files, or its raw body otherwise, which is read and put back for the handler
+func fingerprint(r *http.Request) (string, error) {
+	h := sha256.New()
+	switch {
+	case len(r.PostForm) > 0 || r.MultipartForm != nil:
+		h.Write([]byte(r.PostForm.Encode()))
+		if r.MultipartForm != nil {
+			names := make([]string, 0, len(r.MultipartForm.File))
+			for name := range r.MultipartForm.File {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				for _, file := range r.MultipartForm.File[name] {
+					fmt.Fprintf(h, "\x00%s\x00%s\x00%d\x00", name, file.Filename, file.Size)
+					if err := hashFile(h, file); err != nil {
+						return "", err
+					}
+				}
+			}
+		}
+	case r.Body != nil && r.Body != http.NoBody:
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return "", err
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		h.Write(body)
+	}
+	return base64.RawURLEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// hashFile streams the contents of an uploaded file into h
+func hashFile(h io.Writer, file *multipart.FileHeader) error {
+	f, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(h, f)
+	return err
+}
+
+// replay writes a stored response
+func replay(w http.ResponseWriter, stored *StoredResponse) {
+	for key, values := range stored.Header {
+		w.Header()[key] = append([]string(nil), values...)
+	}
+	w.Header().Set(idempotencyReplayedHeader, "true")
+	w.WriteHeader(stored.Status)
+	// The status is sent, so a failed write can't be reported; the response stays
+	// stored for a retry
+	w.Write(stored.Body)
+}
+
+// idempotencyError writes a JSON error with a code identifying the failure
+func idempotencyError(w http.ResponseWriter, status int, message, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	response := struct {
+		Error string `json:"error"`
+		Code  string `json:"code"`
+	}{
+		Error: message,
+		Code:  code,
+	}
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
+
+// recordingWriter passes a response through while keeping a copy of up to limit bytes
+type recordingWriter struct {
+	http.ResponseWriter
+	status   int
+	body     bytes.Buffer
+	limit    int
+	overflow bool
+}
+
+func (w *recordingWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *recordingWriter) Write(p []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	if !w.overflow {
+		if w.body.Len()+len(p) > w.limit {
+			w.overflow = true
+			w.body.Reset()
+		} else {
+			w.body.Write(p)
+		}
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+// Unwrap returns the underlying writer for http.ResponseController.
+func (w *recordingWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// MemoryIdempotencyStore is an in-memory IdempotencyStore. It is the default store of
+// IdempotencyGuard; use a shared store when running several instances.
+type MemoryIdempotencyStore struct {
+	mu      sync.Mutex
+	entries map[string]idempotencyEntry
+	now     func() time.Time
+}
+
+// idempotencyEntry is a claimed or completed key
+type idempotencyEntry struct {
+	response *StoredResponse
+	expires  time.Time
+}
+
+// NewMemoryIdempotencyStore returns an empty MemoryIdempotencyStore.
+func NewMemoryIdempotencyStore() *MemoryIdempotencyStore {
+	return &MemoryIdempotencyStore{entries: make(map[string]idempotencyEntry), now: time.Now}
+}
+
+// Reserve implements IdempotencyStore.
+func (s *MemoryIdempotencyStore) Reserve(ctx context.Context, key string, lock time.Duration) (*StoredResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if entry, ok := s.entries[key]; ok && now.Before(entry.expires) {
+		if entry.response == nil {
+			return nil, ErrSubmissionInProgress
+		}
+		return entry.response, nil
+	}
+
+	// Drop expired entries now and then, on the way in
+	if len(s.entries)%64 == 63 {
+		for k, entry := range s.entries {
+			if !now.Before(entry.expires) {
+				delete(s.entries, k)
+			}
+		}
+	}
+	s.entries[key] = idempotencyEntry{expires: now.Add(lock)}
+	return nil, nil
+}
+
+// Complete implements IdempotencyStore.
+func (s *MemoryIdempotencyStore) Complete(ctx context.Context, key string, response *StoredResponse, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[key] = idempotencyEntry{response: response, expires: s.now().Add(ttl)}
+	return nil
+}
+
+// Release implements IdempotencyStore.
+func (s *MemoryIdempotencyStore) Release(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, key)
+	return nil
+}
@@ -0,0 +1,331 @@
+package form
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kdsmith18542/gokit/observability"
+)
+
+type paymentForm struct {
+	Amount int `form:"amount" json:"amount" validate:"required,min=1"`
+}
+
+// newPaymentHandler returns a guarded handler that validates paymentForm from forms and
+// JSON bodies and counts successful payments
+func newPaymentHandler(g *IdempotencyGuard, payments *atomic.Int32) http.Handler {
+	decoder := NewDecoder(DecoderOptions{Strict: true})
+	pay := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f := ValidatedFormFromContext(r.Context()).(*paymentForm)
+		w.Header().Set("X-Payment", strconv.Itoa(int(payments.Add(1))))
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, "charged %d", f.Amount)
+	})
+	return g.Middleware(decoder.ValidationMiddleware(paymentForm{}, nil)(pay))
+}
+
+// postPayment posts a form with the given values and returns the recorded response
+func postPayment(handler http.Handler, values url.Values) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/pay", strings.NewReader(values.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestIdempotencyGuardReplaysForms(t *testing.T) {
+	g := NewIdempotencyGuard(IdempotencyOptions{})
+	var payments atomic.Int32
+	handler := newPaymentHandler(g, &payments)
+
+	token := g.Token()
+	values := url.Values{"amount": {"5"}, DefaultIdempotencyField: {token}}
+	first := postPayment(handler, values)
+	if first.Code != http.StatusCreated || first.Body.String() != "charged 5" {
+		t.Fatalf("Expected the payment to run, got %d %q", first.Code, first.Body.String())
+	}
+
+	replayed := postPayment(handler, values)
+	if payments.Load() != 1 {
+		t.Errorf("Expected one payment, got %d", payments.Load())
+	}
+	if replayed.Code != first.Code || replayed.Body.String() != first.Body.String() ||
+		replayed.Header().Get("X-Payment") != "1" || replayed.Header().Get("Idempotent-Replayed") != "true" {
+		t.Errorf("Expected the recorded response, got %d %q %v", replayed.Code, replayed.Body.String(), replayed.Header())
+	}
+
+	// A new token is a new submission, as is the same token at another endpoint
+	values.Set(DefaultIdempotencyField, g.Token())
+	postPayment(handler, values)
+	req := httptest.NewRequest(http.MethodPost, "/pay/again", strings.NewReader(values.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	if payments.Load() != 3 {
+		t.Errorf("Expected new tokens and endpoints to run the handler, got %d payments", payments.Load())
+	}
+
+	// Without a token the handler runs unprotected
+	postPayment(handler, url.Values{"amount": {"5"}})
+	postPayment(handler, url.Values{"amount": {"5"}})
+	if payments.Load() != 5 {
+		t.Errorf("Expected submissions without a token to run, got %d payments", payments.Load())
+	}
+}
+
+func TestIdempotencyGuardJSON(t *testing.T) {
+	g := NewIdempotencyGuard(IdempotencyOptions{RequireKey: true})
+	var payments atomic.Int32
+	handler := newPaymentHandler(g, &payments)
+
+	send := func(key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/pay", strings.NewReader(`{"amount": 7}`))
+		req.Header.Set("Content-Type", "application/json")
+		if key != "" {
+			req.Header.Set("Idempotency-Key", key)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	for i := 0; i < 3; i++ {
+		if rec := send("order-42"); rec.Code != http.StatusCreated || rec.Body.String() != "charged 7" {
+			t.Fatalf("Expected attempt %d to get the payment response, got %d %q", i, rec.Code, rec.Body.String())
+		}
+	}
+	if payments.Load() != 1 {
+		t.Errorf("Expected one payment, got %d", payments.Load())
+	}
+
+	rec := send("")
+	if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), idempotencyCodeKeyRequired) {
+		t.Errorf("Expected a missing key to be rejected, got %d %q", rec.Code, rec.Body.String())
+	}
+}
+
+func TestIdempotencyGuardKeyReuse(t *testing.T) {
+	g := NewIdempotencyGuard(IdempotencyOptions{})
+	var payments atomic.Int32
+	handler := newPaymentHandler(g, &payments)
+
+	token := g.Token()
+	postPayment(handler, url.Values{"amount": {"5"}, DefaultIdempotencyField: {token}})
+	rec := postPayment(handler, url.Values{"amount": {"500"}, DefaultIdempotencyField: {token}})
+	if rec.Code != http.StatusUnprocessableEntity || !strings.Contains(rec.Body.String(), idempotencyCodeKeyReused) {
+		t.Errorf("Expected a reused key to be rejected, got %d %q", rec.Code, rec.Body.String())
+	}
+
+	send := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/pay", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Idempotency-Key", "order-43")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+	if rec := send(`{"amount": 7}`); rec.Code != http.StatusCreated {
+		t.Fatalf("Expected the payment to run, got %d %q", rec.Code, rec.Body.String())
+	}
+	if rec := send(`{"amount": 70}`); rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("Expected a reused key to be rejected, got %d %q", rec.Code, rec.Body.String())
+	}
+	if rec := send(`{"amount": 7}`); rec.Code != http.StatusCreated || rec.Header().Get("Idempotent-Replayed") != "true" {
+		t.Errorf("Expected the same body to be replayed, got %d %q", rec.Code, rec.Body.String())
+	}
+	if payments.Load() != 2 {
+		t.Errorf("Expected two payments, got %d", payments.Load())
+	}
+}
+
+func TestIdempotencyGuardHashesFileContents(t *testing.T) {
+	g := NewIdempotencyGuard(IdempotencyOptions{})
+	handler := g.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	}))
+	upload := func(content string) int {
+		var body bytes.Buffer
+		mw := multipart.NewWriter(&body)
+		part, _ := mw.CreateFormFile("receipt", "receipt.txt")
+		part.Write([]byte(content))
+		mw.Close()
+		req := httptest.NewRequest(http.MethodPost, "/upload", &body)
+		req.Header.Set("Content-Type", mw.FormDataContentType())
+		req.Header.Set("Idempotency-Key", "upload-1")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	if code := upload("first"); code != http.StatusCreated {
+		t.Fatalf("Expected the upload to run, got %d", code)
+	}
+	if code := upload("other"); code != http.StatusUnprocessableEntity {
+		t.Errorf("Expected a different file of the same size to be rejected, got %d", code)
+	}
+	if code := upload("first"); code != http.StatusCreated {
+		t.Errorf("Expected the same file to be replayed, got %d", code)
+	}
+}
+
+func TestIdempotencyGuardFailuresRelease(t *testing.T) {
+	g := NewIdempotencyGuard(IdempotencyOptions{})
+	var payments atomic.Int32
+	handler := newPaymentHandler(g, &payments)
+
+	// A submission with validation errors can be corrected and sent with the same token
+	token := g.Token()
+	if rec := postPayment(handler, url.Values{"amount": {"0"}, DefaultIdempotencyField: {token}}); rec.Code != http.StatusBadRequest {
+		t.Fatalf("Expected a validation failure, got %d", rec.Code)
+	}
+	if rec := postPayment(handler, url.Values{"amount": {"3"}, DefaultIdempotencyField: {token}}); rec.Code != http.StatusCreated {
+		t.Errorf("Expected the corrected submission to run, got %d %q", rec.Code, rec.Body.String())
+	}
+
+	// A panicking handler releases its key
+	panicking := g.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}))
+	func() {
+		defer func() { recover() }()
+		postPayment(panicking, url.Values{DefaultIdempotencyField: {"panic"}})
+	}()
+	stored, err := g.opts.Store.Reserve(context.Background(), "POST /pay\x00panic", time.Minute)
+	if stored != nil || err != nil {
+		t.Errorf("Expected the key of a panicking handler to be free, got %v %v", stored, err)
+	}
+}
+
+func TestIdempotencyGuardInProgress(t *testing.T) {
+	g := NewIdempotencyGuard(IdempotencyOptions{})
+	started, release := make(chan struct{}), make(chan struct{})
+	handler := g.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		w.Write([]byte("done"))
+	}))
+
+	values := url.Values{DefaultIdempotencyField: {"double-click"}}
+	done := make(chan *httptest.ResponseRecorder)
+	go func() { done <- postPayment(handler, values) }()
+	<-started
+
+	rec := postPayment(handler, values)
+	if rec.Code != http.StatusConflict || !strings.Contains(rec.Body.String(), idempotencyCodeInProgress) {
+		t.Errorf("Expected 409 while the first submission runs, got %d %q", rec.Code, rec.Body.String())
+	}
+	close(release)
+	if first := <-done; first.Body.String() != "done" {
+		t.Errorf("Expected the first submission to finish, got %q", first.Body.String())
+	}
+	if rec := postPayment(handler, values); rec.Body.String() != "done" || rec.Header().Get("Idempotent-Replayed") != "true" {
+		t.Errorf("Expected a replay after completion, got %d %q", rec.Code, rec.Body.String())
+	}
+}
+
+// unavailableStore is an IdempotencyStore that is always unavailable
+type unavailableStore struct{ MemoryIdempotencyStore }
+
+func (*unavailableStore) Reserve(ctx context.Context, key string, lock time.Duration) (*StoredResponse, error) {
+	return nil, errors.New("unavailable")
+}
+
+// failingReleaseStore is an IdempotencyStore whose releases fail
+type failingReleaseStore struct{ *MemoryIdempotencyStore }
+
+func (failingReleaseStore) Release(ctx context.Context, key string) error {
+	return errors.New("unavailable")
+}
+
+// storageObserver records the storage operations reported to observability, passing
+// other events on to the observer it wraps
+type storageObserver struct {
+	observability.Observer
+	operations []string
+}
+
+func (o *storageObserver) OnStorageOperation(ctx context.Context, operation, storageType string, duration time.Duration, success bool) {
+	o.operations = append(o.operations, fmt.Sprintf("%s %s %t", storageType, operation, success))
+}
+
+func TestIdempotencyGuardReportsStoreFailures(t *testing.T) {
+	previous := observability.GetObserver()
+	obs := &storageObserver{Observer: previous}
+	observability.SetObserver(obs)
+	t.Cleanup(func() { observability.SetObserver(previous) })
+
+	g := NewIdempotencyGuard(IdempotencyOptions{Store: failingReleaseStore{NewMemoryIdempotencyStore()}})
+	handler := g.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	postPayment(handler, url.Values{DefaultIdempotencyField: {"k"}})
+
+	expected := []string{"idempotency reserve true", "idempotency release false"}
+	if !reflect.DeepEqual(obs.operations, expected) {
+		t.Errorf("Expected %v, got %v", expected, obs.operations)
+	}
+}
+
+func TestIdempotencyGuardOptions(t *testing.T) {
+	g := NewIdempotencyGuard(IdempotencyOptions{Store: &unavailableStore{}, Field: "once"})
+	if field := string(g.Field()); !strings.HasPrefix(field, `<input type="hidden" name="once" value="`) {
+		t.Errorf("Expected a hidden input named once, got %s", field)
+	}
+	if g.Token() == g.Token() {
+		t.Error("Expected tokens to be unique")
+	}
+
+	handler := g.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected the handler not to run when the store is unavailable")
+	}))
+	if rec := postPayment(handler, url.Values{"once": {"k"}}); rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("Expected 503, got %d", rec.Code)
+	}
+
+	// Responses over MaxReplayBytes aren't recorded
+	store := NewMemoryIdempotencyStore()
+	g = NewIdempotencyGuard(IdempotencyOptions{Store: store, MaxReplayBytes: 4})
+	var runs int
+	handler = g.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		runs++
+		w.Write([]byte("too long"))
+	}))
+	postPayment(handler, url.Values{DefaultIdempotencyField: {"k"}})
+	postPayment(handler, url.Values{DefaultIdempotencyField: {"k"}})
+	if runs != 2 {
+		t.Errorf("Expected an unrecorded response to run again, got %d runs", runs)
+	}
+}
+
+func TestMemoryIdempotencyStoreExpiry(t *testing.T) {
+	s := NewMemoryIdempotencyStore()
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	s.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	s.Reserve(ctx, "k", time.Minute)
+	now = now.Add(2 * time.Minute)
+	if stored, err := s.Reserve(ctx, "k", time.Minute); stored != nil || err != nil {
+		t.Errorf("Expected an expired claim to be reclaimable, got %v %v", stored, err)
+	}
+
+	s.Complete(ctx, "k", &StoredResponse{Status: http.StatusOK}, time.Hour)
+	if stored, _ := s.Reserve(ctx, "k", time.Minute); stored == nil || stored.Status != http.StatusOK {
+		t.Errorf("Expected the stored response, got %v", stored)
+	}
+	now = now.Add(2 * time.Hour)
+	if stored, err := s.Reserve(ctx, "k", time.Minute); stored != nil || err != nil {
+		t.Errorf("Expected an expired response to be forgotten, got %v %v", stored, err)
+	}
+}
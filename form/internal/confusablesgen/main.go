@@ -0,0 +1,129 @@
+// Command confusablesgen generates the form package's confusables table from the
+// Unicode confusables.txt data file. It runs offline against the bundled copy:
+//
+//	go run ./internal/confusablesgen -input internal/confusablesgen/confusables.txt -output confusables_table.go
+//
+// Only single-character sources are kept, since the table is looked up rune by rune.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type mapping struct {
+	source rune
+	target string
+	name   string
+}
+
+func main() {
+	input := flag.String("input", "confusables.txt", "Path to confusables.txt")
+	output := flag.String("output", "confusables_table.go", "Path of the generated Go file")
+	flag.Parse()
+
+	mappings, err := parse(*input)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	src, err := render(mappings, filepath.Base(*input))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// #nosec G306 -- generated Go source is meant to be readable like any other source file
+	if err := os.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parse reads the mappings from a confusables.txt file
+func parse(path string) ([]mapping, error) {
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
+
+	var mappings []mapping
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimPrefix(scanner.Text(), "\ufeff")
+		data, comment, _ := strings.Cut(text, "#")
+		if strings.TrimSpace(data) == "" {
+			continue
+		}
+
+		fields := strings.Split(data, ";")
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("%s:%d: expected source ; target", path, line)
+		}
+		source, err := parseCodePoints(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, line, err)
+		}
+		target, err := parseCodePoints(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, line, err)
+		}
+		if len(source) != 1 {
+			continue
+		}
+
+		// Comments read "( а → a ) CYRILLIC SMALL LETTER A → LATIN SMALL LETTER A"; the
+		// characters are cut off whole, since either may be a parenthesis
+		name := strings.TrimSpace(comment)
+		if after, ok := strings.CutPrefix(name, "( "+string(source)+" → "+string(target)+" )"); ok {
+			name = after
+		} else if _, after, ok := strings.Cut(name, ")"); ok {
+			name = after
+		}
+		name, _, _ = strings.Cut(name, "→")
+
+		mappings = append(mappings, mapping{source: source[0], target: string(target), name: strings.TrimSpace(name)})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(mappings, func(i, j int) bool { return mappings[i].source < mappings[j].source })
+	return mappings, nil
+}
+
+// parseCodePoints parses space-separated hexadecimal code points
+func parseCodePoints(field string) ([]rune, error) {
+	var runes []rune
+	for _, hex := range strings.Fields(field) {
+		n, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid code point %q", hex)
+		}
+		runes = append(runes, rune(n))
+	}
+	return runes, nil
+}
+
+// render writes the table as Go source
+func render(mappings []mapping, inputName string) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by confusablesgen from %s. DO NOT EDIT.\n\n", inputName)
+	buf.WriteString("package form\n\n")
+	buf.WriteString("// confusables maps characters to the prototype they are visually confusable with,\n")
+	buf.WriteString("// from the Unicode confusables data (UTS #39).\n")
+	buf.WriteString("var confusables = map[rune]string{\n")
+	for _, m := range mappings {
+		fmt.Fprintf(&buf, "0x%04X: %+q, // %s\n", m.source, m.target, m.name)
+	}
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestTableIsCurrent fails if the committed table doesn't match the bundled data.
+// Regenerate it with `go generate ./form`.
+func TestTableIsCurrent(t *testing.T) {
+	mappings, err := parse("confusables.txt")
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	src, err := render(mappings, "confusables.txt")
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+
+	committed, err := os.ReadFile(filepath.Join("..", "..", "confusables_table.go"))
+	if err != nil {
+		t.Fatalf("Failed to read committed table: %v", err)
+	}
+	if !bytes.Equal(src, committed) {
+		t.Error("confusables_table.go is out of date; run go generate ./form")
+	}
+}
+
+func TestParseSkipsMultiCharacterSources(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "confusables.txt")
+	data := "\ufeff# comment\n\n0430 ;\t0061 ;\tMA\t# ( а → a ) CYRILLIC SMALL LETTER A → LATIN SMALL LETTER A\t#\n" +
+		"0072 006E ;\t006D ;\tMA\t# ( rn → m ) LATIN SMALL LETTER R, LATIN SMALL LETTER N → LATIN SMALL LETTER M\t#\n" +
+		"FF09 ;\t0029 ;\tMA\t# ( ） → ) ) FULLWIDTH RIGHT PARENTHESIS → RIGHT PARENTHESIS\t#\n"
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	mappings, err := parse(path)
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	if len(mappings) != 2 || mappings[0].source != 0x0430 || mappings[0].target != "a" || mappings[0].name != "CYRILLIC SMALL LETTER A" {
+		t.Errorf("Unexpected mappings: %+v", mappings)
+	}
+	if len(mappings) == 2 && mappings[1].name != "FULLWIDTH RIGHT PARENTHESIS" {
+		t.Errorf("Expected the name after a parenthesis target, got %q", mappings[1].name)
+	}
+}
@@ -0,0 +1,158 @@
+// Command countrygen generates the form package's country format table, used by the
+// postal_code, phone and national_id rules, from the bundled countries.txt:
+//
+//	go run ./internal/countrygen -input internal/countrygen/countries.txt -output countries_table.go
+//
+// Every pattern is compiled while generating, so a bad edit fails here rather than at
+// program start.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+type country struct {
+	code        string
+	postalCode  string
+	callingCode string
+	trunkPrefix string
+	phone       string
+	nationalID  string
+	idChecksum  string
+}
+
+var (
+	codePattern   = regexp.MustCompile(`^[A-Z]{2}$`)
+	digitsPattern = regexp.MustCompile(`^\d*$`)
+	namePattern   = regexp.MustCompile(`^[a-z0-9_]*$`)
+)
+
+func main() {
+	input := flag.String("input", "countries.txt", "Path to countries.txt")
+	output := flag.String("output", "countries_table.go", "Path of the generated Go file")
+	flag.Parse()
+
+	countries, err := parse(*input)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	src, err := render(countries, filepath.Base(*input))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// #nosec G306 -- generated Go source is meant to be readable like any other source file
+	if err := os.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parse reads and checks the countries of a countries.txt file
+func parse(path string) ([]country, error) {
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
+
+	var countries []country
+	seen := make(map[string]bool)
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		data, _, _ := strings.Cut(scanner.Text(), "#")
+		if strings.TrimSpace(data) == "" {
+			continue
+		}
+
+		fields := strings.Split(data, ";")
+		if len(fields) != 7 {
+			return nil, fmt.Errorf("%s:%d: expected 7 fields, got %d", path, line, len(fields))
+		}
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		c := country{
+			code: fields[0], postalCode: fields[1], callingCode: fields[2], trunkPrefix: fields[3],
+			phone: fields[4], nationalID: fields[5], idChecksum: fields[6],
+		}
+
+		switch {
+		case !codePattern.MatchString(c.code):
+			return nil, fmt.Errorf("%s:%d: invalid country code %q", path, line, c.code)
+		case seen[c.code]:
+			return nil, fmt.Errorf("%s:%d: duplicate country %s", path, line, c.code)
+		case !digitsPattern.MatchString(c.callingCode) || !digitsPattern.MatchString(c.trunkPrefix):
+			return nil, fmt.Errorf("%s:%d: calling code and trunk prefix must be digits", path, line)
+		case (c.callingCode == "") != (c.phone == ""):
+			return nil, fmt.Errorf("%s:%d: calling code and national number go together", path, line)
+		case !namePattern.MatchString(c.idChecksum) || c.idChecksum != "" && c.nationalID == "":
+			return nil, fmt.Errorf("%s:%d: invalid ID checksum %q", path, line, c.idChecksum)
+		}
+		for _, pattern := range []string{c.postalCode, c.phone, c.nationalID} {
+			if _, err := regexp.Compile(anchor(pattern)); err != nil {
+				return nil, fmt.Errorf("%s:%d: %w", path, line, err)
+			}
+		}
+		seen[c.code] = true
+		countries = append(countries, c)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(countries, func(i, j int) bool { return countries[i].code < countries[j].code })
+	return countries, nil
+}
+
+// anchor makes a pattern match whole values
+func anchor(pattern string) string {
+	return "^(?:" + pattern + ")$"
+}
+
+// render writes the table as Go source
+func render(countries []country, inputName string) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by countrygen from %s. DO NOT EDIT.\n\n", inputName)
+	buf.WriteString("package form\n\n")
+	buf.WriteString("import \"regexp\"\n\n")
+	buf.WriteString("// countryFormats holds the postal code, phone number and national ID formats of each\n")
+	buf.WriteString("// country, by ISO 3166-1 alpha-2 code.\n")
+	buf.WriteString("var countryFormats = map[string]countryFormat{\n")
+	for _, c := range countries {
+		fmt.Fprintf(&buf, "%q: {\n", c.code)
+		writePattern(&buf, "postalCode", c.postalCode)
+		if c.callingCode != "" {
+			fmt.Fprintf(&buf, "callingCode: %q,\n", c.callingCode)
+		}
+		if c.trunkPrefix != "" {
+			fmt.Fprintf(&buf, "trunkPrefix: %q,\n", c.trunkPrefix)
+		}
+		writePattern(&buf, "phone", c.phone)
+		writePattern(&buf, "nationalID", c.nationalID)
+		if c.idChecksum != "" {
+			fmt.Fprintf(&buf, "idChecksum: %q,\n", c.idChecksum)
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
+
+// writePattern writes a compiled pattern field, if the pattern is set
+func writePattern(buf *bytes.Buffer, field, pattern string) {
+	if pattern == "" {
+		return
+	}
+	fmt.Fprintf(buf, "%s: regexp.MustCompile(`%s`),\n", field, anchor(pattern))
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestTableIsCurrent fails if the committed table doesn't match the bundled data.
+// Regenerate it with `go generate ./form`.
+func TestTableIsCurrent(t *testing.T) {
+	countries, err := parse("countries.txt")
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	src, err := render(countries, "countries.txt")
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+
+	committed, err := os.ReadFile(filepath.Join("..", "..", "countries_table.go"))
+	if err != nil {
+		t.Fatalf("Failed to read committed table: %v", err)
+	}
+	if !bytes.Equal(src, committed) {
+		t.Error("countries_table.go is out of date; run go generate ./form")
+	}
+}
+
+func TestParseRejectsBadRows(t *testing.T) {
+	for name, row := range map[string]string{
+		"code":      "Spain ; \\d{5} ; 34 ; ; [5-9]\\d{8} ; ;",
+		"fields":    "ES ; \\d{5} ; 34",
+		"pattern":   "ES ; [0-9 ; 34 ; ; [5-9]\\d{8} ; ;",
+		"calling":   "ES ; \\d{5} ; +34 ; ; [5-9]\\d{8} ; ;",
+		"checksum":  "ES ; \\d{5} ; 34 ; ; [5-9]\\d{8} ; ; es_nif",
+		"duplicate": "ES ; \\d{5} ; 34 ; ; [5-9]\\d{8} ; ;\nES ; \\d{5} ; 34 ; ; [5-9]\\d{8} ; ;",
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "countries.txt")
+			if err := os.WriteFile(path, []byte("# comment\n"+row+"\n"), 0600); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := parse(path); err == nil || !strings.Contains(err.Error(), "countries.txt:") {
+				t.Errorf("Expected a located error, got %v", err)
+			}
+		})
+	}
+}
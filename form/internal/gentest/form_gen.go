@@ -0,0 +1,333 @@
+// Code generated by gokit form gen. DO NOT EDIT.
+
+package gentest
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"strconv"
+
+	"github.com/kdsmith18542/gokit/form"
+)
+
+var _ form.GeneratedForm = (*BookingForm)(nil)
+
+// DecodeForm decodes and validates values into BookingForm without reflection.
+func (f *BookingForm) DecodeForm(values url.Values) form.ValidationErrors {
+	return f.DecodeFormContext(context.Background(), values)
+}
+
+// DecodeFormContext is DecodeForm, recording failed rules in the validation of ctx.
+func (f *BookingForm) DecodeFormContext(ctx context.Context, values url.Values) form.ValidationErrors {
+	d := form.NewGenDecoderContext(ctx, values)
+	if v, ok := d.Scalar("start", "Start", ""); ok {
+		if t, ok := form.ParseTime(v, "required,datetime=local,tz=Europe/Paris,after=now,business_hours"); ok {
+			f.Start = t
+		}
+	}
+	if v, ok := d.Scalar("birthday", "Birthday", ""); ok {
+		if t, ok := form.ParseTime(v, "datetime=date,min_age=18"); ok {
+			f.Birthday = t
+		}
+	}
+	d.Default("created", "2026-01-02T15:04:05Z", "", false)
+	if v, ok := d.Scalar("created", "Created", ""); ok {
+		if t, ok := form.ParseTime(v, ""); ok {
+			f.Created = t
+		}
+	}
+	d.Scalar("updated", "updated", "")
+	d.Validate("start", "required,datetime=local,tz=Europe/Paris,after=now,business_hours", reflect.Struct)
+	d.Validate("birthday", "datetime=date,min_age=18", reflect.Struct)
+	d.Validate("created", "", reflect.Struct)
+	d.Validate("updated", "", reflect.Struct)
+	return d.Errors()
+}
+
+// Validate validates the current field values of BookingForm without reflection.
+func (f *BookingForm) Validate() form.ValidationErrors {
+	return f.ValidateContext(context.Background())
+}
+
+// ValidateContext is Validate, recording failed rules in the validation of ctx.
+func (f *BookingForm) ValidateContext(ctx context.Context) form.ValidationErrors {
+	d := form.NewGenValidator(ctx)
+	d.SetScalar("start", "Start", form.FormatTime(f.Start, "required,datetime=local,tz=Europe/Paris,after=now,business_hours"))
+	d.SetScalar("birthday", "Birthday", form.FormatTime(f.Birthday, "datetime=date,min_age=18"))
+	d.SetScalar("created", "Created", form.FormatTime(f.Created, ""))
+	d.SetScalar("updated", "updated", "")
+	d.Validate("start", "required,datetime=local,tz=Europe/Paris,after=now,business_hours", reflect.Struct)
+	d.Validate("birthday", "datetime=date,min_age=18", reflect.Struct)
+	d.Validate("created", "", reflect.Struct)
+	d.Validate("updated", "", reflect.Struct)
+	return d.Errors()
+}
+
+var _ form.GeneratedForm = (*ProfileForm)(nil)
+
+// DecodeForm decodes and validates values into ProfileForm without reflection.
+func (f *ProfileForm) DecodeForm(values url.Values) form.ValidationErrors {
+	return f.DecodeFormContext(context.Background(), values)
+}
+
+// DecodeFormContext is DecodeForm, recording failed rules in the validation of ctx.
+func (f *ProfileForm) DecodeFormContext(ctx context.Context, values url.Values) form.ValidationErrors {
+	d := form.NewGenDecoderContext(ctx, values)
+	if vs, ok := d.Slice("tags", "Tags", "trim"); ok {
+		f.Tags = vs
+	}
+	if vs, ok := d.Slice("scores", "Scores", ""); ok {
+		s := make([]int, len(vs))
+		for i, v := range vs {
+			if v != "" {
+				if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+					s[i] = int(n)
+				}
+			}
+		}
+		f.Scores = s
+	}
+	d.Default("weights", "1,2.5", "", true)
+	if vs, ok := d.Slice("weights", "Weights", ""); ok {
+		s := make([]float64, len(vs))
+		for i, v := range vs {
+			if v != "" {
+				if n, err := strconv.ParseFloat(v, 64); err == nil {
+					s[i] = n
+				}
+			}
+		}
+		f.Weights = s
+	}
+	if vs, ok := d.Slice("labels", "Labels", "to_upper"); ok {
+		s := make([]Label, len(vs))
+		for i, v := range vs {
+			s[i] = Label(v)
+		}
+		f.Labels = s
+	}
+	if keys, vs, ok := d.Map("attrs", "Attrs", ""); ok {
+		m := make(map[string]string, len(keys))
+		for i, k := range keys {
+			m[k] = vs[i]
+		}
+		f.Attrs = m
+	}
+	if keys, vs, ok := d.Map("limits", "Limits", ""); ok {
+		m := make(map[Label]uint, len(keys))
+		for i, k := range keys {
+			var e uint
+			if vs[i] != "" {
+				if n, err := strconv.ParseUint(vs[i], 10, 64); err == nil {
+					e = uint(n)
+				}
+			}
+			m[Label(k)] = e
+		}
+		f.Limits = m
+	}
+	if keys, vs, ok := d.Map("flags", "Flags", ""); ok {
+		m := make(map[string]bool, len(keys))
+		for i, k := range keys {
+			var e bool
+			if vs[i] != "" {
+				if b, err := strconv.ParseBool(vs[i]); err == nil {
+					e = b
+				}
+			}
+			m[k] = e
+		}
+		f.Flags = m
+	}
+	d.Scalar("avatar", "Avatar", "")
+	d.Scalar("checksum", "Checksum", "")
+	d.Validate("tags", "required,min=1,max=3,unique,dive,alpha", reflect.String)
+	d.Validate("scores", "dive,min=1,max=10", reflect.Int)
+	d.Validate("weights", "", reflect.Float64)
+	d.Validate("labels", "", reflect.String)
+	d.Validate("attrs", "dive,keys,alpha,endkeys,required", reflect.String)
+	d.Validate("limits", "dive,max=99", reflect.Uint)
+	d.Validate("flags", "", reflect.Bool)
+	d.Validate("avatar", "", reflect.Slice)
+	d.Validate("checksum", "", reflect.Uintptr)
+	return d.Errors()
+}
+
+// Validate validates the current field values of ProfileForm without reflection.
+func (f *ProfileForm) Validate() form.ValidationErrors {
+	return f.ValidateContext(context.Background())
+}
+
+// ValidateContext is Validate, recording failed rules in the validation of ctx.
+func (f *ProfileForm) ValidateContext(ctx context.Context) form.ValidationErrors {
+	d := form.NewGenValidator(ctx)
+	d.SetSlice("tags", "Tags", f.Tags)
+	{
+		s := make([]string, len(f.Scores))
+		for i, e := range f.Scores {
+			s[i] = strconv.FormatInt(int64(e), 10)
+		}
+		d.SetSlice("scores", "Scores", s)
+	}
+	{
+		s := make([]string, len(f.Weights))
+		for i, e := range f.Weights {
+			s[i] = strconv.FormatFloat(e, 'f', -1, 64)
+		}
+		d.SetSlice("weights", "Weights", s)
+	}
+	{
+		s := make([]string, len(f.Labels))
+		for i, e := range f.Labels {
+			s[i] = string(e)
+		}
+		d.SetSlice("labels", "Labels", s)
+	}
+	d.SetMap("attrs", "Attrs", f.Attrs)
+	{
+		m := make(map[string]string, len(f.Limits))
+		for k, e := range f.Limits {
+			m[string(k)] = strconv.FormatUint(uint64(e), 10)
+		}
+		d.SetMap("limits", "Limits", m)
+	}
+	{
+		m := make(map[string]string, len(f.Flags))
+		for k, e := range f.Flags {
+			m[k] = strconv.FormatBool(e)
+		}
+		d.SetMap("flags", "Flags", m)
+	}
+	d.SetScalar("avatar", "Avatar", "")
+	d.SetScalar("checksum", "Checksum", "")
+	d.Validate("tags", "required,min=1,max=3,unique,dive,alpha", reflect.String)
+	d.Validate("scores", "dive,min=1,max=10", reflect.Int)
+	d.Validate("weights", "", reflect.Float64)
+	d.Validate("labels", "", reflect.String)
+	d.Validate("attrs", "dive,keys,alpha,endkeys,required", reflect.String)
+	d.Validate("limits", "dive,max=99", reflect.Uint)
+	d.Validate("flags", "", reflect.Bool)
+	d.Validate("avatar", "", reflect.Slice)
+	d.Validate("checksum", "", reflect.Uintptr)
+	return d.Errors()
+}
+
+var _ form.GeneratedForm = (*SignUpForm)(nil)
+
+// DecodeForm decodes and validates values into SignUpForm without reflection.
+func (f *SignUpForm) DecodeForm(values url.Values) form.ValidationErrors {
+	return f.DecodeFormContext(context.Background(), values)
+}
+
+// DecodeFormContext is DecodeForm, recording failed rules in the validation of ctx.
+func (f *SignUpForm) DecodeFormContext(ctx context.Context, values url.Values) form.ValidationErrors {
+	d := form.NewGenDecoderContext(ctx, values)
+	if v, ok := d.Scalar("email", "Email", "trim,to_lower"); ok {
+		f.Email = v
+	}
+	if v, ok := d.Scalar("password", "Password", ""); ok {
+		f.Password = v
+	}
+	if v, ok := d.Scalar("confirm_password", "ConfirmPassword", ""); ok {
+		f.ConfirmPassword = v
+	}
+	if v, ok := d.Scalar("nickname", "Nickname", "trim,truncate=12"); ok {
+		f.Nickname = Label(v)
+	}
+	if v, ok := d.Scalar("age", "Age", ""); ok {
+		if v != "" {
+			if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+				f.Age = int(n)
+			}
+		}
+	}
+	d.Default("level", "3", "", false)
+	if v, ok := d.Scalar("level", "Level", ""); ok {
+		if v != "" {
+			if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+				f.Level = Level(n)
+			}
+		}
+	}
+	if v, ok := d.Scalar("score", "Score", ""); ok {
+		if v != "" {
+			if n, err := strconv.ParseFloat(v, 64); err == nil {
+				f.Score = float32(n)
+			}
+		}
+	}
+	if v, ok := d.Scalar("balance", "Balance", ""); ok {
+		if v != "" {
+			if n, err := strconv.ParseFloat(v, 64); err == nil {
+				f.Balance = n
+			}
+		}
+	}
+	if v, ok := d.Scalar("visits", "Visits", ""); ok {
+		if v != "" {
+			if n, err := strconv.ParseUint(v, 10, 64); err == nil {
+				f.Visits = uint16(n)
+			}
+		}
+	}
+	if v, ok := d.Scalar("newsletter", "Newsletter", ""); ok {
+		if v != "" {
+			if b, err := strconv.ParseBool(v); err == nil {
+				f.Newsletter = b
+			}
+		}
+	}
+	if v, ok := d.Scalar("bio", "Bio", "valid_utf8"); ok {
+		f.Bio = v
+	}
+	d.Scalar("note", "note", "")
+	d.Validate("email", "required,email", reflect.String)
+	d.Validate("password", "required,min=8", reflect.String)
+	d.Validate("confirm_password", "required,eqfield=password", reflect.String)
+	d.Validate("nickname", "omitempty,alphanumeric,min=3", reflect.String)
+	d.Validate("age", "min=18,max=130", reflect.Int)
+	d.Validate("level", "", reflect.Int8)
+	d.Validate("score", "max=100", reflect.Float32)
+	d.Validate("balance", "", reflect.Float64)
+	d.Validate("visits", "", reflect.Uint16)
+	d.Validate("newsletter", "", reflect.Bool)
+	d.Validate("bio", "", reflect.String)
+	d.Validate("note", "required", reflect.String)
+	return d.Errors()
+}
+
+// Validate validates the current field values of SignUpForm without reflection.
+func (f *SignUpForm) Validate() form.ValidationErrors {
+	return f.ValidateContext(context.Background())
+}
+
+// ValidateContext is Validate, recording failed rules in the validation of ctx.
+func (f *SignUpForm) ValidateContext(ctx context.Context) form.ValidationErrors {
+	d := form.NewGenValidator(ctx)
+	d.SetScalar("email", "Email", f.Email)
+	d.SetScalar("password", "Password", f.Password)
+	d.SetScalar("confirm_password", "ConfirmPassword", f.ConfirmPassword)
+	d.SetScalar("nickname", "Nickname", string(f.Nickname))
+	d.SetScalar("age", "Age", strconv.FormatInt(int64(f.Age), 10))
+	d.SetScalar("level", "Level", strconv.FormatInt(int64(f.Level), 10))
+	d.SetScalar("score", "Score", strconv.FormatFloat(float64(f.Score), 'f', -1, 32))
+	d.SetScalar("balance", "Balance", strconv.FormatFloat(f.Balance, 'f', -1, 64))
+	d.SetScalar("visits", "Visits", strconv.FormatUint(uint64(f.Visits), 10))
+	d.SetScalar("newsletter", "Newsletter", strconv.FormatBool(f.Newsletter))
+	d.SetScalar("bio", "Bio", f.Bio)
+	d.SetScalar("note", "note", "")
+	d.Validate("email", "required,email", reflect.String)
+	d.Validate("password", "required,min=8", reflect.String)
+	d.Validate("confirm_password", "required,eqfield=password", reflect.String)
+	d.Validate("nickname", "omitempty,alphanumeric,min=3", reflect.String)
+	d.Validate("age", "min=18,max=130", reflect.Int)
+	d.Validate("level", "", reflect.Int8)
+	d.Validate("score", "max=100", reflect.Float32)
+	d.Validate("balance", "", reflect.Float64)
+	d.Validate("visits", "", reflect.Uint16)
+	d.Validate("newsletter", "", reflect.Bool)
+	d.Validate("bio", "", reflect.String)
+	d.Validate("note", "required", reflect.String)
+	return d.Errors()
+}
@@ -0,0 +1,321 @@
+package gentest
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kdsmith18542/gokit/form"
+)
+
+// The plain types share the fixtures' fields but not their generated methods,
+// so decoding into them always takes the reflective path.
+type plainSignUpForm SignUpForm
+
+type plainProfileForm ProfileForm
+
+type plainBookingForm BookingForm
+
+func newRequest(values url.Values) *http.Request {
+	req, _ := http.NewRequest("POST", "/test", strings.NewReader(values.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return req
+}
+
+func TestSignUpFormParity(t *testing.T) {
+	tests := []struct {
+		name   string
+		values url.Values
+	}{
+		{
+			name: "valid",
+			values: url.Values{
+				"email":            {"  User@Example.COM "},
+				"-":                {"ignored"},
+				"password":         {"hunter2hunter2"},
+				"confirm_password": {"hunter2hunter2"},
+				"nickname":         {"  gopher  "},
+				"age":              {"30"},
+				"level":            {"-3"},
+				"score":            {"99.5"},
+				"balance":          {"-12.25"},
+				"visits":           {"7"},
+				"newsletter":       {"true"},
+				"note":             {"ignored"},
+			},
+		},
+		{
+			name: "invalid",
+			values: url.Values{
+				"email":            {"not-an-email"},
+				"password":         {"short"},
+				"confirm_password": {"different"},
+				"nickname":         {"has spaces and is far too long"},
+				"age":              {"12"},
+				"level":            {"300"},
+				"score":            {"100.5"},
+				"visits":           {"-1"},
+				"newsletter":       {"maybe"},
+				"bio":              {"\xff"},
+			},
+		},
+		{
+			name:   "empty",
+			values: url.Values{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var generated SignUpForm
+			generatedErrs := form.DecodeAndValidate(newRequest(tt.values), &generated)
+
+			var plain plainSignUpForm
+			plainErrs := form.DecodeAndValidate(newRequest(tt.values), &plain)
+
+			if !reflect.DeepEqual(generatedErrs, plainErrs) {
+				t.Errorf("Errors differ:\ngenerated:  %v\nreflective: %v", generatedErrs, plainErrs)
+			}
+			if !reflect.DeepEqual(generated, SignUpForm(plain)) {
+				t.Errorf("Values differ:\ngenerated:  %+v\nreflective: %+v", generated, plain)
+			}
+		})
+	}
+}
+
+func TestProfileFormParity(t *testing.T) {
+	tests := []struct {
+		name   string
+		values url.Values
+	}{
+		{
+			name: "valid",
+			values: url.Values{
+				"tags[]":        {" go ", "web"},
+				"scores[1]":     {"4"},
+				"scores[0]":     {"9"},
+				"weights":       {"0.25", "1.5"},
+				"labels":        {"a", "b"},
+				"attrs[color]":  {"red"},
+				"attrs[size]":   {"xl"},
+				"limits[daily]": {"10"},
+				"flags[beta]":   {"true"},
+				"flags[dark]":   {"0"},
+				"avatar":        {"ignored"},
+				"checksum":      {"42"},
+			},
+		},
+		{
+			name: "invalid",
+			values: url.Values{
+				"tags":          {"go", "go", "web", "x1"},
+				"scores":        {"0", "11", "nan"},
+				"attrs[1x]":     {""},
+				"limits[month]": {"100"},
+				"flags[beta]":   {"perhaps"},
+			},
+		},
+		{
+			name:   "empty",
+			values: url.Values{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var generated ProfileForm
+			generatedErrs := form.DecodeAndValidate(newRequest(tt.values), &generated)
+
+			var plain plainProfileForm
+			plainErrs := form.DecodeAndValidate(newRequest(tt.values), &plain)
+
+			if !reflect.DeepEqual(generatedErrs, plainErrs) {
+				t.Errorf("Errors differ:\ngenerated:  %v\nreflective: %v", generatedErrs, plainErrs)
+			}
+			if !reflect.DeepEqual(generated, ProfileForm(plain)) {
+				t.Errorf("Values differ:\ngenerated:  %+v\nreflective: %+v", generated, plain)
+			}
+		})
+	}
+}
+
+func TestBookingFormParity(t *testing.T) {
+	tests := []struct {
+		name   string
+		values url.Values
+	}{
+		{
+			name: "valid",
+			values: url.Values{
+				"start":    {"2099-06-01T10:30"},
+				"birthday": {"1990-05-04"},
+				"created":  {"2024-01-02T03:04:05Z"},
+				"updated":  {"2024-01-02T03:04:05Z"},
+			},
+		},
+		{
+			name: "invalid",
+			values: url.Values{
+				"start":    {"2099-06-06T10:30"},
+				"birthday": {"2099-01-01"},
+				"created":  {"yesterday"},
+			},
+		},
+		{
+			name: "unparseable",
+			values: url.Values{
+				"start":    {"2099-06-01 10:30"},
+				"birthday": {"04/05/1990"},
+			},
+		},
+		{
+			name:   "empty",
+			values: url.Values{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var generated BookingForm
+			generatedErrs := form.DecodeAndValidate(newRequest(tt.values), &generated)
+
+			var plain plainBookingForm
+			plainErrs := form.DecodeAndValidate(newRequest(tt.values), &plain)
+
+			if !reflect.DeepEqual(generatedErrs, plainErrs) {
+				t.Errorf("Errors differ:\ngenerated:  %v\nreflective: %v", generatedErrs, plainErrs)
+			}
+			if !reflect.DeepEqual(generated, BookingForm(plain)) {
+				t.Errorf("Values differ:\ngenerated:  %+v\nreflective: %+v", generated, plain)
+			}
+		})
+	}
+}
+
+func TestJSONParity(t *testing.T) {
+	body := `{"email":"a@b.co","password":"secret-pass","confirm_password":"secret-pass","age":41,"score":12.5,"newsletter":true}`
+
+	var generated SignUpForm
+	generatedErrs := form.DecodeAndValidateJSON(context.Background(), strings.NewReader(body), &generated)
+
+	var plain plainSignUpForm
+	plainErrs := form.DecodeAndValidateJSON(context.Background(), strings.NewReader(body), &plain)
+
+	if !reflect.DeepEqual(generatedErrs, plainErrs) {
+		t.Errorf("Errors differ:\ngenerated:  %v\nreflective: %v", generatedErrs, plainErrs)
+	}
+	if !reflect.DeepEqual(generated, SignUpForm(plain)) {
+		t.Errorf("Values differ:\ngenerated:  %+v\nreflective: %+v", generated, plain)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	f := ProfileForm{
+		Tags:   []string{"go", "go"},
+		Scores: []int{5, 20},
+		Attrs:  map[string]string{"color": ""},
+		Limits: map[Label]uint{"daily": 100},
+	}
+
+	expected := form.ValidationErrors{
+		"tags":          {"Must not contain duplicate values"},
+		"scores[1]":     {"Must be no more than 10"},
+		"attrs[color]":  {"This field is required"},
+		"limits[daily]": {"Must be no more than 99"},
+	}
+	if errs := f.Validate(); !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected %v, got %v", expected, errs)
+	}
+
+	f = ProfileForm{Tags: []string{"go"}}
+	if errs := f.Validate(); len(errs) > 0 {
+		t.Errorf("Expected no validation errors, got: %v", errs)
+	}
+}
+
+func TestGeneratedStatsParity(t *testing.T) {
+	stats := form.NewValidationStats()
+	form.RegisterValidationStats(stats)
+	t.Cleanup(func() { form.RegisterValidationStats(nil) })
+
+	values := url.Values{"email": {"nope"}, "password": {"short"}, "age": {"12"}}
+	var generated SignUpForm
+	form.DecodeAndValidate(newRequest(values), &generated)
+	var plain plainSignUpForm
+	form.DecodeAndValidate(newRequest(values), &plain)
+	form.ValidateStruct(context.Background(), &SignUpForm{Email: "ada@example.com", Age: 12})
+	form.ValidateStruct(context.Background(), &plainSignUpForm{Email: "ada@example.com", Age: 12})
+
+	forms := stats.Report().Forms
+	if len(forms) != 2 {
+		t.Fatalf("Expected both forms to be recorded, got %+v", forms)
+	}
+	if !reflect.DeepEqual(forms[0].Fields, forms[1].Fields) {
+		t.Errorf("Rules differ:\n%s: %+v\n%s: %+v", forms[0].Form, forms[0].Fields, forms[1].Form, forms[1].Fields)
+	}
+	for _, field := range forms[0].Fields {
+		if field.Rules["other"] > 0 {
+			t.Errorf("Expected the failed rules of %s to be named, got %v", field.Field, field.Rules)
+		}
+	}
+}
+
+func TestGeneratedDefaults(t *testing.T) {
+	var f SignUpForm
+	f.DecodeForm(url.Values{"nickname": {""}})
+	if f.Level != 3 {
+		t.Errorf("Expected the level default for an absent key, got %d", f.Level)
+	}
+
+	var p ProfileForm
+	p.DecodeForm(url.Values{})
+	if !reflect.DeepEqual(p.Weights, []float64{1, 2.5}) {
+		t.Errorf("Expected the weights default, got %v", p.Weights)
+	}
+	p = ProfileForm{}
+	p.DecodeForm(url.Values{"weights[0]": {"4"}})
+	if !reflect.DeepEqual(p.Weights, []float64{4}) {
+		t.Errorf("Expected input to replace the default, got %v", p.Weights)
+	}
+}
+
+func TestValidateStructParity(t *testing.T) {
+	generated := ProfileForm{
+		Tags:    []string{"go", "go", "x"},
+		Scores:  []int{0, 10},
+		Weights: []float64{0.1},
+		Attrs:   map[string]string{"size": "", "c0lor": "red"},
+		Limits:  map[Label]uint{"daily": 120},
+		Flags:   map[string]bool{"beta": true},
+	}
+	plain := plainProfileForm(generated)
+
+	generatedErrs := form.ValidateStruct(context.Background(), &generated)
+	plainErrs := form.ValidateStruct(context.Background(), &plain)
+	if !reflect.DeepEqual(generatedErrs, plainErrs) {
+		t.Errorf("Errors differ:\ngenerated:  %v\nreflective: %v", generatedErrs, plainErrs)
+	}
+
+	booking := BookingForm{Start: time.Date(2000, 1, 3, 10, 0, 0, 0, time.UTC), Birthday: time.Date(1990, 5, 4, 0, 0, 0, 0, time.UTC)}
+	plainBooking := plainBookingForm(booking)
+	generatedErrs = form.ValidateStruct(context.Background(), &booking)
+	plainErrs = form.ValidateStruct(context.Background(), &plainBooking)
+	if !reflect.DeepEqual(generatedErrs, plainErrs) {
+		t.Errorf("Errors differ:\ngenerated:  %v\nreflective: %v", generatedErrs, plainErrs)
+	}
+	if _, ok := generatedErrs["start"]; !ok {
+		t.Errorf("Expected a past start time to be rejected, got: %v", generatedErrs)
+	}
+
+	signUp := SignUpForm{Email: "a@b.co", Password: "longenough", ConfirmPassword: "different", Age: 12, Score: 100.25, Level: -2}
+	plainSignUp := plainSignUpForm(signUp)
+	generatedErrs = form.ValidateStruct(context.Background(), &signUp)
+	plainErrs = form.ValidateStruct(context.Background(), &plainSignUp)
+	if !reflect.DeepEqual(generatedErrs, plainErrs) {
+		t.Errorf("Errors differ:\ngenerated:  %v\nreflective: %v", generatedErrs, plainErrs)
+	}
+}
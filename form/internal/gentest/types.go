@@ -0,0 +1,59 @@
+// Package gentest holds fixture forms for `gokit form gen`. The committed form_gen.go is
+// regenerated by the formgen tests, and the parity tests check that the generated code
+// behaves exactly like the reflective decoder.
+package gentest
+
+import "time"
+
+//go:generate go run ../../../cmd/gokit form gen .
+
+// Level is a named integer type, to exercise conversions in generated code
+type Level int8
+
+// Label is a named string type, to exercise conversions in generated code
+type Label string
+
+// SignUpForm covers scalar fields, sanitizers, defaults and cross-field rules.
+type SignUpForm struct {
+	Email           string  `form:"email" sanitize:"trim,to_lower" validate:"required,email"`
+	Password        string  `form:"password" validate:"required,min=8"`
+	ConfirmPassword string  `form:"confirm_password" validate:"required,eqfield=password"`
+	Nickname        Label   `form:"nickname" sanitize:"trim,truncate=12" validate:"omitempty,alphanumeric,min=3"`
+	Age             int     `form:"age" validate:"min=18,max=130"`
+	Level           Level   `form:"level" default:"3"`
+	Score           float32 `form:"score" validate:"max=100"`
+	Balance         float64 `form:"balance"`
+	Visits          uint16  `form:"visits"`
+	Newsletter      bool    `form:"newsletter"`
+	Bio             string  `sanitize:"valid_utf8"`
+	Internal        string  `form:"-" validate:"required"`
+	note            string  `form:"note" validate:"required"`
+}
+
+// ProfileForm covers slice and map fields.
+type ProfileForm struct {
+	Tags     []string          `form:"tags" sanitize:"trim" validate:"required,min=1,max=3,unique,dive,alpha"`
+	Scores   []int             `form:"scores" validate:"dive,min=1,max=10"`
+	Weights  []float64         `form:"weights" default:"1,2.5"`
+	Labels   []Label           `form:"labels" sanitize:"to_upper"`
+	Attrs    map[string]string `form:"attrs" validate:"dive,keys,alpha,endkeys,required"`
+	Limits   map[Label]uint    `form:"limits" validate:"dive,max=99"`
+	Flags    map[string]bool   `form:"flags"`
+	Avatar   []byte            `form:"avatar"`
+	Checksum uintptr           `form:"checksum"`
+}
+
+// BookingForm covers time.Time fields with layouts, zones and relative bounds.
+type BookingForm struct {
+	Start    time.Time `form:"start" validate:"required,datetime=local,tz=Europe/Paris,after=now,business_hours"`
+	Birthday time.Time `form:"birthday" validate:"datetime=date,min_age=18"`
+	Created  time.Time `form:"created" default:"2026-01-02T15:04:05Z"`
+	updated  time.Time `form:"updated"`
+}
+
+// untagged has no form tags, so no code is generated for it
+type untagged struct {
+	Name string
+}
+
+var _ = untagged{}
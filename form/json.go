@@ -21,67 +21,7 @@ import (
 //	    // Handle validation errors
 //	}
 func DecodeAndValidateJSON(ctx context.Context, reader io.Reader, v interface{}) ValidationErrors {
-	start := time.Now()
-	formName := ""
-	if v != nil {
-		formName = reflect.TypeOf(v).Elem().Name()
-	}
-
-	if obs := getObserver(); obs != nil {
-		obs.OnDecodeStart(ctx, formName)
-	}
-
-	errors := make(ValidationErrors)
-
-	// Decode JSON into a map
-	var jsonData map[string]interface{}
-	if err := json.NewDecoder(reader).Decode(&jsonData); err != nil {
-		errors["_json"] = []string{"Failed to decode JSON: " + err.Error()}
-		if obs := getObserver(); obs != nil {
-			obs.OnDecodeEnd(ctx, formName, err)
-		}
-		return errors
-	}
-
-	// Convert map to form-like structure
-	formData := make(map[string][]string)
-	for key, value := range jsonData {
-		formData[key] = []string{toString(value)}
-	}
-
-	// Validate struct
-	val := reflect.ValueOf(v)
-	if val.Kind() != reflect.Ptr || val.IsNil() {
-		errors["_struct"] = []string{"Target must be a non-nil pointer to struct"}
-		if obs := getObserver(); obs != nil {
-			obs.OnDecodeEnd(ctx, formName, nil)
-		}
-		return errors
-	}
-
-	val = val.Elem()
-	if val.Kind() != reflect.Struct {
-		errors["_struct"] = []string{"Target must be a pointer to struct"}
-		if obs := getObserver(); obs != nil {
-			obs.OnDecodeEnd(ctx, formName, nil)
-		}
-		return errors
-	}
-
-	// First pass: collect all field values and apply sanitizers
-	fieldValues := processFormFields(val, formData)
-
-	if obs := getObserver(); obs != nil {
-		obs.OnDecodeEnd(ctx, formName, nil)
-		obs.OnValidationStart(ctx, formName)
-	}
-
-	// Second pass: validate fields
-	errors = validateFormFields(val, fieldValues)
-
-	handleFormObservability(ctx, formName, errors, start)
-
-	return errors
+	return defaultDecoder.DecodeAndValidateJSON(ctx, reader, v)
 }
 
 // DecodeAndValidateMap decodes and validates data from a map[string]interface{}.
@@ -111,7 +51,7 @@ func DecodeAndValidateMap(ctx context.Context, data map[string]interface{}, v in
 	// Convert map to form-like structure
 	formData := make(map[string][]string)
 	for key, value := range data {
-		formData[key] = []string{toString(value)}
+		addFormValue(formData, key, value)
 	}
 
 	// Validate struct
@@ -120,22 +60,40 @@ func DecodeAndValidateMap(ctx context.Context, data map[string]interface{}, v in
 	}
 	val := reflect.ValueOf(v).Elem()
 
-	// First pass: collect all field values and apply sanitizers
-	fieldValues := processFormFields(val, formData)
-
-	if obs := getObserver(); obs != nil {
-		obs.OnDecodeEnd(ctx, formName, nil)
-		obs.OnValidationStart(ctx, formName)
-	}
-
-	// Second pass: validate fields
-	errors = validateFormFields(val, fieldValues)
+	ctx = trackRules(ctx)
+	errors = defaultDecoder.decodeAndValidateFields(ctx, v, val, formData, formName, defaultDecoder.jsonTags)
 
 	handleFormObservability(ctx, formName, errors, start)
 
 	return errors
 }
 
+// addFormValue stores a decoded value in form data under key.
+// Scalars are stored as strings. Arrays are stored under key as a comma-joined string,
+// as they always have been, and element by element under "key[]" for slice fields.
+// Objects are stored under key as a JSON string and flattened as "key.name" for map fields.
+func addFormValue(formData map[string][]string, key string, value interface{}) {
+	switch v := value.(type) {
+	case []interface{}:
+		formData[key] = []string{toString(v)}
+		elements := make([]string, len(v))
+		for i, item := range v {
+			elements[i] = toString(item)
+		}
+		formData[key+"[]"] = elements
+	case []string:
+		formData[key] = []string{strings.Join(v, ",")}
+		formData[key+"[]"] = append([]string{}, v...)
+	case map[string]interface{}:
+		formData[key] = []string{toString(v)}
+		for name, item := range v {
+			addFormValue(formData, key+"."+name, item)
+		}
+	default:
+		formData[key] = []string{toString(v)}
+	}
+}
+
 // toString converts any value to a string representation.
 // This handles various JSON types (string, number, boolean, null).
 func toString(value interface{}) string {
@@ -0,0 +1,386 @@
+package form
+
+import (
+	"context"
+	"encoding/json"
+	"html/template"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kdsmith18542/gokit/i18n"
+)
+
+// Defaults for FieldValidationOptions, sized for validation on every keystroke
+const (
+	DefaultFieldCacheTTL  = 30 * time.Second
+	DefaultFieldCacheSize = 4096
+	DefaultFieldRateLimit = 10
+	DefaultFieldRateBurst = 20
+)
+
+// maxRateLimitClients is the number of clients tracked at most. Refilled buckets are
+// dropped first when it is reached.
+const maxRateLimitClients = 10000
+
+// defaultFieldTemplate renders a field's errors for HTMX. The container is rendered for
+// valid fields too, so that swapping it in clears earlier errors.
+var defaultFieldTemplate = template.Must(template.New("field").Parse(
+	`<div class="field-errors" data-field="{{.Field}}">` +
+		`{{range .Errors}}{{range .Messages}}<p class="field-error">{{.}}</p>{{end}}{{end}}` +
+		`</div>`))
+
+// FieldValidationOptions configures FieldValidationHandler. The zero value gives
+// defaults suited to validation on every keystroke.
+type FieldValidationOptions struct {
+	// FieldParam names the request parameter holding the key of the field to validate;
+	// it defaults to "field". Without it, the HX-Trigger-Name header sent by HTMX is used.
+	FieldParam string
+	// CacheTTL is how long a result is reused for the same field and form state. It
+	// defaults to DefaultFieldCacheTTL; a negative value disables caching.
+	CacheTTL time.Duration
+	// CacheSize bounds the number of cached results; it defaults to DefaultFieldCacheSize.
+	CacheSize int
+	// RateLimit is the sustained number of requests per second allowed for each client,
+	// and RateBurst the number allowed at once. They default to DefaultFieldRateLimit and
+	// DefaultFieldRateBurst; a negative RateLimit disables limiting.
+	RateLimit float64
+	RateBurst int
+	// ClientKey identifies the client a request is counted against; it defaults to the
+	// IP address of the request's RemoteAddr.
+	ClientKey func(r *http.Request) string
+	// Template renders HTML responses with a FieldResult. It defaults to a div of
+	// class "field-errors" holding one paragraph per message.
+	Template *template.Template
+	// AllowGET accepts GET requests with the form state in the query string. By default
+	// only POST is accepted, since a GET puts the whole state, passwords included, in
+	// URLs that end up in access logs, proxies and browser history.
+	AllowGET bool
+}
+
+// FieldResult is the outcome of validating a single field. Errors holds the field's
+// messages under its key, and those of collection elements under keys such as "tags[2]".
+type FieldResult struct {
+	Field  string        `json:"field"`
+	Valid  bool          `json:"valid"`
+	Errors OrderedErrors `json:"errors"`
+}
+
+// FieldValidationHandler returns a handler that validates one field of formStruct for
+// "validate as you type" UIs, using the package's default decoder. See
+// Decoder.FieldValidationHandler.
+func FieldValidationHandler(formStruct interface{}, opts FieldValidationOptions) http.Handler {
+	return defaultDecoder.FieldValidationHandler(formStruct, opts)
+}
+
+// FieldValidationHandler returns a handler that validates one field of formStruct.
+// POST requests carry the current form state as a urlencoded or multipart body, plus
+// the key of the field to validate in the "field" parameter. Other methods get 405,
+// unless AllowGET accepts the state in a query string.
+//
+// The whole state is decoded and sanitized as on submit, so cross-field rules such as
+// eqfield compare the same values, but only the requested field's rules run.
+// Struct-level validators don't run. Responses are HTML fragments for HTMX requests and
+// for clients that accept text/html, and JSON otherwise, always with status 200 so that
+// HTMX swaps them in. Unknown fields get 400 and clients over the rate limit 429.
+//
+// Identical requests within CacheTTL reuse the previous result, so rules backed by a
+// database or API run at most once per distinct input. Keep the TTL short for rules
+// whose answer can change, such as username availability. Requests carrying values of
+// sensitive fields are never cached, so passwords aren't kept in memory.
+//
+// Example:
+//
+//	mux.Handle("/signup/validate", form.FieldValidationHandler(SignUpForm{}, form.FieldValidationOptions{}))
+//
+//	<input name="username" hx-post="/signup/validate" hx-include="closest form"
+//	       hx-trigger="keyup changed delay:300ms" hx-target="next .field-errors" hx-swap="outerHTML">
+//	<div class="field-errors"></div>
+func (d *Decoder) FieldValidationHandler(formStruct interface{}, opts FieldValidationOptions) http.Handler {
+	typ := reflect.TypeOf(formStruct)
+	for typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if opts.FieldParam == "" {
+		opts.FieldParam = "field"
+	}
+	if opts.CacheTTL == 0 {
+		opts.CacheTTL = DefaultFieldCacheTTL
+	}
+	if opts.CacheSize <= 0 {
+		opts.CacheSize = DefaultFieldCacheSize
+	}
+	if opts.RateLimit == 0 {
+		opts.RateLimit = DefaultFieldRateLimit
+	}
+	if opts.RateBurst <= 0 {
+		opts.RateBurst = DefaultFieldRateBurst
+	}
+	if opts.ClientKey == nil {
+		opts.ClientKey = remoteIP
+	}
+	if opts.Template == nil {
+		opts.Template = defaultFieldTemplate
+	}
+	return &fieldValidator{
+		decoder: d,
+		typ:     typ,
+		opts:    opts,
+		cache:   make(map[string]cachedField),
+		buckets: make(map[string]*tokenBucket),
+		now:     time.Now,
+	}
+}
+
+// fieldValidator is the handler returned by FieldValidationHandler
+type fieldValidator struct {
+	decoder *Decoder
+	typ     reflect.Type
+	opts    FieldValidationOptions
+	now     func() time.Time
+
+	mu      sync.Mutex
+	cache   map[string]cachedField
+	buckets map[string]*tokenBucket
+}
+
+// cachedField is a cached result and its expiry
+type cachedField struct {
+	result  FieldResult
+	expires time.Time
+}
+
+// tokenBucket holds a client's rate limit state
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func (h *fieldValidator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost && (r.Method != http.MethodGet || !h.opts.AllowGET) {
+		w.Header().Set("Allow", h.allowedMethods())
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	if wait, ok := h.allow(h.opts.ClientKey(r)); !ok {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+		http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+		return
+	}
+
+	if r.Body != nil && r.Form == nil {
+		r.Body = &maxBytesReader{r: r.Body, n: h.decoder.opts.MaxBodyBytes}
+	}
+	err := r.ParseForm()
+	if err == nil && strings.Contains(r.Header.Get("Content-Type"), "multipart/form-data") {
+		// #nosec G120 -- request body capped by maxBytesReader wrapper above
+		err = r.ParseMultipartForm(h.decoder.opts.MaxMemory)
+	}
+	if err != nil {
+		http.Error(w, parseFailure(err, "Failed to parse form data"), http.StatusBadRequest)
+		return
+	}
+
+	// r.Form holds the query string and the body, including multipart values
+	formData := make(map[string][]string)
+	for key, values := range r.Form {
+		formData[key] = values
+	}
+	field := r.Header.Get("HX-Trigger-Name")
+	if values, ok := formData[h.opts.FieldParam]; ok {
+		field = values[0]
+		delete(formData, h.opts.FieldParam)
+	}
+
+	result, ok := h.result(r.Context(), field, formData)
+	if !ok {
+		http.Error(w, ErrUnknownField, http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Add("Vary", "Accept, HX-Request")
+	if r.Header.Get("HX-Request") == "true" || strings.Contains(r.Header.Get("Accept"), "text/html") {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if err := h.opts.Template.Execute(w, result); err != nil {
+			http.Error(w, "Failed to render field errors", http.StatusInternalServerError)
+		}
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
+
+// allowedMethods returns the methods the handler accepts, for the Allow header
+func (h *fieldValidator) allowedMethods() string {
+	if h.opts.AllowGET {
+		return "GET, POST"
+	}
+	return http.MethodPost
+}
+
+// result returns the cached result for field and formData or validates it. It returns
+// false if field isn't a field of the form. Results are cached per locale when the
+// decoder localizes input, and never when formData holds values of sensitive fields,
+// which would otherwise be kept in the cache keys.
+func (h *fieldValidator) result(ctx context.Context, field string, formData map[string][]string) (FieldResult, bool) {
+	cacheable := h.opts.CacheTTL > 0 && !h.hasSensitiveInput(formData)
+	cacheKey := field + "\x00" + url.Values(formData).Encode()
+	if h.decoder.opts.Localize {
+		cacheKey += "\x00" + i18n.LocaleFromContext(ctx)
+	}
+	if cacheable {
+		h.mu.Lock()
+		cached, ok := h.cache[cacheKey]
+		h.mu.Unlock()
+		if ok && h.now().Before(cached.expires) {
+			return cached.result, true
+		}
+	}
+
+	errors, ok := h.decoder.validateSingleField(ctx, h.typ, field, formData)
+	if !ok {
+		return FieldResult{}, false
+	}
+	result := FieldResult{Field: field, Valid: len(errors) == 0, Errors: errors.orderedByType(h.typ, h.decoder.tags)}
+
+	if cacheable {
+		h.mu.Lock()
+		h.store(cacheKey, cachedField{result: result, expires: h.now().Add(h.opts.CacheTTL)})
+		h.mu.Unlock()
+	}
+	return result, true
+}
+
+// hasSensitiveInput reports whether formData has a non-empty value for a sensitive field
+func (h *fieldValidator) hasSensitiveInput(formData map[string][]string) bool {
+	plan := planWithTags(h.typ, h.decoder.tags)
+	for key, values := range formData {
+		if f, ok := matchInputKey(plan, key); ok && f.sensitive != "" && strings.Join(values, "") != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// store caches a result, evicting expired entries, or any entry if none has expired,
+// when the cache is full. h.mu must be held.
+func (h *fieldValidator) store(key string, entry cachedField) {
+	if len(h.cache) >= h.opts.CacheSize {
+		now := h.now()
+		for k, cached := range h.cache {
+			if now.After(cached.expires) {
+				delete(h.cache, k)
+			}
+		}
+		for k := range h.cache {
+			if len(h.cache) < h.opts.CacheSize {
+				break
+			}
+			delete(h.cache, k)
+		}
+	}
+	h.cache[key] = entry
+}
+
+// allow takes a token from the client's bucket. When the bucket is empty it returns
+// false and the time until the next token.
+func (h *fieldValidator) allow(client string) (time.Duration, bool) {
+	if h.opts.RateLimit < 0 {
+		return 0, true
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.now()
+	burst := float64(h.opts.RateBurst)
+	b, ok := h.buckets[client]
+	if !ok {
+		if len(h.buckets) >= maxRateLimitClients {
+			// Forget clients whose buckets have refilled; they start full again anyway
+			for k, other := range h.buckets {
+				if other.tokens+now.Sub(other.last).Seconds()*h.opts.RateLimit >= burst {
+					delete(h.buckets, k)
+				}
+			}
+			// Then any others, so that many clients can't grow the map without bound
+			for k := range h.buckets {
+				if len(h.buckets) < maxRateLimitClients {
+					break
+				}
+				delete(h.buckets, k)
+			}
+		}
+		b = &tokenBucket{tokens: burst, last: now}
+		h.buckets[client] = b
+	}
+
+	b.tokens = math.Min(burst, b.tokens+now.Sub(b.last).Seconds()*h.opts.RateLimit)
+	b.last = now
+	if b.tokens < 1 {
+		return time.Duration((1 - b.tokens) / h.opts.RateLimit * float64(time.Second)), false
+	}
+	b.tokens--
+	return 0, true
+}
+
+// remoteIP returns the IP address of the request's RemoteAddr
+func remoteIP(r *http.Request) string {
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
+
+// validateSingleField decodes formData into a new struct of type typ and validates the
+// field with the given form key. It returns false if key isn't a field of typ.
+func (d *Decoder) validateSingleField(ctx context.Context, typ reflect.Type, key string, formData map[string][]string) (ValidationErrors, bool) {
+	if f, ok := matchInputKey(planWithTags(typ, d.tags), key); !ok || f.nested != nil || !strings.HasSuffix(key, f.key) {
+		return nil, false
+	}
+	return d.validateFields(ctx, reflect.New(typ), formData, func(k string) bool { return k == key }), true
+}
+
+// validateFields decodes formData into the struct ptr points to and validates the fields
+// whose form keys selected accepts. Other fields are decoded and sanitized so that
+// references resolve as they would for the whole form, but their rules don't run, and
+// neither do struct-level validators. Fields of nested structs behind nil pointers without
+// input aren't validated, as for the whole form.
+func (d *Decoder) validateFields(ctx context.Context, ptr reflect.Value, formData map[string][]string, selected func(key string) bool) ValidationErrors {
+	plan := planWithTags(ptr.Elem().Type(), d.tags)
+	if problems := referenceErrors(plan); len(problems) > 0 {
+		return ValidationErrors{"_struct": problems}
+	}
+	if inputErrors := d.checkInput(plan, formData); inputErrors != nil {
+		errors := make(ValidationErrors)
+		for key, messages := range inputErrors {
+			if key == FormErrorKey || selected(key) {
+				errors[key] = messages
+			}
+		}
+		if len(errors) > 0 {
+			return errors
+		}
+	}
+
+	decoded := processFormFields(ptr.Elem(), plan, formData, d.localeFor(ctx))
+	errors := make(ValidationErrors)
+	nodes := walkStructs(ptr, plan)
+	for _, node := range nodes {
+		s := node.scopes[len(node.scopes)-1]
+		context := ValidationContext{values: decoded.values, scopes: node.scopes}
+		for _, f := range s.plan.fields {
+			if key := s.prefix + f.key; f.nested == nil && selected(key) {
+				decoded.validateField(errors, key, f.validate, f.kind, context)
+			}
+		}
+	}
+	return sensitiveValues(nodes, formData, decoded).redact(errors)
+}
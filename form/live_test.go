@@ -0,0 +1,247 @@
+package form
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type liveForm struct {
+	Username string      `form:"username" sanitize:"trim" validate:"required,min=3,live_available"`
+	Password string      `form:"password" sanitize:"trim" validate:"required,min=8"`
+	Confirm  string      `form:"confirm" validate:"required,eqfield=password"`
+	Tags     []string    `form:"tags" validate:"max=2,dive,alpha"`
+	Address  liveAddress `form:"address"`
+}
+
+type liveAddress struct {
+	Country string `form:"country" validate:"required,alpha"`
+}
+
+// liveChecks counts calls to the live_available rule
+var liveChecks atomic.Int32
+
+func init() {
+	RegisterValidator("live_available", func(value string) string {
+		liveChecks.Add(1)
+		if value == "admin" {
+			return "Username already taken"
+		}
+		return ""
+	})
+}
+
+// newLiveHandler returns a field validation handler with a controllable clock
+func newLiveHandler(opts FieldValidationOptions) (*fieldValidator, *time.Time) {
+	h := FieldValidationHandler(liveForm{}, opts).(*fieldValidator)
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	h.now = func() time.Time { return now }
+	return h, &now
+}
+
+// serveField validates field with the given form state and returns the recorded response
+func serveField(h http.Handler, field string, values url.Values, headers ...string) *httptest.ResponseRecorder {
+	body := url.Values{"field": {field}}
+	for key, vs := range values {
+		body[key] = vs
+	}
+	req := httptest.NewRequest(http.MethodPost, "/validate", strings.NewReader(body.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	for i := 0; i+1 < len(headers); i += 2 {
+		req.Header.Set(headers[i], headers[i+1])
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func decodeFieldResult(t *testing.T, rec *httptest.ResponseRecorder) map[string]interface{} {
+	t.Helper()
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var result map[string]interface{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to decode response %q: %v", rec.Body.String(), err)
+	}
+	return result
+}
+
+func TestFieldValidationHandlerValidatesOneField(t *testing.T) {
+	h, _ := newLiveHandler(FieldValidationOptions{CacheTTL: -1})
+
+	// Only the requested field is reported, though the others are invalid too
+	rec := serveField(h, "username", url.Values{"username": {"ab"}})
+	expected := `{"field":"username","valid":false,"errors":{"username":["Must be at least 3 characters long"]}}`
+	if got := strings.TrimSpace(rec.Body.String()); rec.Code != http.StatusOK || got != expected {
+		t.Errorf("Expected %s, got %d %s", expected, rec.Code, got)
+	}
+
+	// Cross-field rules see the other submitted values, sanitized as on submit
+	values := url.Values{"password": {"  s3cret-pass "}, "confirm": {"s3cret-pass"}}
+	if result := decodeFieldResult(t, serveField(h, "confirm", values)); result["valid"] != true {
+		t.Errorf("Expected confirm to match the sanitized password, got %v", result)
+	}
+	values.Set("confirm", "other")
+	if result := decodeFieldResult(t, serveField(h, "confirm", values)); result["valid"] != false {
+		t.Errorf("Expected confirm to fail eqfield, got %v", result)
+	}
+
+	// Nested fields and collection elements
+	result := decodeFieldResult(t, serveField(h, "address.country", url.Values{"address.country": {"U5"}}))
+	if errs := result["errors"].(map[string]interface{}); errs["address.country"] == nil {
+		t.Errorf("Expected an error on address.country, got %v", result)
+	}
+	result = decodeFieldResult(t, serveField(h, "tags", url.Values{"tags": {"go", "b4d"}}))
+	if errs := result["errors"].(map[string]interface{}); errs["tags[1]"] == nil {
+		t.Errorf("Expected an error on tags[1], got %v", result)
+	}
+
+	for _, field := range []string{"", "unknown", "address", "tags[0]"} {
+		if rec := serveField(h, field, nil); rec.Code != http.StatusBadRequest {
+			t.Errorf("Expected 400 for field %q, got %d", field, rec.Code)
+		}
+	}
+}
+
+func TestFieldValidationHandlerHTML(t *testing.T) {
+	h, _ := newLiveHandler(FieldValidationOptions{CacheTTL: -1})
+
+	req := httptest.NewRequest(http.MethodPost, "/validate", strings.NewReader("username=ab"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("HX-Request", "true")
+	req.Header.Set("HX-Trigger-Name", "username")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	expected := `<div class="field-errors" data-field="username"><p class="field-error">Must be at least 3 characters long</p></div>`
+	if rec.Code != http.StatusOK || rec.Body.String() != expected {
+		t.Errorf("Expected %q, got %d %q", expected, rec.Code, rec.Body.String())
+	}
+	if contentType := rec.Header().Get("Content-Type"); !strings.HasPrefix(contentType, "text/html") {
+		t.Errorf("Expected an HTML content type, got %q", contentType)
+	}
+
+	// Valid fields render the empty container so that earlier errors are cleared
+	rec = serveField(h, "username", url.Values{"username": {"ada"}}, "Accept", "text/html")
+	if expected := `<div class="field-errors" data-field="username"></div>`; rec.Body.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, rec.Body.String())
+	}
+}
+
+func TestFieldValidationHandlerCache(t *testing.T) {
+	h, now := newLiveHandler(FieldValidationOptions{CacheTTL: time.Second, RateLimit: -1})
+	liveChecks.Store(0)
+
+	values := url.Values{"username": {"admin"}}
+	for i := 0; i < 3; i++ {
+		if result := decodeFieldResult(t, serveField(h, "username", values)); result["valid"] != false {
+			t.Fatalf("Expected admin to be taken, got %v", result)
+		}
+	}
+	if calls := liveChecks.Load(); calls != 1 {
+		t.Errorf("Expected identical requests to be served from the cache, got %d checks", calls)
+	}
+
+	// Any change to the form state is a new input
+	serveField(h, "username", url.Values{"username": {"admin"}, "password": {"x"}})
+	if calls := liveChecks.Load(); calls != 2 {
+		t.Errorf("Expected a changed form state to be validated, got %d checks", calls)
+	}
+
+	*now = now.Add(2 * time.Second)
+	serveField(h, "username", values)
+	if calls := liveChecks.Load(); calls != 3 {
+		t.Errorf("Expected an expired result to be validated again, got %d checks", calls)
+	}
+}
+
+func TestFieldValidationHandlerSkipsCacheForSensitiveInput(t *testing.T) {
+	type secretForm struct {
+		Username string `form:"username" validate:"live_available"`
+		Password string `form:"password" sensitive:"true"`
+	}
+	h := FieldValidationHandler(secretForm{}, FieldValidationOptions{CacheTTL: time.Minute, RateLimit: -1}).(*fieldValidator)
+	liveChecks.Store(0)
+
+	values := url.Values{"username": {"admin"}, "password": {"hunter22"}}
+	serveField(h, "username", values)
+	serveField(h, "username", values)
+	if calls := liveChecks.Load(); calls != 2 || len(h.cache) != 0 {
+		t.Errorf("Expected sensitive input to bypass the cache, got %d checks and %d entries", calls, len(h.cache))
+	}
+
+	// Without a sensitive value, results are cached as usual
+	serveField(h, "username", url.Values{"username": {"admin"}, "password": {""}})
+	if len(h.cache) != 1 {
+		t.Errorf("Expected one cached result, got %d", len(h.cache))
+	}
+}
+
+func TestFieldValidationHandlerMethods(t *testing.T) {
+	h, _ := newLiveHandler(FieldValidationOptions{})
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/validate?field=password&password=hunter22", nil))
+	if rec.Code != http.StatusMethodNotAllowed || rec.Header().Get("Allow") != http.MethodPost {
+		t.Errorf("Expected GET to be refused by default, got %d %q", rec.Code, rec.Header().Get("Allow"))
+	}
+
+	h, _ = newLiveHandler(FieldValidationOptions{AllowGET: true})
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/validate?field=username&username=ada", nil))
+	if result := decodeFieldResult(t, rec); result["valid"] != true {
+		t.Errorf("Expected GET to be validated when allowed, got %v", result)
+	}
+	if cacheControl := rec.Header().Get("Cache-Control"); cacheControl != "" {
+		t.Errorf("Expected no Cache-Control, got %q", cacheControl)
+	}
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/validate", nil))
+	if rec.Code != http.StatusMethodNotAllowed || rec.Header().Get("Allow") != "GET, POST" {
+		t.Errorf("Expected PUT to be refused, got %d %q", rec.Code, rec.Header().Get("Allow"))
+	}
+}
+
+func TestFieldValidationHandlerClientCap(t *testing.T) {
+	h, now := newLiveHandler(FieldValidationOptions{})
+	for i := 0; i < maxRateLimitClients; i++ {
+		h.buckets[strconv.Itoa(i)] = &tokenBucket{last: *now}
+	}
+	if _, ok := h.allow("new"); !ok || len(h.buckets) > maxRateLimitClients {
+		t.Errorf("Expected at most %d clients, got %d", maxRateLimitClients, len(h.buckets))
+	}
+}
+
+func TestFieldValidationHandlerRateLimit(t *testing.T) {
+	h, now := newLiveHandler(FieldValidationOptions{RateLimit: 2, RateBurst: 2})
+
+	for i := 0; i < 2; i++ {
+		if rec := serveField(h, "username", nil); rec.Code != http.StatusOK {
+			t.Fatalf("Expected request %d within the burst to pass, got %d", i, rec.Code)
+		}
+	}
+	rec := serveField(h, "username", nil)
+	if rec.Code != http.StatusTooManyRequests || rec.Header().Get("Retry-After") != "1" {
+		t.Errorf("Expected 429 with Retry-After 1, got %d %q", rec.Code, rec.Header().Get("Retry-After"))
+	}
+
+	// Other clients have their own buckets
+	req := httptest.NewRequest(http.MethodPost, "/validate?field=username", nil)
+	req.RemoteAddr = "192.0.2.99:1234"
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected another client to pass, got %d", rec.Code)
+	}
+
+	*now = now.Add(500 * time.Millisecond)
+	if rec := serveField(h, "username", nil); rec.Code != http.StatusOK {
+		t.Errorf("Expected a refilled token to pass, got %d", rec.Code)
+	}
+}
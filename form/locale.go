@@ -0,0 +1,115 @@
+package form
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kdsmith18542/gokit/i18n"
+)
+
+// Values of the locale tag
+const (
+	LocaleNumber   = "number"
+	LocaleCurrency = "currency"
+	LocaleDate     = "date"
+	LocaleOff      = "-"
+)
+
+// localeParser parses input written in a locale's formats; *i18n.Translator implements it
+type localeParser interface {
+	ParseNumber(formatted string) (float64, error)
+	ParseCurrency(formatted string) (float64, error)
+	ParseDate(formatted string) (time.Time, error)
+}
+
+// localeFor returns the parser for the request's locale when the decoder localizes input
+func (d *Decoder) localeFor(ctx context.Context) localeParser {
+	if !d.opts.Localize {
+		return nil
+	}
+	if translator := i18n.TranslatorFromContext(ctx); translator != nil {
+		return translator
+	}
+	return nil
+}
+
+// localeMode returns how a field's input is localized. The locale tag wins; otherwise
+// time fields and string fields with date rules are read as dates. Numbers are only
+// read in the locale when tagged, since a plain "1234.56" would be misread as 123456
+// where "." groups digits, and browsers submit number inputs that way.
+func (f fieldPlan) localeMode() string {
+	switch {
+	case f.locale != "":
+		return f.locale
+	case f.nested != nil:
+		return ""
+	case f.isTime:
+		return LocaleDate
+	case f.kind == reflect.String && !f.collection && hasDateRule(f.validate):
+		return LocaleDate
+	}
+	return ""
+}
+
+// hasDateRule reports whether a validate tag has a rule that reads the value as a date
+func hasDateRule(validateTag string) bool {
+	for _, rule := range strings.Split(validateTag, ",") {
+		name, _, _ := strings.Cut(strings.TrimSpace(rule), "=")
+		if name == "datetime" || name == "date_after" || name == "date_before" {
+			return true
+		}
+	}
+	return false
+}
+
+// localize rewrites a value written in the request's locale in the form the decoder and
+// rules read: numbers with a "." decimal separator and no grouping, and dates as ISO
+// dates or in the field's datetime= layout. Values that don't parse are left as they
+// are, so that rules such as numeric report them.
+func (d *decodedFields) localize(value string, f fieldPlan) string {
+	if d.locale == nil || strings.TrimSpace(value) == "" {
+		return value
+	}
+	switch f.localeMode() {
+	case LocaleNumber, LocaleCurrency:
+		parse := d.locale.ParseNumber
+		if f.localeMode() == LocaleCurrency {
+			parse = d.locale.ParseCurrency
+		}
+		n, err := parse(strings.TrimSpace(value))
+		if err != nil || isIntegerKind(f.kind) && n != math.Trunc(n) {
+			return value
+		}
+		return strconv.FormatFloat(n, 'f', -1, 64)
+
+	case LocaleDate:
+		settings := timeSettingsFor(f.validate)
+		if !f.isTime && settings.layout == "" {
+			if dateRegex.MatchString(value) {
+				return value
+			}
+			if t, err := d.locale.ParseDate(value); err == nil {
+				return t.Format(time.DateOnly)
+			}
+			return value
+		}
+		if _, ok := settings.parse(value); ok {
+			return value
+		}
+		t, err := d.locale.ParseDate(value)
+		if err != nil {
+			return value
+		}
+		// Keep the wall clock the user typed, in the field's time zone
+		t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, settings.location)
+		if settings.layout != "" {
+			return t.Format(settings.layout)
+		}
+		return t.Format("2006-01-02T15:04:05")
+	}
+	return value
+}
@@ -0,0 +1,115 @@
+package form
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kdsmith18542/gokit/i18n"
+)
+
+type invoiceForm struct {
+	Quantity int       `form:"quantity" locale:"number" validate:"required,min=1,max=5000"`
+	Price    float64   `form:"price" locale:"number" validate:"min=0.5"`
+	Total    float64   `form:"total" locale:"currency"`
+	Due      time.Time `form:"due" validate:"datetime=date,after=2025-01-01"`
+	Issued   string    `form:"issued" validate:"date_before=cutoff"`
+	Cutoff   string    `form:"cutoff" default:"2030-01-01"`
+	Weights  []float64 `form:"weights" locale:"number" validate:"dive,max=10"`
+	Code     float64   `form:"code" locale:"-"`
+	Amount   float64   `form:"amount" validate:"max=5000"`
+}
+
+// localizedSubmit posts values through the i18n middleware with the given locale and
+// returns the decoded form and its errors
+func localizedSubmit(t *testing.T, decoder *Decoder, locale string, values url.Values) (invoiceForm, ValidationErrors) {
+	t.Helper()
+	manager := i18n.NewManagerEmpty()
+	manager.AddLocale("en", map[string]interface{}{})
+	manager.AddLocale("de", map[string]interface{}{})
+	manager.SetDefaultFormats()
+
+	var f invoiceForm
+	var errs ValidationErrors
+	handler := i18n.LocaleDetector(manager)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		errs = decoder.DecodeAndValidate(r.Context(), r, &f)
+	}))
+	req := httptest.NewRequest(http.MethodPost, "/invoice", strings.NewReader(values.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept-Language", locale)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	return f, errs
+}
+
+func TestLocalizedDecoding(t *testing.T) {
+	decoder := NewDecoder(DecoderOptions{Localize: true})
+	f, errs := localizedSubmit(t, decoder, "de", url.Values{
+		"quantity": {"1.200"},
+		"price":    {"1.234,56"},
+		"total":    {"1.481.472,00 €"},
+		"due":      {"31.12.2025"},
+		"issued":   {"2. Januar 2026"},
+		"weights":  {"0,5", "2,25"},
+		"code":     {"1.5"},
+	})
+	if len(errs) != 0 {
+		t.Fatalf("Expected no errors, got %v", errs)
+	}
+	expected := invoiceForm{
+		Quantity: 1200, Price: 1234.56, Total: 1481472,
+		Due:     time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC),
+		Issued:  "2026-01-02",
+		Weights: []float64{0.5, 2.25},
+		Code:    1.5,
+	}
+	if f.Quantity != expected.Quantity || f.Price != expected.Price || f.Total != expected.Total ||
+		!f.Due.Equal(expected.Due) || f.Issued != expected.Issued || f.Code != expected.Code ||
+		len(f.Weights) != 2 || f.Weights[0] != 0.5 || f.Weights[1] != 2.25 {
+		t.Errorf("Expected %+v, got %+v", expected, f)
+	}
+
+	// Rules compare the parsed values
+	_, errs = localizedSubmit(t, decoder, "de", url.Values{
+		"quantity": {"5.001"},
+		"price":    {"0,25"},
+		"due":      {"31.12.2024"},
+		"issued":   {"1.1.2031"},
+		"weights":  {"10,5"},
+	})
+	for _, key := range []string{"quantity", "price", "due", "issued", "weights[0]"} {
+		if len(errs[key]) == 0 {
+			t.Errorf("Expected an error for %s, got %v", key, errs)
+		}
+	}
+
+	// ISO dates from date inputs are still accepted
+	if f, errs := localizedSubmit(t, decoder, "en", url.Values{"quantity": {"1,200"}, "due": {"2025-12-31"}, "issued": {"12/31/2025"}}); len(errs) != 0 || f.Quantity != 1200 || f.Issued != "2025-12-31" {
+		t.Errorf("Expected English input to decode, got %+v %v", f, errs)
+	}
+}
+
+func TestLocalizedNumbersAreOptIn(t *testing.T) {
+	decoder := NewDecoder(DecoderOptions{Localize: true})
+	f, errs := localizedSubmit(t, decoder, "de", url.Values{"quantity": {"3"}, "amount": {"1234.56"}})
+	if len(errs) != 0 || f.Amount != 1234.56 {
+		t.Errorf("Expected an untagged number to be read as usual, got %v %v", f.Amount, errs)
+	}
+}
+
+func TestLocalizeOff(t *testing.T) {
+	f, errs := localizedSubmit(t, NewDecoder(DecoderOptions{}), "de", url.Values{"quantity": {"3"}, "price": {"1.5"}, "due": {"2025-12-31"}})
+	if len(errs) != 0 || f.Price != 1.5 {
+		t.Errorf("Expected input to be read as usual without Localize, got %+v %v", f, errs)
+	}
+
+	// Without a Translator in the context, input is read as usual too
+	var g invoiceForm
+	req := httptest.NewRequest(http.MethodPost, "/invoice", strings.NewReader("quantity=3&price=1.5"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if errs := NewDecoder(DecoderOptions{Localize: true}).DecodeAndValidate(req.Context(), req, &g); len(errs) != 0 || g.Price != 1.5 {
+		t.Errorf("Expected input to be read as usual, got %+v %v", g, errs)
+	}
+}
@@ -0,0 +1,231 @@
+package form
+
+import (
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Redacted replaces sensitive values that are masked completely
+const Redacted = "[REDACTED]"
+
+// Mask returns the displayable form of a sensitive value.
+type Mask func(value string) string
+
+// RegisterMask registers a masking policy, to be named in sensitive tags.
+//
+// Example:
+//
+//	form.RegisterMask("email", func(value string) string {
+//	    if at := strings.LastIndex(value, "@"); at > 0 {
+//	        return "***" + value[at:]
+//	    }
+//	    return form.Redacted
+//	})
+func RegisterMask(name string, mask Mask) {
+	registry.masks[name] = mask
+}
+
+// MaskValue masks value with a masking policy, as written in a sensitive tag:
+//
+//	"true"            the whole value is replaced with Redacted
+//	"last=4"          all but the last 4 characters become '*': "************4242"
+//	"first=6,last=4"  the first 6 and last 4 characters are kept
+//	name              a policy registered with RegisterMask
+//
+// Values no longer than the characters a policy would keep, and unknown policies, are
+// redacted completely, so a value is never shown whole by mistake.
+func MaskValue(value, policy string) string {
+	if mask, ok := registry.masks[policy]; ok {
+		return mask(value)
+	}
+
+	first, last := 0, 0
+	for _, part := range strings.Split(policy, ",") {
+		name, param, _ := strings.Cut(strings.TrimSpace(part), "=")
+		n, err := strconv.Atoi(param)
+		switch {
+		case err != nil || n < 0:
+			return Redacted
+		case name == "first":
+			first = n
+		case name == "last":
+			last = n
+		default:
+			return Redacted
+		}
+	}
+
+	length := utf8.RuneCountInString(value)
+	if length <= first+last {
+		return Redacted
+	}
+	runes := []rune(value)
+	return string(runes[:first]) + strings.Repeat("*", length-first-last) + string(runes[length-last:])
+}
+
+// sensitivePolicy returns the masking policy of a sensitive tag, or "" for fields that
+// aren't sensitive
+func sensitivePolicy(tag string) string {
+	if tag == "" || tag == "false" {
+		return ""
+	}
+	return tag
+}
+
+// Redact encodes v like Encode, with the values of fields tagged sensitive masked, for
+// logs and debug output:
+//
+//	type PaymentForm struct {
+//	    Email    string `form:"email"`
+//	    Card     string `form:"card" sensitive:"last=4"`
+//	    Password string `form:"password" sensitive:"true"`
+//	}
+//
+//	values, _ := form.Redact(&f)
+//	slog.Info("payment submitted", "form", values)
+//	// form=map[card:[************4242] email:[ada@example.com] password:[[REDACTED]]]
+func Redact(v interface{}) (url.Values, error) {
+	values, err := Encode(v)
+	if err != nil {
+		return nil, err
+	}
+	plan := planFor(reflect.Indirect(reflect.ValueOf(v)).Type())
+	for key, vals := range values {
+		if f, ok := matchInputKey(plan, key); ok && f.sensitive != "" {
+			for i, value := range vals {
+				vals[i] = MaskValue(value, f.sensitive)
+			}
+		}
+	}
+	return values, nil
+}
+
+// minSecretLength is the length below which values aren't searched for in messages:
+// one or two characters reveal next to nothing, and replacing them would garble the
+// words of every message
+const minSecretLength = 3
+
+// secrets maps the values of sensitive fields to their masks
+type secrets map[string]string
+
+// add records values of a field with the given masking policy
+func (s secrets) add(policy string, values ...string) {
+	for _, value := range values {
+		if len(value) >= minSecretLength {
+			s[value] = MaskValue(value, policy)
+		}
+	}
+}
+
+// sensitiveValues collects the values of the sensitive fields of a struct tree: as they
+// were submitted in formData, and as the rules saw them after sanitizing, if decoded is
+// set, or as they are bound otherwise
+func sensitiveValues(nodes []structNode, formData map[string][]string, decoded *decodedFields) secrets {
+	found := make(secrets)
+	for _, node := range nodes {
+		s := node.scopes[len(node.scopes)-1]
+		for _, f := range s.plan.fields {
+			if f.sensitive == "" || f.nested != nil {
+				continue
+			}
+			key := s.prefix + f.key
+			for input, values := range formData {
+				if input == key || strings.HasPrefix(input, key+"[") || f.isMap && strings.HasPrefix(input, key+".") {
+					found.add(f.sensitive, values...)
+				}
+			}
+			if decoded != nil {
+				found.add(f.sensitive, decoded.values[key])
+				if c, ok := decoded.collections[key]; ok {
+					found.add(f.sensitive, c.values...)
+				}
+				continue
+			}
+			if !f.exported {
+				continue
+			}
+			field := node.ptr.Elem().Field(f.index)
+			switch {
+			case f.collection:
+				found.add(f.sensitive, collectionOf(field).values...)
+			case isScalarKind(field.Kind()):
+				found.add(f.sensitive, formatScalar(field))
+			}
+		}
+	}
+	return found
+}
+
+// redact replaces the values of sensitive fields in error messages with their masks,
+// so that validators quoting the input can't leak them into responses, re-rendered
+// pages, observers or logs. Longer values are replaced first, so that a value containing
+// another is masked whole.
+func (s secrets) redact(errs ValidationErrors) ValidationErrors {
+	if len(s) == 0 || len(errs) == 0 {
+		return errs
+	}
+	values := make([]string, 0, len(s))
+	for value := range s {
+		values = append(values, value)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		if len(values[i]) != len(values[j]) {
+			return len(values[i]) > len(values[j])
+		}
+		return values[i] < values[j]
+	})
+	pairs := make([]string, 0, 2*len(values))
+	for _, value := range values {
+		pairs = append(pairs, value, s[value])
+	}
+	replacer := strings.NewReplacer(pairs...)
+	for key, messages := range errs {
+		// Copy before changing, as validators may return shared slices
+		var redacted []string
+		for i, message := range messages {
+			if masked := replacer.Replace(message); masked != message {
+				if redacted == nil {
+					redacted = append([]string(nil), messages...)
+				}
+				redacted[i] = masked
+			}
+		}
+		if redacted != nil {
+			errs[key] = redacted
+		}
+	}
+	return errs
+}
+
+// clearSensitive zeroes the sensitive fields of a struct tree, so that pages rendering
+// it don't show their values again
+func clearSensitive(nodes []structNode) {
+	for _, node := range nodes {
+		s := node.scopes[len(node.scopes)-1]
+		for _, f := range s.plan.fields {
+			if f.sensitive != "" && f.nested == nil && f.exported {
+				field := node.ptr.Elem().Field(f.index)
+				field.Set(reflect.Zero(field.Type()))
+			}
+		}
+	}
+}
+
+// hasSensitiveFields reports whether a struct, or a struct nested in it, has fields
+// tagged sensitive
+func hasSensitiveFields(plan *structPlan, seen map[reflect.Type]bool) bool {
+	seen[plan.typ] = true
+	for _, f := range plan.fields {
+		if f.sensitive != "" {
+			return true
+		}
+		if f.nested != nil && !seen[f.nested] && hasSensitiveFields(plan.nested(f), seen) {
+			return true
+		}
+	}
+	return false
+}
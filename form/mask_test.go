@@ -0,0 +1,197 @@
+package form
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type checkoutPayment struct {
+	Email    string   `form:"email" validate:"required,email"`
+	Card     string   `form:"card" sanitize:"trim" validate:"echo_value" sensitive:"last=4"`
+	Password string   `form:"password" validate:"echo_value" sensitive:"true"`
+	Tokens   []string `form:"tokens" validate:"dive,echo_value" sensitive:"true"`
+}
+
+func (f *checkoutPayment) Validate(ctx context.Context) ValidationErrors {
+	if f.Password != "" && strings.Contains(f.Email, f.Password) {
+		return ValidationErrors{FormErrorKey: {fmt.Sprintf("Password %s must not be part of %s", f.Password, f.Email)}}
+	}
+	return nil
+}
+
+func init() {
+	// echo_value quotes the input, as error models with values would
+	RegisterValidator("echo_value", func(value string) string {
+		if value == "" || value == "ok" {
+			return ""
+		}
+		return fmt.Sprintf("%q was rejected", value)
+	})
+}
+
+func TestMaskValue(t *testing.T) {
+	for _, tc := range []struct{ value, policy, expected string }{
+		{"hunter22", "true", Redacted},
+		{"4242424242424242", "last=4", "************4242"},
+		{"4242424242424242", "first=6,last=4", "424242******4242"},
+		{"4242", "last=4", Redacted},
+		{"geheimnis€", "last=2", "********s€"},
+		{"4242424242424242", "last=x", Redacted},
+		{"4242424242424242", "middle=4", Redacted},
+		{"", "last=4", Redacted},
+	} {
+		if got := MaskValue(tc.value, tc.policy); got != tc.expected {
+			t.Errorf("MaskValue(%q, %q) = %q, expected %q", tc.value, tc.policy, got, tc.expected)
+		}
+	}
+
+	RegisterMask("test_domain", func(value string) string {
+		_, domain, _ := strings.Cut(value, "@")
+		return "***@" + domain
+	})
+	if got := MaskValue("ada@example.com", "test_domain"); got != "***@example.com" {
+		t.Errorf("Expected the registered mask, got %q", got)
+	}
+}
+
+func TestSensitiveErrors(t *testing.T) {
+	var f checkoutPayment
+	errs := DecodeAndValidate(newValuesRequest(url.Values{
+		"email":    {"ada-hunter22@example.com"},
+		"card":     {" 4242424242424242 "},
+		"password": {"hunter22"},
+		"tokens":   {"ok", "tok_abc123"},
+	}), &f)
+
+	expected := ValidationErrors{
+		"card":       {`"************4242" was rejected`},
+		"password":   {`"[REDACTED]" was rejected`},
+		"tokens[1]":  {`"[REDACTED]" was rejected`},
+		FormErrorKey: {"Password [REDACTED] must not be part of ada-[REDACTED]@example.com"},
+	}
+	if !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected %v, got %v", expected, errs)
+	}
+	if f.Password != "hunter22" {
+		t.Errorf("Expected the bound value to be kept, got %q", f.Password)
+	}
+
+	// Validating the struct directly redacts the bound values
+	errs = ValidateStruct(context.Background(), &checkoutPayment{Email: "ada@example.com", Password: "hunter22"})
+	if !reflect.DeepEqual(errs, ValidationErrors{"password": {`"[REDACTED]" was rejected`}}) {
+		t.Errorf("Expected the struct's password to be redacted, got %v", errs)
+	}
+
+	// Live validation responses too
+	rec := httptest.NewRecorder()
+	FieldValidationHandler(checkoutPayment{}, FieldValidationOptions{}).ServeHTTP(rec,
+		newBodyRequest(url.Values{"field": {"password"}, "password": {"hunter22"}}.Encode(), "application/x-www-form-urlencoded"))
+	if !strings.Contains(rec.Body.String(), "was rejected") || strings.Contains(rec.Body.String(), "hunter22") {
+		t.Errorf("Expected the live result not to echo the password, got %s", rec.Body)
+	}
+}
+
+func TestSensitiveErrorsObserved(t *testing.T) {
+	obs := &recordingObserver{}
+	observeWith(t, obs)
+
+	var f checkoutPayment
+	DecodeAndValidate(newValuesRequest(url.Values{"email": {"ada@example.com"}, "password": {"hunter22"}}), &f)
+	if len(obs.errors) == 0 || strings.Contains(fmt.Sprint(obs.errors), "hunter22") {
+		t.Errorf("Expected observers to see redacted errors, got %v", obs.errors)
+	}
+}
+
+// recordingObserver keeps the errors of the last validation
+type recordingObserver struct {
+	testObserver
+	errors ValidationErrors
+}
+
+func (o *recordingObserver) OnValidationEnd(ctx context.Context, formName string, errors ValidationErrors) {
+	o.errors = errors
+}
+
+func TestRedact(t *testing.T) {
+	values, err := Redact(&checkoutPayment{
+		Email:    "ada@example.com",
+		Card:     "4242424242424242",
+		Password: "hunter22",
+		Tokens:   []string{"tok_abc123"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := url.Values{
+		"email":    {"ada@example.com"},
+		"card":     {"************4242"},
+		"password": {Redacted},
+		"tokens":   {Redacted},
+	}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("Expected %v, got %v", expected, values)
+	}
+}
+
+func TestWizardSensitiveFields(t *testing.T) {
+	type checkoutForm struct {
+		Email string `form:"email" validate:"required,email"`
+		Card  string `form:"card" validate:"required" sensitive:"last=4"`
+		Plan  string `form:"plan" validate:"required"`
+	}
+	wizard := NewWizard(checkoutForm{}, WizardOptions{Secret: []byte("k")},
+		WizardStep{Name: "payment", Fields: []string{"email", "card"}},
+		WizardStep{Name: "review", Fields: []string{"plan"}},
+	)
+	if !wizard.opts.Encrypt {
+		t.Error("Expected state to be encrypted for a form with sensitive fields")
+	}
+
+	var page *WizardPage
+	handler := wizard.Handler(func(w http.ResponseWriter, r *http.Request, p *WizardPage) { page = p }, http.NotFoundHandler())
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	body := url.Values{DefaultWizardStateField: {page.State}, DefaultWizardNavField: {WizardNext}, "email": {"ada@example.com"}, "card": {"4242424242424242"}}
+	handler.ServeHTTP(httptest.NewRecorder(), newBodyRequest(body.Encode(), "application/x-www-form-urlencoded"))
+
+	form := page.Form.(*checkoutForm)
+	if page.Step != 1 || form.Email != "ada@example.com" || form.Card != "" {
+		t.Errorf("Expected the card to be left out of the rendered form, got step %d %+v", page.Step, form)
+	}
+	if got := page.Masked("card"); got != "************4242" {
+		t.Errorf("Expected the masked card, got %q", got)
+	}
+	if strings.Contains(page.State, "4242") {
+		t.Errorf("Expected the card not to be readable in the state, got %s", page.State)
+	}
+}
+
+func TestWizardSensitiveNestedFirstField(t *testing.T) {
+	type cardDetails struct {
+		Number string `form:"number" validate:"required" sensitive:"last=4"`
+	}
+	type checkoutForm struct {
+		Card  cardDetails `form:"card"`
+		Email string      `form:"email" validate:"required,email"`
+		Plan  string      `form:"plan" validate:"required"`
+	}
+	wizard := NewWizard(checkoutForm{}, WizardOptions{Secret: []byte("k")},
+		WizardStep{Name: "payment", Fields: []string{"card.number", "email"}},
+		WizardStep{Name: "review", Fields: []string{"plan"}},
+	)
+
+	var page *WizardPage
+	handler := wizard.Handler(func(w http.ResponseWriter, r *http.Request, p *WizardPage) { page = p }, http.NotFoundHandler())
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	body := url.Values{DefaultWizardStateField: {page.State}, DefaultWizardNavField: {WizardNext}, "email": {"ada@example.com"}, "card.number": {"4242424242424242"}}
+	handler.ServeHTTP(httptest.NewRecorder(), newBodyRequest(body.Encode(), "application/x-www-form-urlencoded"))
+
+	if form := page.Form.(*checkoutForm); page.Step != 1 || form.Card.Number != "" {
+		t.Errorf("Expected the nested card number to be left out of the rendered form, got step %d %+v", page.Step, form)
+	}
+}
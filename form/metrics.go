@@ -0,0 +1,191 @@
+package form
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// otherRule is reported for errors no recorded rule produced, such as those of
+// generated decoders and invalid field references
+const otherRule = "other"
+
+// ruleFailure is one field and rule that failed in a validation. rule is the rule's name
+// as written in the validate tag, "sanitize" for sanitizer failures, "struct" for
+// struct-level validators, a limit name for input rejected by the decoder, or otherRule.
+// field is the form key without collection indices. Neither holds submitted values.
+type ruleFailure struct {
+	field, rule string
+}
+
+// ruleFailuresKey is the context key of the rules recorded for one validation
+type ruleFailuresKey struct{}
+
+// ruleFailures records the rules that failed while validating one form, by form key
+type ruleFailures struct {
+	byField map[string][]string
+	// rejected is set when the input was rejected before decoding. The keys of those
+	// errors were submitted by the client, so they are never used as field names.
+	rejected bool
+}
+
+// trackRules returns ctx carrying a recorder for the rules that fail while validating
+// one form, when an observer or ValidationStats will read them
+func trackRules(ctx context.Context) context.Context {
+	if getObserver() == nil && getValidationStats() == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, ruleFailuresKey{}, &ruleFailures{byField: make(map[string][]string)})
+}
+
+// rulesFrom returns the recorder of ctx, or nil if validation isn't observed
+func rulesFrom(ctx context.Context) *ruleFailures {
+	failures, _ := ctx.Value(ruleFailuresKey{}).(*ruleFailures)
+	return failures
+}
+
+// add records a failed rule; it does nothing on a nil recorder
+func (f *ruleFailures) add(key, rule string) {
+	if f == nil {
+		return
+	}
+	f.byField[key] = append(f.byField[key], rule)
+}
+
+// reject records input rejected by the decoder's limits under FormErrorKey
+func (f *ruleFailures) reject(errs ValidationErrors) {
+	if f == nil {
+		return
+	}
+	f.rejected = true
+	for _, messages := range errs {
+		for _, message := range messages {
+			rule := "max_length"
+			switch message {
+			case ErrTooManyFields:
+				rule = "max_fields"
+			case ErrNestedTooDeeply:
+				rule = "max_depth"
+			case ErrUnknownField:
+				rule = "unknown_field"
+			case ErrDuplicateField:
+				rule = "duplicate_field"
+			}
+			f.add(FormErrorKey, rule)
+		}
+	}
+}
+
+// list returns each field and rule that failed once, in no particular order. Error
+// keys without a recorded rule are reported with otherRule.
+func (f *ruleFailures) list(errs ValidationErrors) []ruleFailure {
+	var failures []ruleFailure
+	seen := make(map[ruleFailure]bool)
+	covered := make(map[string]bool)
+	addFailure := func(failure ruleFailure) {
+		if !seen[failure] {
+			seen[failure] = true
+			failures = append(failures, failure)
+		}
+	}
+
+	if f != nil {
+		for key, rules := range f.byField {
+			field := metricField(key)
+			covered[field] = true
+			for _, rule := range rules {
+				addFailure(ruleFailure{field: field, rule: rule})
+			}
+		}
+		if f.rejected {
+			return failures
+		}
+	}
+	for key := range errs {
+		if field := metricField(key); !covered[field] {
+			addFailure(ruleFailure{field: field, rule: otherRule})
+		}
+	}
+	return failures
+}
+
+// metricField removes collection indices and map keys from a form key, so that fields
+// are reported once however many elements they have
+func metricField(key string) string {
+	if !strings.Contains(key, "[") {
+		return key
+	}
+	var b strings.Builder
+	depth := 0
+	for _, r := range key {
+		switch {
+		case r == '[':
+			depth++
+		case r == ']' && depth > 0:
+			depth--
+		case depth == 0:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// validationInstruments are the OpenTelemetry instruments of form validation
+type validationInstruments struct {
+	provider    metric.MeterProvider
+	validations metric.Int64Counter
+	failures    metric.Int64Counter
+	duration    metric.Float64Histogram
+}
+
+// instruments caches the instruments of the current global meter provider
+var instruments atomic.Pointer[validationInstruments]
+
+// currentInstruments returns the instruments of the global meter provider, creating
+// them again when the provider has been replaced
+func currentInstruments() *validationInstruments {
+	provider := otel.GetMeterProvider()
+	if current := instruments.Load(); current != nil && current.provider == provider {
+		return current
+	}
+
+	meter := provider.Meter("github.com/kdsmith18542/gokit/form")
+	i := &validationInstruments{provider: provider}
+	// Creation only fails for invalid names or options; the returned instruments are no-ops then
+	i.validations, _ = meter.Int64Counter("gokit.form.validations",
+		metric.WithDescription("Form validations, by form and outcome"),
+		metric.WithUnit("{validation}"))
+	i.failures, _ = meter.Int64Counter("gokit.form.validation.failures",
+		metric.WithDescription("Failed validation rules, by form, field and rule"),
+		metric.WithUnit("{failure}"))
+	i.duration, _ = meter.Float64Histogram("gokit.form.validation.duration",
+		metric.WithDescription("Time spent decoding and validating forms"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(0.0001, 0.00025, 0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1))
+	instruments.Store(i)
+	return i
+}
+
+// recordMetrics records one validation with the OpenTelemetry instruments
+func recordMetrics(ctx context.Context, formName string, valid bool, failures []ruleFailure, duration time.Duration) {
+	i := currentInstruments()
+	outcome := "valid"
+	if !valid {
+		outcome = "invalid"
+	}
+	formAttrs := metric.WithAttributes(attribute.String("form.name", formName), attribute.String("form.outcome", outcome))
+	i.validations.Add(ctx, 1, formAttrs)
+	i.duration.Record(ctx, duration.Seconds(), formAttrs)
+	for _, failure := range failures {
+		i.failures.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("form.name", formName),
+			attribute.String("form.field", failure.field),
+			attribute.String("form.rule", failure.rule),
+		))
+	}
+}
@@ -0,0 +1,153 @@
+package form
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"sort"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+type funnelForm struct {
+	Email string   `form:"email" sanitize:"trim" validate:"required,email"`
+	Age   int      `form:"age" validate:"min=18"`
+	Tags  []string `form:"tags" validate:"max=3,dive,alpha"`
+}
+
+func (f *funnelForm) Validate(ctx context.Context) ValidationErrors {
+	if f.Email == "blocked@example.com" {
+		return ValidationErrors{FormErrorKey: {"This address can't sign up"}}
+	}
+	return nil
+}
+
+// observeWith registers an observer for the duration of a test
+func observeWith(t *testing.T, obs Observer) {
+	t.Helper()
+	previous := getObserver()
+	RegisterObserver(obs)
+	t.Cleanup(func() { RegisterObserver(previous) })
+}
+
+// sortedFailures returns failures in a stable order for comparison
+func sortedFailures(failures []ruleFailure) []ruleFailure {
+	sort.Slice(failures, func(i, j int) bool {
+		if failures[i].field != failures[j].field {
+			return failures[i].field < failures[j].field
+		}
+		return failures[i].rule < failures[j].rule
+	})
+	return failures
+}
+
+func TestRuleFailures(t *testing.T) {
+	observeWith(t, &testObserver{})
+
+	ctx := trackRules(context.Background())
+	var f funnelForm
+	errs := defaultDecoder.decodeAndValidateFields(ctx, &f, reflect.ValueOf(&f).Elem(), url.Values{
+		"email": {"blocked@example.com"},
+		"age":   {"12"},
+		"tags":  {"go", "c3po", "r2d2", "x", "y"},
+	}, "funnelForm", defaultTags)
+
+	expected := []ruleFailure{
+		{field: "_form", rule: "struct"},
+		{field: "age", rule: "min"},
+		{field: "tags", rule: "alpha"},
+		{field: "tags", rule: "max"},
+	}
+	if got := sortedFailures(rulesFrom(ctx).list(errs)); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+
+	// Errors without a recorded rule are reported as other
+	if got := (*ruleFailures)(nil).list(ValidationErrors{"items[2]": {"bad"}}); !reflect.DeepEqual(got, []ruleFailure{{field: "items", rule: otherRule}}) {
+		t.Errorf("Expected an other failure for items, got %v", got)
+	}
+}
+
+func TestRuleFailuresOfRejectedInput(t *testing.T) {
+	observeWith(t, &testObserver{})
+
+	ctx := trackRules(context.Background())
+	decoder := NewDecoder(DecoderOptions{Strict: true})
+	var f funnelForm
+	errs := decoder.decodeAndValidateFields(ctx, &f, reflect.ValueOf(&f).Elem(), url.Values{"email": {"a@example.com"}, "x-evil-key": {"1"}}, "funnelForm", decoder.tags)
+	if len(errs["x-evil-key"]) == 0 {
+		t.Fatalf("Expected the unknown key to be rejected, got %v", errs)
+	}
+	expected := []ruleFailure{{field: FormErrorKey, rule: "unknown_field"}}
+	if got := rulesFrom(ctx).list(errs); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected submitted keys not to become fields, got %v", got)
+	}
+}
+
+func TestMetricField(t *testing.T) {
+	for key, expected := range map[string]string{
+		"email":                "email",
+		"tags[3]":              "tags",
+		"prices[usd]":          "prices",
+		"billing.lines[2].sku": "billing.lines.sku",
+	} {
+		if got := metricField(key); got != expected {
+			t.Errorf("metricField(%q) = %q, expected %q", key, got, expected)
+		}
+	}
+}
+
+func TestValidationMetrics(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	observeWith(t, &formObserver{})
+
+	var f funnelForm
+	DecodeAndValidate(newValuesRequest(url.Values{"email": {"nope"}, "age": {"30"}}), &f)
+	DecodeAndValidate(newValuesRequest(url.Values{"email": {"ada@example.com"}, "age": {"30"}}), &f)
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatal(err)
+	}
+	metrics := make(map[string]metricdata.Aggregation)
+	for _, scope := range rm.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			metrics[m.Name] = m.Data
+		}
+	}
+
+	failures, ok := metrics["gokit.form.validation.failures"].(metricdata.Sum[int64])
+	if !ok || len(failures.DataPoints) != 1 {
+		t.Fatalf("Expected one failure series, got %+v", metrics["gokit.form.validation.failures"])
+	}
+	point := failures.DataPoints[0]
+	expected := attribute.NewSet(
+		attribute.String("form.name", "funnelForm"),
+		attribute.String("form.field", "email"),
+		attribute.String("form.rule", "email"),
+	)
+	if !point.Attributes.Equals(&expected) || point.Value != 1 {
+		t.Errorf("Expected one email failure, got %v = %d", point.Attributes.ToSlice(), point.Value)
+	}
+
+	validations, ok := metrics["gokit.form.validations"].(metricdata.Sum[int64])
+	if !ok || len(validations.DataPoints) != 2 {
+		t.Errorf("Expected valid and invalid validation counts, got %+v", metrics["gokit.form.validations"])
+	}
+	duration, ok := metrics["gokit.form.validation.duration"].(metricdata.Histogram[float64])
+	if !ok {
+		t.Fatalf("Expected a duration histogram, got %+v", metrics["gokit.form.validation.duration"])
+	}
+	var count uint64
+	for _, p := range duration.DataPoints {
+		count += p.Count
+	}
+	if count != 2 {
+		t.Errorf("Expected 2 recorded durations, got %d", count)
+	}
+}
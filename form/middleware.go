@@ -6,8 +6,10 @@ package form
 import (
 	"context"
 	"encoding/json"
+	"html/template"
 	"net/http"
 	"reflect"
+	"strings"
 )
 
 // contextKey is a custom type for context keys to avoid collisions.
@@ -16,19 +18,49 @@ type contextKey string
 const (
 	// formDataKey is the context key for form data.
 	formDataKey contextKey = "formData"
+	// formTypeKey is the context key for the form type, set for error handlers so that
+	// they can list errors in field declaration order.
+	formTypeKey contextKey = "formType"
 )
 
+// formType is the form handled by a middleware, and the tags its keys were read from
+type formType struct {
+	typ  reflect.Type
+	tags tagNames
+}
+
+// withFormType records the form type in the request passed to an error handler
+func withFormType(r *http.Request, formStruct interface{}, tags tagNames) *http.Request {
+	typ := reflect.TypeOf(formStruct)
+	for typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return r.WithContext(context.WithValue(r.Context(), formTypeKey, formType{typ: typ, tags: tags}))
+}
+
+// orderedErrors orders errors by the declaration order of the form handled by the
+// middleware, or by key when the handler is called directly
+func orderedErrors(r *http.Request, errors ValidationErrors) OrderedErrors {
+	form, _ := r.Context().Value(formTypeKey).(formType)
+	return errors.orderedByType(form.typ, form.tags)
+}
+
 // ValidationErrorHandler is a function type for handling validation errors
 type ValidationErrorHandler func(w http.ResponseWriter, r *http.Request, errors ValidationErrors)
 
-// DefaultValidationErrorHandler returns a JSON error response with validation errors
+// DefaultValidationErrorHandler returns a JSON error response with validation errors,
+// with a 400 status, or the 413 or 415 that StatusCode gives for rejected requests.
+// Fields are listed in declaration order, as described for ValidationErrors.Ordered.
 func DefaultValidationErrorHandler(w http.ResponseWriter, r *http.Request, errors ValidationErrors) {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusBadRequest)
+	w.WriteHeader(StatusCode(errors, http.StatusBadRequest))
 
-	response := map[string]interface{}{
-		"error":   "Validation failed",
-		"details": errors,
+	response := struct {
+		Error   string        `json:"error"`
+		Details OrderedErrors `json:"details"`
+	}{
+		Error:   "Validation failed",
+		Details: orderedErrors(r, errors),
 	}
 
 	if err := json.NewEncoder(w).Encode(response); err != nil {
@@ -96,6 +128,14 @@ func DefaultValidationErrorHandler(w http.ResponseWriter, r *http.Request, error
 //	    Bio      string `form:"bio" sanitize:"trim,escape_html" validate:"max=500"`
 //	}
 func ValidationMiddleware(formStruct interface{}, errorHandler ValidationErrorHandler) func(http.Handler) http.Handler {
+	return validationMiddleware(formStruct, errorHandler, nil, DecodeAndValidate)
+}
+
+// validationMiddleware returns middleware that decodes each request into a new instance
+// of formStruct with decode, calling errorHandler on failure and storing the form in the
+// request context on success. tags returns the tags a request's keys are read from; if
+// nil, the default tags are used.
+func validationMiddleware(formStruct interface{}, errorHandler ValidationErrorHandler, tags func(*http.Request) tagNames, decode func(*http.Request, interface{}) ValidationErrors) func(http.Handler) http.Handler {
 	if errorHandler == nil {
 		errorHandler = DefaultValidationErrorHandler
 	}
@@ -106,11 +146,15 @@ func ValidationMiddleware(formStruct interface{}, errorHandler ValidationErrorHa
 			form := reflect.New(reflect.TypeOf(formStruct)).Interface()
 
 			// Validate the form
-			errors := DecodeAndValidate(r, form)
+			errors := decode(r, form)
 
 			if len(errors) > 0 {
 				// Validation failed, call error handler
-				errorHandler(w, r, errors)
+				keys := defaultTags
+				if tags != nil {
+					keys = tags(r)
+				}
+				errorHandler(w, withFormType(r, formStruct, keys), errors)
 				return
 			}
 
@@ -124,6 +168,35 @@ func ValidationMiddleware(formStruct interface{}, errorHandler ValidationErrorHa
 	}
 }
 
+// parseRequestForm parses the query string and a urlencoded or multipart body of r, with
+// the body capped at maxBodyBytes, leaving them in place for a decoder further down the
+// chain. Other bodies, such as JSON, are left unread.
+func parseRequestForm(r *http.Request, maxBodyBytes int64) error {
+	if r.Body != nil && r.Form == nil {
+		r.Body = &maxBytesReader{r: r.Body, n: maxBodyBytes}
+	}
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+	if r.MultipartForm == nil && strings.Contains(r.Header.Get("Content-Type"), "multipart/form-data") {
+		// #nosec G120 -- request body capped by maxBytesReader wrapper above
+		return r.ParseMultipartForm(DefaultMaxMemory)
+	}
+	return nil
+}
+
+// takeFormValue returns the first value of key in the parsed form of r and removes the
+// key, so that fields used by middleware don't reach the decoder
+func takeFormValue(r *http.Request, key string) string {
+	value := r.Form.Get(key)
+	r.Form.Del(key)
+	r.PostForm.Del(key)
+	if r.MultipartForm != nil {
+		delete(r.MultipartForm.Value, key)
+	}
+	return value
+}
+
 // ValidatedFormFromContext retrieves the validated form from the request context.
 // Returns nil if no form was found in the context.
 //
@@ -162,39 +235,17 @@ func MustValidatedFormFromContext(ctx context.Context) interface{} {
 
 // ValidationMiddlewareWithContext returns middleware that validates request data and provides context-aware validation support.
 func ValidationMiddlewareWithContext(formStruct interface{}, errorHandler ValidationErrorHandler) func(next http.Handler) http.Handler {
-	if errorHandler == nil {
-		errorHandler = DefaultValidationErrorHandler
-	}
-
-	return func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			// Create a new instance of the form struct
-			form := reflect.New(reflect.TypeOf(formStruct)).Interface()
-
-			// Validate the form with context
-			errors := DecodeAndValidateWithContext(r.Context(), r, form)
-
-			if len(errors) > 0 {
-				// Validation failed, call error handler
-				errorHandler(w, r, errors)
-				return
-			}
-
-			// Validation succeeded, store form in context
-			ctx := context.WithValue(r.Context(), formDataKey, form)
-			r = r.WithContext(ctx)
-
-			// Call next handler
-			next.ServeHTTP(w, r)
-		})
-	}
+	return validationMiddleware(formStruct, errorHandler, nil, func(r *http.Request, v interface{}) ValidationErrors {
+		return DecodeAndValidateWithContext(r.Context(), r, v)
+	})
 }
 
 // JSONValidationErrorHandler returns a JSON error handler that formats errors
 // in a specific structure for API responses.
 //
-// This handler returns a 422 Unprocessable Entity status code and formats validation
-// errors as a structured JSON response suitable for API clients.
+// This handler returns a 422 Unprocessable Entity status code, or 413 or 415 for requests
+// rejected before decoding (see StatusCode), and formats validation errors as a
+// structured JSON response suitable for API clients.
 //
 // Example response:
 //
@@ -245,23 +296,28 @@ func ValidationMiddlewareWithContext(formStruct interface{}, errorHandler Valida
 //	}
 func JSONValidationErrorHandler(w http.ResponseWriter, r *http.Request, errors ValidationErrors) {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusUnprocessableEntity)
+	w.WriteHeader(StatusCode(errors, http.StatusUnprocessableEntity))
 
-	// Flatten errors into a single array
-	var errorList []map[string]string
-	for field, fieldErrors := range errors {
-		for _, err := range fieldErrors {
-			errorList = append(errorList, map[string]string{
-				"field": field,
-				"error": err,
-			})
+	// Flatten errors into a single array, in field and rule order
+	type fieldError struct {
+		Field string `json:"field"`
+		Error string `json:"error"`
+	}
+	var errorList []fieldError
+	for _, field := range orderedErrors(r, errors) {
+		for _, err := range field.Messages {
+			errorList = append(errorList, fieldError{Field: field.Field, Error: err})
 		}
 	}
 
-	response := map[string]interface{}{
-		"status":  "error",
-		"message": "Validation failed",
-		"errors":  errorList,
+	response := struct {
+		Status  string       `json:"status"`
+		Message string       `json:"message"`
+		Errors  []fieldError `json:"errors"`
+	}{
+		Status:  "error",
+		Message: "Validation failed",
+		Errors:  errorList,
 	}
 
 	if err := json.NewEncoder(w).Encode(response); err != nil {
@@ -273,7 +329,8 @@ func JSONValidationErrorHandler(w http.ResponseWriter, r *http.Request, errors V
 // HTMLValidationErrorHandler returns an HTML error handler that renders
 // validation errors in HTML format.
 //
-// This handler returns a 400 Bad Request status code and renders validation errors
+// This handler returns a 400 Bad Request status code, or 413 or 415 for requests
+// rejected before decoding (see StatusCode), and renders validation errors
 // as a user-friendly HTML page. It's suitable for web applications that need to
 // display errors to end users.
 //
@@ -309,7 +366,7 @@ func JSONValidationErrorHandler(w http.ResponseWriter, r *http.Request, errors V
 // - Responsive design suitable for mobile devices
 func HTMLValidationErrorHandler(w http.ResponseWriter, r *http.Request, errors ValidationErrors) {
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
-	w.WriteHeader(http.StatusBadRequest)
+	w.WriteHeader(StatusCode(errors, http.StatusBadRequest))
 
 	html := `<!DOCTYPE html>
 <html>
@@ -325,10 +382,10 @@ func HTMLValidationErrorHandler(w http.ResponseWriter, r *http.Request, errors V
     <h1>Validation Error</h1>
     <p>The following errors occurred:</p>`
 
-	for field, fieldErrors := range errors {
-		for _, err := range fieldErrors {
+	for _, field := range orderedErrors(r, errors) {
+		for _, err := range field.Messages {
 			html += `<div class="error">
-                <span class="field">` + field + `:</span> ` + err + `
+                <span class="field">` + template.HTMLEscapeString(field.Field) + `:</span> ` + template.HTMLEscapeString(err) + `
             </div>`
 		}
 	}
@@ -2,6 +2,7 @@ package form
 
 import (
 	"context"
+	"time"
 
 	"github.com/kdsmith18542/gokit/observability"
 )
@@ -61,6 +62,13 @@ func (f *formObserver) OnValidationEnd(ctx context.Context, formName string, err
 	}
 }
 
+// observeStore reports an operation on a store of submissions, such as the idempotency
+// guard's, to the observability system, so that a failing store shows up in metrics
+// even when the request itself can carry on
+func observeStore(ctx context.Context, operation, storageType string, start time.Time, err error) {
+	observability.GetObserver().OnStorageOperation(ctx, operation, storageType, time.Since(start), err == nil)
+}
+
 // EnableObservability enables observability integration for the form package
 func EnableObservability() {
 	RegisterObserver(&formObserver{})
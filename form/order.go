@@ -0,0 +1,164 @@
+package form
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// FieldErrors holds the error messages of one field, in the order its rules ran.
+type FieldErrors struct {
+	Field    string
+	Messages []string
+}
+
+// OrderedErrors is a view of ValidationErrors in a stable order. It encodes to JSON as an
+// object whose keys keep that order.
+type OrderedErrors []FieldErrors
+
+// Ordered returns the errors in the declaration order of the fields of v, a struct value
+// or pointer. Form-level keys such as "_form" come first, elements of a collection follow
+// the collection in index order, and keys that don't belong to v come last, sorted.
+// If v is nil, fields are sorted by key.
+//
+// Example:
+//
+//	for _, field := range errs.Ordered(&SignUpForm{}) {
+//	    fmt.Println(field.Field, strings.Join(field.Messages, ", "))
+//	}
+func (e ValidationErrors) Ordered(v interface{}) OrderedErrors {
+	var typ reflect.Type
+	if v != nil {
+		typ = reflect.TypeOf(v)
+		for typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+	}
+	return e.orderedByType(typ, defaultTags)
+}
+
+// orderedByType orders the errors by the field order of typ, which may be nil, with its
+// keys read from tags
+func (e ValidationErrors) orderedByType(typ reflect.Type, tags tagNames) OrderedErrors {
+	var ranks map[string]int
+	if typ != nil && typ.Kind() == reflect.Struct {
+		ranks = fieldRanks(typ, tags)
+	}
+
+	type entry struct {
+		key, element string
+		group, rank  int
+	}
+	entries := make([]entry, 0, len(e))
+	for key := range e {
+		en := entry{key: key, group: 2}
+		base := key
+		if i := strings.IndexByte(key, '['); i > 0 {
+			base, en.element = key[:i], key[i:]
+		}
+		if strings.HasPrefix(key, "_") {
+			en.group = 0
+		} else if rank, ok := ranks[base]; ok {
+			en.group, en.rank = 1, rank
+		}
+		entries = append(entries, en)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		switch {
+		case a.group != b.group:
+			return a.group < b.group
+		case a.group != 1:
+			return a.key < b.key
+		case a.rank != b.rank:
+			return a.rank < b.rank
+		default:
+			return compareElements(a.element, b.element) < 0
+		}
+	})
+
+	ordered := make(OrderedErrors, len(entries))
+	for i, en := range entries {
+		ordered[i] = FieldErrors{Field: en.key, Messages: e[en.key]}
+	}
+	return ordered
+}
+
+// compareElements orders collection element suffixes such as "[2]" and "[10]": the
+// collection itself first, then numeric indexes by value, then other keys lexically
+func compareElements(a, b string) int {
+	if a == "" || b == "" {
+		return len(a) - len(b)
+	}
+	na, errA := strconv.Atoi(strings.Trim(a, "[]"))
+	nb, errB := strconv.Atoi(strings.Trim(b, "[]"))
+	switch {
+	case errA == nil && errB == nil:
+		return na - nb
+	case errA == nil:
+		return -1
+	case errB == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// fieldRankCache caches field declaration order by struct type and tags
+var fieldRankCache sync.Map
+
+// fieldRanks returns the position of every form key of typ in declaration order, with
+// nested struct fields listed at the position of the struct, before their own fields
+func fieldRanks(typ reflect.Type, tags tagNames) map[string]int {
+	if cached, ok := fieldRankCache.Load(planKey{typ, tags}); ok {
+		return cached.(map[string]int)
+	}
+
+	ranks := make(map[string]int)
+	var walk func(plan *structPlan, prefix string, onPath map[reflect.Type]bool)
+	walk = func(plan *structPlan, prefix string, onPath map[reflect.Type]bool) {
+		for _, f := range plan.fields {
+			key := prefix + f.key
+			if _, seen := ranks[key]; !seen {
+				ranks[key] = len(ranks)
+			}
+			if f.nested != nil && !onPath[f.nested] {
+				onPath[f.nested] = true
+				walk(plan.nested(f), key+".", onPath)
+				delete(onPath, f.nested)
+			}
+		}
+	}
+	walk(planWithTags(typ, tags), "", map[reflect.Type]bool{typ: true})
+
+	fieldRankCache.Store(planKey{typ, tags}, ranks)
+	return ranks
+}
+
+// MarshalJSON encodes the errors as a JSON object whose keys keep their order.
+func (o OrderedErrors) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, field := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(field.Field)
+		if err != nil {
+			return nil, err
+		}
+		messages, err := json.Marshal(field.Messages)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(messages)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,168 @@
+package form
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type orderedSignUp struct {
+	Username string            `form:"username" validate:"required,min=3,alphanumeric"`
+	Email    string            `form:"email" validate:"required,email"`
+	Tags     []string          `form:"tags" validate:"max=2,dive,alpha"`
+	Address  ShippingAddress   `form:"address"`
+	Labels   map[string]string `form:"labels" validate:"dive,alpha"`
+}
+
+func TestValidationErrorsOrdered(t *testing.T) {
+	errs := ValidationErrors{
+		"labels[b]":         {ErrMustBeAlpha},
+		"tags[10]":          {ErrMustBeAlpha},
+		"email":             {ErrFieldRequired},
+		"tags[2]":           {ErrMustBeAlpha},
+		"address.post_code": {ErrFieldRequired},
+		"tags":              {"Must contain no more than 2 items"},
+		"username":          {"Must be at least 3 characters long", ErrMustBeAlphanumeric},
+		"extra":             {"Unexpected"},
+		"address":           {"Address is not deliverable"},
+		"labels[a]":         {ErrMustBeAlpha},
+		FormErrorKey:        {"Sign-ups are closed"},
+		"address.country":   {ErrFieldRequired},
+		"_struct":           {"Broken"},
+	}
+
+	var fields []string
+	for _, field := range errs.Ordered(&orderedSignUp{}) {
+		fields = append(fields, field.Field)
+	}
+	expected := []string{
+		FormErrorKey, "_struct",
+		"username", "email",
+		"tags", "tags[2]", "tags[10]",
+		"address", "address.country", "address.post_code",
+		"labels[a]", "labels[b]",
+		"extra",
+	}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Errorf("Expected order %v, got %v", expected, fields)
+	}
+
+	// Messages keep the order the rules ran in
+	if got := errs.Ordered(orderedSignUp{})[2]; !reflect.DeepEqual(got.Messages, errs["username"]) {
+		t.Errorf("Expected username messages in rule order, got %v", got.Messages)
+	}
+
+	// Without a type, fields are sorted by key
+	fields = nil
+	for _, field := range (ValidationErrors{"b": {"x"}, "a": {"y"}, "_form": {"z"}}).Ordered(nil) {
+		fields = append(fields, field.Field)
+	}
+	if !reflect.DeepEqual(fields, []string{"_form", "a", "b"}) {
+		t.Errorf("Expected sorted order, got %v", fields)
+	}
+}
+
+func TestOrderedErrorsMarshalJSON(t *testing.T) {
+	errs := ValidationErrors{
+		"email":    {ErrFieldRequired},
+		"username": {"Must be at least 3 characters long", ErrMustBeAlphanumeric},
+	}
+
+	data, err := json.Marshal(errs.Ordered(orderedSignUp{}))
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	expected := `{"username":["Must be at least 3 characters long","Must contain only letters and numbers"],"email":["This field is required"]}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+
+	if data, _ := json.Marshal(OrderedErrors{}); string(data) != "{}" {
+		t.Errorf("Expected empty object, got %s", data)
+	}
+}
+
+func TestErrorHandlersUseDeclarationOrder(t *testing.T) {
+	values := url.Values{"username": {"x!"}, "email": {"nope"}}
+
+	tests := []struct {
+		name     string
+		handler  ValidationErrorHandler
+		expected string
+	}{
+		{
+			name:     "default",
+			handler:  nil,
+			expected: `{"error":"Validation failed","details":{"username":["Must be at least 3 characters long","Must contain only letters and numbers"],"email":["Invalid email format"],"address.country":["This field is required"],"address.post_code":["This field is required"]}}`,
+		},
+		{
+			name:     "json",
+			handler:  JSONValidationErrorHandler,
+			expected: `{"status":"error","message":"Validation failed","errors":[{"field":"username","error":"Must be at least 3 characters long"},{"field":"username","error":"Must contain only letters and numbers"},{"field":"email","error":"Invalid email format"},{"field":"address.country","error":"This field is required"},{"field":"address.post_code","error":"This field is required"}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Repeat to catch map iteration order leaking into the output
+			for i := 0; i < 20; i++ {
+				req := httptest.NewRequest("POST", "/", strings.NewReader(values.Encode()))
+				req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+				w := httptest.NewRecorder()
+
+				ValidationMiddleware(orderedSignUp{}, tt.handler)(http.NotFoundHandler()).ServeHTTP(w, req)
+
+				if body := strings.TrimSpace(w.Body.String()); body != tt.expected {
+					t.Fatalf("Expected %s, got %s", tt.expected, body)
+				}
+			}
+		})
+	}
+
+	req := httptest.NewRequest("POST", "/", strings.NewReader(values.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	ValidationMiddleware(orderedSignUp{}, HTMLValidationErrorHandler)(http.NotFoundHandler()).ServeHTTP(w, req)
+	body := w.Body.String()
+	if strings.Index(body, "username:") > strings.Index(body, "email:") {
+		t.Errorf("Expected username before email in HTML output, got %s", body)
+	}
+}
+
+func TestDecoderErrorHandlersUseTheDecoderTags(t *testing.T) {
+	type apiSignUp struct {
+		Username string `json:"username" validate:"required,min=3"`
+		Email    string `json:"email" validate:"required,email"`
+	}
+	decoder := NewDecoder(DecoderOptions{FormTag: "json"})
+	expected := `{"error":"Validation failed","details":{"username":["Must be at least 3 characters long"],"email":["Invalid email format"]}}`
+
+	for _, contentType := range []string{"application/x-www-form-urlencoded", "application/json"} {
+		body := url.Values{"username": {"x"}, "email": {"nope"}}.Encode()
+		if contentType == "application/json" {
+			body = `{"username":"x","email":"nope"}`
+		}
+		for i := 0; i < 20; i++ {
+			req := httptest.NewRequest("POST", "/", strings.NewReader(body))
+			req.Header.Set("Content-Type", contentType)
+			w := httptest.NewRecorder()
+			decoder.ValidationMiddleware(apiSignUp{}, nil)(http.NotFoundHandler()).ServeHTTP(w, req)
+			if got := strings.TrimSpace(w.Body.String()); got != expected {
+				t.Fatalf("Expected %s for %s, got %s", expected, contentType, got)
+			}
+		}
+	}
+}
+
+func TestHTMLValidationErrorHandlerEscapes(t *testing.T) {
+	w := httptest.NewRecorder()
+	HTMLValidationErrorHandler(w, httptest.NewRequest("POST", "/", nil), ValidationErrors{"<b>": {"<script>alert(1)</script>"}})
+
+	if body := w.Body.String(); strings.Contains(body, "<script>") || strings.Contains(body, "<b>") {
+		t.Errorf("Expected field names and messages to be escaped, got %s", body)
+	}
+}
@@ -0,0 +1,381 @@
+package form
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// fieldRefRules lists the rules whose parameter names another field. required_if and
+// required_unless take "field:value", required_with and required_without a list of
+// fields such as "a:b", after and before also accept time bounds, and the country
+// rules also accept country codes.
+var fieldRefRules = map[string]bool{
+	"eqfield":          true,
+	"nefield":          true,
+	"gtfield":          true,
+	"gtefield":         true,
+	"ltfield":          true,
+	"ltefield":         true,
+	"required_if":      true,
+	"required_unless":  true,
+	"required_with":    true,
+	"required_without": true,
+	"date_after":       true,
+	"date_before":      true,
+	"after":            true,
+	"before":           true,
+	"postal_code":      true,
+	"phone":            true,
+	"national_id":      true,
+}
+
+// tagNames are the struct tags a plan reads keys, rules and sanitizers from
+type tagNames struct {
+	form, validate, sanitize string
+	dialect                  Dialect
+}
+
+// defaultTags are the tags read by the package-level functions
+var defaultTags = tagNames{form: "form", validate: "validate", sanitize: "sanitize"}
+
+// structPlan is the cached description of one struct type used by the reflective
+// decoder and validator
+type structPlan struct {
+	typ    reflect.Type
+	tags   tagNames
+	fields []fieldPlan
+	// byName finds fields by Go name, form key and lowercase Go name
+	byName map[string]int
+}
+
+// fieldPlan describes one struct field
+type fieldPlan struct {
+	index    int
+	name     string
+	key      string
+	sanitize string
+	validate string
+	// unsupported lists the rules dropped when translating the validate tag from a dialect
+	unsupported []string
+	// def is the default tag, applied when the field's key is absent from the input
+	def string
+	// locale is the locale tag, overriding how localized input is read
+	locale string
+	// sensitive is the masking policy of a field tagged sensitive, or "" if it isn't
+	sensitive string
+	exported  bool
+	// kind is the kind passed to validation: the element kind for collections
+	kind       reflect.Kind
+	collection bool
+	isMap      bool
+	isTime     bool
+	// nested is the struct type of a nested struct or pointer-to-struct field
+	nested  reflect.Type
+	pointer bool
+}
+
+// planKey identifies a cached plan
+type planKey struct {
+	typ  reflect.Type
+	tags tagNames
+}
+
+// plans caches struct plans by type and tag names
+var plans sync.Map
+
+// planFor returns the plan for a struct type with the default tags, compiling it on first use
+func planFor(t reflect.Type) *structPlan {
+	return planWithTags(t, defaultTags)
+}
+
+// planWithTags returns the plan for a struct type read with the given tags. Keys are
+// read up to the first comma, so json tags such as "name,omitempty" can serve as form
+// tags, and fields whose key tag is "-" are skipped.
+func planWithTags(t reflect.Type, tags tagNames) *structPlan {
+	if plan, ok := plans.Load(planKey{t, tags}); ok {
+		return plan.(*structPlan)
+	}
+
+	plan := &structPlan{typ: t, tags: tags, byName: make(map[string]int)}
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		key, _, _ := strings.Cut(sf.Tag.Get(tags.form), ",")
+		if key == "-" {
+			continue
+		}
+		if key == "" {
+			key = strings.ToLower(sf.Name)
+		}
+		f := fieldPlan{
+			index:     i,
+			name:      sf.Name,
+			key:       key,
+			sanitize:  sf.Tag.Get(tags.sanitize),
+			validate:  sf.Tag.Get(tags.validate),
+			def:       sf.Tag.Get("default"),
+			locale:    sf.Tag.Get("locale"),
+			sensitive: sensitivePolicy(sf.Tag.Get("sensitive")),
+			exported:  sf.IsExported(),
+			kind:      sf.Type.Kind(),
+			isTime:    sf.Type == timeType,
+		}
+		if tags.dialect == DialectPlayground {
+			f.validate, f.unsupported = translatePlayground(f.validate, sf.Type)
+		}
+		switch {
+		case isCollectionType(sf.Type):
+			f.collection = true
+			f.isMap = sf.Type.Kind() == reflect.Map
+			f.kind = sf.Type.Elem().Kind()
+		case !f.exported || f.isTime:
+		case sf.Type.Kind() == reflect.Struct:
+			f.nested = sf.Type
+		case sf.Type.Kind() == reflect.Ptr && sf.Type.Elem().Kind() == reflect.Struct && sf.Type.Elem() != timeType:
+			f.nested, f.pointer = sf.Type.Elem(), true
+		}
+
+		plan.fields = append(plan.fields, f)
+		for _, name := range []string{f.name, f.key, strings.ToLower(f.name)} {
+			if _, taken := plan.byName[name]; !taken {
+				plan.byName[name] = len(plan.fields) - 1
+			}
+		}
+	}
+
+	actual, _ := plans.LoadOrStore(planKey{t, tags}, plan)
+	return actual.(*structPlan)
+}
+
+// nested returns the plan of a nested struct field, read with the same tags
+func (p *structPlan) nested(f fieldPlan) *structPlan {
+	return planWithTags(f.nested, p.tags)
+}
+
+// lookup finds a field by Go name or form key, trying the same spellings as ValidationContext.Get
+func (p *structPlan) lookup(name string) (fieldPlan, bool) {
+	for _, variation := range []string{
+		name,
+		strings.ToLower(name),
+		strings.ReplaceAll(name, "_", ""),
+		strings.ReplaceAll(strings.ToLower(name), "_", ""),
+	} {
+		if i, ok := p.byName[variation]; ok {
+			return p.fields[i], true
+		}
+	}
+	return fieldPlan{}, false
+}
+
+// scope is one struct level on the path from the root to the field being validated
+type scope struct {
+	// prefix is the dotted form key of the struct, ending in "." except at the root
+	prefix string
+	plan   *structPlan
+}
+
+// resolveReference resolves a field reference to the form key its value is stored under.
+// References are relative to the struct holding the field being validated:
+//
+//	.Password        a field of the same struct
+//	../StartDate     a field of the enclosing struct, one level up per "../"
+//	Billing.Country  a path from the root struct
+//	Password         a field of the same struct, or of the root struct
+func resolveReference(scopes []scope, ref string) (string, bool) {
+	if len(scopes) == 0 || ref == "" {
+		return "", false
+	}
+
+	current := len(scopes) - 1
+	switch {
+	case strings.HasPrefix(ref, "../"):
+		for strings.HasPrefix(ref, "../") {
+			ref = ref[len("../"):]
+			current--
+		}
+		if current < 0 {
+			return "", false
+		}
+	case strings.HasPrefix(ref, "."):
+		ref = ref[1:]
+	case strings.Contains(ref, "."):
+		current = 0
+	default:
+		if key, ok := resolvePath(scopes[current], ref); ok {
+			return key, true
+		}
+		current = 0
+	}
+	return resolvePath(scopes[current], ref)
+}
+
+// resolvePath follows a dotted path of field names from a struct
+func resolvePath(s scope, path string) (string, bool) {
+	prefix, plan := s.prefix, s.plan
+	segments := strings.Split(path, ".")
+	for i, segment := range segments {
+		f, ok := plan.lookup(segment)
+		if !ok {
+			return "", false
+		}
+		if i == len(segments)-1 {
+			// A reference must name a value, not a nested struct
+			return prefix + f.key, f.nested == nil
+		}
+		if f.nested == nil {
+			return "", false
+		}
+		prefix, plan = prefix+f.key+".", plan.nested(f)
+	}
+	return "", false
+}
+
+// referenceChecks caches the reference errors of root struct plans
+var referenceChecks sync.Map
+
+// referenceErrors checks every field reference and default provider in the struct tree
+// rooted at plan, so that a misspelled reference is reported instead of silently
+// comparing against "", and a misspelled provider instead of defaulting to "". The
+// result is computed once per plan, so providers must be registered before first use.
+func referenceErrors(plan *structPlan) []string {
+	if cached, ok := referenceChecks.Load(plan); ok {
+		return cached.([]string)
+	}
+	var problems []string
+	checkReferences([]scope{{plan: plan}}, map[reflect.Type]bool{plan.typ: true}, &problems)
+	referenceChecks.Store(plan, problems)
+	return problems
+}
+
+// checkReferences checks the references of one struct level, then of its nested structs.
+// Recursive types are checked once, since each level refers to the same fields.
+func checkReferences(scopes []scope, onPath map[reflect.Type]bool, problems *[]string) {
+	s := scopes[len(scopes)-1]
+	for _, f := range s.plan.fields {
+		key := s.prefix + f.key
+		for _, rule := range strings.Split(f.validate, ",") {
+			name, param, _ := strings.Cut(strings.TrimSpace(rule), "=")
+			if name == "tz" {
+				if _, ok := loadLocation(param); !ok {
+					*problems = append(*problems, fmt.Sprintf("%s: tz refers to unknown time zone %q", key, param))
+				}
+				continue
+			}
+			if (name == "postal_code" || name == "national_id") && param == "" {
+				*problems = append(*problems, fmt.Sprintf("%s: %s needs a country code or field", key, name))
+				continue
+			}
+			if !fieldRefRules[name] || param == "" {
+				continue
+			}
+			refs := []string{param}
+			switch name {
+			case "required_if", "required_unless":
+				refs[0], _, _ = strings.Cut(param, ":")
+			case "required_with", "required_without":
+				refs = SplitParam(param, 0)
+			case "after", "before":
+				if _, ok := literalBound(param, timeSettingsFor(f.validate)); ok {
+					continue
+				}
+			case "postal_code", "phone", "national_id":
+				if isCountryCode(param) {
+					if _, ok := countryFormats[param]; !ok {
+						*problems = append(*problems, fmt.Sprintf("%s: %s refers to unknown country %q", key, name, param))
+					}
+					continue
+				}
+			}
+			for _, ref := range refs {
+				if _, ok := resolveReference(scopes, ref); !ok {
+					*problems = append(*problems, fmt.Sprintf("%s: %s refers to unknown field %q", key, name, ref))
+				}
+			}
+		}
+		if provider, ok := strings.CutPrefix(f.def, "@"); ok && !strings.HasPrefix(provider, "@") {
+			if _, exists := defaultProviders[provider]; !exists {
+				*problems = append(*problems, fmt.Sprintf("%s: default refers to unknown provider %q", key, f.def))
+			}
+		}
+
+		if f.nested != nil && !onPath[f.nested] {
+			onPath[f.nested] = true
+			checkReferences(append(scopes[:len(scopes):len(scopes)], scope{prefix: key + ".", plan: s.plan.nested(f)}), onPath, problems)
+			delete(onPath, f.nested)
+		}
+	}
+}
+
+// structNode is one struct value in a tree being validated
+type structNode struct {
+	// ptr points to the struct
+	ptr    reflect.Value
+	scopes []scope
+}
+
+// prefix returns the dotted form key of the node, ending in "." except at the root
+func (n structNode) prefix() string {
+	return n.scopes[len(n.scopes)-1].prefix
+}
+
+// structAddr identifies a struct value. The type is part of it because a struct's first
+// field shares the struct's address.
+type structAddr struct {
+	addr uintptr
+	typ  reflect.Type
+}
+
+// walkStructs lists the struct ptr points to, described by plan, then its nested structs
+// and non-nil pointers to structs, depth first. Pointer cycles are visited once.
+func walkStructs(ptr reflect.Value, plan *structPlan) []structNode {
+	var nodes []structNode
+	visited := make(map[structAddr]bool)
+
+	var walk func(ptr reflect.Value, scopes []scope)
+	walk = func(ptr reflect.Value, scopes []scope) {
+		visited[structAddr{ptr.Pointer(), ptr.Type()}] = true
+		nodes = append(nodes, structNode{ptr: ptr, scopes: scopes})
+
+		s := scopes[len(scopes)-1]
+		val := ptr.Elem()
+		for _, f := range s.plan.fields {
+			if f.nested == nil {
+				continue
+			}
+			nested := val.Field(f.index)
+			if f.pointer {
+				if nested.IsNil() {
+					continue
+				}
+			} else {
+				nested = nested.Addr()
+			}
+			if visited[structAddr{nested.Pointer(), nested.Type()}] {
+				continue
+			}
+			walk(nested, append(scopes[:len(scopes):len(scopes)], scope{prefix: s.prefix + f.key + ".", plan: s.plan.nested(f)}))
+		}
+	}
+
+	walk(ptr, []scope{{plan: plan}})
+	return nodes
+}
+
+// runNestedStructValidators runs the struct-level validators of nested structs, reporting
+// their errors under dotted keys. Form-level errors are reported under the struct's key.
+func runNestedStructValidators(ctx context.Context, nodes []structNode, errors ValidationErrors) {
+	for _, node := range nodes[1:] {
+		prefix := node.prefix()
+		for key, messages := range structValidatorErrors(ctx, node.ptr.Interface()) {
+			if key == FormErrorKey {
+				key = strings.TrimSuffix(prefix, ".")
+			} else {
+				key = prefix + key
+			}
+			errors[key] = append(errors[key], messages...)
+			rulesFrom(ctx).add(key, "struct")
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package form
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type ShippingAddress struct {
+	Country         string `form:"country" validate:"required"`
+	PostCode        string `form:"post_code" validate:"required"`
+	ConfirmPostCode string `form:"confirm_post_code" validate:"eqfield=.PostCode"`
+}
+
+type StayDates struct {
+	EndDate string `form:"end_date" validate:"datetime=date,after=../StartDate"`
+}
+
+type CheckoutForm struct {
+	StartDate      string           `form:"start_date" validate:"required,datetime=date"`
+	Stay           StayDates        `form:"stay"`
+	Shipping       ShippingAddress  `form:"shipping"`
+	Billing        *ShippingAddress `form:"billing"`
+	InvoiceCountry string           `form:"invoice_country" validate:"eqfield=Shipping.Country"`
+	Allocation     *AllocationForm  `form:"allocation"`
+}
+
+func TestDecodeNestedStructs(t *testing.T) {
+	values := url.Values{
+		"start_date":                 {"2026-05-10"},
+		"stay.end_date":              {"2026-05-09"},
+		"shipping.country":           {"NL"},
+		"shipping.post_code":         {"1011AB"},
+		"shipping.confirm_post_code": {"1011AC"},
+		"invoice_country":            {"BE"},
+	}
+
+	var f CheckoutForm
+	errs := DecodeAndValidate(newValuesRequest(values), &f)
+
+	expected := ValidationErrors{
+		"stay.end_date":              {`Must be after "../StartDate"`},
+		"shipping.confirm_post_code": {`Must match the ".PostCode" field`},
+		"invoice_country":            {`Must match the "Shipping.Country" field`},
+	}
+	if !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected %v, got %v", expected, errs)
+	}
+	if f.Shipping.PostCode != "1011AB" || f.Stay.EndDate != "2026-05-09" {
+		t.Errorf("Expected nested fields to be bound, got %+v", f)
+	}
+	if f.Billing != nil || f.Allocation != nil {
+		t.Errorf("Expected optional nested structs without input to stay nil, got %+v", f)
+	}
+}
+
+func TestDecodeNestedPointerStructs(t *testing.T) {
+	values := url.Values{
+		"start_date":                 {"2026-05-10"},
+		"shipping.country":           {"NL"},
+		"shipping.post_code":         {"1011AB"},
+		"shipping.confirm_post_code": {"1011AB"},
+		"invoice_country":            {"NL"},
+		"billing.country":            {"BE"},
+		"allocation.compute":         {"60"},
+		"allocation.storage":         {"30"},
+		"allocation.network":         {"5"},
+	}
+
+	var f CheckoutForm
+	errs := DecodeAndValidate(newValuesRequest(values), &f)
+
+	expected := ValidationErrors{
+		"billing.post_code": {ErrFieldRequired},
+		"allocation":        {"Allocations must add up to 100"},
+	}
+	if !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected %v, got %v", expected, errs)
+	}
+	if f.Billing == nil || f.Billing.Country != "BE" {
+		t.Errorf("Expected billing to be allocated and bound, got %+v", f.Billing)
+	}
+}
+
+func TestDecodeNestedJSON(t *testing.T) {
+	body := `{"start_date":"2026-05-10","stay":{"end_date":"2026-05-12"},"shipping":{"country":"NL","post_code":"1011AB","confirm_post_code":"1011AB"},"invoice_country":"NL"}`
+
+	var f CheckoutForm
+	if errs := DecodeAndValidateJSON(context.Background(), strings.NewReader(body), &f); len(errs) > 0 {
+		t.Errorf("Expected no validation errors, got: %v", errs)
+	}
+	if f.Shipping.Country != "NL" || f.Stay.EndDate != "2026-05-12" {
+		t.Errorf("Expected nested JSON objects to be bound, got %+v", f)
+	}
+}
+
+// paymentFirstForm has a nested struct as its first field, at the address of the form
+type paymentFirstForm struct {
+	Payment paymentCard `form:"payment"`
+	Name    string      `form:"name"`
+}
+
+type paymentCard struct {
+	Number string `form:"number" validate:"required,numeric"`
+}
+
+func TestNestedStructAsFirstField(t *testing.T) {
+	expected := ValidationErrors{"payment.number": {ErrMustBeNumber}}
+
+	var f paymentFirstForm
+	if errs := DecodeAndValidate(newValuesRequest(url.Values{"payment.number": {"abc"}}), &f); !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected %v when decoding a form, got %v", expected, errs)
+	}
+	f = paymentFirstForm{}
+	if errs := DecodeAndValidateJSON(context.Background(), strings.NewReader(`{"payment":{"number":"abc"}}`), &f); !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected %v when decoding JSON, got %v", expected, errs)
+	}
+	expected = ValidationErrors{"payment.number": {ErrFieldRequired}}
+	if errs := ValidateStruct(context.Background(), &paymentFirstForm{Name: "Ada"}); !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected %v from ValidateStruct, got %v", expected, errs)
+	}
+}
+
+func TestValidateStructNestedReferences(t *testing.T) {
+	f := CheckoutForm{
+		StartDate:      "2026-05-10",
+		Stay:           StayDates{EndDate: "2026-05-11"},
+		Shipping:       ShippingAddress{Country: "NL", PostCode: "1011AB", ConfirmPostCode: "1011AB"},
+		Billing:        &ShippingAddress{Country: "BE", PostCode: "1000", ConfirmPostCode: "1001"},
+		InvoiceCountry: "NL",
+	}
+
+	expected := ValidationErrors{
+		"billing.confirm_post_code": {`Must match the ".PostCode" field`},
+	}
+	if errs := ValidateStruct(context.Background(), &f); !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected %v, got %v", expected, errs)
+	}
+}
+
+func TestUnknownReferences(t *testing.T) {
+	type Broken struct {
+		Password string          `form:"password"`
+		Confirm  string          `form:"confirm" validate:"eqfield=.Pasword"`
+		Window   StayDates       `form:"window"`
+		Address  ShippingAddress `form:"address" validate:"required"`
+		Country  string          `form:"country" validate:"required_if=Address.Region:EU"`
+		Opens    string          `form:"opens" validate:"after=now,before=closes"`
+		Starts   string          `form:"starts" validate:"datetime=local,tz=Europe/Pariss"`
+	}
+
+	expected := []string{
+		`confirm: eqfield refers to unknown field ".Pasword"`,
+		`window.end_date: after refers to unknown field "../StartDate"`,
+		`country: required_if refers to unknown field "Address.Region"`,
+		`opens: before refers to unknown field "closes"`,
+		`starts: tz refers to unknown time zone "Europe/Pariss"`,
+	}
+
+	var b Broken
+	errs := DecodeAndValidate(newValuesRequest(url.Values{"confirm": {"x"}}), &b)
+	if !reflect.DeepEqual(errs, ValidationErrors{"_struct": expected}) {
+		t.Errorf("Expected %v, got %v", expected, errs)
+	}
+	if errs := ValidateStruct(context.Background(), &b); !reflect.DeepEqual(errs["_struct"], expected) {
+		t.Errorf("Expected %v, got %v", expected, errs)
+	}
+}
+
+func TestResolveReference(t *testing.T) {
+	root := scope{plan: planFor(reflect.TypeOf(CheckoutForm{}))}
+	stay := scope{prefix: "stay.", plan: planFor(reflect.TypeOf(StayDates{}))}
+	shipping := scope{prefix: "shipping.", plan: planFor(reflect.TypeOf(ShippingAddress{}))}
+
+	tests := []struct {
+		scopes []scope
+		ref    string
+		key    string
+		ok     bool
+	}{
+		{[]scope{root}, "StartDate", "start_date", true},
+		{[]scope{root}, "start_date", "start_date", true},
+		{[]scope{root}, ".InvoiceCountry", "invoice_country", true},
+		{[]scope{root}, "Shipping.PostCode", "shipping.post_code", true},
+		{[]scope{root}, "billing.confirm_post_code", "billing.confirm_post_code", true},
+		{[]scope{root}, "Shipping", "", false},
+		{[]scope{root}, "../StartDate", "", false},
+		{[]scope{root, stay}, "../StartDate", "start_date", true},
+		{[]scope{root, stay}, ".EndDate", "stay.end_date", true},
+		{[]scope{root, stay}, "../Shipping.Country", "shipping.country", true},
+		{[]scope{root, shipping}, "PostCode", "shipping.post_code", true},
+		{[]scope{root, shipping}, "InvoiceCountry", "invoice_country", true},
+		{[]scope{root, shipping}, ".InvoiceCountry", "", false},
+		{[]scope{root, shipping}, "../../StartDate", "", false},
+	}
+
+	for _, tt := range tests {
+		key, ok := resolveReference(tt.scopes, tt.ref)
+		if key != tt.key && tt.ok || ok != tt.ok {
+			t.Errorf("resolveReference(%q) = %q, %v; expected %q, %v", tt.ref, key, ok, tt.key, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,255 @@
+package form
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ParamSanitizer is a sanitizer that receives the parameter written after "=" in the
+// sanitize tag, e.g. "140" for `sanitize:"truncate=140"`. The parameter is empty when
+// the tag omits it. Returning a non-nil error fails validation of the field and the
+// error message is reported as the field's validation error.
+//
+// Use SplitParam to decode multi-part parameters such as "6:0".
+//
+// Example:
+//
+//	form.RegisterParamSanitizer("repeat", func(value, param string) (string, error) {
+//	    n, err := strconv.Atoi(param)
+//	    if err != nil {
+//	        return value, nil
+//	    }
+//	    return strings.Repeat(value, n), nil
+//	})
+type ParamSanitizer func(value, param string) (string, error)
+
+// maxPipelineDepth bounds pipeline expansion so a pipeline that refers to itself
+// fails instead of recursing forever.
+const maxPipelineDepth = 8
+
+// RegisterParamSanitizer registers a custom parameterized sanitizer.
+// Parameterized sanitizers take precedence over plain sanitizers with the same name.
+func RegisterParamSanitizer(name string, sanitizer ParamSanitizer) {
+	registry.paramSanitizers[name] = sanitizer
+}
+
+// RegisterPipeline registers a named, reusable chain of sanitizers.
+// The spec uses the same syntax as the sanitize tag and may reference other pipelines.
+// Once registered, the pipeline name can be used anywhere a sanitizer name is accepted.
+//
+// Example:
+//
+//	form.RegisterPipeline("username", "trim,to_lower,slugify")
+//
+//	type SignUpForm struct {
+//	    Username string `form:"username" sanitize:"username" validate:"required,min=3"`
+//	}
+func RegisterPipeline(name, spec string) {
+	registry.pipelines[name] = spec
+}
+
+// applySanitizers applies a chain of sanitizers to a value.
+// The first sanitizer error stops the chain and is returned with the value sanitized so far.
+func applySanitizers(value, sanitizeTag string) (string, error) {
+	return applySanitizerChain(value, sanitizeTag, 0)
+}
+
+// applySanitizerChain applies a sanitize spec, expanding pipelines up to maxPipelineDepth
+func applySanitizerChain(value, spec string, depth int) (string, error) {
+	if depth > maxPipelineDepth {
+		return value, fmt.Errorf("sanitizer pipelines nested more than %d levels deep", maxPipelineDepth)
+	}
+
+	for _, rule := range splitRules(spec) {
+		name, param, hasParam := strings.Cut(rule, "=")
+		name = strings.TrimSpace(name)
+
+		if pipeline, exists := registry.pipelines[name]; exists && !hasParam {
+			var err error
+			if value, err = applySanitizerChain(value, pipeline, depth+1); err != nil {
+				return value, err
+			}
+			continue
+		}
+
+		if sanitizer, exists := registry.paramSanitizers[name]; exists {
+			sanitized, err := sanitizer(value, param)
+			if err != nil {
+				return value, err
+			}
+			value = sanitized
+		} else if sanitizer, exists := registry.sanitizers[name]; exists {
+			value = sanitizer(value)
+		}
+	}
+	return value, nil
+}
+
+// splitRules splits a tag on commas that are not escaped with a backslash.
+// Escape sequences are kept intact so that SplitParam can decode them later.
+func splitRules(tag string) []string {
+	if tag == "" {
+		return nil
+	}
+
+	var rules []string
+	var current strings.Builder
+	for i := 0; i < len(tag); i++ {
+		switch c := tag[i]; {
+		case c == '\\' && i+1 < len(tag):
+			current.WriteByte(c)
+			current.WriteByte(tag[i+1])
+			i++
+		case c == ',':
+			rules = append(rules, current.String())
+			current.Reset()
+		default:
+			current.WriteByte(c)
+		}
+	}
+	return append(rules, current.String())
+}
+
+// SplitParam splits a tag parameter on unescaped colons into at most n parts
+// (n <= 0 means no limit) and decodes backslash escapes in each part.
+// Recognised escapes are \t, \n, \r, \s (space), and a backslash followed by any
+// other character, which yields that character (e.g. "\:" or "\,").
+//
+// Inside a Go struct tag the backslash itself must be doubled, e.g.
+// `sanitize:"replace=\\,:;"` replaces commas with semicolons.
+//
+// Example:
+//
+//	form.SplitParam("6:0", 2)    // ["6", "0"]
+//	form.SplitParam(`a\:b`, 2)   // ["a:b"]
+//	form.SplitParam("x:y:z", 2)  // ["x", "y:z"]
+func SplitParam(param string, n int) []string {
+	var parts []string
+	var current strings.Builder
+	for i := 0; i < len(param); i++ {
+		c := param[i]
+		switch {
+		case c == '\\' && i+1 < len(param):
+			i++
+			switch param[i] {
+			case 't':
+				current.WriteByte('\t')
+			case 'n':
+				current.WriteByte('\n')
+			case 'r':
+				current.WriteByte('\r')
+			case 's':
+				current.WriteByte(' ')
+			default:
+				current.WriteByte(param[i])
+			}
+		case c == ':' && (n <= 0 || len(parts) < n-1):
+			parts = append(parts, current.String())
+			current.Reset()
+		default:
+			current.WriteByte(c)
+		}
+	}
+	return append(parts, current.String())
+}
+
+// pad pads value with fill up to width runes, on the left or on the right
+func pad(value, param string, left bool) string {
+	args := SplitParam(param, 2)
+	width, err := strconv.Atoi(args[0])
+	if err != nil {
+		return value
+	}
+	fill := " "
+	if len(args) > 1 && args[1] != "" {
+		fill = args[1]
+	}
+
+	missing := width - utf8.RuneCountInString(value)
+	if missing <= 0 {
+		return value
+	}
+	padding := strings.Repeat(fill, missing)
+	padding = string([]rune(padding)[:missing])
+	if left {
+		return padding + value
+	}
+	return value + padding
+}
+
+// builtinParamSanitizers contains all built-in parameterized sanitization functions
+var builtinParamSanitizers = map[string]ParamSanitizer{
+	"normalize_unicode": func(value, param string) (string, error) {
+		// normalize_unicode=NFC|NFD|NFKC|NFKD; plain normalize_unicode uses NFC
+		if param == "" {
+			param = "NFC"
+		}
+		return normalizeUnicode(value, param), nil
+	},
+	"truncate": func(value, param string) (string, error) {
+		// truncate=N keeps at most N characters
+		limit, err := strconv.Atoi(param)
+		if err != nil || limit < 0 {
+			return value, nil
+		}
+		if utf8.RuneCountInString(value) <= limit {
+			return value, nil
+		}
+		return string([]rune(value)[:limit]), nil
+	},
+	"replace": func(value, param string) (string, error) {
+		// replace=old:new replaces every occurrence of old with new
+		args := SplitParam(param, 2)
+		if len(args) != 2 || args[0] == "" {
+			return value, nil
+		}
+		return strings.ReplaceAll(value, args[0], args[1]), nil
+	},
+	"pad_left": func(value, param string) (string, error) {
+		// pad_left=width:char pads on the left, using a space if char is omitted
+		return pad(value, param, true), nil
+	},
+	"pad_right": func(value, param string) (string, error) {
+		// pad_right=width:char pads on the right, using a space if char is omitted
+		return pad(value, param, false), nil
+	},
+	"default": func(value, param string) (string, error) {
+		// default=value substitutes value when the input is blank
+		if strings.TrimSpace(value) == "" {
+			return SplitParam(param, 1)[0], nil
+		}
+		return value, nil
+	},
+	"slugify": func(value, param string) (string, error) {
+		// slugify=sep lowercases and joins runs of letters and digits with sep (default "-")
+		sep := "-"
+		if param != "" {
+			sep = SplitParam(param, 1)[0]
+		}
+		var result strings.Builder
+		pending := false
+		for _, char := range strings.ToLower(value) {
+			if unicode.IsLetter(char) || unicode.IsDigit(char) {
+				if pending && result.Len() > 0 {
+					result.WriteString(sep)
+				}
+				pending = false
+				result.WriteRune(char)
+			} else {
+				pending = true
+			}
+		}
+		return result.String(), nil
+	},
+	"valid_utf8": func(value, param string) (string, error) {
+		// valid_utf8 fails validation instead of passing malformed input through
+		if !utf8.ValidString(value) {
+			return value, errors.New(ErrInvalidUTF8)
+		}
+		return value, nil
+	},
+}
@@ -0,0 +1,162 @@
+package form
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParamSanitizers(t *testing.T) {
+	testCases := []struct {
+		name     string
+		tag      string
+		input    string
+		expected string
+	}{
+		{"truncate", "truncate=5", "hello world", "hello"},
+		{"truncate counts runes", "truncate=4", "Josécito", "José"},
+		{"truncate shorter input", "truncate=140", "short", "short"},
+		{"truncate invalid param", "truncate=abc", "unchanged", "unchanged"},
+		{"replace", "replace=-:_", "a-b-c", "a_b_c"},
+		{"replace tab with space", "replace=\t:\\s", "a\tb", "a b"},
+		{"replace escaped colon", `replace=\::=`, "a:b", "a=b"},
+		{"replace escaped comma", `replace=\,:;`, "a,b", "a;b"},
+		{"pad_left", "pad_left=6:0", "42", "000042"},
+		{"pad_left default fill", "pad_left=4", "ab", "  ab"},
+		{"pad_right", "pad_right=5:.", "ab", "ab..."},
+		{"pad longer input", "pad_left=2:0", "12345", "12345"},
+		{"default blank", "default=guest", "  ", "guest"},
+		{"default present", "default=guest", "alice", "alice"},
+		{"slugify", "slugify", "  Hello, World! 2024 ", "hello-world-2024"},
+		{"slugify separator", "slugify=_", "Hello World", "hello_world"},
+		{"chain", "trim,truncate=3,pad_right=5:*", "  abcdef ", "abc**"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := applySanitizers(tc.input, tc.tag)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result != tc.expected {
+				t.Errorf("Expected '%s', got '%s'", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestSplitRules(t *testing.T) {
+	testCases := []struct {
+		tag      string
+		expected []string
+	}{
+		{"", nil},
+		{"trim,to_lower", []string{"trim", "to_lower"}},
+		{`replace=\,:;,trim`, []string{`replace=\,:;`, "trim"}},
+		{`replace=\\,trim`, []string{`replace=\\`, "trim"}},
+	}
+
+	for _, tc := range testCases {
+		if result := splitRules(tc.tag); !reflect.DeepEqual(result, tc.expected) {
+			t.Errorf("splitRules(%q): expected %q, got %q", tc.tag, tc.expected, result)
+		}
+	}
+}
+
+func TestSplitParam(t *testing.T) {
+	testCases := []struct {
+		param    string
+		n        int
+		expected []string
+	}{
+		{"6:0", 2, []string{"6", "0"}},
+		{"x:y:z", 2, []string{"x", "y:z"}},
+		{"x:y:z", 0, []string{"x", "y", "z"}},
+		{`a\:b`, 2, []string{"a:b"}},
+		{`\t:\n`, 2, []string{"\t", "\n"}},
+		{"http://example.com", 1, []string{"http://example.com"}},
+	}
+
+	for _, tc := range testCases {
+		if result := SplitParam(tc.param, tc.n); !reflect.DeepEqual(result, tc.expected) {
+			t.Errorf("SplitParam(%q, %d): expected %q, got %q", tc.param, tc.n, tc.expected, result)
+		}
+	}
+}
+
+func TestRegisterPipeline(t *testing.T) {
+	RegisterPipeline("test_username", "trim,to_lower,slugify")
+	RegisterPipeline("test_handle", "test_username,truncate=8")
+
+	result, err := applySanitizers("  John Smith-Jones  ", "test_handle")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result != "john-smi" {
+		t.Errorf("Expected 'john-smi', got '%s'", result)
+	}
+
+	RegisterPipeline("test_loop", "trim,test_loop")
+	if _, err := applySanitizers("value", "test_loop"); err == nil {
+		t.Error("Expected error for self-referencing pipeline")
+	}
+}
+
+func TestRegisterParamSanitizer(t *testing.T) {
+	RegisterParamSanitizer("test_repeat", func(value, param string) (string, error) {
+		if param == "" {
+			return value, errors.New("repeat count is required")
+		}
+		return strings.Repeat(value, len(param)), nil
+	})
+
+	result, err := applySanitizers("ab", "test_repeat=xxx")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result != "ababab" {
+		t.Errorf("Expected 'ababab', got '%s'", result)
+	}
+
+	if _, err := applySanitizers("ab", "test_repeat"); err == nil {
+		t.Error("Expected error when parameter is missing")
+	}
+}
+
+func TestSanitizerErrorsFailValidation(t *testing.T) {
+	type ProfileForm struct {
+		Name     string `form:"name" sanitize:"valid_utf8" validate:"required"`
+		Username string `form:"username" sanitize:"trim,slugify" validate:"required,min=3"`
+		Role     string `form:"role" sanitize:"default=guest"`
+		Zip      string `form:"zip" sanitize:"pad_left=5:0"`
+	}
+
+	request := createRequest(map[string]string{
+		"name":     "bad\xffname",
+		"username": "  Jane Doe ",
+		"zip":      "501",
+	})
+
+	var f ProfileForm
+	errs := DecodeAndValidate(request, &f)
+
+	if len(errs["name"]) != 1 || errs["name"][0] != ErrInvalidUTF8 {
+		t.Errorf("Expected name error %q, got %v", ErrInvalidUTF8, errs["name"])
+	}
+	if f.Name != "" {
+		t.Errorf("Expected name to be left unset, got '%s'", f.Name)
+	}
+	if len(errs) != 1 {
+		t.Errorf("Expected only the name error, got %v", errs)
+	}
+	if f.Username != "jane-doe" {
+		t.Errorf("Expected username 'jane-doe', got '%s'", f.Username)
+	}
+	if f.Role != "guest" {
+		t.Errorf("Expected role 'guest', got '%s'", f.Role)
+	}
+	if f.Zip != "00501" {
+		t.Errorf("Expected zip '00501', got '%s'", f.Zip)
+	}
+}
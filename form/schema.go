@@ -0,0 +1,320 @@
+package form
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+// schemaTypes maps the scalar type names of a schema definition to Go types
+var schemaTypes = map[string]reflect.Type{
+	"string": reflect.TypeOf(""),
+	"int":    reflect.TypeOf(int64(0)),
+	"uint":   reflect.TypeOf(uint64(0)),
+	"float":  reflect.TypeOf(float64(0)),
+	"bool":   reflect.TypeOf(false),
+	"time":   timeType,
+}
+
+// SchemaDefinition describes a form defined at runtime, for example by an admin, instead
+// of by a Go struct. It can be written as JSON or TOML.
+type SchemaDefinition struct {
+	// Name identifies the form in observability events
+	Name   string        `json:"name" toml:"name"`
+	Fields []SchemaField `json:"fields" toml:"fields"`
+}
+
+// SchemaField describes one field of a SchemaDefinition, with the same syntax as the
+// form, sanitize, validate, default, locale and sensitive tags of a struct field.
+type SchemaField struct {
+	// Name is the form key
+	Name string `json:"name" toml:"name"`
+	// Type is "string" (the default), "int", "uint", "float", "bool" or "time"; "[]T" or
+	// "map[string]T" of one of those for collections; or "object" for a nested form
+	// whose fields are in Fields.
+	Type      string        `json:"type,omitempty" toml:"type"`
+	Sanitize  string        `json:"sanitize,omitempty" toml:"sanitize"`
+	Validate  string        `json:"validate,omitempty" toml:"validate"`
+	Default   string        `json:"default,omitempty" toml:"default"`
+	Locale    string        `json:"locale,omitempty" toml:"locale"`
+	Sensitive string        `json:"sensitive,omitempty" toml:"sensitive"`
+	Fields    []SchemaField `json:"fields,omitempty" toml:"fields"`
+}
+
+// Schema validates forms defined at runtime. It is compiled from a SchemaDefinition into
+// the same representation as a tagged struct, so it uses the registered validators and
+// sanitizers, resolves cross-field and conditional rules such as eqfield and
+// required_if, and reports errors in the same format, under the same keys. A Schema is
+// safe for concurrent use.
+//
+// Example:
+//
+//	schema, err := form.ParseSchemaJSON([]byte(`{
+//	    "name": "intake",
+//	    "fields": [
+//	        {"name": "email", "sanitize": "trim", "validate": "required,email"},
+//	        {"name": "age", "type": "int", "validate": "required,min=18"},
+//	        {"name": "company", "validate": "required_if=type:business"}
+//	    ]
+//	}`))
+//	values, errs := schema.Validate(ctx, r.PostForm)
+type Schema struct {
+	name    string
+	typ     reflect.Type
+	decoder *Decoder
+}
+
+// ParseSchemaJSON compiles a JSON SchemaDefinition.
+func ParseSchemaJSON(data []byte) (*Schema, error) {
+	var def SchemaDefinition
+	if err := json.Unmarshal(data, &def); err != nil {
+		return nil, fmt.Errorf("form: schema: %w", err)
+	}
+	return NewSchema(def)
+}
+
+// ParseSchemaTOML compiles a TOML SchemaDefinition, whose fields are written as
+// [[fields]] tables.
+func ParseSchemaTOML(data []byte) (*Schema, error) {
+	var def SchemaDefinition
+	if err := toml.Unmarshal(data, &def); err != nil {
+		return nil, fmt.Errorf("form: schema: %w", err)
+	}
+	return NewSchema(def)
+}
+
+// schemaTypeCache holds the struct types of compiled schemas by a hash of their fields,
+// so that compiling a definition again, such as a tenant's form on every request,
+// reuses its type and plan instead of adding new ones
+var schemaTypeCache sync.Map
+
+// NewSchema compiles a SchemaDefinition. It returns an error for unknown types, rules and
+// sanitizers, invalid or repeated field names, and references to fields that don't
+// exist. Rules and sanitizers are checked when the schema is compiled, so register
+// custom validators and sanitizers first.
+//
+// Schemas with the same fields share one compiled type, whatever their names. Each
+// distinct definition keeps its type for the life of the process, as Go types can't be
+// freed, so don't generate definitions that differ on every call.
+func NewSchema(def SchemaDefinition) (*Schema, error) {
+	fields, err := json.Marshal(def.Fields)
+	if err != nil {
+		return nil, fmt.Errorf("form: schema %s: %w", def.Name, err)
+	}
+	hash := sha256.Sum256(fields)
+	if typ, ok := schemaTypeCache.Load(hash); ok {
+		return &Schema{name: def.Name, typ: typ.(reflect.Type), decoder: defaultDecoder}, nil
+	}
+
+	typ, err := schemaStruct(def.Fields, "")
+	if err != nil {
+		return nil, fmt.Errorf("form: schema %s: %w", def.Name, err)
+	}
+	if problems := referenceErrors(planFor(typ)); len(problems) > 0 {
+		return nil, fmt.Errorf("form: schema %s: %s", def.Name, strings.Join(problems, "; "))
+	}
+	schemaTypeCache.Store(hash, typ)
+	return &Schema{name: def.Name, typ: typ, decoder: defaultDecoder}, nil
+}
+
+// WithDecoder returns a copy of the schema that decodes with the limits and strictness
+// of d. Tag names and dialects don't apply to schemas.
+func (s *Schema) WithDecoder(d *Decoder) *Schema {
+	copied := *s
+	copied.decoder = d
+	return &copied
+}
+
+// Validate sanitizes and validates form values, such as a request's PostForm. It returns
+// the typed values by field name, with nested objects as maps, and the validation errors.
+// The values are returned even when validation fails, so the form can be redisplayed.
+func (s *Schema) Validate(ctx context.Context, values url.Values) (map[string]interface{}, ValidationErrors) {
+	start := time.Now()
+	if obs := getObserver(); obs != nil {
+		obs.OnDecodeStart(ctx, s.name)
+	}
+	return s.validate(ctx, values, start)
+}
+
+// ValidateJSON decodes a JSON object and validates it like Validate. The body is capped
+// at the decoder's MaxBodyBytes.
+func (s *Schema) ValidateJSON(ctx context.Context, reader io.Reader) (map[string]interface{}, ValidationErrors) {
+	start := time.Now()
+	if obs := getObserver(); obs != nil {
+		obs.OnDecodeStart(ctx, s.name)
+	}
+	formData, errs, err := s.decoder.readJSON(reader)
+	if errs != nil {
+		if obs := getObserver(); obs != nil {
+			obs.OnDecodeEnd(ctx, s.name, err)
+		}
+		return nil, errs
+	}
+	return s.validate(ctx, formData, start)
+}
+
+// validate decodes formData into a new value of the schema's struct type and validates it
+func (s *Schema) validate(ctx context.Context, formData map[string][]string, start time.Time) (map[string]interface{}, ValidationErrors) {
+	ptr := reflect.New(s.typ)
+	ctx = trackRules(ctx)
+	errs := s.decoder.decodeAndValidateFields(ctx, ptr.Interface(), ptr.Elem(), formData, s.name, defaultTags)
+	handleFormObservability(ctx, s.name, errs, start)
+	return schemaValues(ptr.Elem(), planFor(s.typ)), errs
+}
+
+// schemaStruct builds the struct type for a list of schema fields, with tags holding
+// their definitions. Go field names are derived from the form keys, so that the
+// lowercase names under which root values are also stored don't collide with other keys.
+func schemaStruct(fields []SchemaField, prefix string) (reflect.Type, error) {
+	keys := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		if f.Name == "" || strings.HasPrefix(f.Name, "_") || strings.ContainsAny(f.Name, ",.[]") {
+			return nil, fmt.Errorf("invalid field name %q", prefix+f.Name)
+		}
+		if keys[f.Name] {
+			return nil, fmt.Errorf("field %q is defined twice", prefix+f.Name)
+		}
+		keys[f.Name] = true
+	}
+
+	names := make(map[string]bool, len(fields))
+	structFields := make([]reflect.StructField, len(fields))
+	for i, f := range fields {
+		key := prefix + f.Name
+		typ, err := schemaFieldType(f, key)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkSchemaRules(f.Validate); err != nil {
+			return nil, fmt.Errorf("field %q: %w", key, err)
+		}
+		if err := checkSchemaSanitizers(f.Sanitize); err != nil {
+			return nil, fmt.Errorf("field %q: %w", key, err)
+		}
+
+		name := schemaGoName(f.Name)
+		for names[name] || (strings.ToLower(name) != f.Name && keys[strings.ToLower(name)]) {
+			name += "_" + strconv.Itoa(i)
+		}
+		names[name] = true
+
+		tag := `form:` + strconv.Quote(f.Name)
+		for _, t := range []struct{ name, value string }{{"sanitize", f.Sanitize}, {"validate", f.Validate}, {"default", f.Default}, {"locale", f.Locale}, {"sensitive", f.Sensitive}} {
+			if t.value != "" {
+				tag += ` ` + t.name + `:` + strconv.Quote(t.value)
+			}
+		}
+		structFields[i] = reflect.StructField{Name: name, Type: typ, Tag: reflect.StructTag(tag)}
+	}
+	return reflect.StructOf(structFields), nil
+}
+
+// schemaFieldType returns the Go type of a schema field
+func schemaFieldType(f SchemaField, key string) (reflect.Type, error) {
+	if f.Type == "object" {
+		if len(f.Fields) == 0 {
+			return nil, fmt.Errorf("object field %q has no fields", key)
+		}
+		return schemaStruct(f.Fields, key+".")
+	}
+	if len(f.Fields) > 0 {
+		return nil, fmt.Errorf("field %q of type %q can't have fields", key, f.Type)
+	}
+
+	name := f.Type
+	if name == "" {
+		name = "string"
+	}
+	if elem, ok := strings.CutPrefix(name, "[]"); ok {
+		if typ, ok := schemaTypes[elem]; ok && typ != timeType {
+			return reflect.SliceOf(typ), nil
+		}
+	} else if elem, ok := strings.CutPrefix(name, "map[string]"); ok {
+		if typ, ok := schemaTypes[elem]; ok && typ != timeType {
+			return reflect.MapOf(schemaTypes["string"], typ), nil
+		}
+	} else if typ, ok := schemaTypes[name]; ok {
+		return typ, nil
+	}
+	return nil, fmt.Errorf("field %q has unknown type %q", key, f.Type)
+}
+
+// checkSchemaRules reports the first rule of a validate tag that isn't built in or registered
+func checkSchemaRules(validateTag string) error {
+	for _, rule := range strings.Split(validateTag, ",") {
+		name, _, _ := strings.Cut(strings.TrimSpace(rule), "=")
+		switch name {
+		case "", "dive", "keys", "endkeys":
+			continue
+		}
+		if !isKnownRule(name) {
+			return fmt.Errorf("unknown rule %q", name)
+		}
+	}
+	return nil
+}
+
+// checkSchemaSanitizers reports the first sanitizer of a sanitize tag that isn't built in,
+// registered or a registered pipeline
+func checkSchemaSanitizers(sanitizeTag string) error {
+	for _, rule := range splitRules(sanitizeTag) {
+		name, _, hasParam := strings.Cut(rule, "=")
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if _, ok := registry.pipelines[name]; ok && !hasParam {
+			continue
+		}
+		if _, ok := registry.paramSanitizers[name]; ok {
+			continue
+		}
+		if _, ok := registry.sanitizers[name]; !ok {
+			return fmt.Errorf("unknown sanitizer %q", name)
+		}
+	}
+	return nil
+}
+
+// schemaGoName returns an exported Go field name for a form key, replacing characters
+// that can't appear in identifiers
+func schemaGoName(key string) string {
+	var b strings.Builder
+	if c := key[0]; c < 'A' || c > 'Z' && c < 'a' || c > 'z' {
+		b.WriteString("X")
+	}
+	for _, r := range key {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		} else {
+			b.WriteByte('_')
+		}
+	}
+	name := b.String()
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// schemaValues returns the values of a decoded schema struct by form key, with nested
+// structs as maps
+func schemaValues(val reflect.Value, plan *structPlan) map[string]interface{} {
+	values := make(map[string]interface{}, len(plan.fields))
+	for _, f := range plan.fields {
+		field := val.Field(f.index)
+		if f.nested != nil {
+			values[f.key] = schemaValues(field, plan.nested(f))
+			continue
+		}
+		values[f.key] = field.Interface()
+	}
+	return values
+}
@@ -0,0 +1,183 @@
+package form
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const intakeSchema = `{
+	"name": "intake",
+	"fields": [
+		{"name": "email", "sanitize": "trim,to_lower", "validate": "required,email"},
+		{"name": "age", "type": "int", "validate": "required,min=18"},
+		{"name": "type", "default": "person", "validate": "oneof=person:business"},
+		{"name": "company", "validate": "required_if=type:business"},
+		{"name": "start", "type": "time", "validate": "datetime=date"},
+		{"name": "tags", "type": "[]string", "validate": "max=2,dive,alpha"},
+		{"name": "address", "type": "object", "fields": [
+			{"name": "city", "sanitize": "trim", "validate": "required"},
+			{"name": "zip-code", "validate": "numeric"}
+		]}
+	]
+}`
+
+func TestSchemaValidate(t *testing.T) {
+	schema, err := ParseSchemaJSON([]byte(intakeSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values, errs := schema.Validate(context.Background(), url.Values{
+		"email":            {" Ada@Example.com "},
+		"age":              {"36"},
+		"start":            {"2025-03-01"},
+		"tags":             {"vip", "eu"},
+		"address.city":     {" London "},
+		"address.zip-code": {"12345"},
+	})
+	if len(errs) != 0 {
+		t.Fatalf("Expected no errors, got %v", errs)
+	}
+	expected := map[string]interface{}{
+		"email":   "ada@example.com",
+		"age":     int64(36),
+		"type":    "person",
+		"company": "",
+		"start":   time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		"tags":    []string{"vip", "eu"},
+		"address": map[string]interface{}{"city": "London", "zip-code": "12345"},
+	}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("Expected %v, got %v", expected, values)
+	}
+
+	values, errs = schema.Validate(context.Background(), url.Values{
+		"email": {"nope"}, "age": {"12"}, "type": {"business"}, "tags": {"a", "b1", "c"},
+	})
+	for _, key := range []string{"email", "age", "company", "tags", "tags[1]", "address.city"} {
+		if len(errs[key]) == 0 {
+			t.Errorf("Expected an error for %s, got %v", key, errs)
+		}
+	}
+	if values["email"] != "nope" {
+		t.Errorf("Expected the values to be returned with errors, got %v", values)
+	}
+}
+
+func TestSchemaObjectAsFirstField(t *testing.T) {
+	schema, err := ParseSchemaJSON([]byte(`{"name": "shipping", "fields": [
+		{"name": "address", "type": "object", "fields": [{"name": "city", "validate": "required"}]},
+		{"name": "note"}
+	]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, errs := schema.Validate(context.Background(), url.Values{"note": {"hi"}}); len(errs["address.city"]) == 0 {
+		t.Errorf("Expected the object's rules to run, got %v", errs)
+	}
+}
+
+func TestSchemaMatchesStruct(t *testing.T) {
+	schema, err := ParseSchemaJSON([]byte(`{"fields": [
+		{"name": "email", "validate": "required,email"},
+		{"name": "password", "validate": "required,min=8"},
+		{"name": "confirm_password", "validate": "required,eqfield=Password"},
+		{"name": "name", "sanitize": "trim", "validate": "required"},
+		{"name": "age", "type": "int", "validate": "required,min=18"}
+	]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := `{"email":"bad","password":"password123","confirm_password":"different","age":12}`
+	_, schemaErrs := schema.ValidateJSON(context.Background(), strings.NewReader(body))
+	structErrs := DecodeAndValidateJSON(context.Background(), strings.NewReader(body), &UserRegistrationForm{})
+	if len(schemaErrs) == 0 || !reflect.DeepEqual(schemaErrs, structErrs) {
+		t.Errorf("Expected the struct's errors %v, got %v", structErrs, schemaErrs)
+	}
+
+	if _, errs := schema.ValidateJSON(context.Background(), strings.NewReader(`{"email":`)); len(errs["_json"]) == 0 {
+		t.Errorf("Expected a JSON error, got %v", errs)
+	}
+}
+
+func TestParseSchemaTOML(t *testing.T) {
+	schema, err := ParseSchemaTOML([]byte(`
+name = "survey"
+
+[[fields]]
+name = "score"
+type = "float"
+validate = "required,max=10"
+
+[[fields]]
+name = "answers"
+type = "map[string]bool"
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	values, errs := schema.Validate(context.Background(), url.Values{"score": {"7.5"}, "answers[agree]": {"true"}})
+	if len(errs) != 0 || values["score"] != 7.5 || !reflect.DeepEqual(values["answers"], map[string]bool{"agree": true}) {
+		t.Errorf("Expected typed values, got %v %v", values, errs)
+	}
+}
+
+func TestSchemaGoNames(t *testing.T) {
+	// Keys that differ only in characters that aren't allowed in Go names stay apart
+	schema, err := NewSchema(SchemaDefinition{Fields: []SchemaField{
+		{Name: "first-name", Validate: "required"},
+		{Name: "first_name"},
+		{Name: "2fa", Validate: "eqfield=first-name"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	values, errs := schema.Validate(context.Background(), url.Values{"first-name": {"Ada"}, "first_name": {"Lovelace"}, "2fa": {"Ada"}})
+	if len(errs) != 0 || values["first-name"] != "Ada" || values["first_name"] != "Lovelace" {
+		t.Errorf("Expected separate fields, got %v %v", values, errs)
+	}
+}
+
+func TestSchemaTypesAreShared(t *testing.T) {
+	compile := func(name, validate string) *Schema {
+		schema, err := NewSchema(SchemaDefinition{Name: name, Fields: []SchemaField{{Name: "email", Sanitize: "trim, to_lower", Validate: validate}}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return schema
+	}
+	first, second := compile("tenant-a", "required,email"), compile("tenant-b", "required,email")
+	if first.typ != second.typ || second.name != "tenant-b" {
+		t.Errorf("Expected identical definitions to share a type, got %v and %v", first.typ, second.typ)
+	}
+	if other := compile("tenant-c", "email"); other.typ == first.typ {
+		t.Error("Expected a different definition to get its own type")
+	}
+}
+
+func TestNewSchemaErrors(t *testing.T) {
+	for name, fields := range map[string][]SchemaField{
+		"empty name":        {{Name: ""}},
+		"reserved name":     {{Name: "_form"}},
+		"dotted name":       {{Name: "a.b"}},
+		"duplicate":         {{Name: "a"}, {Name: "a"}},
+		"unknown type":      {{Name: "a", Type: "decimal"}},
+		"unknown rule":      {{Name: "a", Validate: "required,shiny"}},
+		"unknown sanitizer": {{Name: "a", Sanitize: "trim,to_lowr"}},
+		"unknown field":     {{Name: "a", Validate: "eqfield=b"}},
+		"empty object":      {{Name: "a", Type: "object"}},
+		"scalar fields":     {{Name: "a", Fields: []SchemaField{{Name: "b"}}}},
+		"time collection":   {{Name: "a", Type: "[]time"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := NewSchema(SchemaDefinition{Name: "test", Fields: fields}); err == nil {
+				t.Error("Expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package form
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// errTooManyValues and errNestedTooDeeply are returned by the screening readers when a
+// body exceeds MaxValues or MaxDepth
+var (
+	errTooManyValues   = errors.New("form: too many values")
+	errNestedTooDeeply = errors.New("form: input nested too deeply")
+)
+
+// StatusCode returns the HTTP status for errs: 413 Content Too Large when the request was
+// rejected for its size, field count or nesting, 415 Unsupported Media Type when its
+// content type isn't accepted, and fallback otherwise. The built-in error handlers use
+// it, and custom ValidationErrorHandlers can do the same:
+//
+//	w.WriteHeader(form.StatusCode(errs, http.StatusBadRequest))
+func StatusCode(errs ValidationErrors, fallback int) int {
+	for _, message := range errs[FormErrorKey] {
+		switch message {
+		case ErrBodyTooLarge, ErrTooManyFields, ErrNestedTooDeeply:
+			return http.StatusRequestEntityTooLarge
+		case ErrUnsupportedMediaType:
+			return http.StatusUnsupportedMediaType
+		}
+	}
+	return fallback
+}
+
+// screen checks a request before its body is read: the declared Content-Length against
+// MaxBodyBytes, the content type against ContentTypes, and the query against MaxValues.
+// A urlencoded body is then wrapped to count its values as it is read. Because nothing
+// reads the body of a rejected request, clients that sent "Expect: 100-continue" get the
+// error instead of a 100 Continue, and never upload the body.
+func (d *Decoder) screen(r *http.Request) ValidationErrors {
+	hasBody := r.Body != nil && r.Body != http.NoBody && r.ContentLength != 0
+	if r.ContentLength > d.opts.MaxBodyBytes {
+		return ValidationErrors{FormErrorKey: {ErrBodyTooLarge}}
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if hasBody && len(d.opts.ContentTypes) > 0 && !acceptsMediaType(d.opts.ContentTypes, mediaType) {
+		return ValidationErrors{FormErrorKey: {ErrUnsupportedMediaType}}
+	}
+
+	if d.opts.MaxValues <= 0 || r.Form != nil {
+		return nil
+	}
+	remaining := d.opts.MaxValues - countPairs(r.URL.RawQuery)
+	if remaining < 0 {
+		return ValidationErrors{FormErrorKey: {ErrTooManyFields}}
+	}
+	if hasBody && mediaType == "application/x-www-form-urlencoded" {
+		r.Body = &pairCountReader{ReadCloser: r.Body, remaining: remaining}
+	}
+	return nil
+}
+
+// acceptsMediaType reports whether mediaType matches one of allowed, which may end in
+// "/*" to accept every subtype
+func acceptsMediaType(allowed []string, mediaType string) bool {
+	if mediaType == "" {
+		return false
+	}
+	for _, accepted := range allowed {
+		accepted = strings.ToLower(strings.TrimSpace(accepted))
+		if accepted == mediaType {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(accepted, "*"); ok && strings.HasSuffix(prefix, "/") && strings.HasPrefix(mediaType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// countPairs returns the number of name=value pairs in a urlencoded string
+func countPairs(s string) int {
+	if s == "" {
+		return 0
+	}
+	return strings.Count(s, "&") + 1
+}
+
+// countValues returns the number of values and files of a parsed multipart form
+func countValues(r *http.Request) int {
+	n := 0
+	if r.MultipartForm != nil {
+		for _, values := range r.MultipartForm.Value {
+			n += len(values)
+		}
+		for _, files := range r.MultipartForm.File {
+			n += len(files)
+		}
+		return n
+	}
+	for _, values := range r.Form {
+		n += len(values)
+	}
+	return n
+}
+
+// pairCountReader fails a urlencoded body as soon as it holds more pairs than remaining
+type pairCountReader struct {
+	io.ReadCloser
+	remaining int
+	started   bool
+}
+
+func (p *pairCountReader) Read(b []byte) (int, error) {
+	n, err := p.ReadCloser.Read(b)
+	if n > 0 && !p.started {
+		p.started = true
+		p.remaining--
+	}
+	p.remaining -= bytes.Count(b[:n], []byte("&"))
+	if p.remaining < 0 {
+		return 0, errTooManyValues
+	}
+	return n, err
+}
+
+// jsonScreen checks the nesting depth and number of values of a JSON body as it is read,
+// so that oversized input is rejected before it is decoded. Values are object members
+// and array elements, at any depth.
+type jsonScreen struct {
+	r                   io.Reader
+	maxDepth, maxValues int
+	depth, values       int
+	inString, escaped   bool
+	// opened is set after an object or array starts, until its first value or its end
+	opened bool
+}
+
+func (s *jsonScreen) Read(p []byte) (int, error) {
+	n, err := s.r.Read(p)
+	for _, c := range p[:n] {
+		if s.inString {
+			switch {
+			case s.escaped:
+				s.escaped = false
+			case c == '\\':
+				s.escaped = true
+			case c == '"':
+				s.inString = false
+			}
+			continue
+		}
+		if s.opened && c != ' ' && c != '\t' && c != '\n' && c != '\r' {
+			s.opened = false
+			if c != '}' && c != ']' {
+				s.values++
+			}
+		}
+		switch c {
+		case '"':
+			s.inString = true
+		case '{', '[':
+			s.depth++
+			s.opened = true
+			if s.maxDepth > 0 && s.depth > s.maxDepth {
+				return 0, errNestedTooDeeply
+			}
+		case '}', ']':
+			s.depth--
+		case ',':
+			if s.depth > 0 {
+				s.values++
+			}
+		}
+		if s.maxValues > 0 && s.values > s.maxValues {
+			return 0, errTooManyValues
+		}
+	}
+	return n, err
+}
+
+// keyDepth returns the nesting depth of a form key: 1 for "name", plus one for every
+// nested struct, map key or collection index, so "lines[0].sku" has a depth of 3
+func keyDepth(key string) int {
+	return 1 + strings.Count(key, ".") + strings.Count(key, "[")
+}
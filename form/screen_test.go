@@ -0,0 +1,206 @@
+package form
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// unreadBody fails the test if anything reads it
+type unreadBody struct{ t *testing.T }
+
+func (b unreadBody) Read(p []byte) (int, error) {
+	b.t.Error("Expected the body not to be read")
+	return 0, io.EOF
+}
+
+func (b unreadBody) Close() error { return nil }
+
+func TestScreenContentLength(t *testing.T) {
+	d := NewDecoder(DecoderOptions{MaxBodyBytes: 16})
+
+	req := httptest.NewRequest("POST", "/", nil)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Body = unreadBody{t}
+	req.ContentLength = 1 << 20
+
+	var f decoderForm
+	errs := d.DecodeAndValidate(context.Background(), req, &f)
+	if !reflect.DeepEqual(errs, ValidationErrors{FormErrorKey: {ErrBodyTooLarge}}) {
+		t.Errorf("Expected the declared length to be rejected, got %v", errs)
+	}
+}
+
+func TestScreenContentTypes(t *testing.T) {
+	d := NewDecoder(DecoderOptions{ContentTypes: []string{"application/x-www-form-urlencoded", "multipart/*"}})
+	handler := d.ValidationMiddleware(decoderForm{}, DefaultValidationErrorHandler)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	for contentType, status := range map[string]int{
+		"application/x-www-form-urlencoded": http.StatusBadRequest,
+		"multipart/form-data; boundary=x":   http.StatusBadRequest,
+		"application/json":                  http.StatusUnsupportedMediaType,
+		"text/plain":                        http.StatusUnsupportedMediaType,
+		"":                                  http.StatusUnsupportedMediaType,
+		"Application/X-WWW-Form-Urlencoded": http.StatusBadRequest,
+	} {
+		req := httptest.NewRequest("POST", "/", strings.NewReader("name="))
+		if contentType != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != status {
+			t.Errorf("Content-Type %q: expected %d, got %d: %s", contentType, status, rec.Code, rec.Body)
+		}
+	}
+
+	// Requests without a body, such as searches, have no content type to check
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/?name=Ada&address.country=NL&address.post_code=1011", nil))
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("Expected a GET without a body to pass, got %d: %s", rec.Code, rec.Body)
+	}
+}
+
+func TestScreenMaxValues(t *testing.T) {
+	d := NewDecoder(DecoderOptions{MaxValues: 3})
+	tooMany := ValidationErrors{FormErrorKey: {ErrTooManyFields}}
+
+	var f decoderForm
+	errs := d.DecodeAndValidate(context.Background(), newBodyRequest("tags=a&tags=b&tags=c&tags=d", "application/x-www-form-urlencoded"), &f)
+	if !reflect.DeepEqual(errs, tooMany) {
+		t.Errorf("Expected repeated values to count, got %v", errs)
+	}
+
+	req := httptest.NewRequest("POST", "/?a=1&b=2", strings.NewReader("name=Ada&tags=x"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if errs := d.DecodeAndValidate(context.Background(), req, &f); !reflect.DeepEqual(errs, tooMany) {
+		t.Errorf("Expected query values to count, got %v", errs)
+	}
+
+	errs = d.DecodeAndValidate(context.Background(), newBodyRequest("name=Ada&address.country=NL&address.post_code=1011", "application/x-www-form-urlencoded"), &f)
+	if len(errs) != 0 {
+		t.Errorf("Expected values at the limit to decode, got %v", errs)
+	}
+
+	errs = d.DecodeAndValidateJSON(context.Background(), strings.NewReader(`{"tags":["a","b","c"]}`), &f)
+	if !reflect.DeepEqual(errs, tooMany) {
+		t.Errorf("Expected JSON members and elements to count, got %v", errs)
+	}
+}
+
+func TestScreenMaxDepth(t *testing.T) {
+	d := NewDecoder(DecoderOptions{MaxDepth: 2})
+	tooDeep := ValidationErrors{FormErrorKey: {ErrNestedTooDeeply}}
+
+	var f decoderForm
+	errs := d.DecodeAndValidateJSON(context.Background(), strings.NewReader(`{"attrs":{"a":[1]}}`), &f)
+	if !reflect.DeepEqual(errs, tooDeep) {
+		t.Errorf("Expected deep JSON to be rejected, got %v", errs)
+	}
+	errs = d.DecodeAndValidate(context.Background(), newValuesRequest(url.Values{"name": {"Ada"}, "attrs.a[0]": {"1"}}), &f)
+	if !reflect.DeepEqual(errs, tooDeep) {
+		t.Errorf("Expected deep keys to be rejected, got %v", errs)
+	}
+	errs = d.DecodeAndValidateJSON(context.Background(), strings.NewReader(`{"name":"Ada","address":{"country":"NL","post_code":"1011"}}`), &f)
+	if len(errs) != 0 {
+		t.Errorf("Expected input at the limit to decode, got %v", errs)
+	}
+}
+
+func TestJSONScreen(t *testing.T) {
+	for input, expected := range map[string][2]int{
+		`{}`:                           {1, 0},
+		`{"a":1}`:                      {1, 1},
+		`{"a":[1,2],"b":{"c":null}}`:   {2, 5},
+		`{"a":[ ],"b":"[{,\"]}"}`:      {2, 2},
+		`{"a":[[[]]]}`:                 {4, 3},
+		"{\n \"a\" : [ 1 ,\n 2 ]\n}\n": {2, 3},
+	} {
+		// Read one byte at a time to find the deepest point
+		s := &jsonScreen{r: strings.NewReader(input)}
+		maxDepth := 0
+		for b := make([]byte, 1); ; {
+			if _, err := s.Read(b); err != nil {
+				break
+			}
+			maxDepth = max(maxDepth, s.depth)
+		}
+		if got := [2]int{maxDepth, s.values}; got != expected {
+			t.Errorf("%s: expected depth and values %v, got %v", input, expected, got)
+		}
+	}
+}
+
+func TestStatusCode(t *testing.T) {
+	for _, tc := range []struct {
+		errs     ValidationErrors
+		expected int
+	}{
+		{ValidationErrors{FormErrorKey: {ErrBodyTooLarge}}, http.StatusRequestEntityTooLarge},
+		{ValidationErrors{FormErrorKey: {ErrTooManyFields}}, http.StatusRequestEntityTooLarge},
+		{ValidationErrors{FormErrorKey: {ErrNestedTooDeeply}}, http.StatusRequestEntityTooLarge},
+		{ValidationErrors{FormErrorKey: {ErrUnsupportedMediaType}}, http.StatusUnsupportedMediaType},
+		{ValidationErrors{"name": {ErrBodyTooLarge}}, http.StatusUnprocessableEntity},
+		{ValidationErrors{"name": {ErrFieldRequired}}, http.StatusUnprocessableEntity},
+	} {
+		if got := StatusCode(tc.errs, http.StatusUnprocessableEntity); got != tc.expected {
+			t.Errorf("StatusCode(%v) = %d, expected %d", tc.errs, got, tc.expected)
+		}
+	}
+}
+
+// TestScreenExpectContinue sends a request that waits for 100 Continue before its body,
+// and expects the rejection instead
+func TestScreenExpectContinue(t *testing.T) {
+	d := NewDecoder(DecoderOptions{MaxBodyBytes: 1 << 10})
+	server := httptest.NewServer(d.ValidationMiddleware(decoderForm{}, JSONValidationErrorHandler)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected the request to be rejected")
+	})))
+	defer server.Close()
+
+	conn, err := net.Dial("tcp", server.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	_, err = io.WriteString(conn, "POST / HTTP/1.1\r\nHost: example.com\r\n"+
+		"Content-Type: application/json\r\nContent-Length: 1048576\r\nExpect: 100-continue\r\n\r\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.ReadResponse(bufio.NewReader(conn), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Errorf("Expected 413 without 100 Continue, got %s", resp.Status)
+	}
+}
+
+func TestScreenReaderErrors(t *testing.T) {
+	p := &pairCountReader{ReadCloser: io.NopCloser(strings.NewReader("a=1&b=2&c=3")), remaining: 2}
+	if _, err := io.ReadAll(p); !errors.Is(err, errTooManyValues) {
+		t.Errorf("Expected errTooManyValues, got %v", err)
+	}
+	p = &pairCountReader{ReadCloser: io.NopCloser(strings.NewReader("a=1&b=2")), remaining: 2}
+	if _, err := io.ReadAll(p); err != nil {
+		t.Errorf("Expected two pairs to be read, got %v", err)
+	}
+}
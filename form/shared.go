@@ -2,6 +2,7 @@ package form
 
 import (
 	"context"
+	"net/url"
 	"reflect"
 	"strings"
 	"time"
@@ -11,65 +12,286 @@ import (
 
 // Common form processing logic shared between form.go and json.go
 
-// processFormFields processes form fields by collecting values, applying sanitizers, and setting field values
-func processFormFields(val reflect.Value, formData map[string][]string) map[string]string {
-	fieldValues := make(map[string]string)
-	typ := val.Type()
-
-	for i := 0; i < val.NumField(); i++ {
-		field := val.Field(i)
-		fieldType := typ.Field(i)
-		formTag := fieldType.Tag.Get("form")
-		if formTag == "" {
-			formTag = strings.ToLower(fieldType.Name)
+// decodeAndValidateFields binds formData to the struct, reading the struct with tags,
+// and validates it, then runs the struct-level validators. Input that breaks the
+// decoder's limits is rejected first. Types with generated decoders skip reflection
+// entirely, unless custom tag names are in use.
+func (d *Decoder) decodeAndValidateFields(ctx context.Context, v interface{}, val reflect.Value, formData map[string][]string, formName string, tags tagNames) ValidationErrors {
+	plan := planWithTags(val.Type(), tags)
+	if problems := referenceErrors(plan); len(problems) > 0 {
+		if obs := getObserver(); obs != nil {
+			obs.OnDecodeEnd(ctx, formName, nil)
 		}
+		return ValidationErrors{"_struct": problems}
+	}
 
-		var value string
-		if values := formData[formTag]; len(values) > 0 {
-			value = values[0]
+	if inputErrors := d.checkInput(plan, formData); inputErrors != nil {
+		if obs := getObserver(); obs != nil {
+			obs.OnDecodeEnd(ctx, formName, nil)
+		}
+		rulesFrom(ctx).reject(inputErrors)
+		return inputErrors
+	}
+
+	locale := d.localeFor(ctx)
+	if generated, ok := v.(GeneratedForm); ok && tags == defaultTags && locale == nil {
+		if obs := getObserver(); obs != nil {
+			obs.OnDecodeEnd(ctx, formName, nil)
+			obs.OnValidationStart(ctx, formName)
 		}
+		errors := runStructValidators(ctx, v, generated.DecodeFormContext(ctx, url.Values(formData)))
+		return sensitiveValues(walkStructs(val.Addr(), plan), formData, nil).redact(errors)
+	}
+
+	// First pass: collect all field values and apply sanitizers
+	decoded := processFormFields(val, plan, formData, locale)
+	decoded.failures = rulesFrom(ctx)
+
+	if obs := getObserver(); obs != nil {
+		obs.OnDecodeEnd(ctx, formName, nil)
+		obs.OnValidationStart(ctx, formName)
+	}
+
+	// Second pass: validate fields
+	nodes := walkStructs(val.Addr(), plan)
+	errors := validateFormFields(nodes, decoded)
+	errors = runStructValidators(ctx, v, errors)
+	runNestedStructValidators(ctx, nodes, errors)
+	return sensitiveValues(nodes, formData, decoded).redact(errors)
+}
 
-		sanitizeTag := fieldType.Tag.Get("sanitize")
-		if sanitizeTag != "" {
-			value = applySanitizers(value, sanitizeTag)
+// decodedFields holds the sanitized input collected while decoding a struct
+type decodedFields struct {
+	// values holds scalar values by form key and lowercase field name for cross-field validation.
+	// Collections are stored with their elements joined by commas.
+	values map[string]string
+	// collections holds slice and map input by form key
+	collections map[string]*collection
+	// errors holds sanitizer failures by form key
+	errors ValidationErrors
+	// defaults holds resolved default values by form key, used when the key is absent
+	defaults map[string]string
+	// locale parses localized numbers and dates, if the decoder localizes input
+	locale localeParser
+	// failures records the rules that fail, when validation is observed
+	failures *ruleFailures
+	// existing is set when validating the current values of a struct, where a zero number
+	// or false can't be told apart from a field that was never set
+	existing bool
+}
+
+// newDecodedFields returns empty decoding state
+func newDecodedFields() *decodedFields {
+	return &decodedFields{
+		values:      make(map[string]string),
+		collections: make(map[string]*collection),
+		errors:      make(ValidationErrors),
+		defaults:    make(map[string]string),
+	}
+}
+
+// setScalar records a scalar value under its form key and lowercase field name.
+// Nested fields pass an empty field name and are stored under their dotted key only.
+func (d *decodedFields) setScalar(key, fieldName, value string) {
+	d.values[key] = value
+	// Also store by lowercase field name for cross-field validation
+	if fieldName != "" {
+		d.values[strings.ToLower(fieldName)] = value
+	}
+}
+
+// setCollection records a collection under its form key and its joined value for cross-field validation
+func (d *decodedFields) setCollection(key, fieldName string, c *collection) {
+	d.collections[key] = c
+	d.setScalar(key, fieldName, strings.Join(c.values, ","))
+}
+
+// setDefault records the default for a field whose key is absent from the input, so
+// that providers only run when their value is used
+func (d *decodedFields) setDefault(formData map[string][]string, key, defaultTag, validateTag string, collection bool) {
+	if collection {
+		if hasCollectionInput(formData, key) {
+			return
 		}
+	} else if _, present := formData[key]; present {
+		return
+	}
+	d.defaults[key] = resolveDefault(defaultTag, validateTag)
+}
 
-		fieldValues[formTag] = value
-		// Also store by lowercase field name for cross-field validation
-		fieldValues[strings.ToLower(fieldType.Name)] = value
-		if field.CanSet() {
-			setFieldValue(field, value)
+// decodeScalar collects and sanitizes the input for a scalar field.
+// It returns false if sanitization failed, in which case the field should be left unset.
+func (d *decodedFields) decodeScalar(formData map[string][]string, key, fieldName, sanitizeTag string) (string, bool) {
+	var value string
+	if values, present := formData[key]; present {
+		if len(values) > 0 {
+			value = values[0]
 		}
+	} else if def, ok := d.defaults[key]; ok {
+		value = def
 	}
 
-	return fieldValues
+	var sanitizeErr error
+	if sanitizeTag != "" {
+		value, sanitizeErr = applySanitizers(value, sanitizeTag)
+	}
+
+	d.setScalar(key, fieldName, value)
+	if sanitizeErr != nil {
+		d.errors[key] = []string{sanitizeErr.Error()}
+		return value, false
+	}
+	return value, true
 }
 
-// validateFormFields validates all form fields using the validation context
-func validateFormFields(val reflect.Value, fieldValues map[string]string) ValidationErrors {
-	errors := make(ValidationErrors)
-	typ := val.Type()
-
-	validationContext := ValidationContext{values: fieldValues}
-	for i := 0; i < val.NumField(); i++ {
-		fieldType := typ.Field(i)
-		formTag := fieldType.Tag.Get("form")
-		if formTag == "" {
-			formTag = strings.ToLower(fieldType.Name)
+// decodeCollection collects and sanitizes the input for a slice or map field.
+// It returns false if sanitization failed, in which case the field should be left unset.
+func (d *decodedFields) decodeCollection(formData map[string][]string, key, fieldName, sanitizeTag string, isMap bool) (*collection, bool) {
+	if def, ok := d.defaults[key]; ok && !isMap {
+		formData = map[string][]string{key: splitDefault(def)}
+	}
+	c, err := collectFieldValues(formData, key, sanitizeTag, isMap)
+	d.setCollection(key, fieldName, c)
+	if err != nil {
+		d.errors[key] = []string{err.Error()}
+		return c, false
+	}
+	return c, true
+}
+
+// validateField validates one field and stores its errors. Sanitizer failures are reported
+// instead of running the rules. For collections, kind is the element kind.
+func (d *decodedFields) validateField(errors ValidationErrors, key, validateTag string, kind reflect.Kind, context ValidationContext) {
+	context.field = key
+	if sanitizeErrs := d.errors[key]; len(sanitizeErrs) > 0 {
+		errors[key] = sanitizeErrs
+		context.failures.add(key, "sanitize")
+		return
+	}
+	if validateTag == "" {
+		return
+	}
+	if c, exists := d.collections[key]; exists {
+		for elementKey, fieldErrors := range validateCollection(key, c, validateTag, context, kind) {
+			errors[elementKey] = fieldErrors
+		}
+		return
+	}
+	value := d.values[key]
+	if d.existing && isEmptyValue(value, kind) && hasRequired(strings.Split(validateTag, ",")) {
+		// required treats the zero value of an existing number or bool as missing
+		value = ""
+	}
+	if fieldErrors := validateFieldWithContext(value, validateTag, context, kind); len(fieldErrors) > 0 {
+		errors[key] = fieldErrors
+	}
+}
+
+// processFormFields processes form fields by collecting values, applying sanitizers, and setting field values.
+// Nested structs are decoded from dotted keys such as "billing.country"; nil pointers to
+// structs are only allocated when input for them is present.
+// Sanitizer failures are recorded so they can be reported together with validation errors.
+// With a locale, localized numbers and dates are rewritten after sanitizing.
+func processFormFields(val reflect.Value, plan *structPlan, formData map[string][]string, locale localeParser) *decodedFields {
+	decoded := newDecodedFields()
+	decoded.locale = locale
+	decodeStructFields(val, plan, "", formData, decoded)
+	return decoded
+}
+
+// decodeStructFields decodes the fields of one struct level, recursing into nested structs
+func decodeStructFields(val reflect.Value, plan *structPlan, prefix string, formData map[string][]string, decoded *decodedFields) {
+	for _, f := range plan.fields {
+		field := val.Field(f.index)
+		key := prefix + f.key
+		// Only root fields are also stored by lowercase field name, as nested names would collide
+		fieldName := f.name
+		if prefix != "" {
+			fieldName = ""
+		}
+		if f.def != "" && f.nested == nil {
+			decoded.setDefault(formData, key, f.def, f.validate, f.collection)
 		}
-		value := fieldValues[formTag]
-		validateTag := fieldType.Tag.Get("validate")
-		if validateTag != "" {
-			fieldErrors := validateFieldWithContext(value, validateTag, validationContext, fieldType.Type.Kind())
-			if len(fieldErrors) > 0 {
-				errors[formTag] = fieldErrors
+
+		switch {
+		case f.nested != nil:
+			if f.pointer {
+				if field.IsNil() {
+					if !hasInputWithPrefix(formData, key+".") {
+						continue
+					}
+					field.Set(reflect.New(f.nested))
+				}
+				field = field.Elem()
+			}
+			decodeStructFields(field, plan.nested(f), key+".", formData, decoded)
+
+		case f.collection:
+			c, ok := decoded.decodeCollection(formData, key, fieldName, f.sanitize, f.isMap)
+			if ok && decoded.locale != nil {
+				for i, value := range c.values {
+					c.values[i] = decoded.localize(value, f)
+				}
+				decoded.setCollection(key, fieldName, c)
+			}
+			if ok && field.CanSet() {
+				setCollectionValue(field, c)
+			}
+
+		default:
+			value, ok := decoded.decodeScalar(formData, key, fieldName, f.sanitize)
+			if ok && decoded.locale != nil {
+				if localized := decoded.localize(value, f); localized != value {
+					value = localized
+					decoded.setScalar(key, fieldName, value)
+				}
+			}
+			// Leave the field unset rather than binding a value that failed sanitization
+			if ok && field.CanSet() {
+				if f.isTime {
+					setTimeValue(field, value, f.validate)
+				} else {
+					setFieldValue(field, value)
+				}
 			}
 		}
 	}
+}
 
+// hasInputWithPrefix reports whether any input key starts with prefix
+func hasInputWithPrefix(formData map[string][]string, prefix string) bool {
+	for key := range formData {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateFormFields validates all form fields using the validation context, including
+// the fields of nested structs. Fields that failed sanitization report the sanitizer error
+// and skip their validation rules.
+func validateFormFields(nodes []structNode, decoded *decodedFields) ValidationErrors {
+	errors := make(ValidationErrors)
+	for _, node := range nodes {
+		decoded.validateStructLevel(errors, node)
+	}
 	return errors
 }
 
+// validateStructLevel validates the fields of one struct in a tree. References in rules
+// resolve against the struct's position in the tree.
+func (d *decodedFields) validateStructLevel(errors ValidationErrors, node structNode) {
+	s := node.scopes[len(node.scopes)-1]
+	validationContext := ValidationContext{values: d.values, scopes: node.scopes, failures: d.failures}
+	for _, f := range s.plan.fields {
+		if f.nested != nil {
+			continue
+		}
+		d.validateField(errors, s.prefix+f.key, f.validate, f.kind, validationContext)
+	}
+}
+
 // validateStructPointer validates that the target is a non-nil pointer to struct
 func validateStructPointer(ctx context.Context, v interface{}, formName string) ValidationErrors {
 	errors := make(ValidationErrors)
@@ -95,15 +317,27 @@ func validateStructPointer(ctx context.Context, v interface{}, formName string)
 	return nil
 }
 
-// handleFormObservability handles observability for form processing
+// handleFormObservability handles observability for form processing. The rules that
+// failed are read from ctx, which trackRules prepared before validating.
 func handleFormObservability(ctx context.Context, formName string, errors ValidationErrors, start time.Time) {
 	duration := time.Since(start)
 	if obs := getObserver(); obs != nil {
 		obs.OnValidationEnd(ctx, formName, errors)
 	}
 
+	stats := getValidationStats()
+	_, metrics := observer.(*formObserver)
+	if !metrics && stats == nil {
+		return
+	}
+	failures := rulesFrom(ctx).list(errors)
+
 	// Update the formObserver to include duration
-	if _, ok := observer.(*formObserver); ok {
+	if metrics {
 		observability.GetObserver().OnFormValidationEnd(ctx, formName, len(errors), duration)
+		recordMetrics(ctx, formName, len(errors) == 0, failures, duration)
+	}
+	if stats != nil {
+		stats.record(formName, len(errors) == 0, failures, duration)
 	}
 }
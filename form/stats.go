@@ -0,0 +1,197 @@
+package form
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ValidationStats aggregates validation outcomes in process, per form, field and rule,
+// for a dashboard of the fields users struggle with. Register it with
+// RegisterValidationStats and serve its report, which it implements http.Handler for:
+//
+//	stats := form.NewValidationStats()
+//	form.RegisterValidationStats(stats)
+//	mux.Handle("/debug/forms", adminOnly(stats))
+//
+// It records rule names and form keys only, never submitted values. Memory grows with
+// the number of forms, fields and rules, not with traffic.
+type ValidationStats struct {
+	mu    sync.Mutex
+	since time.Time
+	forms map[string]*formStats
+}
+
+// formStats are the counts of one form
+type formStats struct {
+	validations   int64
+	invalid       int64
+	totalDuration time.Duration
+	maxDuration   time.Duration
+	fields        map[string]*fieldStats
+}
+
+// fieldStats are the counts of one field of a form
+type fieldStats struct {
+	failures int64
+	rules    map[string]int64
+}
+
+// ValidationReport is the aggregated outcome of the validations since Since.
+type ValidationReport struct {
+	Since time.Time    `json:"since"`
+	Forms []FormReport `json:"forms"`
+}
+
+// FormReport is the outcome of one form's validations. Fields lists the fields that
+// failed, the most frequent first.
+type FormReport struct {
+	Form          string        `json:"form"`
+	Validations   int64         `json:"validations"`
+	Invalid       int64         `json:"invalid"`
+	InvalidRate   float64       `json:"invalid_rate"`
+	AvgDurationMS float64       `json:"avg_duration_ms"`
+	MaxDurationMS float64       `json:"max_duration_ms"`
+	Fields        []FieldReport `json:"fields"`
+}
+
+// FieldReport counts the validations in which a field failed, and how often each of its
+// rules failed. Rule codes are described with RegisterValidationStats.
+type FieldReport struct {
+	Field       string           `json:"field"`
+	Failures    int64            `json:"failures"`
+	FailureRate float64          `json:"failure_rate"`
+	Rules       map[string]int64 `json:"rules"`
+}
+
+// validationStats is swapped atomically, so stats can be registered while serving
+var validationStats atomic.Pointer[ValidationStats]
+
+// RegisterValidationStats sets the aggregator that every validation is recorded in;
+// nil stops recording. Rules are reported by the name written in the validate tag, or
+// as "sanitize" for sanitizer failures, "struct" for struct-level validators,
+// "max_fields", "max_depth", "max_length", "unknown_field" or "duplicate_field" for
+// input rejected by the decoder's limits, and "other" for errors without a rule.
+// Element errors such as "tags[2]" count for their field, "tags".
+func RegisterValidationStats(stats *ValidationStats) {
+	validationStats.Store(stats)
+}
+
+// getValidationStats returns the registered aggregator (or nil)
+func getValidationStats() *ValidationStats {
+	return validationStats.Load()
+}
+
+// NewValidationStats returns an empty aggregator.
+func NewValidationStats() *ValidationStats {
+	return &ValidationStats{since: time.Now(), forms: make(map[string]*formStats)}
+}
+
+// record adds one validation of a form
+func (s *ValidationStats) record(formName string, valid bool, failures []ruleFailure, duration time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	form, ok := s.forms[formName]
+	if !ok {
+		form = &formStats{fields: make(map[string]*fieldStats)}
+		s.forms[formName] = form
+	}
+	form.validations++
+	if !valid {
+		form.invalid++
+	}
+	form.totalDuration += duration
+	form.maxDuration = max(form.maxDuration, duration)
+
+	counted := make(map[string]bool, len(failures))
+	for _, failure := range failures {
+		field, ok := form.fields[failure.field]
+		if !ok {
+			field = &fieldStats{rules: make(map[string]int64)}
+			form.fields[failure.field] = field
+		}
+		if !counted[failure.field] {
+			counted[failure.field] = true
+			field.failures++
+		}
+		field.rules[failure.rule]++
+	}
+}
+
+// Report returns the counts since the aggregator was created or last reset, with forms
+// sorted by name.
+func (s *ValidationStats) Report() ValidationReport {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	report := ValidationReport{Since: s.since, Forms: make([]FormReport, 0, len(s.forms))}
+	for name, form := range s.forms {
+		r := FormReport{
+			Form:          name,
+			Validations:   form.validations,
+			Invalid:       form.invalid,
+			InvalidRate:   float64(form.invalid) / float64(form.validations),
+			AvgDurationMS: float64(form.totalDuration.Microseconds()) / 1000 / float64(form.validations),
+			MaxDurationMS: float64(form.maxDuration.Microseconds()) / 1000,
+			Fields:        make([]FieldReport, 0, len(form.fields)),
+		}
+		for fieldName, field := range form.fields {
+			rules := make(map[string]int64, len(field.rules))
+			for rule, count := range field.rules {
+				rules[rule] = count
+			}
+			r.Fields = append(r.Fields, FieldReport{
+				Field:       fieldName,
+				Failures:    field.failures,
+				FailureRate: float64(field.failures) / float64(form.validations),
+				Rules:       rules,
+			})
+		}
+		sort.Slice(r.Fields, func(i, j int) bool {
+			if r.Fields[i].Failures != r.Fields[j].Failures {
+				return r.Fields[i].Failures > r.Fields[j].Failures
+			}
+			return r.Fields[i].Field < r.Fields[j].Field
+		})
+		report.Forms = append(report.Forms, r)
+	}
+	sort.Slice(report.Forms, func(i, j int) bool { return report.Forms[i].Form < report.Forms[j].Form })
+	return report
+}
+
+// Reset clears the counts.
+func (s *ValidationStats) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.since = time.Now()
+	s.forms = make(map[string]*formStats)
+}
+
+// ServeHTTP writes the report as JSON. A "form" query parameter limits it to one form.
+// The report names forms, fields and rules, so serve it to operators only.
+func (s *ValidationStats) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	report := s.Report()
+	if name := r.URL.Query().Get("form"); name != "" {
+		forms := report.Forms[:0]
+		for _, form := range report.Forms {
+			if form.Form == name {
+				forms = append(forms, form)
+			}
+		}
+		report.Forms = forms
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	_ = json.NewEncoder(w).Encode(report)
+}
@@ -0,0 +1,83 @@
+package form
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestRegisterValidationStatsWhileValidating(t *testing.T) {
+	t.Cleanup(func() { RegisterValidationStats(nil) })
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			ValidateStruct(context.Background(), &funnelForm{Age: 12})
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		RegisterValidationStats(NewValidationStats())
+	}
+	<-done
+}
+
+func TestValidationStats(t *testing.T) {
+	stats := NewValidationStats()
+	RegisterValidationStats(stats)
+	t.Cleanup(func() { RegisterValidationStats(nil) })
+
+	for _, values := range []url.Values{
+		{"email": {"ada@example.com"}, "age": {"30"}},
+		{"email": {"nope"}, "age": {"12"}},
+		{"age": {"15"}, "tags": {"c3po", "r2d2"}},
+	} {
+		var f funnelForm
+		DecodeAndValidate(newValuesRequest(values), &f)
+	}
+	ValidateStruct(context.Background(), &funnelForm{Email: "ada@example.com", Age: 40})
+
+	report := stats.Report()
+	if len(report.Forms) != 1 {
+		t.Fatalf("Expected one form, got %+v", report.Forms)
+	}
+	form := report.Forms[0]
+	if form.Form != "funnelForm" || form.Validations != 4 || form.Invalid != 2 || form.InvalidRate != 0.5 {
+		t.Errorf("Unexpected form counts: %+v", form)
+	}
+	expected := []FieldReport{
+		{Field: "age", Failures: 2, FailureRate: 0.5, Rules: map[string]int64{"min": 2}},
+		{Field: "email", Failures: 2, FailureRate: 0.5, Rules: map[string]int64{"email": 1, "required": 1}},
+		{Field: "tags", Failures: 1, FailureRate: 0.25, Rules: map[string]int64{"alpha": 1}},
+	}
+	if !reflect.DeepEqual(form.Fields, expected) {
+		t.Errorf("Expected fields %+v, got %+v", expected, form.Fields)
+	}
+
+	// The report is served as JSON, optionally for one form
+	rec := httptest.NewRecorder()
+	stats.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/forms?form=funnelForm", nil))
+	var served ValidationReport
+	if err := json.NewDecoder(rec.Body).Decode(&served); err != nil || len(served.Forms) != 1 || served.Forms[0].Fields[0].Field != "age" {
+		t.Errorf("Expected the report as JSON, got %v %+v", err, served)
+	}
+	rec = httptest.NewRecorder()
+	stats.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/forms?form=Other", nil))
+	if err := json.NewDecoder(rec.Body).Decode(&served); err != nil || len(served.Forms) != 0 {
+		t.Errorf("Expected no forms, got %v %+v", err, served)
+	}
+	rec = httptest.NewRecorder()
+	stats.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/debug/forms", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Expected 405 for POST, got %d", rec.Code)
+	}
+
+	stats.Reset()
+	if report := stats.Report(); len(report.Forms) != 0 {
+		t.Errorf("Expected Reset to clear the counts, got %+v", report)
+	}
+}
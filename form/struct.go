@@ -0,0 +1,106 @@
+package form
+
+import (
+	"context"
+	"reflect"
+)
+
+// FormErrorKey is the ValidationErrors key for errors that concern the form as a whole
+// rather than a single field.
+const FormErrorKey = "_form"
+
+// StructValidator is implemented by forms with invariants that span several fields,
+// such as "allocations add up to 100" or "end is after start unless all-day".
+// Validate is called after the tag rules have run, whether or not they passed, and the
+// errors it returns are merged into the result. Report field errors under the field's
+// form name and form-level errors under FormErrorKey.
+//
+// Example:
+//
+//	type EventForm struct {
+//	    Start  string `form:"start" validate:"required"`
+//	    End    string `form:"end"`
+//	    AllDay bool   `form:"all_day"`
+//	}
+//
+//	func (f *EventForm) Validate(ctx context.Context) form.ValidationErrors {
+//	    errs := form.ValidationErrors{}
+//	    if !f.AllDay && f.End <= f.Start {
+//	        errs.Add("end", "Must be after the start")
+//	    }
+//	    return errs
+//	}
+type StructValidator interface {
+	Validate(ctx context.Context) ValidationErrors
+}
+
+// StructValidatorFunc validates a decoded struct. v is a pointer to the struct.
+type StructValidatorFunc func(ctx context.Context, v interface{}) ValidationErrors
+
+// RegisterStructValidator registers a struct-level validator for the type of v, which
+// may be a struct value or a pointer to one. Use it for types you don't own and can't
+// add a Validate method to. Registered validators run after the type's own Validate method.
+//
+// Example:
+//
+//	form.RegisterStructValidator(billing.Allocation{}, func(ctx context.Context, v interface{}) form.ValidationErrors {
+//	    a := v.(*billing.Allocation)
+//	    if a.Compute+a.Storage+a.Network != 100 {
+//	        return form.ValidationErrors{form.FormErrorKey: {"Allocations must add up to 100"}}
+//	    }
+//	    return nil
+//	})
+func RegisterStructValidator(v interface{}, fn StructValidatorFunc) {
+	typ := reflect.TypeOf(v)
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	registry.structValidators[typ] = append(registry.structValidators[typ], fn)
+}
+
+// Add appends an error message for a field.
+func (e ValidationErrors) Add(field, message string) {
+	e[field] = append(e[field], message)
+}
+
+// Merge appends all errors from other, keeping the existing messages first.
+func (e ValidationErrors) Merge(other ValidationErrors) {
+	for field, messages := range other {
+		e[field] = append(e[field], messages...)
+	}
+}
+
+// runStructValidators runs the struct-level validators for v and merges their errors
+func runStructValidators(ctx context.Context, v interface{}, errors ValidationErrors) ValidationErrors {
+	if errors == nil {
+		errors = make(ValidationErrors)
+	}
+
+	found := structValidatorErrors(ctx, v)
+	for key := range found {
+		rulesFrom(ctx).add(key, "struct")
+	}
+	errors.Merge(found)
+	return errors
+}
+
+// structValidatorErrors returns the errors of v's Validate method and registered struct validators
+func structValidatorErrors(ctx context.Context, v interface{}) ValidationErrors {
+	errors := make(ValidationErrors)
+	if validator, ok := v.(StructValidator); ok {
+		errors.Merge(validator.Validate(ctx))
+	}
+
+	typ := reflect.TypeOf(v)
+	if typ == nil {
+		return errors
+	}
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	for _, fn := range registry.structValidators[typ] {
+		errors.Merge(fn(ctx, v))
+	}
+
+	return errors
+}
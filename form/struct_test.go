@@ -0,0 +1,93 @@
+package form
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type AllocationForm struct {
+	Compute int `form:"compute" validate:"required,numeric"`
+	Storage int `form:"storage" validate:"required,numeric"`
+	Network int `form:"network"`
+}
+
+func (f *AllocationForm) Validate(ctx context.Context) ValidationErrors {
+	errs := ValidationErrors{}
+	if f.Compute+f.Storage+f.Network != 100 {
+		errs.Add(FormErrorKey, "Allocations must add up to 100")
+	}
+	if f.Network > 50 {
+		errs.Add("network", "Must not exceed half of the allocation")
+	}
+	return errs
+}
+
+func TestStructValidatorMethod(t *testing.T) {
+	values := url.Values{}
+	values.Set("compute", "40")
+	values.Set("storage", "")
+	values.Set("network", "70")
+
+	var f AllocationForm
+	errs := DecodeAndValidate(newValuesRequest(values), &f)
+
+	expected := ValidationErrors{
+		"storage":    {ErrFieldRequired},
+		FormErrorKey: {"Allocations must add up to 100"},
+		"network":    {"Must not exceed half of the allocation"},
+	}
+	if !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected %v, got %v", expected, errs)
+	}
+
+	values.Set("storage", "40")
+	values.Set("network", "20")
+	f = AllocationForm{}
+	if errs := DecodeAndValidate(newValuesRequest(values), &f); len(errs) > 0 {
+		t.Errorf("Expected no validation errors, got: %v", errs)
+	}
+}
+
+type externalEvent struct {
+	Start  string `form:"start" validate:"required"`
+	End    string `form:"end"`
+	AllDay bool   `form:"all_day"`
+}
+
+func TestRegisterStructValidator(t *testing.T) {
+	RegisterStructValidator(&externalEvent{}, func(ctx context.Context, v interface{}) ValidationErrors {
+		e := v.(*externalEvent)
+		if !e.AllDay && e.End <= e.Start {
+			return ValidationErrors{"end": {"Must be after the start"}}
+		}
+		return nil
+	})
+
+	var e externalEvent
+	errs := DecodeAndValidateJSON(context.Background(), strings.NewReader(`{"start":"2025-01-02","end":"2025-01-01"}`), &e)
+	if !reflect.DeepEqual(errs, ValidationErrors{"end": {"Must be after the start"}}) {
+		t.Errorf("Expected end error, got %v", errs)
+	}
+
+	e = externalEvent{}
+	errs = DecodeAndValidateMap(context.Background(), map[string]interface{}{
+		"start":   "2025-01-02",
+		"all_day": true,
+	}, &e)
+	if len(errs) > 0 {
+		t.Errorf("Expected no validation errors for all-day event, got: %v", errs)
+	}
+}
+
+func TestValidationErrorsMerge(t *testing.T) {
+	errs := ValidationErrors{"email": {"first"}}
+	errs.Merge(ValidationErrors{"email": {"second"}, "name": {"third"}})
+
+	expected := ValidationErrors{"email": {"first", "second"}, "name": {"third"}}
+	if !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected %v, got %v", expected, errs)
+	}
+}
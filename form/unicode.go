@@ -0,0 +1,160 @@
+package form
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// LengthMode selects how the min and max rules measure strings.
+type LengthMode int
+
+const (
+	// LengthRunes counts Unicode code points, so "José" has length 4. This is the default.
+	LengthRunes LengthMode = iota
+	// LengthBytes counts UTF-8 bytes, so "José" has length 5.
+	LengthBytes
+	// LengthGraphemes counts user-perceived characters (grapheme clusters), so a flag
+	// emoji or "e" followed by a combining accent each count as one.
+	LengthGraphemes
+)
+
+// SetLengthMode sets how the min and max rules measure strings. Numeric fields and
+// collections are unaffected. Call it during initialization, before validating.
+//
+// Example:
+//
+//	form.SetLengthMode(form.LengthGraphemes)
+func SetLengthMode(mode LengthMode) {
+	registry.lengthMode = mode
+}
+
+// stringLength measures a string according to the configured length mode
+func stringLength(value string) int {
+	switch registry.lengthMode {
+	case LengthBytes:
+		return len(value)
+	case LengthGraphemes:
+		return graphemeCount(value)
+	default:
+		return utf8.RuneCountInString(value)
+	}
+}
+
+// normalizationForms maps the normalize_unicode parameter to a normalization form
+var normalizationForms = map[string]norm.Form{
+	"NFC":  norm.NFC,
+	"NFD":  norm.NFD,
+	"NFKC": norm.NFKC,
+	"NFKD": norm.NFKD,
+}
+
+// normalizeUnicode drops invalid UTF-8 and normalizes value to the named form.
+// The form name is case-insensitive; unknown forms leave the value unchanged,
+// as other sanitizers do with invalid parameters.
+func normalizeUnicode(value, form string) string {
+	f, ok := normalizationForms[strings.ToUpper(form)]
+	if !ok {
+		return value
+	}
+	return f.String(strings.ToValidUTF8(value, ""))
+}
+
+// graphemeCount counts extended grapheme clusters. It follows the UAX #29 rules that
+// matter in practice: CR LF, combining and spacing marks, variation selectors, emoji
+// modifiers and ZWJ sequences, regional indicator pairs (flags) and Hangul jamo.
+func graphemeCount(value string) int {
+	count := 0
+	var prev rune
+	regionalRun := 0
+	afterPictographicZWJ := false
+	lastPictographic := false
+
+	for i, r := range value {
+		joins := false
+		if i > 0 {
+			switch {
+			case prev == '\r' && r == '\n':
+				joins = true
+			case isGraphemeExtend(r):
+				joins = true
+			case afterPictographicZWJ && isPictographic(r):
+				joins = true
+			case isRegionalIndicator(r) && isRegionalIndicator(prev) && regionalRun%2 == 1:
+				joins = true
+			case joinsHangul(prev, r):
+				joins = true
+			}
+		}
+		if !joins {
+			count++
+		}
+
+		if isRegionalIndicator(r) {
+			regionalRun++
+		} else {
+			regionalRun = 0
+		}
+		afterPictographicZWJ = r == '\u200d' && lastPictographic
+		if isPictographic(r) {
+			lastPictographic = true
+		} else if !isGraphemeExtend(r) {
+			lastPictographic = false
+		}
+		prev = r
+	}
+	return count
+}
+
+// isGraphemeExtend reports whether r attaches to the preceding character
+func isGraphemeExtend(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc) ||
+		r == '\u200d' || // zero width joiner
+		(r >= 0x1F3FB && r <= 0x1F3FF) || // emoji skin tone modifiers
+		(r >= 0xE0020 && r <= 0xE007F) // emoji tag sequences
+}
+
+// isPictographic approximates Extended_Pictographic with the emoji and symbol blocks
+func isPictographic(r rune) bool {
+	return (r >= 0x1F000 && r <= 0x1FAFF) || (r >= 0x2600 && r <= 0x27BF) || r == 0x00A9 || r == 0x00AE
+}
+
+// isRegionalIndicator reports whether r is one of the letters that pair up into flags
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+// hangulType classifies Hangul jamo and syllables for grapheme segmentation
+func hangulType(r rune) byte {
+	switch {
+	case (r >= 0x1100 && r <= 0x115F) || (r >= 0xA960 && r <= 0xA97C):
+		return 'L'
+	case (r >= 0x1160 && r <= 0x11A7) || (r >= 0xD7B0 && r <= 0xD7C6):
+		return 'V'
+	case (r >= 0x11A8 && r <= 0x11FF) || (r >= 0xD7CB && r <= 0xD7FB):
+		return 'T'
+	case r >= 0xAC00 && r <= 0xD7A3:
+		if (r-0xAC00)%28 == 0 {
+			return 'v' // LV syllable
+		}
+		return 't' // LVT syllable
+	}
+	return 0
+}
+
+// joinsHangul reports whether two Hangul characters form one syllable block
+func joinsHangul(prev, r rune) bool {
+	switch hangulType(prev) {
+	case 'L':
+		t := hangulType(r)
+		return t == 'L' || t == 'V' || t == 'v' || t == 't'
+	case 'V', 'v':
+		t := hangulType(r)
+		return t == 'V' || t == 'T'
+	case 'T', 't':
+		return hangulType(r) == 'T'
+	}
+	return false
+}
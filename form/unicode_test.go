@@ -0,0 +1,129 @@
+package form
+
+import (
+	"testing"
+)
+
+func TestNormalizeUnicodeSanitizer(t *testing.T) {
+	decomposed := "Jose\u0301"
+	composed := "Jos\u00e9"
+
+	tests := []struct {
+		tag      string
+		input    string
+		expected string
+	}{
+		{"normalize_unicode", decomposed, composed},
+		{"normalize_unicode=NFC", decomposed, composed},
+		{"normalize_unicode=nfd", composed, decomposed},
+		{"normalize_unicode=NFKC", "\uff21\uff22\u2460", "AB1"},
+		{"normalize_unicode=NFKD", "\ufb01", "fi"},
+		{"normalize_unicode", "ok\xff", "ok"},
+		{"normalize_unicode=bogus", decomposed, decomposed},
+	}
+
+	for _, tt := range tests {
+		result, err := applySanitizers(tt.input, tt.tag)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", tt.tag, err)
+		}
+		if result != tt.expected {
+			t.Errorf("%s(%q): expected %q, got %q", tt.tag, tt.input, tt.expected, result)
+		}
+	}
+}
+
+func TestGraphemeCount(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"", 0},
+		{"Jos\u00e9", 4},
+		{"Jose\u0301", 4},
+		{"\r\n", 1},
+		{"\U0001F1EF\U0001F1F5\U0001F1FA\U0001F1F8", 2},   // two flags
+		{"\U0001F44D\U0001F3FD", 1},                       // thumbs up with skin tone
+		{"\U0001F468\u200d\U0001F469\u200d\U0001F467", 1}, // family ZWJ sequence
+		{"\u2764\ufe0f", 1},                               // heart with variation selector
+		{"\u1112\u1161\u11ab\uad6d", 2},                   // conjoining jamo, then a syllable
+	}
+
+	for _, tt := range tests {
+		if got := graphemeCount(tt.input); got != tt.expected {
+			t.Errorf("graphemeCount(%q): expected %d, got %d", tt.input, tt.expected, got)
+		}
+	}
+}
+
+func TestLengthModes(t *testing.T) {
+	defer SetLengthMode(LengthRunes)
+
+	name := "Jose\u0301"
+	tests := []struct {
+		mode   LengthMode
+		rule   string
+		errors int
+	}{
+		{LengthRunes, "max=4", 1},
+		{LengthRunes, "max=5", 0},
+		{LengthBytes, "max=5", 1},
+		{LengthGraphemes, "max=4", 0},
+		{LengthGraphemes, "min=5", 1},
+	}
+
+	for _, tt := range tests {
+		SetLengthMode(tt.mode)
+		if errs := validateFieldWithContext(name, tt.rule, ValidationContext{}); len(errs) != tt.errors {
+			t.Errorf("mode %d, %s: expected %d errors, got %v", tt.mode, tt.rule, tt.errors, errs)
+		}
+	}
+
+	// Runes are the default, so a precomposed accent counts once
+	SetLengthMode(LengthRunes)
+	if errs := validateFieldWithContext("Jos\u00e9", "max=4", ValidationContext{}); len(errs) > 0 {
+		t.Errorf("Expected the precomposed name to pass max=4, got %v", errs)
+	}
+}
+
+func TestNoConfusables(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{"paypal", ""},
+		{"jos\u00e9_92", ""},
+		{"\u0410\u043b\u0435\u043a\u0441\u0435\u0439", ""},
+		{"\u6771\u4eac\u30bf\u30ef\u30fc", ""},
+		{"tokyo\u6771\u4eac", ""},
+		{"p\u0430ypal", ErrMixedScripts},                        // Cyrillic \u0430
+		{"\u0440\u0430\u0443\u0440\u0430\u04cf", ErrConfusable}, // "paypal" in Cyrillic lookalikes
+		{"\u03bf\u03bd", ErrConfusable},                         // Greek "ov"
+		{"admin\u03b1", ErrMixedScripts},
+	}
+
+	for _, tt := range tests {
+		if got := noConfusables(tt.value, ""); got != tt.expected {
+			t.Errorf("no_confusables(%q): expected %q, got %q", tt.value, tt.expected, got)
+		}
+	}
+}
+
+func TestSkeleton(t *testing.T) {
+	lookalike := "p\u0430yp\u0430l"
+	if Skeleton(lookalike) != Skeleton("paypal") {
+		t.Errorf("Expected lookalikes to share a skeleton, got %q and %q", Skeleton(lookalike), Skeleton("paypal"))
+	}
+	if Skeleton("paypal") == Skeleton("paypa1x") {
+		t.Error("Expected different strings to have different skeletons")
+	}
+	if Skeleton("g00gle") != Skeleton("gOOgle") {
+		t.Errorf("Expected digit zero to match letter O, got %q and %q", Skeleton("g00gle"), Skeleton("gOOgle"))
+	}
+	// Mathematical and fullwidth letters are in the full Unicode data
+	for _, lookalike := range []string{"𝐩𝐚𝐲𝐩𝐚𝐥", "ｐａｙｐａｌ"} {
+		if Skeleton(lookalike) != Skeleton("paypal") {
+			t.Errorf("Expected %q to share the skeleton of paypal, got %q", lookalike, Skeleton(lookalike))
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package form
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// ValidateStruct validates the tag rules of a struct that is already populated, such as a
+// configuration struct or a domain object. Nothing is decoded or sanitized: rules run
+// against the current field values, so numeric min/max compare the typed values. As an
+// unset number or bool can't be told from a zero one, required fails for 0 and false.
+//
+// Nested structs and non-nil pointers to structs are validated too, and their errors are
+// reported under dotted keys such as "database.port". Struct-level validators run for
+// each struct after its tag rules. Types with code generated by `gokit form gen` are
+// validated with their generated ValidateContext method.
+//
+// Example:
+//
+//	cfg := Config{Port: 80, Host: ""}
+//	if errs := form.ValidateStruct(ctx, &cfg); len(errs) > 0 {
+//	    // Handle validation errors
+//	}
+func ValidateStruct(ctx context.Context, v interface{}) ValidationErrors {
+	return validateStruct(ctx, v, false)
+}
+
+// validateStruct validates a populated struct, sanitizing its fields in place first if requested
+func validateStruct(ctx context.Context, v interface{}, sanitize bool) ValidationErrors {
+	start := time.Now()
+
+	val := reflect.ValueOf(v)
+	if val.Kind() != reflect.Ptr || val.IsNil() {
+		return ValidationErrors{"_struct": {"Target must be a non-nil pointer to struct"}}
+	}
+	if val.Elem().Kind() != reflect.Struct {
+		return ValidationErrors{"_struct": {"Target must be a pointer to struct"}}
+	}
+
+	formName := val.Elem().Type().Name()
+	if obs := getObserver(); obs != nil {
+		obs.OnValidationStart(ctx, formName)
+	}
+
+	if problems := referenceErrors(planFor(val.Elem().Type())); len(problems) > 0 {
+		return ValidationErrors{"_struct": problems}
+	}
+
+	ctx = trackRules(ctx)
+	errors := validateStructValue(ctx, val, sanitize)
+
+	handleFormObservability(ctx, formName, errors, start)
+	return errors
+}
+
+// validateStructValue validates the struct ptr points to and its nested structs. The values
+// of the whole tree are collected first, so that references can reach any level.
+func validateStructValue(ctx context.Context, ptr reflect.Value, sanitize bool) ValidationErrors {
+	nodes := walkStructs(ptr, planFor(ptr.Elem().Type()))
+	decoded := newDecodedFields()
+	decoded.existing = true
+	decoded.failures = rulesFrom(ctx)
+	for _, node := range nodes {
+		collectStructFields(node, sanitize, decoded)
+	}
+
+	errors := make(ValidationErrors)
+	for _, node := range nodes {
+		generated, ok := node.ptr.Interface().(GeneratedForm)
+		if !ok || len(decoded.errors) > 0 {
+			decoded.validateStructLevel(errors, node)
+			continue
+		}
+		prefix := node.prefix()
+		for key, messages := range generated.ValidateContext(ctx) {
+			errors[prefix+key] = append(errors[prefix+key], messages...)
+		}
+	}
+
+	errors = runStructValidators(ctx, ptr.Interface(), errors)
+	runNestedStructValidators(ctx, nodes, errors)
+	return sensitiveValues(nodes, nil, decoded).redact(errors)
+}
+
+// collectStructFields gathers the current field values of one struct in a tree for validation,
+// formatted as the reflective decoder would receive them. Unexported fields are treated as empty.
+// If sanitize is set, sanitize tags are applied and the sanitized values are stored back.
+func collectStructFields(node structNode, sanitize bool, decoded *decodedFields) {
+	s := node.scopes[len(node.scopes)-1]
+	val := node.ptr.Elem()
+
+	for _, f := range s.plan.fields {
+		if f.nested != nil {
+			continue
+		}
+		field := val.Field(f.index)
+		key := s.prefix + f.key
+		fieldName := f.name
+		if s.prefix != "" {
+			fieldName = ""
+		}
+		sanitizeTag := ""
+		if sanitize {
+			sanitizeTag = f.sanitize
+		}
+
+		if f.collection {
+			c := &collection{}
+			if f.exported {
+				c = collectionOf(field)
+			}
+			if sanitizeTag != "" && f.exported {
+				if err := sanitizeCollection(c, sanitizeTag); err != nil {
+					decoded.errors[key] = []string{err.Error()}
+				} else if len(c.values) > 0 {
+					setCollectionValue(field, c)
+				}
+			}
+			decoded.setCollection(key, fieldName, c)
+			continue
+		}
+
+		value := ""
+		if f.exported && f.isTime {
+			value = FormatTime(field.Interface().(time.Time), f.validate)
+			if sanitizeTag != "" {
+				sanitized, err := applySanitizers(value, sanitizeTag)
+				if err != nil {
+					decoded.errors[key] = []string{err.Error()}
+				} else if sanitized != value {
+					value = sanitized
+					setTimeValue(field, value, f.validate)
+				}
+			}
+		} else if f.exported && isScalarKind(field.Kind()) {
+			value = formatScalar(field)
+			if sanitizeTag != "" {
+				sanitized, err := applySanitizers(value, sanitizeTag)
+				if err != nil {
+					decoded.errors[key] = []string{err.Error()}
+				} else if sanitized != value {
+					value = sanitized
+					setFieldValue(field, value)
+					value = formatScalar(field)
+				}
+			}
+		}
+		decoded.setScalar(key, fieldName, value)
+	}
+}
+
+// sanitizeCollection applies a sanitize tag to every element of a collection.
+// The first sanitizer error is returned and stops processing.
+func sanitizeCollection(c *collection, sanitizeTag string) error {
+	for i, value := range c.values {
+		sanitized, err := applySanitizers(value, sanitizeTag)
+		if err != nil {
+			return err
+		}
+		c.values[i] = sanitized
+	}
+	return nil
+}
+
+// collectionOf formats the elements of a slice or map field. Map entries are sorted by key.
+func collectionOf(field reflect.Value) *collection {
+	c := &collection{}
+	if field.Kind() == reflect.Map {
+		c.keys = make([]string, 0, field.Len())
+		entries := make(map[string]string, field.Len())
+		iter := field.MapRange()
+		for iter.Next() {
+			key := iter.Key().String()
+			c.keys = append(c.keys, key)
+			entries[key] = formatScalar(iter.Value())
+		}
+		sort.Strings(c.keys)
+		for _, key := range c.keys {
+			c.values = append(c.values, entries[key])
+		}
+		return c
+	}
+
+	c.values = make([]string, field.Len())
+	for i := range c.values {
+		c.values[i] = formatScalar(field.Index(i))
+	}
+	return c
+}
+
+// formatScalar formats a scalar value as a string that setFieldValue parses back to the same value
+func formatScalar(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 32)
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	}
+	return ""
+}
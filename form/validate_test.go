@@ -0,0 +1,127 @@
+package form
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+type ServerLimits struct {
+	MaxBody uint64  `form:"max_body" validate:"min=1024,max=18446744073709551615"`
+	Ratio   float32 `form:"ratio" validate:"min=0.5,max=1"`
+}
+
+type ServerSettings struct {
+	Name    string            `form:"name" validate:"required,alphanumeric"`
+	Port    int               `form:"port" validate:"min=1,max=65535"`
+	Offset  int64             `form:"offset" validate:"max=9007199254740992"`
+	Hosts   []string          `form:"hosts" validate:"min=1,dive,required"`
+	Labels  map[string]string `form:"labels" validate:"dive,alpha"`
+	Limits  ServerLimits      `form:"limits"`
+	Backup  *ServerLimits     `form:"backup"`
+	Primary *ServerSettings   `form:"primary"`
+}
+
+func TestValidateStruct(t *testing.T) {
+	s := ServerSettings{
+		Name:   "edge 1",
+		Port:   0,
+		Offset: 9007199254740993,
+		Hosts:  []string{"a", ""},
+		Labels: map[string]string{"zone": "eu1"},
+		Limits: ServerLimits{MaxBody: 512, Ratio: 0.25},
+		Backup: &ServerLimits{MaxBody: 2048, Ratio: 1.5},
+	}
+	s.Primary = &s
+
+	expected := ValidationErrors{
+		"name":            {ErrMustBeAlphanumeric},
+		"port":            {"Must be at least 1"},
+		"offset":          {"Must be no more than 9007199254740992"},
+		"hosts[1]":        {ErrFieldRequired},
+		"labels[zone]":    {ErrMustBeAlpha},
+		"limits.max_body": {"Must be at least 1024"},
+		"limits.ratio":    {"Must be at least 0.5"},
+		"backup.ratio":    {"Must be no more than 1"},
+	}
+	errs := ValidateStruct(context.Background(), &s)
+	if !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected %v, got %v", expected, errs)
+	}
+
+	valid := ServerSettings{
+		Name:   "edge1",
+		Port:   8080,
+		Hosts:  []string{"a"},
+		Limits: ServerLimits{MaxBody: 18446744073709551615, Ratio: 0.5},
+	}
+	if errs := ValidateStruct(context.Background(), &valid); len(errs) > 0 {
+		t.Errorf("Expected no validation errors, got: %v", errs)
+	}
+}
+
+func TestValidateStructRequiredZeroValues(t *testing.T) {
+	type accountSettings struct {
+		Quota   int     `form:"quota" validate:"required"`
+		Rate    float64 `form:"rate" validate:"required,max=1"`
+		Enabled bool    `form:"enabled" validate:"required"`
+		Retries int     `form:"retries" validate:"max=5"`
+	}
+
+	expected := ValidationErrors{
+		"quota":   {ErrFieldRequired},
+		"rate":    {ErrFieldRequired},
+		"enabled": {ErrFieldRequired},
+	}
+	if errs := ValidateStruct(context.Background(), &accountSettings{}); !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected unset required fields to fail, got %v", errs)
+	}
+	if errs := ValidateStruct(context.Background(), &accountSettings{Quota: -1, Rate: 0.5, Enabled: true}); len(errs) > 0 {
+		t.Errorf("Expected no validation errors, got: %v", errs)
+	}
+
+	// Submitted zeros are present, as before
+	var f accountSettings
+	errs := DecodeAndValidate(newValuesRequest(url.Values{"quota": {"0"}, "rate": {"0"}, "enabled": {"false"}}), &f)
+	if len(errs) > 0 {
+		t.Errorf("Expected submitted zero values to pass required, got %v", errs)
+	}
+}
+
+func TestValidateStructDoesNotSanitize(t *testing.T) {
+	s := struct {
+		Name string `form:"name" sanitize:"trim" validate:"alpha"`
+	}{Name: " padded "}
+
+	errs := ValidateStruct(context.Background(), &s)
+	if !reflect.DeepEqual(errs, ValidationErrors{"name": {ErrMustBeAlpha}}) {
+		t.Errorf("Expected alpha error, got %v", errs)
+	}
+	if s.Name != " padded " {
+		t.Errorf("Expected value to be left unchanged, got %q", s.Name)
+	}
+}
+
+func TestValidateStructRunsStructValidators(t *testing.T) {
+	f := AllocationForm{Compute: 50, Storage: 20, Network: 60}
+
+	expected := ValidationErrors{
+		FormErrorKey: {"Allocations must add up to 100"},
+		"network":    {"Must not exceed half of the allocation"},
+	}
+	if errs := ValidateStruct(context.Background(), &f); !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected %v, got %v", expected, errs)
+	}
+}
+
+func TestValidateStructInvalidTarget(t *testing.T) {
+	var s ServerSettings
+	if errs := ValidateStruct(context.Background(), s); len(errs["_struct"]) == 0 {
+		t.Errorf("Expected _struct error for non-pointer, got %v", errs)
+	}
+	n := 1
+	if errs := ValidateStruct(context.Background(), &n); len(errs["_struct"]) == 0 {
+		t.Errorf("Expected _struct error for non-struct pointer, got %v", errs)
+	}
+}
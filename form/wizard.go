@@ -0,0 +1,561 @@
+package form
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Defaults for WizardOptions
+const (
+	DefaultWizardStateField = "_wizard"
+	DefaultWizardNavField   = "_wizard_nav"
+	DefaultWizardMaxAge     = 24 * time.Hour
+)
+
+// Navigation values of the wizard's nav field
+const (
+	WizardNext = "next"
+	WizardBack = "back"
+)
+
+// errWizardState is returned when wizard state can't be read
+var errWizardState = errors.New("form: invalid wizard state")
+
+// WizardStep is one page of a wizard.
+type WizardStep struct {
+	// Name identifies the step, for templates and for jumping back to it
+	Name string
+	// Fields lists the form keys submitted and validated on this step. A key of a nested
+	// struct or collection covers its fields and elements, so "address" covers
+	// "address.city". A step without fields, such as a review page, validates nothing.
+	Fields []string
+}
+
+// owns reports whether key is one of the step's fields or belongs to one of them
+func (s WizardStep) owns(key string) bool {
+	for _, field := range s.Fields {
+		if key == field || strings.HasPrefix(key, field+".") || strings.HasPrefix(key, field+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+// WizardStore keeps wizard state on the server, so that only a signed ID travels with
+// the form.
+type WizardStore interface {
+	// Load returns the state saved under id. It returns an error if there is none.
+	Load(ctx context.Context, id string) ([]byte, error)
+	// Save stores state under id for ttl.
+	Save(ctx context.Context, id string, state []byte, ttl time.Duration) error
+	// Delete removes the state saved under id once the wizard completes. Errors are
+	// reported to the observability system; the state then expires after its ttl.
+	Delete(ctx context.Context, id string) error
+}
+
+// WizardOptions configures a Wizard.
+type WizardOptions struct {
+	// Secret signs the state, and encrypts it with Encrypt. Instances behind a load
+	// balancer must share it; if empty, a random secret is generated, valid for this
+	// process only.
+	Secret []byte
+	// Encrypt encrypts state carried in the form, so that users can't read the values of
+	// earlier steps, such as a password. It is turned on for forms with fields tagged
+	// sensitive, and has no effect with a Store.
+	Encrypt bool
+	// Store keeps state on the server instead of in the form. A wizard is saved once
+	// its first step is submitted, not when the first page is shown.
+	Store WizardStore
+	// MaxAge is how long a wizard can be left between steps; it defaults to
+	// DefaultWizardMaxAge.
+	MaxAge time.Duration
+	// StateField and NavField name the hidden field carrying the state and the field
+	// whose value navigates: "next", "back" or the name of a step reached before. They
+	// default to DefaultWizardStateField and DefaultWizardNavField.
+	StateField string
+	NavField   string
+	// Decoder decodes and validates submissions; it defaults to the package's default decoder.
+	Decoder *Decoder
+}
+
+// WizardRenderer renders the page of the current step of a wizard, with its errors.
+type WizardRenderer func(w http.ResponseWriter, r *http.Request, page *WizardPage)
+
+// WizardPage describes the step to render.
+type WizardPage struct {
+	// Step is the index of the current step in Steps
+	Step  int
+	Steps []WizardStep
+	// Reached is the index of the furthest step reached; any step up to it can be revisited
+	Reached int
+	// Form points to a new struct of the wizard's type holding the values submitted so
+	// far, except for fields tagged sensitive, which are left empty; see Masked
+	Form interface{}
+	// Errors holds the errors of the current step's fields, and form-level errors
+	Errors ValidationErrors
+	// State is the encoded state, to be sent back in the state field; Fields renders it
+	State string
+
+	stateField string
+	plan       *structPlan
+	data       map[string][]string
+}
+
+// Name returns the name of the current step.
+func (p *WizardPage) Name() string {
+	return p.Steps[p.Step].Name
+}
+
+// First reports whether the current step is the first.
+func (p *WizardPage) First() bool {
+	return p.Step == 0
+}
+
+// Masked returns the value submitted for a field with the given form key, masked with
+// the policy of its sensitive tag, such as "************4242" for a card number tagged
+// sensitive:"last=4". Fields that aren't sensitive are returned as submitted.
+func (p *WizardPage) Masked(key string) string {
+	values := p.data[key]
+	if len(values) == 0 {
+		return ""
+	}
+	if f, ok := matchInputKey(p.plan, key); ok && f.sensitive != "" {
+		return MaskValue(values[0], f.sensitive)
+	}
+	return values[0]
+}
+
+// Last reports whether the current step is the last, whose submission completes the wizard.
+func (p *WizardPage) Last() bool {
+	return p.Step == len(p.Steps)-1
+}
+
+// Fields returns the hidden input carrying the wizard's state, to be placed inside the form.
+func (p *WizardPage) Fields() template.HTML {
+	return template.HTML(`<input type="hidden" name="` + template.HTMLEscapeString(p.stateField) + `" value="` + template.HTMLEscapeString(p.State) + `">`) // #nosec G203 -- name and value are escaped
+}
+
+// Wizard spreads one form struct over several pages. Each step submits and validates
+// some of the fields, with the values of earlier steps available to cross-field rules;
+// the last step validates the whole struct, including struct-level validators, and
+// passes it on. Values travel between steps as signed or encrypted state in a hidden
+// field, or are kept in a WizardStore. A Wizard is safe for concurrent use.
+//
+// Example:
+//
+//	wizard := form.NewWizard(OnboardingForm{}, form.WizardOptions{Secret: secret},
+//	    form.WizardStep{Name: "account", Fields: []string{"email", "password", "confirm"}},
+//	    form.WizardStep{Name: "profile", Fields: []string{"name", "address"}},
+//	    form.WizardStep{Name: "review"},
+//	)
+//	mux.Handle("/onboarding", wizard.Handler(renderStep, completeHandler))
+type Wizard struct {
+	typ   reflect.Type
+	steps []WizardStep
+	opts  WizardOptions
+	now   func() time.Time
+}
+
+// wizardState is the progress of one wizard
+type wizardState struct {
+	Step    int                 `json:"s"`
+	Reached int                 `json:"r"`
+	Data    map[string][]string `json:"d"`
+	Issued  int64               `json:"t"`
+	// id identifies state kept in a WizardStore
+	id string
+}
+
+// NewWizard returns a Wizard over the fields of formStruct. It panics if no steps are
+// given or a step names a field that formStruct doesn't have.
+func NewWizard(formStruct interface{}, opts WizardOptions, steps ...WizardStep) *Wizard {
+	typ := reflect.TypeOf(formStruct)
+	for typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ == nil || typ.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("form: wizard needs a struct, got %T", formStruct))
+	}
+	if len(steps) == 0 {
+		panic("form: wizard needs at least one step")
+	}
+
+	if opts.Decoder == nil {
+		opts.Decoder = defaultDecoder
+	}
+	plan := planWithTags(typ, opts.Decoder.tags)
+	for _, step := range steps {
+		for _, field := range step.Fields {
+			if _, ok := matchInputKey(plan, field); !ok {
+				panic(fmt.Sprintf("form: wizard step %q names unknown field %q", step.Name, field))
+			}
+		}
+	}
+
+	// State carried in the form would show sensitive values to anyone reading the page
+	if hasSensitiveFields(plan, make(map[reflect.Type]bool)) {
+		opts.Encrypt = true
+	}
+	if len(opts.Secret) == 0 {
+		opts.Secret = randomBytes(32)
+	}
+	if opts.MaxAge <= 0 {
+		opts.MaxAge = DefaultWizardMaxAge
+	}
+	if opts.StateField == "" {
+		opts.StateField = DefaultWizardStateField
+	}
+	if opts.NavField == "" {
+		opts.NavField = DefaultWizardNavField
+	}
+	return &Wizard{typ: typ, steps: steps, opts: opts, now: time.Now}
+}
+
+// Handler returns a handler running the wizard. GET requests start it at the first
+// step. Each POST merges the current step's input into the state and then navigates:
+// "back" and step names move without validating, and "next", the default, validates the
+// step's fields and moves on, or renders the step again with its errors.
+//
+// Submitting the last step validates the whole struct. If that fails, the wizard returns
+// to the first step with an error, with that step's errors, or stays on the last step
+// for form-level errors. If it passes, complete is called with the struct in the
+// request context, as with ValidationMiddleware, and server-side state is deleted.
+//
+// Expired or tampered state restarts the wizard at the first step with a form-level
+// ErrWizardExpired error. Files aren't carried between steps.
+func (wz *Wizard) Handler(render WizardRenderer, complete http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			wz.render(w, r, render, &wizardState{Data: make(map[string][]string)}, nil)
+			return
+		}
+
+		if err := parseRequestForm(r, wz.opts.Decoder.opts.MaxBodyBytes); err != nil {
+			wz.render(w, r, render, &wizardState{Data: make(map[string][]string)}, ValidationErrors{FormErrorKey: {parseFailure(err, "Failed to parse form data")}})
+			return
+		}
+		token := takeFormValue(r, wz.opts.StateField)
+		nav := takeFormValue(r, wz.opts.NavField)
+
+		state := &wizardState{Data: make(map[string][]string)}
+		if token != "" {
+			loaded, err := wz.load(r.Context(), token)
+			if err != nil {
+				wz.render(w, r, render, state, ValidationErrors{FormErrorKey: {ErrWizardExpired}})
+				return
+			}
+			state = loaded
+		}
+
+		// The step's input replaces what was submitted for its fields before, so that
+		// cleared checkboxes and removed collection elements disappear
+		step := wz.steps[state.Step]
+		for key := range state.Data {
+			if step.owns(key) {
+				delete(state.Data, key)
+			}
+		}
+		for key, values := range r.Form {
+			if step.owns(key) {
+				state.Data[key] = values
+			}
+		}
+
+		switch {
+		case nav == WizardBack:
+			if state.Step > 0 {
+				state.Step--
+			}
+			wz.render(w, r, render, state, nil)
+			return
+		case nav != "" && nav != WizardNext:
+			for i, s := range wz.steps[:state.Reached+1] {
+				if s.Name == nav {
+					state.Step = i
+				}
+			}
+			wz.render(w, r, render, state, nil)
+			return
+		}
+
+		// The last step is validated with the whole struct below
+		if state.Step < len(wz.steps)-1 {
+			if errs := wz.opts.Decoder.validateFields(r.Context(), reflect.New(wz.typ), state.Data, step.owns); len(errs) > 0 {
+				wz.render(w, r, render, state, errs)
+				return
+			}
+			state.Step++
+			if state.Step > state.Reached {
+				state.Reached = state.Step
+			}
+			wz.render(w, r, render, state, nil)
+			return
+		}
+
+		start := time.Now()
+		formName := wz.typ.Name()
+		if obs := getObserver(); obs != nil {
+			obs.OnDecodeStart(r.Context(), formName)
+		}
+		v := reflect.New(wz.typ)
+		ctx := trackRules(r.Context())
+		errs := wz.opts.Decoder.decodeAndValidateFields(ctx, v.Interface(), v.Elem(), state.Data, formName, wz.opts.Decoder.tags)
+		handleFormObservability(ctx, formName, errs, start)
+		if len(errs) > 0 {
+			state.Step = wz.stepWithErrors(errs)
+			wz.render(w, r, render, state, errs)
+			return
+		}
+		if state.id != "" {
+			// The submission is complete either way; a failed delete leaves the state to
+			// expire after MaxAge, and is reported so that a failing store is noticed
+			start := time.Now()
+			observeStore(r.Context(), "delete", "wizard", start, wz.opts.Store.Delete(r.Context(), state.id))
+		}
+		complete.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), formDataKey, v.Interface())))
+	})
+}
+
+// ownerOf returns the index of the first step owning key, or of the last step for
+// form-level keys and keys of fields outside every step
+func (wz *Wizard) ownerOf(key string) int {
+	for i, step := range wz.steps {
+		if step.owns(key) {
+			return i
+		}
+	}
+	return len(wz.steps) - 1
+}
+
+// stepWithErrors returns the first step that has errors
+func (wz *Wizard) stepWithErrors(errs ValidationErrors) int {
+	first := len(wz.steps) - 1
+	for key := range errs {
+		if owner := wz.ownerOf(key); owner < first {
+			first = owner
+		}
+	}
+	return first
+}
+
+// render saves the state and renders its current step with the errors belonging to it.
+// With a Store, a wizard is saved only once something was submitted, so that page views
+// and crawlers don't fill the store; its first page carries no state.
+func (wz *Wizard) render(w http.ResponseWriter, r *http.Request, render WizardRenderer, state *wizardState, errs ValidationErrors) {
+	var token string
+	if wz.opts.Store == nil || state.id != "" || state.Step > 0 || len(state.Data) > 0 {
+		var err error
+		if token, err = wz.save(r.Context(), state); err != nil {
+			http.Error(w, "Failed to save form progress", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	// Decode the values so far for redisplay; validation happens on submission only.
+	// Sensitive fields are left empty, to be entered again or shown with Masked.
+	v := reflect.New(wz.typ)
+	plan := planWithTags(wz.typ, wz.opts.Decoder.tags)
+	processFormFields(v.Elem(), plan, state.Data, wz.opts.Decoder.localeFor(r.Context()))
+	clearSensitive(walkStructs(v, plan))
+
+	pageErrors := make(ValidationErrors)
+	for key, messages := range errs {
+		if key == FormErrorKey || key == "_struct" || wz.ownerOf(key) == state.Step {
+			pageErrors[key] = messages
+		}
+	}
+
+	render(w, r, &WizardPage{
+		Step:       state.Step,
+		Steps:      wz.steps,
+		Reached:    state.Reached,
+		Form:       v.Interface(),
+		Errors:     pageErrors,
+		State:      token,
+		stateField: wz.opts.StateField,
+		plan:       plan,
+		data:       state.Data,
+	})
+}
+
+// save encodes the state, storing it first when state is kept on the server
+func (wz *Wizard) save(ctx context.Context, state *wizardState) (string, error) {
+	state.Issued = wz.now().Unix()
+	data, err := json.Marshal(state)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case wz.opts.Store != nil:
+		if state.id == "" {
+			state.id = base64.RawURLEncoding.EncodeToString(randomBytes(16))
+		}
+		if err := wz.opts.Store.Save(ctx, state.id, data, wz.opts.MaxAge); err != nil {
+			return "", err
+		}
+		return wz.sign(state.id), nil
+	case wz.opts.Encrypt:
+		aead, err := wz.aead()
+		if err != nil {
+			return "", err
+		}
+		nonce := randomBytes(aead.NonceSize())
+		return base64.RawURLEncoding.EncodeToString(aead.Seal(nonce, nonce, data, nil)), nil
+	default:
+		return wz.sign(base64.RawURLEncoding.EncodeToString(data)), nil
+	}
+}
+
+// load decodes and checks the state in token
+func (wz *Wizard) load(ctx context.Context, token string) (*wizardState, error) {
+	var data []byte
+	var id string
+	switch {
+	case wz.opts.Store != nil:
+		var err error
+		if id, err = wz.verify(token); err != nil {
+			return nil, err
+		}
+		if data, err = wz.opts.Store.Load(ctx, id); err != nil {
+			return nil, errWizardState
+		}
+	case wz.opts.Encrypt:
+		aead, err := wz.aead()
+		if err != nil {
+			return nil, err
+		}
+		sealed, err := base64.RawURLEncoding.DecodeString(token)
+		if err != nil || len(sealed) < aead.NonceSize() {
+			return nil, errWizardState
+		}
+		if data, err = aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil); err != nil {
+			return nil, errWizardState
+		}
+	default:
+		payload, err := wz.verify(token)
+		if err != nil {
+			return nil, err
+		}
+		if data, err = base64.RawURLEncoding.DecodeString(payload); err != nil {
+			return nil, errWizardState
+		}
+	}
+
+	state := wizardState{id: id}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, errWizardState
+	}
+	if wz.now().Sub(time.Unix(state.Issued, 0)) > wz.opts.MaxAge ||
+		state.Step < 0 || state.Reached >= len(wz.steps) || state.Step > state.Reached {
+		return nil, errWizardState
+	}
+	if state.Data == nil {
+		state.Data = make(map[string][]string)
+	}
+	return &state, nil
+}
+
+// sign appends the HMAC of payload
+func (wz *Wizard) sign(payload string) string {
+	mac := hmac.New(sha256.New, wz.opts.Secret)
+	mac.Write([]byte(payload))
+	return payload + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// verify checks a signed token and returns its payload
+func (wz *Wizard) verify(token string) (string, error) {
+	i := strings.LastIndexByte(token, '.')
+	if i < 0 || !hmac.Equal([]byte(wz.sign(token[:i])), []byte(token)) {
+		return "", errWizardState
+	}
+	return token[:i], nil
+}
+
+// aead returns the cipher encrypting state, keyed by a hash of the secret
+func (wz *Wizard) aead() (cipher.AEAD, error) {
+	key := sha256.Sum256(append([]byte("gokit wizard state\x00"), wz.opts.Secret...))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// randomBytes returns n random bytes
+func randomBytes(n int) []byte {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		panic("form: reading random bytes: " + err.Error())
+	}
+	return b
+}
+
+// MemoryWizardStore is an in-memory WizardStore; use a shared store when running
+// several instances.
+type MemoryWizardStore struct {
+	mu      sync.Mutex
+	entries map[string]wizardEntry
+	now     func() time.Time
+}
+
+// wizardEntry is saved wizard state and its expiry
+type wizardEntry struct {
+	state   []byte
+	expires time.Time
+}
+
+// NewMemoryWizardStore returns an empty MemoryWizardStore.
+func NewMemoryWizardStore() *MemoryWizardStore {
+	return &MemoryWizardStore{entries: make(map[string]wizardEntry), now: time.Now}
+}
+
+// Load implements WizardStore.
+func (s *MemoryWizardStore) Load(ctx context.Context, id string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry, ok := s.entries[id]
+	if !ok || !s.now().Before(entry.expires) {
+		return nil, errWizardState
+	}
+	return entry.state, nil
+}
+
+// Save implements WizardStore.
+func (s *MemoryWizardStore) Save(ctx context.Context, id string, state []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if len(s.entries)%64 == 63 {
+		for k, entry := range s.entries {
+			if !now.Before(entry.expires) {
+				delete(s.entries, k)
+			}
+		}
+	}
+	s.entries[id] = wizardEntry{state: state, expires: now.Add(ttl)}
+	return nil
+}
+
+// Delete implements WizardStore.
+func (s *MemoryWizardStore) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, id)
+	return nil
+}
@@ -0,0 +1,250 @@
+package form
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kdsmith18542/gokit/observability"
+)
+
+type onboardingForm struct {
+	Email    string            `form:"email" sanitize:"trim" validate:"required,email"`
+	Password string            `form:"password" validate:"required,min=8"`
+	Confirm  string            `form:"confirm" validate:"required,eqfield=password"`
+	Name     string            `form:"name" validate:"required"`
+	Address  onboardingAddress `form:"address"`
+	Plan     string            `form:"plan" validate:"required,oneof=free:pro"`
+}
+
+type onboardingAddress struct {
+	City string `form:"city" validate:"required"`
+}
+
+// onboardingRun drives a wizard through its handler, keeping the last rendered page
+type onboardingRun struct {
+	t         *testing.T
+	handler   http.Handler
+	page      *WizardPage
+	completed *onboardingForm
+}
+
+func newOnboardingRun(t *testing.T, opts WizardOptions) (*onboardingRun, *Wizard) {
+	wizard := NewWizard(onboardingForm{}, opts,
+		WizardStep{Name: "account", Fields: []string{"email", "password", "confirm"}},
+		WizardStep{Name: "profile", Fields: []string{"name", "address"}},
+		WizardStep{Name: "plan", Fields: []string{"plan"}},
+	)
+	run := &onboardingRun{t: t}
+	run.handler = wizard.Handler(
+		func(w http.ResponseWriter, r *http.Request, page *WizardPage) { run.page = page },
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			run.completed = ValidatedFormFromContext(r.Context()).(*onboardingForm)
+		}),
+	)
+	run.handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/onboarding", nil))
+	return run, wizard
+}
+
+// submit posts values with the current state and returns the rendered page
+func (run *onboardingRun) submit(nav string, values url.Values) *WizardPage {
+	run.t.Helper()
+	body := url.Values{DefaultWizardStateField: {run.page.State}, DefaultWizardNavField: {nav}}
+	for key, vs := range values {
+		body[key] = vs
+	}
+	req := httptest.NewRequest(http.MethodPost, "/onboarding", strings.NewReader(body.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	run.page = nil
+	run.handler.ServeHTTP(httptest.NewRecorder(), req)
+	return run.page
+}
+
+var onboardingAccount = url.Values{"email": {" ada@example.com "}, "password": {"s3cret-pass"}, "confirm": {"s3cret-pass"}}
+
+func TestWizardSteps(t *testing.T) {
+	run, _ := newOnboardingRun(t, WizardOptions{})
+	if run.page.Step != 0 || run.page.Name() != "account" || !run.page.First() {
+		t.Fatalf("Expected to start on the account step, got %+v", run.page)
+	}
+
+	// Only the step's fields are validated
+	page := run.submit(WizardNext, url.Values{"email": {"nope"}, "password": {"s3cret-pass"}, "confirm": {"other"}})
+	if page.Step != 0 || len(page.Errors["email"]) == 0 || len(page.Errors["confirm"]) == 0 || len(page.Errors) != 2 {
+		t.Fatalf("Expected account errors only, got step %d %v", page.Step, page.Errors)
+	}
+
+	page = run.submit(WizardNext, onboardingAccount)
+	if page.Step != 1 || page.Reached != 1 || len(page.Errors) != 0 {
+		t.Fatalf("Expected the profile step, got step %d %v", page.Step, page.Errors)
+	}
+	if f := page.Form.(*onboardingForm); f.Email != "ada@example.com" {
+		t.Errorf("Expected the page to hold sanitized earlier values, got %+v", f)
+	}
+
+	// Input for fields of other steps is ignored
+	page = run.submit(WizardNext, url.Values{"name": {"Ada"}, "address.city": {"London"}, "email": {"evil@example.com"}})
+	if page.Step != 2 || !page.Last() {
+		t.Fatalf("Expected the plan step, got step %d %v", page.Step, page.Errors)
+	}
+
+	// Back keeps the input of the step left, and step names jump to reached steps
+	page = run.submit(WizardBack, url.Values{"plan": {"pro"}})
+	if page.Step != 1 || page.Form.(*onboardingForm).Plan != "pro" {
+		t.Fatalf("Expected to go back with the plan kept, got step %d %+v", page.Step, page.Form)
+	}
+	page = run.submit("plan", url.Values{"name": {"Ada"}, "address.city": {"London"}})
+	if page.Step != 2 {
+		t.Fatalf("Expected to jump to the plan step, got %d", page.Step)
+	}
+
+	page = run.submit(WizardNext, url.Values{"plan": {"pro"}})
+	if page != nil {
+		t.Fatalf("Expected the wizard to complete, got step %d %v", page.Step, page.Errors)
+	}
+	expected := onboardingForm{Email: "ada@example.com", Password: "s3cret-pass", Confirm: "s3cret-pass", Name: "Ada", Address: onboardingAddress{City: "London"}, Plan: "pro"}
+	if run.completed == nil || *run.completed != expected {
+		t.Errorf("Expected the completed form %+v, got %+v", expected, run.completed)
+	}
+}
+
+func TestWizardNavigationLimits(t *testing.T) {
+	run, _ := newOnboardingRun(t, WizardOptions{})
+
+	if page := run.submit(WizardBack, nil); page.Step != 0 {
+		t.Errorf("Expected to stay on the first step, got %d", page.Step)
+	}
+	if page := run.submit("plan", nil); page.Step != 0 {
+		t.Errorf("Expected unreached steps to be out of reach, got %d", page.Step)
+	}
+}
+
+func TestWizardFinalValidation(t *testing.T) {
+	run, _ := newOnboardingRun(t, WizardOptions{})
+	run.submit(WizardNext, onboardingAccount)
+	run.submit(WizardNext, url.Values{"name": {"Ada"}, "address.city": {"London"}})
+
+	// Go back, clear the name and jump ahead without validating the step
+	run.submit(WizardBack, nil)
+	run.submit("plan", url.Values{"name": {""}, "address.city": {"London"}})
+
+	page := run.submit(WizardNext, url.Values{"plan": {"gold"}})
+	if run.completed != nil {
+		t.Fatal("Expected the invalid form not to complete")
+	}
+	if page.Step != 1 || len(page.Errors["name"]) == 0 || page.Errors["plan"] != nil {
+		t.Errorf("Expected the profile step with its errors only, got step %d %v", page.Step, page.Errors)
+	}
+}
+
+func TestWizardState(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		opts WizardOptions
+	}{
+		{"signed", WizardOptions{Secret: []byte("k")}},
+		{"encrypted", WizardOptions{Secret: []byte("k"), Encrypt: true}},
+		{"stored", WizardOptions{Secret: []byte("k"), Store: NewMemoryWizardStore()}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			run, wizard := newOnboardingRun(t, tc.opts)
+			page := run.submit(WizardNext, onboardingAccount)
+			if page.Step != 1 {
+				t.Fatalf("Expected the profile step, got %d %v", page.Step, page.Errors)
+			}
+			if tc.opts.Encrypt || tc.opts.Store != nil {
+				if strings.Contains(page.State, "s3cret") || strings.Contains(page.State, "czNjcmV0") {
+					t.Errorf("Expected the password not to be readable from the state, got %s", page.State)
+				}
+			}
+			if string(page.Fields()) != `<input type="hidden" name="_wizard" value="`+page.State+`">` {
+				t.Errorf("Expected a hidden state field, got %s", page.Fields())
+			}
+
+			// Tampered state restarts the wizard
+			state := page.State
+			run.page.State = state[:len(state)-2] + "xx"
+			page = run.submit(WizardNext, url.Values{"name": {"Ada"}})
+			if page.Step != 0 || page.Errors[FormErrorKey][0] != ErrWizardExpired {
+				t.Errorf("Expected tampered state to restart, got step %d %v", page.Step, page.Errors)
+			}
+
+			// So does state older than MaxAge
+			wizard.now = func() time.Time { return time.Now().Add(DefaultWizardMaxAge + time.Minute) }
+			run.page.State = state
+			page = run.submit(WizardNext, url.Values{"name": {"Ada"}})
+			if page.Step != 0 || len(page.Errors[FormErrorKey]) == 0 {
+				t.Errorf("Expected expired state to restart, got step %d %v", page.Step, page.Errors)
+			}
+		})
+	}
+}
+
+func TestWizardStoreCleanup(t *testing.T) {
+	store := NewMemoryWizardStore()
+	run, _ := newOnboardingRun(t, WizardOptions{Store: store})
+	run.handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/onboarding", nil))
+	if len(store.entries) != 0 || run.page.State != "" {
+		t.Errorf("Expected page views not to be saved, got %d entries and state %q", len(store.entries), run.page.State)
+	}
+	run.submit(WizardNext, onboardingAccount)
+	run.submit(WizardNext, url.Values{"name": {"Ada"}, "address.city": {"London"}})
+	if len(store.entries) != 1 {
+		t.Errorf("Expected one saved state per wizard, got %d", len(store.entries))
+	}
+	run.submit(WizardNext, url.Values{"plan": {"free"}})
+	if run.completed == nil || len(store.entries) != 0 {
+		t.Errorf("Expected completion to delete the state, got %d entries", len(store.entries))
+	}
+	if _, err := store.Load(context.Background(), "missing"); err == nil {
+		t.Error("Expected an error for missing state")
+	}
+}
+
+// failingDeleteStore is a WizardStore whose deletes fail
+type failingDeleteStore struct{ *MemoryWizardStore }
+
+func (failingDeleteStore) Delete(ctx context.Context, id string) error {
+	return errors.New("unavailable")
+}
+
+func TestWizardReportsDeleteFailures(t *testing.T) {
+	previous := observability.GetObserver()
+	obs := &storageObserver{Observer: previous}
+	observability.SetObserver(obs)
+	t.Cleanup(func() { observability.SetObserver(previous) })
+
+	run, _ := newOnboardingRun(t, WizardOptions{Store: failingDeleteStore{NewMemoryWizardStore()}})
+	run.submit(WizardNext, onboardingAccount)
+	run.submit(WizardNext, url.Values{"name": {"Ada"}, "address.city": {"London"}})
+	run.submit(WizardNext, url.Values{"plan": {"free"}})
+	if run.completed == nil {
+		t.Fatal("Expected the wizard to complete despite the failed delete")
+	}
+	if expected := []string{"wizard delete false"}; !reflect.DeepEqual(obs.operations, expected) {
+		t.Errorf("Expected %v, got %v", expected, obs.operations)
+	}
+}
+
+func TestNewWizardPanics(t *testing.T) {
+	for name, build := range map[string]func(){
+		"no steps":      func() { NewWizard(onboardingForm{}, WizardOptions{}) },
+		"unknown field": func() { NewWizard(onboardingForm{}, WizardOptions{}, WizardStep{Name: "x", Fields: []string{"phone"}}) },
+		"not a struct":  func() { NewWizard("form", WizardOptions{}, WizardStep{Name: "x"}) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("Expected a panic")
+				}
+			}()
+			build()
+		})
+	}
+}
@@ -634,6 +634,41 @@ func (t *Translator) ParseCurrency(formatted string) (float64, error) {
 	return t.ParseNumber(strings.TrimSpace(cleaned))
 }
 
+// ParseDate parses a date written in the locale's short, medium or long date format,
+// optionally followed by a time in its short or medium time format, as FormatDate and
+// FormatDateTime write them. The result is in UTC.
+func (t *Translator) ParseDate(formatted string) (time.Time, error) {
+	formatted = strings.TrimSpace(formatted)
+
+	dates := []string{"2006-01-02"}
+	times := []string{"15:04", "15:04:05"}
+	if t.locale != nil {
+		dates, times = nil, nil
+		for _, formatType := range []string{FormatShort, FormatMedium, FormatLong} {
+			dates = append(dates, t.getFormatWithFallback(formatType,
+				t.locale.DateFormat.Short, t.locale.DateFormat.Medium, t.locale.DateFormat.Long,
+				FallbackDateShort, FallbackDateMedium, FallbackDateLong))
+		}
+		for _, formatType := range []string{FormatShort, FormatMedium} {
+			times = append(times, t.getFormatWithFallback(formatType,
+				t.locale.TimeFormat.Short, t.locale.TimeFormat.Medium, t.locale.TimeFormat.Long,
+				FallbackTimeShort, FallbackTimeMedium, FallbackTimeLong))
+		}
+	}
+
+	for _, dateFormat := range dates {
+		if parsed, err := time.Parse(dateFormat, formatted); err == nil {
+			return parsed, nil
+		}
+		for _, timeFormat := range times {
+			if parsed, err := time.Parse(dateFormat+" "+timeFormat, formatted); err == nil {
+				return parsed, nil
+			}
+		}
+	}
+	return time.Time{}, fmt.Errorf("i18n: %q is not a date in the locale's formats", formatted)
+}
+
 // getFormatWithFallback returns the format string with appropriate fallback
 func (t *Translator) getFormatWithFallback(formatType string, short, medium, long string, fallbackShort, fallbackMedium, fallbackLong string) string {
 	var format string
@@ -671,6 +671,36 @@ func TestAdvancedFormatting(t *testing.T) {
 	})
 }
 
+func TestParseDate(t *testing.T) {
+	manager := NewManagerEmpty()
+	manager.AddLocale("en", map[string]interface{}{})
+	manager.AddLocale("de", map[string]interface{}{})
+	manager.SetDefaultFormats()
+
+	tests := []struct {
+		locale, input string
+		expected      time.Time
+	}{
+		{"de", "31.12.2025", time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"de", "2. Januar 2006", time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{"de", "31.12.2025 18:30", time.Date(2025, 12, 31, 18, 30, 0, 0, time.UTC)},
+		{"en", "12/31/2025", time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"en", " Dec 31, 2025 ", time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"en", "12/31/2025 6:30 PM", time.Date(2025, 12, 31, 18, 30, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		translator := manager.Translator(createRequest(tt.locale))
+		parsed, err := translator.ParseDate(tt.input)
+		if err != nil || !parsed.Equal(tt.expected) {
+			t.Errorf("ParseDate(%q) in %s = %v, %v; expected %v", tt.input, tt.locale, parsed, err, tt.expected)
+		}
+	}
+
+	if _, err := manager.Translator(createRequest("de")).ParseDate("12/31/2025"); err == nil {
+		t.Error("Expected an error for a date in another locale's format")
+	}
+}
+
 func TestFormattingWithNilLocale(t *testing.T) {
 	// Test formatting when locale is nil (should use fallbacks)
 	manager := &Manager{